@@ -2,6 +2,7 @@ package analysis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -11,11 +12,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-rollouts/metricproviders"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 )
 
 const (
@@ -27,6 +30,24 @@ const (
 	DefaultErrorRetryInterval time.Duration = 10 * time.Second
 )
 
+var (
+	measurementHistoryLimitMutex sync.RWMutex
+	measurementHistoryLimit      = DefaultMeasurementHistoryLimit
+)
+
+// SetMeasurementHistoryLimit sets the maximum number of measurements to retain per metric
+func SetMeasurementHistoryLimit(limit int) {
+	measurementHistoryLimitMutex.Lock()
+	defer measurementHistoryLimitMutex.Unlock()
+	measurementHistoryLimit = limit
+}
+
+func getMeasurementHistoryLimit() int {
+	measurementHistoryLimitMutex.RLock()
+	defer measurementHistoryLimitMutex.RUnlock()
+	return measurementHistoryLimit
+}
+
 // metricTask holds the metric which need to be measured during this reconciliation along with
 // an in-progress measurement
 type metricTask struct {
@@ -34,7 +55,10 @@ type metricTask struct {
 	incompleteMeasurement *v1alpha1.Measurement
 }
 
-func (c *Controller) reconcileAnalysisRun(origRun *v1alpha1.AnalysisRun) *v1alpha1.AnalysisRun {
+func (c *Controller) reconcileAnalysisRun(ctx context.Context, origRun *v1alpha1.AnalysisRun) *v1alpha1.AnalysisRun {
+	if origRun.Status.Phase == v1alpha1.AnalysisPhaseInconclusive && origRun.Spec.Judgment != nil {
+		return c.applyJudgment(origRun)
+	}
 	if origRun.Status.Phase.Completed() {
 		return origRun
 	}
@@ -56,7 +80,7 @@ func (c *Controller) reconcileAnalysisRun(origRun *v1alpha1.AnalysisRun) *v1alph
 
 	tasks := generateMetricTasks(run)
 	log.Infof("taking %d measurements", len(tasks))
-	err := c.runMeasurements(run, tasks)
+	err := c.runMeasurements(ctx, run, tasks)
 	if err != nil {
 		message := fmt.Sprintf("unable to resolve metric arguments: %v", err)
 		log.Warn(message)
@@ -75,7 +99,7 @@ func (c *Controller) reconcileAnalysisRun(origRun *v1alpha1.AnalysisRun) *v1alph
 		}
 	}
 
-	err = c.garbageCollectMeasurements(run, DefaultMeasurementHistoryLimit)
+	err = c.garbageCollectMeasurements(run, getMeasurementHistoryLimit())
 	if err != nil {
 		// TODO(jessesuen): surface errors to controller so they can be retried
 		log.Warnf("Failed to garbage collect measurements: %v", err)
@@ -102,6 +126,37 @@ func (c *Controller) recordAnalysisRunCompletionEvent(run *v1alpha1.AnalysisRun)
 	c.recorder.Eventf(run, record.EventOptions{EventType: eventType, EventReason: "AnalysisRun" + string(run.Status.Phase)}, "analysis completed %s", run.Status.Phase)
 }
 
+// applyJudgment concludes an Inconclusive run with the phase of its manual judgment
+func (c *Controller) applyJudgment(origRun *v1alpha1.AnalysisRun) *v1alpha1.AnalysisRun {
+	log := logutil.WithAnalysisRun(origRun)
+	judgment := origRun.Spec.Judgment
+	switch judgment.Phase {
+	case v1alpha1.AnalysisPhaseSuccessful, v1alpha1.AnalysisPhaseFailed:
+	default:
+		log.Warnf("Ignoring judgment with invalid phase '%s'", judgment.Phase)
+		return origRun
+	}
+	run := origRun.DeepCopy()
+	user := judgment.User
+	if user == "" {
+		user = "unknown user"
+	}
+	message := fmt.Sprintf("analysis judged %s by %s", judgment.Phase, user)
+	if judgment.Reason != "" {
+		message = fmt.Sprintf("%s: %s", message, judgment.Reason)
+	}
+	log.Info(message)
+	run.Status.Phase = judgment.Phase
+	run.Status.Message = message
+
+	eventType := corev1.EventTypeNormal
+	if judgment.Phase == v1alpha1.AnalysisPhaseFailed {
+		eventType = corev1.EventTypeWarning
+	}
+	c.recorder.Eventf(run, record.EventOptions{EventType: eventType, EventReason: "AnalysisRunJudged"}, message)
+	return run
+}
+
 // generateMetricTasks generates a list of metrics tasks needed to be measured as part of this
 // sync, based on the last completion times that metric was measured (if ever). If the run is
 // terminating (e.g. due to manual termination or failing metric), will not schedule further
@@ -207,6 +262,22 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 			resolvedArg := arg.DeepCopy()
 			resolvedArg.Value = &secretContent
 			args[i] = *resolvedArg
+		} else if arg.ValueFrom != nil && arg.ValueFrom.ConfigMapKeyRef != nil {
+			//if configmap specified in valueFrom, replace value with configmap value
+			name := arg.ValueFrom.ConfigMapKeyRef.Name
+			configMap, err := c.kubeclientset.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			configMapContent, ok := configMap.Data[arg.ValueFrom.ConfigMapKeyRef.Key]
+			if !ok {
+				err := fmt.Errorf("key '%s' does not exist in configmap '%s'", arg.ValueFrom.ConfigMapKeyRef.Key, arg.ValueFrom.ConfigMapKeyRef.Name)
+				return nil, nil, err
+			}
+			resolvedArg := arg.DeepCopy()
+			resolvedArg.Value = &configMapContent
+			args[i] = *resolvedArg
 		} else {
 			args[i] = arg
 		}
@@ -231,7 +302,7 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 }
 
 // runMeasurements iterates a list of metric tasks, and runs, resumes, or terminates measurements
-func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTask) error {
+func (c *Controller) runMeasurements(ctx context.Context, run *v1alpha1.AnalysisRun, tasks []metricTask) error {
 	var wg sync.WaitGroup
 	// resultsLock should be held whenever we are accessing or setting status.metricResults since
 	// we are performing queries in parallel
@@ -255,6 +326,9 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 
 			resultsLock.Lock()
 			metricResult := analysisutil.GetResult(run, t.metric.Name)
+			// providers may inspect the measurement history of the run while other measurements
+			// are being recorded, so give them a snapshot of the run
+			runSnapshot := run.DeepCopy()
 			resultsLock.Unlock()
 
 			if metricResult == nil {
@@ -276,20 +350,36 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 				newMeasurement.Phase = v1alpha1.AnalysisPhaseError
 				newMeasurement.Message = err.Error()
 			} else {
+				spanName := "metricprovider.Run"
+				if t.incompleteMeasurement != nil {
+					spanName = "metricprovider.Resume"
+					if terminating {
+						spanName = "metricprovider.Terminate"
+					}
+				}
+				_, span := tracing.StartSpan(ctx, spanName, append(tracing.AnalysisRunAttributes(run),
+					tracing.MetricKey.String(t.metric.Name),
+					tracing.MetricProviderKey.String(metricproviders.Type(t.metric)))...)
 				if t.incompleteMeasurement == nil {
-					newMeasurement = provider.Run(run, t.metric)
+					newMeasurement = provider.Run(runSnapshot, t.metric)
 				} else {
 					// metric is incomplete. either terminate or resume it
 					if terminating {
 						log.Infof("terminating in-progress measurement")
-						newMeasurement = provider.Terminate(run, t.metric, *t.incompleteMeasurement)
+						newMeasurement = provider.Terminate(runSnapshot, t.metric, *t.incompleteMeasurement)
 						if newMeasurement.Phase == v1alpha1.AnalysisPhaseSuccessful {
 							newMeasurement.Message = "metric terminated"
 						}
 					} else {
-						newMeasurement = provider.Resume(run, t.metric, *t.incompleteMeasurement)
+						newMeasurement = provider.Resume(runSnapshot, t.metric, *t.incompleteMeasurement)
 					}
 				}
+				var measurementErr error
+				if newMeasurement.Phase == v1alpha1.AnalysisPhaseError {
+					measurementErr = errors.New(newMeasurement.Message)
+				}
+				span.SetAttributes(tracing.MeasurementPhaseKey.String(string(newMeasurement.Phase)))
+				tracing.EndSpan(span, measurementErr)
 			}
 
 			if newMeasurement.Phase.Completed() {
@@ -886,7 +886,7 @@ func TestReconcileAnalysisRunInitial(t *testing.T) {
 	}
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
 	{
-		newRun := c.reconcileAnalysisRun(run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), run)
 		assert.Equal(t, v1alpha1.AnalysisPhaseRunning, newRun.Status.MetricResults[0].Phase)
 		assert.Equal(t, v1alpha1.AnalysisPhaseRunning, newRun.Status.Phase)
 		assert.Equal(t, 1, len(newRun.Status.MetricResults[0].Measurements))
@@ -896,7 +896,7 @@ func TestReconcileAnalysisRunInitial(t *testing.T) {
 		// now set count to one and run should be completed immediately
 		newCount := intstr.FromInt(1)
 		run.Spec.Metrics[0].Count = &newCount
-		newRun := c.reconcileAnalysisRun(run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), run)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.MetricResults[0].Phase)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 		assert.Equal(t, 1, len(newRun.Status.MetricResults[0].Measurements))
@@ -907,7 +907,7 @@ func TestReconcileAnalysisRunInitial(t *testing.T) {
 		count := intstr.FromInt(0)
 		run.Spec.Metrics[0].Count = &count
 		run.Spec.Metrics[0].Interval = ""
-		newRun := c.reconcileAnalysisRun(run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), run)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.MetricResults[0].Phase)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 		assert.Equal(t, 1, len(newRun.Status.MetricResults[0].Measurements))
@@ -926,7 +926,7 @@ func TestReconcileAnalysisRunInvalid(t *testing.T) {
 			}},
 		},
 	}
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, newRun.Status.Phase)
 }
 
@@ -942,7 +942,7 @@ func TestReconcileAnalysisRunTerminateSiblingAfterFail(t *testing.T) {
 
 	for _, status := range []v1alpha1.AnalysisPhase{v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseInconclusive, v1alpha1.AnalysisPhaseError} {
 		run := newTerminatingRun(status)
-		newRun := c.reconcileAnalysisRun(run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), run)
 
 		assert.Equal(t, status, newRun.Status.Phase)
 		assert.Equal(t, status, newRun.Status.MetricResults[1].Phase)
@@ -985,7 +985,7 @@ func TestReconcileAnalysisRunResumeInProgress(t *testing.T) {
 	// mocks resume to complete the in-progress measurement
 	f.provider.On("Resume", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
 
-	newRun := c.reconcileAnalysisRun(&run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), &run)
 
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.MetricResults[0].Phase)
@@ -1024,7 +1024,7 @@ func TestRunMeasurementsResetConsecutiveErrorCounter(t *testing.T) {
 		}
 		f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(status), nil)
 
-		newRun := c.reconcileAnalysisRun(&run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), &run)
 		if status == v1alpha1.AnalysisPhaseError {
 			assert.Equal(t, int32(5), newRun.Status.MetricResults[0].ConsecutiveError)
 			assert.Equal(t, int32(5), newRun.Status.MetricResults[0].Error)
@@ -1082,7 +1082,7 @@ func TestResolveMetricArgsUnableToSubstitute(t *testing.T) {
 			}},
 		},
 	}
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, newRun.Status.Phase, v1alpha1.AnalysisPhaseError)
 	assert.Equal(t, newRun.Status.Message, "unable to resolve metric arguments: failed to resolve {{args.metric-name}}")
 }
@@ -1131,7 +1131,7 @@ func TestSecretContentReferenceSuccess(t *testing.T) {
 		},
 	}
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 }
 
@@ -1195,7 +1195,7 @@ func TestSecretContentReferenceProviderError(t *testing.T) {
 	measurement.Message = error.Error()
 
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(measurement)
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	logMessage := buf.String()
 
 	assert.Equal(t, expectedValue, newRun.Status.MetricResults[0].Measurements[0].Message)
@@ -1256,7 +1256,7 @@ func TestSecretContentReferenceAndMultipleArgResolutionSuccess(t *testing.T) {
 	}
 
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 }
 
@@ -1355,6 +1355,51 @@ func TestSecretResolution(t *testing.T) {
 	assert.Contains(t, secretList, secretData)
 }
 
+func TestConfigMapResolution(t *testing.T) {
+	f := newFixture(t)
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "thresholds",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Data: map[string]string{
+			"success-rate": "0.95",
+		},
+	}
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	f.kubeclient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Create(context.TODO(), configMap, metav1.CreateOptions{})
+
+	args := []v1alpha1.Argument{{
+		Name: "success-rate",
+		ValueFrom: &v1alpha1.ValueFrom{
+			ConfigMapKeyRef: &v1alpha1.ConfigMapKeyRef{
+				Name: "thresholds",
+				Key:  "success-rate",
+			},
+		},
+	}}
+	tasks := []metricTask{{
+		metric: v1alpha1.Metric{
+			Name:             "metric-name",
+			SuccessCondition: "result >= {{args.success-rate}}",
+		},
+		incompleteMeasurement: nil,
+	}}
+	metricTaskList, secretList, err := c.resolveArgs(tasks, args, metav1.NamespaceDefault)
+	assert.NoError(t, err)
+	assert.Equal(t, "result >= 0.95", metricTaskList[0].metric.SuccessCondition)
+	assert.Empty(t, secretList)
+
+	args[0].ValueFrom.ConfigMapKeyRef.Key = "key-name"
+	_, _, err = c.resolveArgs(tasks, args, metav1.NamespaceDefault)
+	assert.EqualError(t, err, "key 'key-name' does not exist in configmap 'thresholds'")
+
+	args[0].ValueFrom.ConfigMapKeyRef.Name = "does-not-exist"
+	_, _, err = c.resolveArgs(tasks, args, metav1.NamespaceDefault)
+	assert.EqualError(t, err, "configmaps \"does-not-exist\" not found")
+}
+
 // TestAssessMetricFailureInconclusiveOrError verifies that assessMetricFailureInconclusiveOrError returns the correct phases and messages
 // for Failed, Inconclusive, and Error metrics respectively
 func TestAssessMetricFailureInconclusiveOrError(t *testing.T) {
@@ -1456,7 +1501,7 @@ func TestAssessRunStatusWorstMessageInReconcileAnalysisRun(t *testing.T) {
 
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseFailed), nil)
 
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, newRun.Status.Phase)
 	assert.Equal(t, "metric \"run-forever\" assessed Failed due to failed (1) > failureLimit (0)", newRun.Status.Message)
 }
@@ -1493,7 +1538,39 @@ func TestTerminateAnalysisRun(t *testing.T) {
 			Phase:     v1alpha1.AnalysisPhaseRunning,
 		},
 	}
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 	assert.Equal(t, "run terminated", newRun.Status.Message)
 }
+
+func TestReconcileAnalysisRunJudgment(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	run := newRun()
+	run.Status.Phase = v1alpha1.AnalysisPhaseInconclusive
+	run.Spec.Judgment = &v1alpha1.AnalysisRunJudgment{
+		Phase:  v1alpha1.AnalysisPhaseFailed,
+		Reason: "latency regression",
+		User:   "alice",
+	}
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, newRun.Status.Phase)
+	assert.Equal(t, "analysis judged Failed by alice: latency regression", newRun.Status.Message)
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, run.Status.Phase)
+}
+
+func TestReconcileAnalysisRunJudgmentInvalidPhase(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	run := newRun()
+	run.Status.Phase = v1alpha1.AnalysisPhaseInconclusive
+	run.Spec.Judgment = &v1alpha1.AnalysisRunJudgment{
+		Phase: v1alpha1.AnalysisPhaseRunning,
+	}
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, newRun.Status.Phase)
+}
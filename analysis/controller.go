@@ -1,11 +1,13 @@
 package analysis
 
 import (
+	"context"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	batchv1 "k8s.io/api/batch/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	batchinformers "k8s.io/client-go/informers/batch/v1"
 	"k8s.io/client-go/kubernetes"
@@ -22,6 +24,8 @@ import (
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/shard"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 )
 
 // Controller is the controller implementation for Analysis resources
@@ -39,6 +43,9 @@ type Controller struct {
 
 	metricsServer *metrics.MetricsServer
 
+	// sharder decides which analysis runs are reconciled by this replica of the controller
+	sharder *shard.Sharder
+
 	newProvider func(logCtx log.Entry, metric v1alpha1.Metric) (metricproviders.Provider, error)
 
 	// used for unit testing
@@ -67,6 +74,7 @@ type ControllerConfig struct {
 	AnalysisRunWorkQueue workqueue.RateLimitingInterface
 	MetricsServer        *metrics.MetricsServer
 	Recorder             record.EventRecorder
+	Sharder              *shard.Sharder
 }
 
 // NewController returns a new analysis controller
@@ -77,6 +85,7 @@ func NewController(cfg ControllerConfig) *Controller {
 		argoProjClientset:    cfg.ArgoProjClientset,
 		analysisRunLister:    cfg.AnalysisRunInformer.Lister(),
 		metricsServer:        cfg.MetricsServer,
+		sharder:              cfg.Sharder,
 		analysisRunWorkQueue: cfg.AnalysisRunWorkQueue,
 		jobInformer:          cfg.JobInformer,
 		analysisRunSynced:    cfg.AnalysisRunInformer.Informer().HasSynced,
@@ -135,7 +144,7 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
 	return nil
 }
 
-func (c *Controller) syncHandler(key string) error {
+func (c *Controller) syncHandler(key string) (err error) {
 	startTime := time.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -150,12 +159,18 @@ func (c *Controller) syncHandler(key string) error {
 	if err != nil {
 		return err
 	}
+	if !c.sharder.Owns(run) {
+		logutil.WithAnalysisRun(run).Debug("Skipping analysis run owned by another shard")
+		return nil
+	}
 
+	ctx, span := tracing.StartSpan(context.TODO(), "analysisrun.reconcile", tracing.AnalysisRunAttributes(run)...)
 	defer func() {
 		duration := time.Since(startTime)
 		c.metricsServer.IncAnalysisRunReconcile(run, duration)
 		logCtx := logutil.WithAnalysisRun(run).WithField("time_ms", duration.Seconds()*1e3)
 		logCtx.Info("Reconciliation completed")
+		tracing.EndSpan(span, err)
 	}()
 
 	if run.DeletionTimestamp != nil {
@@ -163,8 +178,24 @@ func (c *Controller) syncHandler(key string) error {
 		return nil
 	}
 
-	newRun := c.reconcileAnalysisRun(run)
-	return c.persistAnalysisRunStatus(run, newRun.Status)
+	newRun := c.reconcileAnalysisRun(ctx, run)
+	err = c.persistAnalysisRunStatus(ctx, run, newRun.Status)
+	if err == nil {
+		c.observeRolloutAnalysis(run, newRun)
+	}
+	return err
+}
+
+// observeRolloutAnalysis measures the time spent in an analysis run of a rollout once the run completes
+func (c *Controller) observeRolloutAnalysis(run, newRun *v1alpha1.AnalysisRun) {
+	if run.Status.Phase.Completed() || !newRun.Status.Phase.Completed() || newRun.Status.StartedAt == nil {
+		return
+	}
+	controllerRef := metav1.GetControllerOf(run)
+	if controllerRef == nil || controllerRef.Kind != register.RolloutKind {
+		return
+	}
+	c.metricsServer.ObserveRolloutAnalysis(newRun, controllerRef.Name, time.Since(newRun.Status.StartedAt.Time))
 }
 
 func (c *Controller) enqueueIfCompleted(obj interface{}) {
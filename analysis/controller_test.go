@@ -8,6 +8,8 @@ import (
 
 	"github.com/argoproj/argo-rollouts/utils/queue"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/undefinedlabs/go-mpatch"
@@ -21,6 +23,7 @@ import (
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/metricproviders"
@@ -311,3 +314,42 @@ func TestNoReconcileForAnalysisRunWithDeletionTimestamp(t *testing.T) {
 
 	f.run(getKey(ar, t))
 }
+
+func TestObserveRolloutAnalysis(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	startedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	run := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook-6c54544bf9-2-1",
+			Namespace: metav1.NamespaceDefault,
+			Labels:    map[string]string{v1alpha1.RolloutTypeLabel: v1alpha1.RolloutTypeStepLabel},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "argoproj.io/v1alpha1",
+				Kind:       "Rollout",
+				Name:       "observe-analysis",
+				Controller: pointer.BoolPtr(true),
+			}},
+		},
+		Status: v1alpha1.AnalysisRunStatus{Phase: v1alpha1.AnalysisPhaseRunning, StartedAt: &startedAt},
+	}
+	newRun := run.DeepCopy()
+	newRun.Status.Phase = v1alpha1.AnalysisPhaseFailed
+	histogram := metrics.MetricRolloutAnalysisDuration.WithLabelValues(metav1.NamespaceDefault, "observe-analysis", v1alpha1.RolloutTypeStepLabel, string(v1alpha1.AnalysisPhaseFailed))
+	samples := func() *dto.Histogram {
+		metric := &dto.Metric{}
+		assert.NoError(t, histogram.(prometheus.Histogram).Write(metric))
+		return metric.GetHistogram()
+	}
+
+	c.observeRolloutAnalysis(run, run)
+	assert.Equal(t, uint64(0), samples().GetSampleCount())
+	c.observeRolloutAnalysis(run, newRun)
+	assert.Equal(t, uint64(1), samples().GetSampleCount())
+	assert.InDelta(t, 60, samples().GetSampleSum(), 5)
+	// completed runs are only measured once
+	c.observeRolloutAnalysis(newRun, newRun)
+	assert.Equal(t, uint64(1), samples().GetSampleCount())
+}
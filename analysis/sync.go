@@ -11,8 +11,7 @@ import (
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 )
 
-func (c *Controller) persistAnalysisRunStatus(orig *v1alpha1.AnalysisRun, newStatus v1alpha1.AnalysisRunStatus) error {
-	ctx := context.TODO()
+func (c *Controller) persistAnalysisRunStatus(ctx context.Context, orig *v1alpha1.AnalysisRun, newStatus v1alpha1.AnalysisRunStatus) error {
 	logCtx := logutil.WithAnalysisRun(orig)
 	patch, modified, err := diff.CreateTwoWayMergePatch(
 		&v1alpha1.AnalysisRun{
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -19,26 +22,50 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
 	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/argoproj/argo-rollouts/analysis"
 	"github.com/argoproj/argo-rollouts/controller"
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	jobprovider "github.com/argoproj/argo-rollouts/metricproviders/job"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-rollouts/pkg/signals"
-	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/alb"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
+	configutil "github.com/argoproj/argo-rollouts/utils/config"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	hookutil "github.com/argoproj/argo-rollouts/utils/hook"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/shard"
 	"github.com/argoproj/argo-rollouts/utils/tolerantinformer"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 	"github.com/argoproj/argo-rollouts/utils/version"
+	"github.com/argoproj/argo-rollouts/webhook"
 	"github.com/argoproj/pkg/kubeclientmetrics"
 )
 
 const (
 	// CLIName is the name of the CLI
 	cliName = "argo-rollouts"
+
+	// defaultShardGroup is the name of the shard group of the controller replicas
+	defaultShardGroup = "argo-rollouts-shard"
+	// defaultShardLeaseDuration is the default duration after which the objects of a dead replica are rebalanced
+	defaultShardLeaseDuration = 15 * time.Second
+	// defaultShardRenewPeriod is the default interval at which a replica renews its shard lease
+	defaultShardRenewPeriod = 5 * time.Second
+	// defaultShardHandoffDelay is the default duration a replica waits before it reconciles the objects which moved to it
+	defaultShardHandoffDelay = 10 * time.Second
+
+	// defaultWebhookPort is the default port of the validating webhook server
+	defaultWebhookPort = 8443
+	// defaultWebhookName is the name of the ValidatingWebhookConfiguration of the controller
+	defaultWebhookName = "argo-rollouts-validating-webhook"
+	// webhookServiceName is the name of the Service which exposes the webhook server to the API server
+	webhookServiceName = "argo-rollouts-webhook"
+	// webhookSecretName is the name of the Secret which holds the certificate of the webhook server
+	webhookSecretName = "argo-rollouts-webhook-tls"
 )
 
 func newCommand() *cobra.Command {
@@ -60,8 +87,19 @@ func newCommand() *cobra.Command {
 		albIngressClasses   []string
 		nginxIngressClasses []string
 		albVerifyWeight     bool
+		approvalURLPrefixes []string
 		namespaced          bool
 		printVersion        bool
+		electOpts           = controller.NewLeaderElectionOptions()
+		sharding            bool
+		shardBy             string
+		shardLeaseDuration  time.Duration
+		shardRenewPeriod    time.Duration
+		shardHandoffDelay   time.Duration
+		webhookEnabled      bool
+		webhookPort         int
+		webhookFailPolicy   string
+		tracingOpts         tracing.Options
 	)
 	var command = cobra.Command{
 		Use:   cliName,
@@ -82,13 +120,22 @@ func newCommand() *cobra.Command {
 			// set up signals so we handle the first shutdown signal gracefully
 			stopCh := signals.SetupSignalHandler()
 
-			alb.SetDefaultVerifyWeight(albVerifyWeight)
 			istioutil.SetIstioAPIVersion(istioVersion)
 			ambassador.SetAPIVersion(ambassadorVersion)
 			smi.SetSMIAPIVersion(trafficSplitVersion)
 
 			config, err := clientConfig.ClientConfig()
 			checkError(err)
+			shutdownTracing, err := tracing.Init(tracingOpts)
+			checkError(err)
+			defer func() {
+				if err := shutdownTracing(context.Background()); err != nil {
+					log.Errorf("Failed to flush traces: %v", err)
+				}
+			}()
+			if tracingOpts.Address != "" {
+				tracing.AddTracingTransportWrapper(config)
+			}
 			namespace := metav1.NamespaceAll
 			configNS, _, err := clientConfig.Namespace()
 			checkError(err)
@@ -122,10 +169,17 @@ func newCommand() *cobra.Command {
 				kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
 					options.LabelSelector = jobprovider.AnalysisRunUIDLabelKey
 				}))
+			hookJobInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(
+				kubeClient,
+				resyncDuration,
+				kubeinformers.WithNamespace(namespace),
+				kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+					options.LabelSelector = hookutil.HookNameLabelKey
+				}))
 			// We need three dynamic informer factories:
 			// 1. The first is the dynamic informer for rollouts, analysisruns, analysistemplates, experiments
 			dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, resyncDuration, namespace, instanceIDTweakListFunc)
-			// 2. The second is for the clusteranalysistemplate. Notice we must instantiate this with
+			// 2. The second is for the clusteranalysistemplate and clusterrolloutfreeze. Notice we must instantiate this with
 			// metav1.NamespaceAll. The reason why we need a cluster specific dynamic informer factory
 			// is to support the mode when the rollout controller is started and only operating against
 			// a single namespace (i.e. rollouts-controller --namespace foo).
@@ -144,6 +198,61 @@ func newCommand() *cobra.Command {
 			configMapInformer := controllerNamespaceInformerFactory.Core().V1().ConfigMaps()
 			secretInformer := controllerNamespaceInformerFactory.Core().V1().Secrets()
 
+			var sharder *shard.Sharder
+			if sharding {
+				if shardBy != shard.ShardByNamespace && shardBy != shard.ShardByRollout {
+					log.Fatalf("Invalid --shard-by '%s': must be one of: %s|%s", shardBy, shard.ShardByNamespace, shard.ShardByRollout)
+				}
+				hostname, err := os.Hostname()
+				checkError(err)
+				group := defaultShardGroup
+				if instanceID != "" {
+					group = group + "-" + instanceID
+				}
+				sharder = shard.NewSharder(kubeClient, shard.Options{
+					ShardBy:       shardBy,
+					Group:         group,
+					Namespace:     defaults.Namespace(),
+					Identity:      strings.ToLower(hostname),
+					LeaseDuration: shardLeaseDuration,
+					RenewPeriod:   shardRenewPeriod,
+					HandoffDelay:  shardHandoffDelay,
+				})
+			}
+
+			var webhookConfig *webhook.ServerConfig
+			if webhookEnabled {
+				if namespaced {
+					log.Fatal("The validating webhook is not supported in namespaced mode")
+				}
+				failurePolicy := admissionregistrationv1.FailurePolicyType(webhookFailPolicy)
+				if failurePolicy != admissionregistrationv1.Ignore && failurePolicy != admissionregistrationv1.Fail {
+					log.Fatalf("Invalid --webhook-failure-policy '%s': must be one of: %s|%s", webhookFailPolicy, admissionregistrationv1.Ignore, admissionregistrationv1.Fail)
+				}
+				webhookName := defaultWebhookName
+				objectSelector := &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      v1alpha1.LabelKeyControllerInstanceID,
+						Operator: metav1.LabelSelectorOpDoesNotExist,
+					}},
+				}
+				if instanceID != "" {
+					webhookName = webhookName + "-" + instanceID
+					objectSelector = &metav1.LabelSelector{
+						MatchLabels: map[string]string{v1alpha1.LabelKeyControllerInstanceID: instanceID},
+					}
+				}
+				webhookConfig = &webhook.ServerConfig{
+					Addr:           fmt.Sprintf("0.0.0.0:%d", webhookPort),
+					Namespace:      defaults.Namespace(),
+					ServiceName:    webhookServiceName,
+					SecretName:     webhookSecretName,
+					WebhookName:    webhookName,
+					ObjectSelector: objectSelector,
+					FailurePolicy:  failurePolicy,
+				}
+			}
+
 			k8sRequestProvider := &metrics.K8sRequestsCountProvider{}
 			kubeclientmetrics.AddMetricsTransportWrapper(config, k8sRequestProvider.IncKubernetesRequest)
 
@@ -156,13 +265,17 @@ func newCommand() *cobra.Command {
 				discoveryClient,
 				kubeInformerFactory.Apps().V1().ReplicaSets(),
 				kubeInformerFactory.Core().V1().Services(),
+				kubeInformerFactory.Core().V1().Secrets(),
 				kubeInformerFactory.Extensions().V1beta1().Ingresses(),
 				jobInformerFactory.Batch().V1().Jobs(),
+				hookJobInformerFactory.Batch().V1().Jobs(),
 				tolerantinformer.NewTolerantRolloutInformer(dynamicInformerFactory),
 				tolerantinformer.NewTolerantExperimentInformer(dynamicInformerFactory),
 				tolerantinformer.NewTolerantAnalysisRunInformer(dynamicInformerFactory),
 				tolerantinformer.NewTolerantAnalysisTemplateInformer(dynamicInformerFactory),
 				tolerantinformer.NewTolerantClusterAnalysisTemplateInformer(clusterDynamicInformerFactory),
+				tolerantinformer.NewTolerantRolloutFreezeInformer(dynamicInformerFactory),
+				tolerantinformer.NewTolerantClusterRolloutFreezeInformer(clusterDynamicInformerFactory),
 				istioPrimaryDynamicClient,
 				istioDynamicInformerFactory.ForResource(istioutil.GetIstioVirtualServiceGVR()).Informer(),
 				istioDynamicInformerFactory.ForResource(istioutil.GetIstioDestinationRuleGVR()).Informer(),
@@ -172,8 +285,16 @@ func newCommand() *cobra.Command {
 				instanceID,
 				metricsPort,
 				k8sRequestProvider,
-				nginxIngressClasses,
-				albIngressClasses)
+				configutil.Config{
+					ProgressDeadlineSeconds: defaults.DefaultProgressDeadlineSeconds,
+					MeasurementHistoryLimit: analysis.DefaultMeasurementHistoryLimit,
+					ALBVerifyWeight:         albVerifyWeight,
+					ALBIngressClasses:       albIngressClasses,
+					NGINXIngressClasses:     nginxIngressClasses,
+					ApprovalURLPrefixes:     approvalURLPrefixes,
+				},
+				sharder,
+				webhookConfig)
 			// notice that there is no need to run Start methods in a separate goroutine. (i.e. go kubeInformerFactory.Start(stopCh)
 			// Start method is non-blocking and runs all registered informers in a dedicated goroutine.
 			dynamicInformerFactory.Start(stopCh)
@@ -183,13 +304,14 @@ func newCommand() *cobra.Command {
 			kubeInformerFactory.Start(stopCh)
 			controllerNamespaceInformerFactory.Start(stopCh)
 			jobInformerFactory.Start(stopCh)
+			hookJobInformerFactory.Start(stopCh)
 
 			// Check if Istio installed on cluster before starting dynamicInformerFactory
 			if istioutil.DoesIstioExist(istioPrimaryDynamicClient, namespace) {
 				istioDynamicInformerFactory.Start(stopCh)
 			}
 
-			if err = cm.Run(rolloutThreads, serviceThreads, ingressThreads, experimentThreads, analysisThreads, stopCh); err != nil {
+			if err = cm.Run(rolloutThreads, serviceThreads, ingressThreads, experimentThreads, analysisThreads, electOpts, stopCh); err != nil {
 				log.Fatalf("Error running controller: %s", err.Error())
 			}
 			return nil
@@ -217,7 +339,24 @@ func newCommand() *cobra.Command {
 	command.Flags().StringArrayVar(&albIngressClasses, "alb-ingress-classes", defaultALBIngressClass, "Defines all the ingress class annotations that the alb ingress controller operates on. Defaults to alb")
 	command.Flags().StringArrayVar(&nginxIngressClasses, "nginx-ingress-classes", defaultNGINXIngressClass, "Defines all the ingress class annotations that the nginx ingress controller operates on. Defaults to nginx")
 	command.Flags().BoolVar(&albVerifyWeight, "alb-verify-weight", false, "Verify ALB target group weights before progressing through steps (requires AWS privileges)")
+	command.Flags().StringArrayVar(&approvalURLPrefixes, "approval-url-prefixes", nil, "Restricts the endpoints of approval steps to the urls which match one of the prefixes. Any http(s) url is allowed by default, but approval headers are only read from secrets if prefixes are set")
 	command.Flags().BoolVar(&printVersion, "version", false, "Print version")
+	command.Flags().BoolVar(&electOpts.LeaderElect, "leader-elect", controller.DefaultLeaderElect, "If true, controller will perform leader election between instances to ensure no more than one instance of controller operates at a time")
+	command.Flags().StringVar(&electOpts.LeaderElectionNamespace, "leader-election-namespace", electOpts.LeaderElectionNamespace, "Namespace of the Lease which is used for leader election. Defaults to the namespace of the controller")
+	command.Flags().DurationVar(&electOpts.LeaderElectionLeaseDuration, "leader-election-lease-duration", controller.DefaultLeaderElectionLeaseDuration, "The duration that non-leader candidates will wait after observing a leadership renewal until attempting to acquire leadership of a led but unrenewed leader slot. This is effectively the maximum duration that a leader can be stopped before it is replaced by another candidate")
+	command.Flags().DurationVar(&electOpts.LeaderElectionRenewDeadline, "leader-election-renew-deadline", controller.DefaultLeaderElectionRenewDeadline, "The interval between attempts by the acting master to renew a leadership slot before it stops leading. This must be less than or equal to the lease duration")
+	command.Flags().DurationVar(&electOpts.LeaderElectionRetryPeriod, "leader-election-retry-period", controller.DefaultLeaderElectionRetryPeriod, "The duration the clients should wait between attempting acquisition and renewal of a leadership")
+	command.Flags().BoolVar(&sharding, "sharding", false, "If true, the replicas of the controller split the Rollouts, Experiments and AnalysisRuns between them instead of electing a leader")
+	command.Flags().StringVar(&shardBy, "shard-by", shard.ShardByRollout, "How objects are assigned to the replicas when sharding is enabled. One of: namespace|rollout")
+	command.Flags().DurationVar(&shardLeaseDuration, "shard-lease-duration", defaultShardLeaseDuration, "The duration after which the objects of a replica which stopped renewing its shard lease are rebalanced to the other replicas")
+	command.Flags().DurationVar(&shardRenewPeriod, "shard-renew-period", defaultShardRenewPeriod, "The interval at which a replica renews its shard lease and refreshes the members of the shard group")
+	command.Flags().DurationVar(&shardHandoffDelay, "shard-handoff-delay", defaultShardHandoffDelay, "The duration a replica waits before it reconciles the objects which moved to it from another replica. This should be longer than the shard renew period")
+	command.Flags().BoolVar(&webhookEnabled, "webhook", false, "If true, the controller serves a validating admission webhook which rejects invalid Rollouts, Experiments and AnalysisTemplates")
+	command.Flags().IntVar(&webhookPort, "webhook-port", defaultWebhookPort, "Set the port the validating webhook should be served on")
+	command.Flags().StringVar(&webhookFailPolicy, "webhook-failure-policy", string(admissionregistrationv1.Ignore), "Whether objects are admitted (Ignore) or rejected (Fail) when the webhook is unavailable. One of: Ignore|Fail")
+	command.Flags().StringVar(&tracingOpts.Address, "otlp-address", "", "Address of the OTLP gRPC collector which the traces of the reconciliations are exported to. Tracing is turned off if empty")
+	command.Flags().BoolVar(&tracingOpts.Insecure, "otlp-insecure", false, "If true, the traces are exported to the OTLP collector without transport security")
+	command.Flags().Float64Var(&tracingOpts.SampleRatio, "tracing-sample-ratio", 1, "Ratio of the reconciliations which are traced, between 0 and 1")
 	return &command
 }
 
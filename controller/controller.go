@@ -1,8 +1,10 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/argoproj/notifications-engine/pkg/api"
@@ -14,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -24,6 +27,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/client-go/util/workqueue"
 
 	"github.com/argoproj/argo-rollouts/analysis"
@@ -35,10 +40,17 @@ import (
 	rolloutscheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/alb"
 	"github.com/argoproj/argo-rollouts/service"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/approval"
+	"github.com/argoproj/argo-rollouts/utils/config"
+	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/queue"
 	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/shard"
+	"github.com/argoproj/argo-rollouts/webhook"
 )
 
 const (
@@ -62,11 +74,47 @@ const (
 
 	// DefaultIngressThreads is the default number of ingress worker threads to start with the controller
 	DefaultIngressThreads = 10
+
+	// DefaultLeaderElect is whether leader election between controller replicas is enabled by default
+	DefaultLeaderElect = true
+
+	// DefaultLeaderElectionLeaseDuration is the default duration that non-leader candidates will wait to force acquire leadership
+	DefaultLeaderElectionLeaseDuration = 15 * time.Second
+
+	// DefaultLeaderElectionRenewDeadline is the default duration that the acting leader will retry refreshing leadership before giving up
+	DefaultLeaderElectionRenewDeadline = 10 * time.Second
+
+	// DefaultLeaderElectionRetryPeriod is the default duration that the leader election clients should wait between tries of actions
+	DefaultLeaderElectionRetryPeriod = 2 * time.Second
+
+	// defaultLeaderElectionLeaseLockName is the name of the Lease which is used as lock by the controller replicas
+	defaultLeaderElectionLeaseLockName = "argo-rollouts-controller-lock"
 )
 
+// LeaderElectionOptions configures the leader election between the replicas of the controller
+type LeaderElectionOptions struct {
+	LeaderElect                 bool
+	LeaderElectionNamespace     string
+	LeaderElectionLeaseDuration time.Duration
+	LeaderElectionRenewDeadline time.Duration
+	LeaderElectionRetryPeriod   time.Duration
+}
+
+// NewLeaderElectionOptions returns the default leader election options
+func NewLeaderElectionOptions() *LeaderElectionOptions {
+	return &LeaderElectionOptions{
+		LeaderElect:                 DefaultLeaderElect,
+		LeaderElectionNamespace:     defaults.Namespace(),
+		LeaderElectionLeaseDuration: DefaultLeaderElectionLeaseDuration,
+		LeaderElectionRenewDeadline: DefaultLeaderElectionRenewDeadline,
+		LeaderElectionRetryPeriod:   DefaultLeaderElectionRetryPeriod,
+	}
+}
+
 // Manager is the controller implementation for Argo-Rollout resources
 type Manager struct {
 	metricsServer           *metrics.MetricsServer
+	webhookServer           *webhook.Server
 	rolloutController       *rollout.Controller
 	experimentController    *experiments.Controller
 	analysisController      *analysis.Controller
@@ -79,9 +127,13 @@ type Manager struct {
 	analysisRunSynced             cache.InformerSynced
 	analysisTemplateSynced        cache.InformerSynced
 	clusterAnalysisTemplateSynced cache.InformerSynced
+	rolloutFreezeSynced           cache.InformerSynced
+	clusterRolloutFreezeSynced    cache.InformerSynced
 	serviceSynced                 cache.InformerSynced
+	secretsSynced                 cache.InformerSynced
 	ingressSynced                 cache.InformerSynced
 	jobSynced                     cache.InformerSynced
+	hookJobSynced                 cache.InformerSynced
 	replicasSetSynced             cache.InformerSynced
 	configMapSynced               cache.InformerSynced
 	secretSynced                  cache.InformerSynced
@@ -93,8 +145,11 @@ type Manager struct {
 	analysisRunWorkqueue workqueue.RateLimitingInterface
 
 	refResolver rollout.TemplateRefResolver
+	sharder     *shard.Sharder
 
-	namespace string
+	kubeClientSet kubernetes.Interface
+	namespace     string
+	instanceID    string
 }
 
 // NewManager returns a new manager to manage all the controllers
@@ -107,13 +162,17 @@ func NewManager(
 	discoveryClient discovery.DiscoveryInterface,
 	replicaSetInformer appsinformers.ReplicaSetInformer,
 	servicesInformer coreinformers.ServiceInformer,
+	secretsInformer coreinformers.SecretInformer,
 	ingressesInformer extensionsinformers.IngressInformer,
 	jobInformer batchinformers.JobInformer,
+	hookJobInformer batchinformers.JobInformer,
 	rolloutsInformer informers.RolloutInformer,
 	experimentsInformer informers.ExperimentInformer,
 	analysisRunInformer informers.AnalysisRunInformer,
 	analysisTemplateInformer informers.AnalysisTemplateInformer,
 	clusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer,
+	rolloutFreezeInformer informers.RolloutFreezeInformer,
+	clusterRolloutFreezeInformer informers.ClusterRolloutFreezeInformer,
 	istioPrimaryDynamicClient dynamic.Interface,
 	istioVirtualServiceInformer cache.SharedIndexInformer,
 	istioDestinationRuleInformer cache.SharedIndexInformer,
@@ -123,8 +182,9 @@ func NewManager(
 	instanceID string,
 	metricsPort int,
 	k8sRequestProvider *metrics.K8sRequestsCountProvider,
-	nginxIngressClasses []string,
-	albIngressClasses []string,
+	controllerConfig config.Config,
+	sharder *shard.Sharder,
+	webhookConfig *webhook.ServerConfig,
 ) *Manager {
 
 	utilruntime.Must(rolloutscheme.AddToScheme(scheme.Scheme))
@@ -163,6 +223,12 @@ func NewManager(
 			}
 			return res, nil
 		}),
+		controller.WithSkipProcessing(func(obj metav1.Object) (bool, string) {
+			if !sharder.Owns(obj) {
+				return true, "rollout is owned by another shard"
+			}
+			return false, ""
+		}),
 	)
 
 	rolloutController := rollout.NewController(rollout.ControllerConfig{
@@ -176,12 +242,16 @@ func NewManager(
 		AnalysisRunInformer:             analysisRunInformer,
 		AnalysisTemplateInformer:        analysisTemplateInformer,
 		ClusterAnalysisTemplateInformer: clusterAnalysisTemplateInformer,
+		RolloutFreezeInformer:           rolloutFreezeInformer,
+		ClusterRolloutFreezeInformer:    clusterRolloutFreezeInformer,
 		IstioPrimaryDynamicClient:       istioPrimaryDynamicClient,
 		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
 		IstioDestinationRuleInformer:    istioDestinationRuleInformer,
 		ReplicaSetInformer:              replicaSetInformer,
 		ServicesInformer:                servicesInformer,
+		SecretsInformer:                 secretsInformer,
 		IngressInformer:                 ingressesInformer,
+		HookJobInformer:                 hookJobInformer,
 		RolloutsInformer:                rolloutsInformer,
 		ResyncPeriod:                    resyncPeriod,
 		RolloutWorkQueue:                rolloutWorkqueue,
@@ -189,6 +259,7 @@ func NewManager(
 		IngressWorkQueue:                ingressWorkqueue,
 		MetricsServer:                   metricsServer,
 		Recorder:                        recorder,
+		Sharder:                         sharder,
 	})
 
 	experimentController := experiments.NewController(experiments.ControllerConfig{
@@ -204,6 +275,7 @@ func NewManager(
 		ExperimentWorkQueue:             experimentWorkqueue,
 		MetricsServer:                   metricsServer,
 		Recorder:                        recorder,
+		Sharder:                         sharder,
 	})
 
 	analysisController := analysis.NewController(analysis.ControllerConfig{
@@ -215,6 +287,7 @@ func NewManager(
 		AnalysisRunWorkQueue: analysisRunWorkqueue,
 		MetricsServer:        metricsServer,
 		Recorder:             recorder,
+		Sharder:              sharder,
 	})
 
 	serviceController := service.NewController(service.ControllerConfig{
@@ -238,20 +311,24 @@ func NewManager(
 
 		MetricsServer: metricsServer,
 
-		ALBClasses:   albIngressClasses,
-		NGINXClasses: nginxIngressClasses,
+		ALBClasses:   controllerConfig.ALBIngressClasses,
+		NGINXClasses: controllerConfig.NGINXIngressClasses,
 	})
 
 	cm := &Manager{
 		metricsServer:                 metricsServer,
 		rolloutSynced:                 rolloutsInformer.Informer().HasSynced,
 		serviceSynced:                 servicesInformer.Informer().HasSynced,
+		secretsSynced:                 secretsInformer.Informer().HasSynced,
 		ingressSynced:                 ingressesInformer.Informer().HasSynced,
 		jobSynced:                     jobInformer.Informer().HasSynced,
+		hookJobSynced:                 hookJobInformer.Informer().HasSynced,
 		experimentSynced:              experimentsInformer.Informer().HasSynced,
 		analysisRunSynced:             analysisRunInformer.Informer().HasSynced,
 		analysisTemplateSynced:        analysisTemplateInformer.Informer().HasSynced,
 		clusterAnalysisTemplateSynced: clusterAnalysisTemplateInformer.Informer().HasSynced,
+		rolloutFreezeSynced:           rolloutFreezeInformer.Informer().HasSynced,
+		clusterRolloutFreezeSynced:    clusterRolloutFreezeInformer.Informer().HasSynced,
 		replicasSetSynced:             replicaSetInformer.Informer().HasSynced,
 		configMapSynced:               configMapInformer.Informer().HasSynced,
 		secretSynced:                  secretInformer.Informer().HasSynced,
@@ -267,7 +344,53 @@ func NewManager(
 		analysisController:            analysisController,
 		notificationsController:       notificationsController,
 		refResolver:                   refResolver,
+		sharder:                       sharder,
+		kubeClientSet:                 kubeclientset,
 		namespace:                     namespace,
+		instanceID:                    instanceID,
+	}
+
+	// The configuration is applied to the defaults of the controllers whenever the ConfigMap changes
+	configWatcher := config.NewWatcher(controllerConfig, configMapInformer)
+	configWatcher.AddChangeHandler(func(cfg *config.Config) {
+		defaults.SetDefaultProgressDeadlineSeconds(cfg.ProgressDeadlineSeconds)
+		analysis.SetMeasurementHistoryLimit(cfg.MeasurementHistoryLimit)
+		alb.SetDefaultVerifyWeight(cfg.ALBVerifyWeight)
+		ingressController.SetIngressClasses(cfg.ALBIngressClasses, cfg.NGINXIngressClasses)
+		approval.SetAllowedURLPrefixes(cfg.ApprovalURLPrefixes)
+	})
+
+	if webhookConfig != nil {
+		webhookConfig.KubeClientSet = kubeclientset
+		webhookConfig.RolloutValidator = rolloutController.ValidateRollout
+		webhookConfig.TemplateGetter = analysisutil.NewTemplateGetter(analysisTemplateInformer.Lister(), clusterAnalysisTemplateInformer.Lister())
+		cm.webhookServer = webhook.NewServer(*webhookConfig)
+	}
+
+	if sharder != nil {
+		// The AnalysisRuns of Experiments are assigned by the Rollout of the Experiment
+		experimentLister := experimentsInformer.Lister()
+		sharder.SetControllerGetter(func(namespace string, ref metav1.OwnerReference) metav1.Object {
+			if ref.Kind != "Experiment" {
+				return nil
+			}
+			ex, err := experimentLister.Experiments(namespace).Get(ref.Name)
+			if err != nil {
+				return nil
+			}
+			return ex
+		})
+		// Objects which moved to this replica are only reconciled once they are enqueued again.
+		// The service and ingress controllers are not sharded: they only enqueue the Rollouts which
+		// reference a Service or Ingress, which are then filtered by the rollout controller, and
+		// otherwise only clean up Services and Ingresses which are no longer referenced by any
+		// Rollout. Those cleanups are idempotent, and an Ingress may be referenced by Rollouts of
+		// different shards, so it has no single owner.
+		sharder.AddChangeHandler(func() {
+			enqueueAll(rolloutsInformer.Informer(), rolloutWorkqueue)
+			enqueueAll(experimentsInformer.Informer(), experimentWorkqueue)
+			enqueueAll(analysisRunInformer.Informer(), analysisRunWorkqueue)
+		})
 	}
 
 	return cm
@@ -275,8 +398,14 @@ func NewManager(
 
 // Run will sync informer caches and start controllers. It will block until stopCh
 // is closed, at which point it will shutdown the workqueue and wait for
-// controllers to finish processing their current work items.
-func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness int, stopCh <-chan struct{}) error {
+// controllers to finish processing their current work items. When leader election
+// is enabled, the informer caches are kept in sync while the replica is on standby,
+// and the controllers are only started once the replica acquires the leadership.
+// An error is returned if the leadership is lost, since the controllers cannot be
+// stopped safely while they process work items. When sharding is enabled, leader
+// election is skipped and the controllers are started right away, since every
+// replica of the shard group reconciles its own share of the objects.
+func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness int, electOpts *LeaderElectionOptions, stopCh <-chan struct{}) error {
 	defer runtime.HandleCrash()
 	defer c.serviceWorkqueue.ShutDown()
 	defer c.ingressWorkqueue.ShutDown()
@@ -286,16 +415,116 @@ func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness
 
 	// Wait for the caches to be synced before starting workers
 	log.Info("Waiting for controller's informer caches to sync")
-	if ok := cache.WaitForCacheSync(stopCh, c.serviceSynced, c.ingressSynced, c.jobSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced); !ok {
+	if ok := cache.WaitForCacheSync(stopCh, c.serviceSynced, c.secretsSynced, c.ingressSynced, c.jobSynced, c.hookJobSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.rolloutFreezeSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
 	// only wait for cluster scoped informers to sync if we are running in cluster-wide mode
 	if c.namespace == metav1.NamespaceAll {
-		if ok := cache.WaitForCacheSync(stopCh, c.clusterAnalysisTemplateSynced); !ok {
+		if ok := cache.WaitForCacheSync(stopCh, c.clusterAnalysisTemplateSynced, c.clusterRolloutFreezeSynced); !ok {
 			return fmt.Errorf("failed to wait for cluster-scoped caches to sync")
 		}
 	}
 
+	go func() {
+		log.Infof("Starting Metric Server at %s", c.metricsServer.Addr)
+		err := c.metricsServer.ListenAndServe()
+		if err != nil {
+			err = errors.Wrap(err, "Starting Metric Server")
+			log.Error(err)
+		}
+	}()
+
+	if c.webhookServer != nil {
+		// The webhook is served by every replica, since the webhook Service selects all of them
+		go func() {
+			if err := c.webhookServer.Run(stopCh); err != nil {
+				log.Error(errors.Wrap(err, "Starting Webhook Server"))
+			}
+		}()
+	}
+
+	if c.sharder != nil {
+		log.Info("Sharding is turned on. Running as a member of the shard group")
+		if err := c.sharder.Sync(context.TODO()); err != nil {
+			return errors.Wrap(err, "failed to join shard group")
+		}
+		go c.sharder.Run(stopCh)
+		c.startLeading(rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness, stopCh)
+		<-stopCh
+		log.Info("Shutting down workers")
+		return nil
+	}
+
+	if !electOpts.LeaderElect {
+		log.Info("Leader election is turned off. Running in single-instance mode")
+		c.metricsServer.SetLeader(true)
+		c.startLeading(rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness, stopCh)
+		<-stopCh
+		log.Info("Shutting down workers")
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return errors.Wrap(err, "failed to get hostname for leader election")
+	}
+	id := hostname + "_" + string(uuid.NewUUID())
+	lockName := defaultLeaderElectionLeaseLockName
+	if c.instanceID != "" {
+		lockName = lockName + "-" + c.instanceID
+	}
+	log.Infof("Leader election is turned on. Campaigning for lease '%s/%s' as '%s'", electOpts.LeaderElectionNamespace, lockName, id)
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      lockName,
+				Namespace: electOpts.LeaderElectionNamespace,
+			},
+			Client: c.kubeClientSet.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: id,
+			},
+		},
+		// The workers are stopped together with the process, so the lease can be released on shutdown
+		ReleaseOnCancel: true,
+		LeaseDuration:   electOpts.LeaderElectionLeaseDuration,
+		RenewDeadline:   electOpts.LeaderElectionRenewDeadline,
+		RetryPeriod:     electOpts.LeaderElectionRetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Infof("Acquired leadership as '%s'", id)
+				c.metricsServer.SetLeader(true)
+				c.startLeading(rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness, ctx.Done())
+			},
+			OnStoppedLeading: func() {
+				log.Infof("Stopped leading as '%s'", id)
+				c.metricsServer.SetLeader(false)
+			},
+			OnNewLeader: func(identity string) {
+				if identity != id {
+					log.Infof("New leader elected: %s", identity)
+				}
+			},
+		},
+	})
+	select {
+	case <-stopCh:
+		log.Info("Shutting down workers")
+		return nil
+	default:
+		return fmt.Errorf("leader election lost")
+	}
+}
+
+// startLeading starts the controllers, which run until stopCh is closed
+func (c *Manager) startLeading(rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness int, stopCh <-chan struct{}) {
 	// Start the informer factories to begin populating the informer caches
 	log.Info("Starting Controllers")
 	go wait.Until(func() { c.rolloutController.Run(rolloutThreadiness, stopCh) }, time.Second, stopCh)
@@ -306,17 +535,11 @@ func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness
 	go wait.Until(func() { c.notificationsController.Run(rolloutThreadiness, stopCh) }, time.Second, stopCh)
 
 	log.Info("Started controller")
+}
 
-	go func() {
-		log.Infof("Starting Metric Server at %s", c.metricsServer.Addr)
-		err := c.metricsServer.ListenAndServe()
-		if err != nil {
-			err = errors.Wrap(err, "Starting Metric Server")
-			log.Error(err)
-		}
-	}()
-	<-stopCh
-	log.Info("Shutting down workers")
-
-	return nil
+// enqueueAll adds all the objects of the informer to the workqueue
+func enqueueAll(informer cache.SharedIndexInformer, queue workqueue.RateLimitingInterface) {
+	for _, obj := range informer.GetStore().List() {
+		controllerutil.Enqueue(obj, queue)
+	}
 }
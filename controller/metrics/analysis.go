@@ -1,11 +1,16 @@
 package metrics
 
 import (
+	"encoding/json"
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/argoproj/argo-rollouts/metricproviders"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	rolloutlister "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/analysis"
@@ -69,6 +74,10 @@ func collectAnalysisRuns(ch chan<- prometheus.Metric, ar *v1alpha1.AnalysisRun)
 		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v, lv...)
 	}
 	calculatedPhase := ar.Status.Phase
+	rolloutName := ""
+	if controllerRef := metav1.GetControllerOf(ar); controllerRef != nil && controllerRef.Kind == rollouts.RolloutKind {
+		rolloutName = controllerRef.Name
+	}
 
 	addGauge(MetricAnalysisRunInfo, 1, string(calculatedPhase))
 
@@ -94,7 +103,33 @@ func collectAnalysisRuns(ch chan<- prometheus.Metric, ar *v1alpha1.AnalysisRun)
 		addGauge(MetricAnalysisRunMetricPhase, boolFloat64(calculatedPhase == v1alpha1.AnalysisPhaseSuccessful), metric.Name, metricType, string(v1alpha1.AnalysisPhaseSuccessful))
 		addGauge(MetricAnalysisRunMetricPhase, boolFloat64(calculatedPhase == v1alpha1.AnalysisPhaseRunning), metric.Name, metricType, string(v1alpha1.AnalysisPhaseRunning))
 		addGauge(MetricAnalysisRunMetricPhase, boolFloat64(calculatedPhase == v1alpha1.AnalysisPhaseInconclusive), metric.Name, metricType, string(v1alpha1.AnalysisPhaseInconclusive))
+		if value, ok := latestMeasurementValue(metricResult); ok {
+			addGauge(MetricAnalysisRunMetricValue, value, metric.Name, rolloutName, ar.Labels[v1alpha1.RolloutCanaryStepIndexLabel])
+		}
+	}
+}
+
+// latestMeasurementValue returns the value of the latest measurement of a metric which has a numeric
+// value. A result with a single series, e.g. a Prometheus vector of one element, is numeric too.
+func latestMeasurementValue(result *v1alpha1.MetricResult) (float64, bool) {
+	if result == nil {
+		return 0, false
+	}
+	for i := len(result.Measurements) - 1; i >= 0; i-- {
+		value := result.Measurements[i].Value
+		if value == "" {
+			continue
+		}
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f, true
+		}
+		var series []float64
+		if err := json.Unmarshal([]byte(value), &series); err == nil && len(series) == 1 {
+			return series[0], true
+		}
+		return 0, false
 	}
+	return 0, false
 }
 
 func collectAnalysisTemplate(ch chan<- prometheus.Metric, namespace, name string, at *v1alpha1.AnalysisTemplateSpec) {
@@ -8,6 +8,7 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -102,6 +103,58 @@ analysis_run_phase{name="http-benchmark-test-tr8rn",namespace="jesse-test",phase
 analysis_run_phase{name="http-benchmark-test-tr8rn",namespace="jesse-test",phase="Successful"} 0
 `
 
+const fakeStepAnalysisRun = `
+apiVersion: argoproj.io/v1alpha1
+kind: AnalysisRun
+metadata:
+  creationTimestamp: "2020-03-16T20:01:13Z"
+  name: guestbook-6c54544bf9-2-1
+  namespace: jesse-test
+  labels:
+    rollout-type: Step
+    step-index: "1"
+  ownerReferences:
+  - apiVersion: argoproj.io/v1alpha1
+    kind: Rollout
+    name: guestbook
+    uid: 8d1c2a6b-2e7b-4a5b-9f3a-5c1e0d3f6a21
+    controller: true
+spec:
+  metrics:
+  - name: success-rate
+    provider:
+      prometheus:
+        address: http://prometheus.example.com
+        query: success_rate
+    successCondition: result[0] >= 0.95
+  - name: webmetric
+    provider:
+      web:
+        url: https://www.google.com
+    successCondition: "true"
+status:
+  metricResults:
+  - name: success-rate
+    phase: Running
+    measurements:
+    - phase: Successful
+      value: "[0.98]"
+    - phase: Successful
+      value: "[0.96]"
+    - phase: Error
+      message: connection refused
+  - name: webmetric
+    phase: Running
+    measurements:
+    - phase: Successful
+      value: '{"status":"ok"}'
+  phase: Running
+`
+
+const expectedStepAnalysisRunResponse = `# HELP analysis_run_metric_value The latest numeric measurement value of a specific metric in the Analysis Run
+# TYPE analysis_run_metric_value gauge
+analysis_run_metric_value{metric="success-rate",name="guestbook-6c54544bf9-2-1",namespace="jesse-test",rollout="guestbook",step="1"} 0.96`
+
 func newFakeAnalysisRun(fakeAnalysisRun string) *v1alpha1.AnalysisRun {
 	var ar v1alpha1.AnalysisRun
 	err := yaml.Unmarshal([]byte(fakeAnalysisRun), &ar)
@@ -135,6 +188,10 @@ func TestCollectAnalysisRuns(t *testing.T) {
 			resource:         fakeAnalysisRun,
 			expectedResponse: expectedAnalysisRunResponse,
 		},
+		{
+			resource:         fakeStepAnalysisRun,
+			expectedResponse: expectedStepAnalysisRunResponse,
+		},
 	}
 
 	for _, combination := range combinations {
@@ -151,6 +208,31 @@ func testAnalysisRunDescribe(t *testing.T, fakeAnalysisRun string, expectedRespo
 	testHttpResponse(t, mux, expectedResponse)
 }
 
+func TestLatestMeasurementValue(t *testing.T) {
+	tests := []struct {
+		values   []string
+		expected float64
+		ok       bool
+	}{
+		{nil, 0, false},
+		{[]string{"1", "2.5"}, 2.5, true},
+		{[]string{"[0.9]", ""}, 0.9, true},
+		{[]string{"1", "[0.9,0.8]"}, 0, false},
+		{[]string{"1", "not a number"}, 0, false},
+	}
+	for _, test := range tests {
+		result := &v1alpha1.MetricResult{}
+		for _, value := range test.values {
+			result.Measurements = append(result.Measurements, v1alpha1.Measurement{Value: value})
+		}
+		value, ok := latestMeasurementValue(result)
+		assert.Equal(t, test.ok, ok)
+		assert.Equal(t, test.expected, value)
+	}
+	_, ok := latestMeasurementValue(nil)
+	assert.False(t, ok)
+}
+
 func TestIncAnalysisRunReconcile(t *testing.T) {
 	expectedResponse := `# HELP analysis_run_reconcile Analysis Run reconciliation performance.
 # TYPE analysis_run_reconcile histogram
@@ -1,7 +1,11 @@
 package metrics
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -21,12 +25,24 @@ type MetricsServer struct {
 	reconcileRolloutHistogram *prometheus.HistogramVec
 	errorRolloutCounter       *prometheus.CounterVec
 
+	rolloutStepHistogram      *prometheus.HistogramVec
+	rolloutPauseHistogram     *prometheus.HistogramVec
+	rolloutAnalysisHistogram  *prometheus.HistogramVec
+	rolloutUpdateHistogram    *prometheus.HistogramVec
+	canaryWeightDesiredGauge  *prometheus.GaugeVec
+	canaryWeightVerifiedGauge *prometheus.GaugeVec
+	rolloutAbortCounter       *prometheus.CounterVec
+	rolloutPromotionCounter   *prometheus.CounterVec
+	rolloutSeries             *rolloutSeriesTracker
+
 	reconcileExperimentHistogram *prometheus.HistogramVec
 	errorExperimentCounter       *prometheus.CounterVec
 
 	reconcileAnalysisRunHistogram *prometheus.HistogramVec
 	errorAnalysisRunCounter       *prometheus.CounterVec
 
+	leaderGauge prometheus.Gauge
+
 	k8sRequestsCounter *K8sRequestsCountProvider
 }
 
@@ -57,10 +73,19 @@ func NewMetricsServer(cfg ServerConfig) *MetricsServer {
 	reg.MustRegister(MetricRolloutReconcile)
 	reg.MustRegister(MetricRolloutReconcileError)
 	reg.MustRegister(MetricRolloutEventsTotal)
+	reg.MustRegister(MetricRolloutStepDuration)
+	reg.MustRegister(MetricRolloutPauseDuration)
+	reg.MustRegister(MetricRolloutAnalysisDuration)
+	reg.MustRegister(MetricRolloutUpdateDuration)
+	reg.MustRegister(MetricRolloutCanaryWeightDesired)
+	reg.MustRegister(MetricRolloutCanaryWeightVerified)
+	reg.MustRegister(MetricRolloutAbortsTotal)
+	reg.MustRegister(MetricRolloutPromotionsTotal)
 	reg.MustRegister(MetricExperimentReconcile)
 	reg.MustRegister(MetricExperimentReconcileError)
 	reg.MustRegister(MetricAnalysisRunReconcile)
 	reg.MustRegister(MetricAnalysisRunReconcileError)
+	reg.MustRegister(MetricControllerLeader)
 
 	mux.Handle(MetricsPath, promhttp.HandlerFor(prometheus.Gatherers{
 		// contains app controller specific metrics
@@ -76,12 +101,24 @@ func NewMetricsServer(cfg ServerConfig) *MetricsServer {
 		reconcileRolloutHistogram: MetricRolloutReconcile,
 		errorRolloutCounter:       MetricRolloutReconcileError,
 
+		rolloutStepHistogram:      MetricRolloutStepDuration,
+		rolloutPauseHistogram:     MetricRolloutPauseDuration,
+		rolloutAnalysisHistogram:  MetricRolloutAnalysisDuration,
+		rolloutUpdateHistogram:    MetricRolloutUpdateDuration,
+		canaryWeightDesiredGauge:  MetricRolloutCanaryWeightDesired,
+		canaryWeightVerifiedGauge: MetricRolloutCanaryWeightVerified,
+		rolloutAbortCounter:       MetricRolloutAbortsTotal,
+		rolloutPromotionCounter:   MetricRolloutPromotionsTotal,
+		rolloutSeries:             newRolloutSeriesTracker(),
+
 		reconcileExperimentHistogram: MetricExperimentReconcile,
 		errorExperimentCounter:       MetricExperimentReconcileError,
 
 		reconcileAnalysisRunHistogram: MetricAnalysisRunReconcile,
 		errorAnalysisRunCounter:       MetricAnalysisRunReconcileError,
 
+		leaderGauge: MetricControllerLeader,
+
 		k8sRequestsCounter: cfg.K8SRequestProvider,
 	}
 }
@@ -91,6 +128,112 @@ func (m *MetricsServer) IncRolloutReconcile(rollout *v1alpha1.Rollout, duration
 	m.reconcileRolloutHistogram.WithLabelValues(rollout.Namespace, rollout.Name).Observe(duration.Seconds())
 }
 
+// ObserveRolloutStep records the time spent in a canary step of a Rollout
+func (m *MetricsServer) ObserveRolloutStep(rollout *v1alpha1.Rollout, stepIndex int32, stepType string, duration time.Duration) {
+	labels := []string{rollout.Namespace, rollout.Name, strconv.Itoa(int(stepIndex)), stepType}
+	m.rolloutStepHistogram.WithLabelValues(labels...).Observe(duration.Seconds())
+	m.rolloutSeries.track(m.rolloutStepHistogram, labels)
+}
+
+// ObserveRolloutPause records the time during which a Rollout was paused
+func (m *MetricsServer) ObserveRolloutPause(rollout *v1alpha1.Rollout, reason v1alpha1.PauseReason, duration time.Duration) {
+	labels := []string{rollout.Namespace, rollout.Name, string(reason)}
+	m.rolloutPauseHistogram.WithLabelValues(labels...).Observe(duration.Seconds())
+	m.rolloutSeries.track(m.rolloutPauseHistogram, labels)
+}
+
+// ObserveRolloutAnalysis records the time spent in a completed AnalysisRun of a Rollout
+func (m *MetricsServer) ObserveRolloutAnalysis(run *v1alpha1.AnalysisRun, rolloutName string, duration time.Duration) {
+	labels := []string{run.Namespace, rolloutName, run.Labels[v1alpha1.RolloutTypeLabel], string(run.Status.Phase)}
+	m.rolloutAnalysisHistogram.WithLabelValues(labels...).Observe(duration.Seconds())
+	m.rolloutSeries.track(m.rolloutAnalysisHistogram, labels)
+}
+
+// ObserveRolloutUpdate records the time it took to promote a new revision of a Rollout as stable
+func (m *MetricsServer) ObserveRolloutUpdate(rollout *v1alpha1.Rollout, duration time.Duration) {
+	strategy, _ := getStrategyAndTrafficRouter(rollout)
+	labels := []string{rollout.Namespace, rollout.Name, strategy}
+	m.rolloutUpdateHistogram.WithLabelValues(labels...).Observe(duration.Seconds())
+	m.rolloutSeries.track(m.rolloutUpdateHistogram, labels)
+}
+
+// SetRolloutCanaryWeight reports the canary weight set on the traffic router of a Rollout
+func (m *MetricsServer) SetRolloutCanaryWeight(rollout *v1alpha1.Rollout, desired int32) {
+	m.canaryWeightDesiredGauge.WithLabelValues(rollout.Namespace, rollout.Name).Set(float64(desired))
+}
+
+// SetRolloutVerifiedWeight reports the canary weight verified on the traffic router of a Rollout
+func (m *MetricsServer) SetRolloutVerifiedWeight(rollout *v1alpha1.Rollout, verified int32) {
+	m.canaryWeightVerifiedGauge.WithLabelValues(rollout.Namespace, rollout.Name).Set(float64(verified))
+}
+
+// IncRolloutAbort increments the abort counter for a Rollout
+func (m *MetricsServer) IncRolloutAbort(rollout *v1alpha1.Rollout, reason string) {
+	labels := []string{rollout.Namespace, rollout.Name, reason}
+	m.rolloutAbortCounter.WithLabelValues(labels...).Inc()
+	m.rolloutSeries.track(m.rolloutAbortCounter, labels)
+}
+
+// IncRolloutPromotion increments the promotion counter for a Rollout
+func (m *MetricsServer) IncRolloutPromotion(rollout *v1alpha1.Rollout, actor string) {
+	labels := []string{rollout.Namespace, rollout.Name, actor}
+	m.rolloutPromotionCounter.WithLabelValues(labels...).Inc()
+	m.rolloutSeries.track(m.rolloutPromotionCounter, labels)
+}
+
+// RemoveRollout removes the gauges, histograms and counters of a deleted Rollout
+func (m *MetricsServer) RemoveRollout(namespace, name string) {
+	m.canaryWeightDesiredGauge.DeleteLabelValues(namespace, name)
+	m.canaryWeightVerifiedGauge.DeleteLabelValues(namespace, name)
+	m.rolloutSeries.remove(namespace, name)
+}
+
+// labelValuesDeleter is implemented by the metric vectors
+type labelValuesDeleter interface {
+	DeleteLabelValues(lvs ...string) bool
+}
+
+type rolloutSeries struct {
+	vec    labelValuesDeleter
+	labels []string
+}
+
+// rolloutSeriesTracker remembers the label values observed for each Rollout, since the per-rollout
+// histograms and counters have further labels and can only be deleted by their full label values
+type rolloutSeriesTracker struct {
+	lock   sync.Mutex
+	series map[string]map[string]rolloutSeries
+}
+
+func newRolloutSeriesTracker() *rolloutSeriesTracker {
+	return &rolloutSeriesTracker{
+		series: map[string]map[string]rolloutSeries{},
+	}
+}
+
+// track records the label values of a series. The first two label values are the namespace and
+// name of the Rollout
+func (t *rolloutSeriesTracker) track(vec labelValuesDeleter, labels []string) {
+	key := labels[0] + "/" + labels[1]
+	seriesKey := fmt.Sprintf("%p/%s", vec, strings.Join(labels, "/"))
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.series[key] == nil {
+		t.series[key] = map[string]rolloutSeries{}
+	}
+	t.series[key][seriesKey] = rolloutSeries{vec: vec, labels: labels}
+}
+
+func (t *rolloutSeriesTracker) remove(namespace, name string) {
+	key := namespace + "/" + name
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, series := range t.series[key] {
+		series.vec.DeleteLabelValues(series.labels...)
+	}
+	delete(t.series, key)
+}
+
 // IncExperimentReconcile increments the reconcile counter for an Experiment
 func (m *MetricsServer) IncExperimentReconcile(ex *v1alpha1.Experiment, duration time.Duration) {
 	m.reconcileExperimentHistogram.WithLabelValues(ex.Namespace, ex.Name).Observe(duration.Seconds())
@@ -101,6 +244,11 @@ func (m *MetricsServer) IncAnalysisRunReconcile(ar *v1alpha1.AnalysisRun, durati
 	m.reconcileAnalysisRunHistogram.WithLabelValues(ar.Namespace, ar.Name).Observe(duration.Seconds())
 }
 
+// SetLeader reports whether the controller replica is the leader
+func (m *MetricsServer) SetLeader(leader bool) {
+	m.leaderGauge.Set(boolFloat64(leader))
+}
+
 // IncError increments the reconcile counter for an rollout
 func (m *MetricsServer) IncError(namespace, name string, kind string) {
 	switch kind {
@@ -7,11 +7,15 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	informerfactory "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
@@ -88,3 +92,61 @@ rollout_reconcile_error{name="name",namespace="ns"} 1`
 	metricsServ.IncError("ns", "name", logutil.RolloutKey)
 	testHttpResponse(t, metricsServ.Handler, expectedResponse)
 }
+
+func TestSetLeader(t *testing.T) {
+	metricsServ := NewMetricsServer(newFakeServerConfig())
+
+	metricsServ.SetLeader(true)
+	testHttpResponse(t, metricsServ.Handler, `# HELP controller_leader Whether the controller replica is the leader (1) or on standby (0).
+# TYPE controller_leader gauge
+controller_leader 1`)
+
+	metricsServ.SetLeader(false)
+	testHttpResponse(t, metricsServ.Handler, `controller_leader 0`)
+}
+
+func TestRolloutProgressMetrics(t *testing.T) {
+	metricsServ := NewMetricsServer(newFakeServerConfig())
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}},
+		},
+	}
+	run := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook-6c54544bf9-2",
+			Namespace: "default",
+			Labels:    map[string]string{v1alpha1.RolloutTypeLabel: v1alpha1.RolloutTypeStepLabel},
+		},
+		Status: v1alpha1.AnalysisRunStatus{Phase: v1alpha1.AnalysisPhaseSuccessful},
+	}
+
+	metricsServ.ObserveRolloutStep(ro, 1, "pause", 45*time.Second)
+	metricsServ.ObserveRolloutPause(ro, v1alpha1.PauseReasonCanaryPauseStep, 45*time.Second)
+	metricsServ.ObserveRolloutAnalysis(run, ro.Name, 90*time.Second)
+	metricsServ.ObserveRolloutUpdate(ro, time.Hour)
+	metricsServ.SetRolloutCanaryWeight(ro, 20)
+	metricsServ.SetRolloutVerifiedWeight(ro, 10)
+	metricsServ.IncRolloutAbort(ro, "AnalysisRunFailed")
+	metricsServ.IncRolloutPromotion(ro, "user")
+	testHttpResponse(t, metricsServ.Handler, `rollout_step_duration_seconds_sum{name="guestbook",namespace="default",step="1",step_type="pause"} 45
+rollout_pause_duration_seconds_count{name="guestbook",namespace="default",reason="CanaryPauseStep"} 1
+rollout_analysis_duration_seconds_sum{name="guestbook",namespace="default",phase="Successful",type="Step"} 90
+rollout_update_duration_seconds_bucket{name="guestbook",namespace="default",strategy="canary",le="3600"} 1
+# TYPE rollout_canary_weight_desired gauge
+rollout_canary_weight_desired{name="guestbook",namespace="default"} 20
+rollout_canary_weight_verified{name="guestbook",namespace="default"} 10
+rollout_aborts_total{name="guestbook",namespace="default",reason="AnalysisRunFailed"} 1
+rollout_promotions_total{actor="user",name="guestbook",namespace="default"} 1`)
+
+	metricsServ.RemoveRollout(ro.Namespace, ro.Name)
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutCanaryWeightDesired))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutCanaryWeightVerified))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutStepDuration))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutPauseDuration))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutAnalysisDuration))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutUpdateDuration))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutAbortsTotal))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutPromotionsTotal))
+}
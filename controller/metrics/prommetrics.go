@@ -6,6 +6,8 @@ import "github.com/prometheus/client_golang/prometheus"
 // https://prometheus.io/docs/practices/naming/
 var (
 	namespaceNameLabels = []string{"namespace", "name"}
+	// progressBuckets are the buckets of the durations of the progress of rollouts, from seconds to a day
+	progressBuckets = []float64{10, 30, 60, 120, 300, 600, 1800, 3600, 7200, 14400, 28800, 86400}
 )
 
 // Rollout metrics
@@ -63,6 +65,74 @@ var (
 		append(namespaceNameLabels, "type", "reason"),
 	)
 
+	MetricRolloutStepDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rollout_step_duration_seconds",
+			Help:    "Time spent in each canary step of a rollout.",
+			Buckets: progressBuckets,
+		},
+		append(namespaceNameLabels, "step", "step_type"),
+	)
+
+	MetricRolloutPauseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rollout_pause_duration_seconds",
+			Help:    "Time during which a rollout was paused, by pause reason.",
+			Buckets: progressBuckets,
+		},
+		append(namespaceNameLabels, "reason"),
+	)
+
+	MetricRolloutAnalysisDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rollout_analysis_duration_seconds",
+			Help:    "Time spent in the analysis runs of a rollout, by type and phase of the analysis run.",
+			Buckets: progressBuckets,
+		},
+		append(namespaceNameLabels, "type", "phase"),
+	)
+
+	MetricRolloutUpdateDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rollout_update_duration_seconds",
+			Help:    "Time from the creation of the ReplicaSet of a new revision to its promotion as stable.",
+			Buckets: progressBuckets,
+		},
+		append(namespaceNameLabels, "strategy"),
+	)
+
+	MetricRolloutCanaryWeightDesired = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rollout_canary_weight_desired",
+			Help: "The canary weight which the controller sets on the traffic router of a rollout.",
+		},
+		namespaceNameLabels,
+	)
+
+	MetricRolloutCanaryWeightVerified = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "rollout_canary_weight_verified",
+			Help: "The last canary weight which was verified on the traffic router of a rollout.",
+		},
+		namespaceNameLabels,
+	)
+
+	MetricRolloutAbortsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rollout_aborts_total",
+			Help: "Count of rollout aborts, by reason.",
+		},
+		append(namespaceNameLabels, "reason"),
+	)
+
+	MetricRolloutPromotionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rollout_promotions_total",
+			Help: "Count of rollout promotions, by the actor which promoted the rollout.",
+		},
+		append(namespaceNameLabels, "actor"),
+	)
+
 	// DEPRECATED in favor of rollout_info
 	MetricRolloutPhase = prometheus.NewDesc(
 		"rollout_phase",
@@ -118,6 +188,13 @@ var (
 		append(namespaceNameLabels, "metric", "type", "phase"),
 		nil,
 	)
+
+	MetricAnalysisRunMetricValue = prometheus.NewDesc(
+		"analysis_run_metric_value",
+		"The latest numeric measurement value of a specific metric in the Analysis Run",
+		append(namespaceNameLabels, "metric", "rollout", "step"),
+		nil,
+	)
 )
 
 // AnalysisTemplate metrics
@@ -172,6 +249,16 @@ var (
 	)
 )
 
+// Controller metrics
+var (
+	MetricControllerLeader = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "controller_leader",
+			Help: "Whether the controller replica is the leader (1) or on standby (0).",
+		},
+	)
+)
+
 // K8s Client metrics
 var (
 	// Custom events metric
@@ -30,6 +30,8 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/diff"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/shard"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
 )
 
@@ -57,6 +59,9 @@ type Controller struct {
 
 	metricsServer *metrics.MetricsServer
 
+	// sharder decides which experiments are reconciled by this replica of the controller
+	sharder *shard.Sharder
+
 	// used for unit testing
 	enqueueExperiment      func(obj interface{})
 	enqueueExperimentAfter func(obj interface{}, duration time.Duration)
@@ -88,6 +93,7 @@ type ControllerConfig struct {
 	ExperimentWorkQueue             workqueue.RateLimitingInterface
 	MetricsServer                   *metrics.MetricsServer
 	Recorder                        record.EventRecorder
+	Sharder                         *shard.Sharder
 }
 
 // NewController returns a new experiment controller
@@ -108,6 +114,7 @@ func NewController(cfg ControllerConfig) *Controller {
 		clusterAnalysisTemplateLister: cfg.ClusterAnalysisTemplateInformer.Lister(),
 		analysisRunLister:             cfg.AnalysisRunInformer.Lister(),
 		metricsServer:                 cfg.MetricsServer,
+		sharder:                       cfg.Sharder,
 		rolloutWorkqueue:              cfg.RolloutWorkQueue,
 		experimentWorkqueue:           cfg.ExperimentWorkQueue,
 
@@ -226,7 +233,7 @@ func (ec *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
 	return nil
 }
 
-func (ec *Controller) syncHandler(key string) error {
+func (ec *Controller) syncHandler(key string) (err error) {
 	startTime := time.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -242,11 +249,17 @@ func (ec *Controller) syncHandler(key string) error {
 	if err != nil {
 		return err
 	}
+	if !ec.sharder.Owns(experiment) {
+		logCtx.Debug("Skipping experiment owned by another shard")
+		return nil
+	}
 
+	ctx, span := tracing.StartSpan(context.TODO(), "experiment.reconcile", tracing.ExperimentAttributes(experiment)...)
 	defer func() {
 		duration := time.Since(startTime)
 		ec.metricsServer.IncExperimentReconcile(experiment, duration)
 		logCtx.WithField("time_ms", duration.Seconds()*1e3).Info("Reconciliation completed")
+		tracing.EndSpan(span, err)
 	}()
 
 	if experiment.DeletionTimestamp != nil {
@@ -265,7 +278,7 @@ func (ec *Controller) syncHandler(key string) error {
 			conditions.RemoveExperimentCondition(newStatus, v1alpha1.InvalidExperimentSpec)
 		}
 		conditions.SetExperimentCondition(newStatus, *invalidSpecCond)
-		return ec.persistExperimentStatus(experiment, newStatus)
+		return ec.persistExperimentStatus(ctx, experiment, newStatus)
 	}
 
 	// List ReplicaSets owned by this Experiment, while reconciling ControllerRef
@@ -287,13 +300,13 @@ func (ec *Controller) syncHandler(key string) error {
 		ec.recorder,
 		ec.enqueueExperimentAfter,
 	)
+	exCtx.ctx = ctx
 
 	newStatus := exCtx.reconcile()
-	return ec.persistExperimentStatus(experiment, newStatus)
+	return ec.persistExperimentStatus(ctx, experiment, newStatus)
 }
 
-func (ec *Controller) persistExperimentStatus(orig *v1alpha1.Experiment, newStatus *v1alpha1.ExperimentStatus) error {
-	ctx := context.TODO()
+func (ec *Controller) persistExperimentStatus(ctx context.Context, orig *v1alpha1.Experiment, newStatus *v1alpha1.ExperimentStatus) error {
 	logCtx := logutil.WithExperiment(orig)
 	patch, modified, err := diff.CreateTwoWayMergePatch(
 		&v1alpha1.Experiment{
@@ -1,6 +1,7 @@
 package experiments
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -41,6 +42,9 @@ type experimentContext struct {
 	recorder                      record.EventRecorder
 	enqueueExperimentAfter        func(obj interface{}, duration time.Duration)
 
+	// ctx carries the span of the reconciliation, which is the parent of the spans of API calls
+	ctx context.Context
+
 	// calculated values during reconciliation
 	log       *log.Entry
 	newStatus *v1alpha1.ExperimentStatus
@@ -74,6 +78,7 @@ func newExperimentContext(
 		recorder:                      recorder,
 		enqueueExperimentAfter:        enqueueExperimentAfter,
 
+		ctx:           context.TODO(),
 		log:           log.WithField(logutil.ExperimentKey, experiment.Name).WithField(logutil.NamespaceKey, experiment.Namespace),
 		newStatus:     experiment.Status.DeepCopy(),
 		isTerminating: experimentutil.IsTerminating(experiment),
@@ -504,7 +509,7 @@ func (ec *experimentContext) newAnalysisRun(analysis v1alpha1.ExperimentAnalysis
 		}
 		name := fmt.Sprintf("%s-%s", ec.ex.Name, analysis.Name)
 
-		run, err := analysisutil.NewAnalysisRunFromClusterTemplate(clusterTemplate, args, name, "", ec.ex.Namespace)
+		run, err := analysisutil.NewAnalysisRunFromTemplates(nil, []*v1alpha1.ClusterAnalysisTemplate{clusterTemplate}, ec.templateGetter(), args, name, "", ec.ex.Namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -521,7 +526,7 @@ func (ec *experimentContext) newAnalysisRun(analysis v1alpha1.ExperimentAnalysis
 		}
 		name := fmt.Sprintf("%s-%s", ec.ex.Name, analysis.Name)
 
-		run, err := analysisutil.NewAnalysisRunFromTemplate(template, args, name, "", ec.ex.Namespace)
+		run, err := analysisutil.NewAnalysisRunFromTemplates([]*v1alpha1.AnalysisTemplate{template}, nil, ec.templateGetter(), args, name, "", ec.ex.Namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -534,6 +539,11 @@ func (ec *experimentContext) newAnalysisRun(analysis v1alpha1.ExperimentAnalysis
 	}
 }
 
+// templateGetter returns a TemplateGetter to resolve the templates included by analysis templates
+func (ec *experimentContext) templateGetter() analysisutil.TemplateGetter {
+	return analysisutil.NewTemplateGetter(ec.analysisTemplateLister, ec.clusterAnalysisTemplateLister)
+}
+
 // verifyAnalysisTemplate verifies an AnalysisTemplate. For now, it simply means that it exists
 func (ec *experimentContext) verifyAnalysisTemplate(analysis v1alpha1.ExperimentAnalysisTemplateRef) error {
 	_, err := ec.analysisTemplateLister.AnalysisTemplates(ec.ex.Namespace).Get(analysis.TemplateName)
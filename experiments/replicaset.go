@@ -1,7 +1,6 @@
 package experiments
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 
@@ -68,7 +67,7 @@ func (c *Controller) getReplicaSetsForExperiment(experiment *v1alpha1.Experiment
 
 // createReplicaSet creates a new replicaset based on the template
 func (ec *experimentContext) createReplicaSet(template v1alpha1.TemplateSpec, collisionCount *int32) (*appsv1.ReplicaSet, error) {
-	ctx := context.TODO()
+	ctx := ec.ctx
 	newRS := newReplicaSetFromTemplate(ec.ex, template, collisionCount)
 
 	newReplicasCount := experimentutil.CalculateTemplateReplicasCount(ec.ex, template)
@@ -222,7 +221,7 @@ func (ec *experimentContext) scaleReplicaSetAndRecordEvent(rs *appsv1.ReplicaSet
 }
 
 func (ec *experimentContext) scaleReplicaSet(rs *appsv1.ReplicaSet, newScale int32, scalingOperation string) (bool, *appsv1.ReplicaSet, error) {
-	ctx := context.TODO()
+	ctx := ec.ctx
 	oldScale := *(rs.Spec.Replicas)
 	sizeNeedsUpdate := oldScale != newScale
 	scaled := false
@@ -36,6 +36,9 @@ var crdPaths = map[string]string{
 	"AnalysisTemplate":        "manifests/crds/analysis-template-crd.yaml",
 	"ClusterAnalysisTemplate": "manifests/crds/cluster-analysis-template-crd.yaml",
 	"AnalysisRun":             "manifests/crds/analysis-run-crd.yaml",
+	"RolloutFreeze":           "manifests/crds/rollout-freeze-crd.yaml",
+	"ClusterRolloutFreeze":    "manifests/crds/cluster-rollout-freeze-crd.yaml",
+	"RolloutRevision":         "manifests/crds/rollout-revision-crd.yaml",
 }
 
 func setValidationOverride(un *unstructured.Unstructured, fieldOverride map[string]interface{}, path string) {
@@ -101,7 +104,10 @@ func NewCustomResourceDefinition() []*extensionsobj.CustomResourceDefinition {
 	deleteFile("config/argoproj.io_analysisruns.yaml")
 	deleteFile("config/argoproj.io_analysistemplates.yaml")
 	deleteFile("config/argoproj.io_clusteranalysistemplates.yaml")
+	deleteFile("config/argoproj.io_clusterrolloutfreezes.yaml")
 	deleteFile("config/argoproj.io_experiments.yaml")
+	deleteFile("config/argoproj.io_rolloutfreezes.yaml")
+	deleteFile("config/argoproj.io_rolloutrevisions.yaml")
 	deleteFile("config/argoproj.io_rollouts.yaml")
 	deleteFile("config")
 
@@ -115,7 +121,7 @@ func NewCustomResourceDefinition() []*extensionsobj.CustomResourceDefinition {
 		createMetadataValidation(obj)
 		crd := toCRD(obj)
 
-		if crd.Name == "clusteranalysistemplates.argoproj.io" {
+		if crd.Name == "clusteranalysistemplates.argoproj.io" || crd.Name == "clusterrolloutfreezes.argoproj.io" {
 			crd.Spec.Scope = "Cluster"
 		} else {
 			crd.Spec.Scope = "Namespaced"
@@ -173,6 +179,27 @@ func createMetadataValidation(un *unstructured.Unstructured) {
 			roValidated = append(roValidated, v)
 		}
 		unstructured.SetNestedSlice(un.Object, roValidated, prePath...)
+
+		for _, hookType := range []string{"preRollout", "postPromotion", "postAbort"} {
+			var hookValidated []interface{}
+			hookPath := []string{
+				"hooks",
+				"properties",
+				hookType,
+				"items",
+				"properties",
+				"job",
+				"properties",
+				"metadata",
+			}
+			hookPath = append(path, hookPath...)
+			objVersions, _, _ := unstructured.NestedSlice(un.Object, prePath...)
+			for _, v := range objVersions {
+				unstructured.SetNestedMap(v.(map[string]interface{}), metadataValidationObj.Object, hookPath...)
+				hookValidated = append(hookValidated, v)
+			}
+			unstructured.SetNestedSlice(un.Object, hookValidated, prePath...)
+		}
 	case "Experiment":
 		var exValidated []interface{}
 		exPath := []string{
@@ -223,6 +250,8 @@ func createMetadataValidation(un *unstructured.Unstructured) {
 			analysisJobValidated = append(analysisJobValidated, v)
 		}
 		unstructured.SetNestedSlice(un.Object, analysisJobValidated, prePath...)
+	case "ClusterRolloutFreeze", "RolloutFreeze", "RolloutRevision":
+		// no embedded object metadata to validate
 	default:
 		panic(fmt.Sprintf("unknown kind: %s", kind))
 	}
@@ -253,6 +282,11 @@ func removeK8S118Fields(un *unstructured.Unstructured) {
 		// Replace this with "spec.template.spec.volumes[].ephemeral.volumeClaimTemplate.spec.resources.{limits/requests}"
 		// when it's ok to only support k8s 1.17+
 		setValidationOverride(un, preserveUnknownFields, "spec.template.spec.volumes")
+		// The Job specs of hooks are not validated, which keeps the size of the CRD within the
+		// annotation limit of kubectl apply
+		setValidationOverride(un, preserveUnknownFields, "spec.hooks.preRollout[].job.spec")
+		setValidationOverride(un, preserveUnknownFields, "spec.hooks.postPromotion[].job.spec")
+		setValidationOverride(un, preserveUnknownFields, "spec.hooks.postAbort[].job.spec")
 	case "Experiment":
 		setValidationOverride(un, preserveUnknownFields, "spec.templates[].template.spec.containers[].resources.limits")
 		setValidationOverride(un, preserveUnknownFields, "spec.templates[].template.spec.containers[].resources.requests")
@@ -273,6 +307,7 @@ func removeK8S118Fields(un *unstructured.Unstructured) {
 		// Replace this with "spec.metrics[].provider.job.spec.template.spec.volumes[].ephemeral.volumeClaimTemplate.spec.resources.{limits/requests}"
 		// when it's ok to only support k8s 1.17+
 		setValidationOverride(un, preserveUnknownFields, "spec.metrics[].provider.job.spec.template.spec.volumes")
+	case "ClusterRolloutFreeze", "RolloutFreeze", "RolloutRevision":
 	default:
 		panic(fmt.Sprintf("unknown kind: %s", kind))
 	}
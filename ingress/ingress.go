@@ -3,6 +3,7 @@ package ingress
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -52,8 +53,10 @@ type Controller struct {
 
 	metricServer   *metrics.MetricsServer
 	enqueueRollout func(obj interface{})
-	albClasses     []string
-	nginxClasses   []string
+
+	classesMutex sync.RWMutex
+	albClasses   []string
+	nginxClasses []string
 }
 
 // NewController returns a new ingress controller
@@ -97,6 +100,14 @@ func NewController(cfg ControllerConfig) *Controller {
 	return controller
 }
 
+// SetIngressClasses sets the ingress classes of the ALB and nginx ingress controllers
+func (c *Controller) SetIngressClasses(albClasses, nginxClasses []string) {
+	c.classesMutex.Lock()
+	defer c.classesMutex.Unlock()
+	c.albClasses = albClasses
+	c.nginxClasses = nginxClasses
+}
+
 // Run starts the controller threads
 func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
 	log.Info("Starting Ingress workers")
@@ -141,10 +152,13 @@ func (c *Controller) syncIngress(key string) error {
 		return nil
 	}
 	class := ingress.Annotations["kubernetes.io/ingress.class"]
+	c.classesMutex.RLock()
+	albClasses, nginxClasses := c.albClasses, c.nginxClasses
+	c.classesMutex.RUnlock()
 	switch {
-	case hasClass(c.albClasses, class):
+	case hasClass(albClasses, class):
 		return c.syncALBIngress(ingress, rollouts)
-	case hasClass(c.nginxClasses, class):
+	case hasClass(nginxClasses, class):
 		return c.syncNginxIngress(name, namespace, rollouts)
 	default:
 		return nil
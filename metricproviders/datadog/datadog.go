@@ -43,6 +43,8 @@ type Provider struct {
 type datadogResponse struct {
 	Series []struct {
 		Pointlist [][]float64 `json:"pointlist"`
+		Scope     string      `json:"scope"`
+		TagSet    []string    `json:"tag_set"`
 	}
 }
 
@@ -106,7 +108,17 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
 
-	value, status, err := p.parseResponse(metric, response)
+	var value string
+	var status v1alpha1.AnalysisPhase
+	if evaluate.IsPerSeries(metric) {
+		var metadata map[string]string
+		value, status, metadata, err = p.parseSeriesResponse(run, metric, response)
+		if len(metadata) > 0 {
+			measurement.Metadata = metadata
+		}
+	} else {
+		value, status, err = p.parseResponse(run, metric, response)
+	}
 	if err != nil {
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
@@ -119,38 +131,90 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	return measurement
 }
 
-func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) parseResponse(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, response *http.Response) (string, v1alpha1.AnalysisPhase, error) {
+	res, bodyBytes, err := decodeResponse(response)
+	if err != nil {
+		return "", v1alpha1.AnalysisPhaseError, err
+	}
+
+	if len(res.Series) < 1 || len(res.Series[0].Pointlist) < 1 {
+		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Datadog returned no value: %s", string(bodyBytes))
+	}
+
+	series := res.Series[0]
+	datapoint := series.Pointlist[len(series.Pointlist)-1]
+	if len(datapoint) < 1 {
+		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Datadog returned no value: %s", string(bodyBytes))
+	}
+
+	status, err := evaluate.EvaluateResult(datapoint[1], metric, run, p.logCtx)
+	return strconv.FormatFloat(datapoint[1], 'f', -1, 64), status, err
+}
+
+// parseSeriesResponse evaluates the metric conditions against the latest datapoint of every
+// series in the response
+func (p *Provider) parseSeriesResponse(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, response *http.Response) (string, v1alpha1.AnalysisPhase, map[string]string, error) {
+	res, bodyBytes, err := decodeResponse(response)
+	if err != nil {
+		return "", v1alpha1.AnalysisPhaseError, nil, err
+	}
+
+	series := make([]evaluate.Series, 0, len(res.Series))
+	values := make([]string, 0, len(res.Series))
+	for _, s := range res.Series {
+		if len(s.Pointlist) < 1 || len(s.Pointlist[len(s.Pointlist)-1]) < 2 {
+			continue
+		}
+		value := s.Pointlist[len(s.Pointlist)-1][1]
+		series = append(series, evaluate.Series{Labels: seriesLabels(s.Scope, s.TagSet), Value: value})
+		values = append(values, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+	if len(series) == 0 {
+		return "", v1alpha1.AnalysisPhaseError, nil, fmt.Errorf("Datadog returned no value: %s", string(bodyBytes))
+	}
+
+	status, metadata, err := evaluate.EvaluateSeriesResult(series, metric, run, p.logCtx)
+	return "[" + strings.Join(values, ",") + "]", status, metadata, err
+}
 
+// decodeResponse verifies the status code of a Datadog query response and decodes its body
+func decodeResponse(response *http.Response) (*datadogResponse, []byte, error) {
 	bodyBytes, err := ioutil.ReadAll(response.Body)
 
 	if err != nil {
-		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Received no bytes in response: %v", err)
+		return nil, nil, fmt.Errorf("Received no bytes in response: %v", err)
 	}
 
 	if response.StatusCode == http.StatusForbidden || response.StatusCode == http.StatusUnauthorized {
-		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("received authentication error response code: %v %s", response.StatusCode, string(bodyBytes))
+		return nil, bodyBytes, fmt.Errorf("received authentication error response code: %v %s", response.StatusCode, string(bodyBytes))
 	} else if response.StatusCode != http.StatusOK {
-		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("received non 2xx response code: %v %s", response.StatusCode, string(bodyBytes))
+		return nil, bodyBytes, fmt.Errorf("received non 2xx response code: %v %s", response.StatusCode, string(bodyBytes))
 	}
 
 	var res datadogResponse
 	err = json.Unmarshal(bodyBytes, &res)
 	if err != nil {
-		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Could not parse JSON body: %v", err)
+		return nil, bodyBytes, fmt.Errorf("Could not parse JSON body: %v", err)
 	}
+	return &res, bodyBytes, nil
+}
 
-	if len(res.Series) < 1 || len(res.Series[0].Pointlist) < 1 {
-		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Datadog returned no value: %s", string(bodyBytes))
+// seriesLabels converts the "key:value" tags of a Datadog series into labels. The scope of the
+// series is used when it has no tags.
+func seriesLabels(scope string, tagSet []string) map[string]string {
+	labels := map[string]string{}
+	for _, tag := range tagSet {
+		parts := strings.SplitN(tag, ":", 2)
+		if len(parts) == 2 {
+			labels[parts[0]] = parts[1]
+		} else {
+			labels[tag] = ""
+		}
 	}
-
-	series := res.Series[0]
-	datapoint := series.Pointlist[len(series.Pointlist)-1]
-	if len(datapoint) < 1 {
-		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Datadog returned no value: %s", string(bodyBytes))
+	if len(labels) == 0 && scope != "" {
+		labels["scope"] = scope
 	}
-
-	status, err := evaluate.EvaluateResult(datapoint[1], metric, p.logCtx)
-	return strconv.FormatFloat(datapoint[1], 'f', -1, 64), status, err
+	return labels
 }
 
 // Resume should not be used the Datadog provider since all the work should occur in the Run method
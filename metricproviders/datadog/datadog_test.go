@@ -169,7 +169,7 @@ func TestRunSuite(t *testing.T) {
 			},
 			expectedIntervalSeconds: 300,
 			expectedPhase:           v1alpha1.AnalysisPhaseError,
-			expectedErrorMessage:    "Could not parse JSON body: json: cannot unmarshal string into Go struct field datadogResponse.Series of type []struct { Pointlist [][]float64 \"json:\\\"pointlist\\\"\" }",
+			expectedErrorMessage:    "Could not parse JSON body: json: cannot unmarshal string into Go struct field datadogResponse.Series of type []struct { Pointlist [][]float64 \"json:\\\"pointlist\\\"\"; Scope string \"json:\\\"scope\\\"\"; TagSet []string \"json:\\\"tag_set\\\"\" }",
 			useEnvVarForKeys:        false,
 		},
 	}
@@ -279,6 +279,45 @@ func TestRunSuite(t *testing.T) {
 	}
 }
 
+func TestRunPerSeries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		io.WriteString(rw, `{"status":"ok","series":[`+
+			`{"scope":"endpoint:/a","tag_set":["endpoint:/a"],"pointlist":[[1598867910000,0.2],[1598867925000,0.0001]]},`+
+			`{"scope":"endpoint:/b","tag_set":["endpoint:/b"],"pointlist":[[1598867910000,0.0001],[1598867925000,0.05]]},`+
+			`{"scope":"endpoint:/c","pointlist":[[1598867925000,0.5]]}]}`)
+	}))
+	defer server.Close()
+
+	os.Setenv("DD_API_KEY", "api-key")
+	os.Setenv("DD_APP_KEY", "app-key")
+	os.Setenv("DD_ADDRESS", server.URL)
+	defer func() {
+		os.Unsetenv("DD_API_KEY")
+		os.Unsetenv("DD_APP_KEY")
+		os.Unsetenv("DD_ADDRESS")
+	}()
+
+	logCtx := log.WithField("test", "test")
+	provider, err := NewDatadogProvider(*logCtx, k8sfake.NewSimpleClientset())
+	assert.NoError(t, err)
+
+	metric := v1alpha1.Metric{
+		Name:             "foo",
+		SuccessCondition: "result < 0.001",
+		EvaluationMode:   v1alpha1.EvaluationModePerSeries,
+		Provider: v1alpha1.MetricProvider{
+			Datadog: &v1alpha1.DatadogMetric{
+				Query: "avg:http.errors{*} by {endpoint}",
+			},
+		},
+	}
+	measurement := provider.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, "[0.0001,0.05,0.5]", measurement.Value)
+	assert.Equal(t, `[{endpoint="/b"},{scope="endpoint:/c"}]`, measurement.Metadata["failedSeries"])
+}
+
 func newAnalysisRun() *v1alpha1.AnalysisRun {
 	return &v1alpha1.AnalysisRun{}
 }
@@ -65,7 +65,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
 
-	valueStr, newStatus, err := p.processResponse(metric, results)
+	valueStr, newStatus, err := p.processResponse(run, metric, results)
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
@@ -85,7 +85,7 @@ func toJSONString(v interface{}) (string, error) {
 	return string(b), nil
 }
 
-func (p *Provider) processResponse(metric v1alpha1.Metric, results []nrdb.NrdbResult) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) processResponse(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, results []nrdb.NrdbResult) (string, v1alpha1.AnalysisPhase, error) {
 	if len(results) == 1 {
 		result := results[0]
 		if len(result) == 0 {
@@ -95,14 +95,14 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, results []nrdb.NrdbRe
 		if err != nil {
 			return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("could not marshal results: %w", err)
 		}
-		newStatus, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResult(result, metric, run, p.logCtx)
 		return valueStr, newStatus, err
 	} else if len(results) > 1 {
 		valueStr, err := toJSONString(results)
 		if err != nil {
 			return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("could not marshal results: %w", err)
 		}
-		newStatus, err := evaluate.EvaluateResult(results, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResult(results, metric, run, p.logCtx)
 		return valueStr, newStatus, err
 	} else {
 		return "", v1alpha1.AnalysisPhaseFailed, fmt.Errorf("no results returned from NRQL query")
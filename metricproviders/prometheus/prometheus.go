@@ -3,6 +3,7 @@ package prometheus
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/api"
@@ -48,10 +49,22 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
 
-	newValue, newStatus, err := p.processResponse(metric, response)
-	if err != nil {
-		return metricutil.MarkMeasurementError(newMeasurement, err)
-
+	var newValue string
+	var newStatus v1alpha1.AnalysisPhase
+	if evaluate.IsPerSeries(metric) {
+		var seriesMetadata map[string]string
+		newValue, newStatus, seriesMetadata, err = p.processSeriesResponse(run, metric, response)
+		if err != nil {
+			return metricutil.MarkMeasurementError(newMeasurement, err)
+		}
+		if len(seriesMetadata) > 0 {
+			newMeasurement.Metadata = seriesMetadata
+		}
+	} else {
+		newValue, newStatus, err = p.processResponse(run, metric, response)
+		if err != nil {
+			return metricutil.MarkMeasurementError(newMeasurement, err)
+		}
 	}
 	newMeasurement.Value = newValue
 	if len(warnings) > 0 {
@@ -61,7 +74,10 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		}
 		warningMetadata = warningMetadata[:len(warningMetadata)-2]
 		if warningMetadata != "" {
-			newMeasurement.Metadata = map[string]string{"warnings": warningMetadata}
+			if newMeasurement.Metadata == nil {
+				newMeasurement.Metadata = map[string]string{}
+			}
+			newMeasurement.Metadata["warnings"] = warningMetadata
 			p.logCtx.Warnf("Prometheus returned the following warnings: %s", warningMetadata)
 		}
 	}
@@ -89,12 +105,12 @@ func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Met
 	return nil
 }
 
-func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) processResponse(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, response model.Value) (string, v1alpha1.AnalysisPhase, error) {
 	switch value := response.(type) {
 	case *model.Scalar:
 		valueStr := value.Value.String()
 		result := float64(value.Value)
-		newStatus, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResult(result, metric, run, p.logCtx)
 		return valueStr, newStatus, err
 	case model.Vector:
 		results := make([]float64, 0, len(value))
@@ -110,7 +126,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value)
 			valueStr = valueStr[:len(valueStr)-1]
 		}
 		valueStr = valueStr + "]"
-		newStatus, err := evaluate.EvaluateResult(results, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResult(results, metric, run, p.logCtx)
 		return valueStr, newStatus, err
 	//TODO(dthomson) add other response types
 	default:
@@ -118,6 +134,35 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value)
 	}
 }
 
+// processSeriesResponse evaluates the metric conditions against each sample of a vector response
+func (p *Provider) processSeriesResponse(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, response model.Value) (string, v1alpha1.AnalysisPhase, map[string]string, error) {
+	var series []evaluate.Series
+	var valueStr string
+	switch value := response.(type) {
+	case *model.Scalar:
+		valueStr = value.Value.String()
+		series = append(series, evaluate.Series{Value: float64(value.Value)})
+	case model.Vector:
+		values := make([]string, 0, len(value))
+		for _, s := range value {
+			if s == nil {
+				continue
+			}
+			labels := make(map[string]string, len(s.Metric))
+			for k, v := range s.Metric {
+				labels[string(k)] = string(v)
+			}
+			values = append(values, s.Value.String())
+			series = append(series, evaluate.Series{Labels: labels, Value: float64(s.Value)})
+		}
+		valueStr = "[" + strings.Join(values, ",") + "]"
+	default:
+		return "", v1alpha1.AnalysisPhaseError, nil, fmt.Errorf("Prometheus metric type not supported")
+	}
+	newStatus, metadata, err := evaluate.EvaluateSeriesResult(series, metric, run, p.logCtx)
+	return valueStr, newStatus, metadata, err
+}
+
 // NewPrometheusProvider Creates a new Prometheus client
 func NewPrometheusProvider(api v1.API, logCtx log.Entry) *Provider {
 	return &Provider{
@@ -213,13 +213,72 @@ func TestProcessScalarResponse(t *testing.T) {
 		Timestamp: model.Time(0),
 	}
 
-	value, status, err := p.processResponse(metric, response)
+	value, status, err := p.processResponse(nil, metric, response)
 	assert.Nil(t, err)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 	assert.Equal(t, "10", value)
 
 }
 
+func TestRunPerSeries(t *testing.T) {
+	e := log.NewEntry(log.New())
+	mock := mockAPI{
+		value: model.Vector{
+			{
+				Metric: model.Metric{"endpoint": "/a"},
+				Value:  model.SampleValue(0.01),
+			},
+			{
+				Metric: model.Metric{"endpoint": "/b"},
+				Value:  model.SampleValue(0.2),
+			},
+		},
+		warnings: v1.Warnings([]string{"warning"}),
+	}
+	p := NewPrometheusProvider(mock, *e)
+	metric := v1alpha1.Metric{
+		Name:             "foo",
+		SuccessCondition: "result < 0.05",
+		EvaluationMode:   v1alpha1.EvaluationModePerSeries,
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: &v1alpha1.PrometheusMetric{
+				Query: "test",
+			},
+		},
+	}
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, "[0.01,0.2]", measurement.Value)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, `[{endpoint="/b"}]`, measurement.Metadata["failedSeries"])
+	assert.Equal(t, `"warning"`, measurement.Metadata["warnings"])
+
+	metric.SuccessCondition = "result < 0.5"
+	measurement = p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.NotContains(t, measurement.Metadata, "failedSeries")
+}
+
+func TestRunPerSeriesEmptyVector(t *testing.T) {
+	e := log.NewEntry(log.New())
+	mock := mockAPI{
+		value: model.Vector{},
+	}
+	p := NewPrometheusProvider(mock, *e)
+	metric := v1alpha1.Metric{
+		Name:             "foo",
+		SuccessCondition: "result < 0.05",
+		EvaluationMode:   v1alpha1.EvaluationModePerSeries,
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: &v1alpha1.PrometheusMetric{
+				Query: "test",
+			},
+		},
+	}
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "no series returned to evaluate", measurement.Message)
+}
+
 func TestProcessNaNResponse(t *testing.T) {
 	logCtx := log.WithField("test", "test")
 	p := Provider{
@@ -237,7 +296,7 @@ func TestProcessNaNResponse(t *testing.T) {
 			FailureCondition: "result < 0.9",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(nil, metric, response)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, status)
 		assert.Equal(t, "NaN", value)
@@ -248,7 +307,7 @@ func TestProcessNaNResponse(t *testing.T) {
 			SuccessCondition: "result >= 0.9 || isNaN(result)",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(nil, metric, response)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 		assert.Equal(t, "NaN", value)
@@ -259,7 +318,7 @@ func TestProcessNaNResponse(t *testing.T) {
 			SuccessCondition: "result >= 0.9",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(nil, metric, response)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
 		assert.Equal(t, "NaN", value)
@@ -282,7 +341,7 @@ func TestProcessInfResponse(t *testing.T) {
 			SuccessCondition: "result >= 0.9",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(nil, metric, response)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 		assert.Equal(t, "+Inf", value)
@@ -293,7 +352,7 @@ func TestProcessInfResponse(t *testing.T) {
 			FailureCondition: "isInf(result)",
 		}
 
-		value, status, err := p.processResponse(metric, response)
+		value, status, err := p.processResponse(nil, metric, response)
 		assert.Nil(t, err)
 		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
 		assert.Equal(t, "+Inf", value)
@@ -320,7 +379,7 @@ func TestProcessVectorResponse(t *testing.T) {
 			Timestamp: model.Time(0),
 		},
 	}
-	value, status, err := p.processResponse(metric, response)
+	value, status, err := p.processResponse(nil, metric, response)
 	assert.Nil(t, err)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 	assert.Equal(t, "[10,11]", value)
@@ -337,7 +396,7 @@ func TestProcessInvalidResponse(t *testing.T) {
 		FailureCondition: "true",
 	}
 
-	value, status, err := p.processResponse(metric, nil)
+	value, status, err := p.processResponse(nil, metric, nil)
 	assert.NotNil(t, err)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, status)
 	assert.Equal(t, "", value)
@@ -64,6 +64,7 @@ type wavefrontResponse struct {
 	newStatus  v1alpha1.AnalysisPhase
 	epochsUsed string
 	drift      string
+	metadata   map[string]string
 }
 
 // Run queries with wavefront provider for the metric
@@ -95,7 +96,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
-	result, err := p.processResponse(metric, response, startTime)
+	result, err := p.processResponse(run, metric, response, startTime)
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
@@ -103,6 +104,9 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	newMeasurement.Phase = result.newStatus
 	newMeasurement.Metadata["timestamps"] = result.epochsUsed
 	newMeasurement.Metadata["drift"] = result.drift
+	for k, v := range result.metadata {
+		newMeasurement.Metadata[k] = v
+	}
 	finishedTime := metav1.Now()
 	newMeasurement.FinishedAt = &finishedTime
 	return newMeasurement
@@ -144,15 +148,33 @@ func (p *Provider) findDataPointValue(datapoints []wavefrontapi.DataPoint, mTime
 	return currentValue, int64(currentTime), int64(delta)
 }
 
-func (p *Provider) processResponse(metric v1alpha1.Metric, response *wavefrontapi.QueryResponse, startTime metav1.Time) (wavefrontResponse, error) {
+func (p *Provider) processResponse(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, response *wavefrontapi.QueryResponse, startTime metav1.Time) (wavefrontResponse, error) {
 	wavefrontResponse := wavefrontResponse{}
 	var err error
-	if len(response.TimeSeries) == 1 {
+	if len(response.TimeSeries) > 0 && evaluate.IsPerSeries(metric) {
+		series := make([]evaluate.Series, 0, len(response.TimeSeries))
+		resultStrs := []string{}
+		epochStrs := []string{}
+		driftStrs := []string{}
+		for _, ts := range response.TimeSeries {
+			value, epoch, drift := p.findDataPointValue(ts.DataPoints, startTime)
+			series = append(series, evaluate.Series{Labels: seriesLabels(ts), Value: value})
+			resultStrs = append(resultStrs, fmt.Sprintf("%.2f", value))
+			epochStrs = append(epochStrs, strconv.Itoa(int(epoch)))
+			driftStrs = append(driftStrs, strconv.Itoa(int(drift)))
+		}
+		wavefrontResponse.newValue = fmt.Sprintf("[%s]", strings.Join(resultStrs, ","))
+		wavefrontResponse.epochsUsed = fmt.Sprintf("[%s]", strings.Join(epochStrs, ","))
+		wavefrontResponse.drift = fmt.Sprintf("[%s]", strings.Join(driftStrs, ","))
+		wavefrontResponse.newStatus, wavefrontResponse.metadata, err = evaluate.EvaluateSeriesResult(series, metric, run, p.logCtx)
+		return wavefrontResponse, err
+
+	} else if len(response.TimeSeries) == 1 {
 		series := response.TimeSeries[0]
 		value, epoch, drift := p.findDataPointValue(series.DataPoints, startTime)
 		wavefrontResponse.newValue = fmt.Sprintf("%.2f", value)
 		wavefrontResponse.epochsUsed = strconv.Itoa(int(epoch))
-		wavefrontResponse.newStatus, err = evaluate.EvaluateResult(value, metric, p.logCtx)
+		wavefrontResponse.newStatus, err = evaluate.EvaluateResult(value, metric, run, p.logCtx)
 		wavefrontResponse.drift = strconv.Itoa(int(drift))
 		return wavefrontResponse, err
 
@@ -171,7 +193,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response *wavefrontap
 		wavefrontResponse.newValue = fmt.Sprintf("[%s]", strings.Join(resultStrs, ","))
 		wavefrontResponse.epochsUsed = fmt.Sprintf("[%s]", strings.Join(epochStrs, ","))
 		wavefrontResponse.drift = fmt.Sprintf("[%s]", strings.Join(driftStrs, ","))
-		wavefrontResponse.newStatus, err = evaluate.EvaluateResult(results, metric, p.logCtx)
+		wavefrontResponse.newStatus, err = evaluate.EvaluateResult(results, metric, run, p.logCtx)
 		return wavefrontResponse, err
 
 	} else {
@@ -180,6 +202,21 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response *wavefrontap
 	}
 }
 
+// seriesLabels returns the point tags of a Wavefront time series along with its metric label and host
+func seriesLabels(ts wavefrontapi.TimeSeries) map[string]string {
+	labels := make(map[string]string, len(ts.Tags)+2)
+	for k, v := range ts.Tags {
+		labels[k] = v
+	}
+	if ts.Label != "" {
+		labels["label"] = ts.Label
+	}
+	if ts.Host != "" {
+		labels["host"] = ts.Host
+	}
+	return labels
+}
+
 // NewWavefrontProvider Creates a new Wavefront client
 func NewWavefrontProvider(api WavefrontClientAPI, logCtx log.Entry) *Provider {
 	return &Provider{
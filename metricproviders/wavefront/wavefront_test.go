@@ -184,13 +184,47 @@ func TestProcessMultipleTimeseriesResponse(t *testing.T) {
 	response := &wavefrontapi.QueryResponse{
 		TimeSeries: []wavefrontapi.TimeSeries{mockSeries1, mockSeries2},
 	}
-	result, err := p.processResponse(metric, response, metav1.Unix(12000, 0))
+	result, err := p.processResponse(nil, metric, response, metav1.Unix(12000, 0))
 	assert.Nil(t, err)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, result.newStatus)
 	assert.Equal(t, "[10.00,11.00]", result.newValue)
 
 }
 
+func TestProcessPerSeriesResponse(t *testing.T) {
+	logCtx := log.WithField("test", "test")
+	p := Provider{
+		logCtx: *logCtx,
+	}
+	metric := v1alpha1.Metric{
+		SuccessCondition: "result < 11",
+		EvaluationMode:   v1alpha1.EvaluationModePerSeries,
+	}
+
+	mockSeries1 := wavefrontapi.TimeSeries{
+		Label: "errors",
+		Tags:  map[string]string{"endpoint": "/a"},
+		DataPoints: []wavefrontapi.DataPoint{
+			[]float64{12000, 10},
+		},
+	}
+	mockSeries2 := wavefrontapi.TimeSeries{
+		Label: "errors",
+		Tags:  map[string]string{"endpoint": "/b"},
+		DataPoints: []wavefrontapi.DataPoint{
+			[]float64{12000, 12},
+		},
+	}
+	response := &wavefrontapi.QueryResponse{
+		TimeSeries: []wavefrontapi.TimeSeries{mockSeries1, mockSeries2},
+	}
+	result, err := p.processResponse(nil, metric, response, metav1.Unix(12000, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, result.newStatus)
+	assert.Equal(t, "[10.00,12.00]", result.newValue)
+	assert.Equal(t, `[{endpoint="/b", label="errors"}]`, result.metadata["failedSeries"])
+}
+
 func TestNewWavefrontAPI(t *testing.T) {
 	metric := v1alpha1.Metric{
 		Provider: v1alpha1.MetricProvider{
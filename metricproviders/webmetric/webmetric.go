@@ -72,7 +72,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		return metricutil.MarkMeasurementError(measurement, fmt.Errorf("received non 2xx response code: %v", response.StatusCode))
 	}
 
-	value, status, err := p.parseResponse(metric, response)
+	value, status, err := p.parseResponse(run, metric, response)
 	if err != nil {
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
@@ -85,7 +85,7 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	return measurement
 }
 
-func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response) (string, v1alpha1.AnalysisPhase, error) {
+func (p *Provider) parseResponse(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, response *http.Response) (string, v1alpha1.AnalysisPhase, error) {
 	var data interface{}
 
 	bodyBytes, err := ioutil.ReadAll(response.Body)
@@ -108,7 +108,7 @@ func (p *Provider) parseResponse(metric v1alpha1.Metric, response *http.Response
 		return "", v1alpha1.AnalysisPhaseError, err
 	}
 
-	status, err := evaluate.EvaluateResult(val, metric, p.logCtx)
+	status, err := evaluate.EvaluateResult(val, metric, run, p.logCtx)
 	return valString, status, err
 }
 
@@ -138,6 +138,7 @@ type SetImageRequest struct {
 	Image                string   `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
 	Tag                  string   `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
 	Namespace            string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Reason               string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -211,10 +212,18 @@ func (m *SetImageRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *SetImageRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type UndoRolloutRequest struct {
 	Rollout              string   `protobuf:"bytes,1,opt,name=rollout,proto3" json:"rollout,omitempty"`
 	Revision             int64    `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -274,9 +283,17 @@ func (m *UndoRolloutRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *UndoRolloutRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type RestartRolloutRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -329,10 +346,18 @@ func (m *RestartRolloutRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *RestartRolloutRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type PromoteRolloutRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Full                 bool     `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -392,9 +417,17 @@ func (m *PromoteRolloutRequest) GetFull() bool {
 	return false
 }
 
+func (m *PromoteRolloutRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type AbortRolloutRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -447,9 +480,17 @@ func (m *AbortRolloutRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *AbortRolloutRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type RetryRolloutRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -502,6 +543,85 @@ func (m *RetryRolloutRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *RetryRolloutRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type JudgeAnalysisRunRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// phase is the judged outcome of the run, either Successful or Failed
+	Phase                string   `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JudgeAnalysisRunRequest) Reset()         { *m = JudgeAnalysisRunRequest{} }
+func (m *JudgeAnalysisRunRequest) String() string { return proto.CompactTextString(m) }
+func (*JudgeAnalysisRunRequest) ProtoMessage()    {}
+func (*JudgeAnalysisRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{8}
+}
+func (m *JudgeAnalysisRunRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JudgeAnalysisRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JudgeAnalysisRunRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JudgeAnalysisRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JudgeAnalysisRunRequest.Merge(m, src)
+}
+func (m *JudgeAnalysisRunRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JudgeAnalysisRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JudgeAnalysisRunRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JudgeAnalysisRunRequest proto.InternalMessageInfo
+
+func (m *JudgeAnalysisRunRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JudgeAnalysisRunRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *JudgeAnalysisRunRequest) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *JudgeAnalysisRunRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type RolloutWatchEvent struct {
 	Type                 string       `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	RolloutInfo          *RolloutInfo `protobuf:"bytes,2,opt,name=rolloutInfo,proto3" json:"rolloutInfo,omitempty"`
@@ -514,7 +634,7 @@ func (m *RolloutWatchEvent) Reset()         { *m = RolloutWatchEvent{} }
 func (m *RolloutWatchEvent) String() string { return proto.CompactTextString(m) }
 func (*RolloutWatchEvent) ProtoMessage()    {}
 func (*RolloutWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{8}
+	return fileDescriptor_99101d942e8912a7, []int{9}
 }
 func (m *RolloutWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +689,7 @@ func (m *NamespaceInfo) Reset()         { *m = NamespaceInfo{} }
 func (m *NamespaceInfo) String() string { return proto.CompactTextString(m) }
 func (*NamespaceInfo) ProtoMessage()    {}
 func (*NamespaceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{9}
+	return fileDescriptor_99101d942e8912a7, []int{10}
 }
 func (m *NamespaceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +743,7 @@ func (m *RolloutInfoList) Reset()         { *m = RolloutInfoList{} }
 func (m *RolloutInfoList) String() string { return proto.CompactTextString(m) }
 func (*RolloutInfoList) ProtoMessage()    {}
 func (*RolloutInfoList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{10}
+	return fileDescriptor_99101d942e8912a7, []int{11}
 }
 func (m *RolloutInfoList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,7 +790,7 @@ func (m *VersionInfo) Reset()         { *m = VersionInfo{} }
 func (m *VersionInfo) String() string { return proto.CompactTextString(m) }
 func (*VersionInfo) ProtoMessage()    {}
 func (*VersionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{11}
+	return fileDescriptor_99101d942e8912a7, []int{12}
 }
 func (m *VersionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,36 +827,37 @@ func (m *VersionInfo) GetRolloutsVersion() string {
 }
 
 type RolloutInfo struct {
-	ObjectMeta           *v1.ObjectMeta         `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	Status               string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Message              string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Icon                 string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
-	Strategy             string                 `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
-	Step                 string                 `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
-	SetWeight            string                 `protobuf:"bytes,7,opt,name=setWeight,proto3" json:"setWeight,omitempty"`
-	ActualWeight         string                 `protobuf:"bytes,8,opt,name=actualWeight,proto3" json:"actualWeight,omitempty"`
-	Ready                int32                  `protobuf:"varint,9,opt,name=ready,proto3" json:"ready,omitempty"`
-	Current              int32                  `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
-	Desired              int32                  `protobuf:"varint,11,opt,name=desired,proto3" json:"desired,omitempty"`
-	Updated              int32                  `protobuf:"varint,12,opt,name=updated,proto3" json:"updated,omitempty"`
-	Available            int32                  `protobuf:"varint,13,opt,name=available,proto3" json:"available,omitempty"`
-	RestartedAt          string                 `protobuf:"bytes,14,opt,name=restartedAt,proto3" json:"restartedAt,omitempty"`
-	Generation           string                 `protobuf:"bytes,15,opt,name=generation,proto3" json:"generation,omitempty"`
-	ReplicaSets          []*ReplicaSetInfo      `protobuf:"bytes,16,rep,name=replicaSets,proto3" json:"replicaSets,omitempty"`
-	Experiments          []*ExperimentInfo      `protobuf:"bytes,17,rep,name=experiments,proto3" json:"experiments,omitempty"`
-	AnalysisRuns         []*AnalysisRunInfo     `protobuf:"bytes,18,rep,name=analysisRuns,proto3" json:"analysisRuns,omitempty"`
-	Containers           []*ContainerInfo       `protobuf:"bytes,19,rep,name=containers,proto3" json:"containers,omitempty"`
-	Steps                []*v1alpha1.CanaryStep `protobuf:"bytes,20,rep,name=steps,proto3" json:"steps,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	ObjectMeta           *v1.ObjectMeta                `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	Status               string                        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                        `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Icon                 string                        `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Strategy             string                        `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Step                 string                        `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
+	SetWeight            string                        `protobuf:"bytes,7,opt,name=setWeight,proto3" json:"setWeight,omitempty"`
+	ActualWeight         string                        `protobuf:"bytes,8,opt,name=actualWeight,proto3" json:"actualWeight,omitempty"`
+	Ready                int32                         `protobuf:"varint,9,opt,name=ready,proto3" json:"ready,omitempty"`
+	Current              int32                         `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
+	Desired              int32                         `protobuf:"varint,11,opt,name=desired,proto3" json:"desired,omitempty"`
+	Updated              int32                         `protobuf:"varint,12,opt,name=updated,proto3" json:"updated,omitempty"`
+	Available            int32                         `protobuf:"varint,13,opt,name=available,proto3" json:"available,omitempty"`
+	RestartedAt          string                        `protobuf:"bytes,14,opt,name=restartedAt,proto3" json:"restartedAt,omitempty"`
+	Generation           string                        `protobuf:"bytes,15,opt,name=generation,proto3" json:"generation,omitempty"`
+	ReplicaSets          []*ReplicaSetInfo             `protobuf:"bytes,16,rep,name=replicaSets,proto3" json:"replicaSets,omitempty"`
+	Experiments          []*ExperimentInfo             `protobuf:"bytes,17,rep,name=experiments,proto3" json:"experiments,omitempty"`
+	AnalysisRuns         []*AnalysisRunInfo            `protobuf:"bytes,18,rep,name=analysisRuns,proto3" json:"analysisRuns,omitempty"`
+	Containers           []*ContainerInfo              `protobuf:"bytes,19,rep,name=containers,proto3" json:"containers,omitempty"`
+	Steps                []*v1alpha1.CanaryStep        `protobuf:"bytes,20,rep,name=steps,proto3" json:"steps,omitempty"`
+	SkippedSteps         []*v1alpha1.SkippedCanaryStep `protobuf:"bytes,21,rep,name=skippedSteps,proto3" json:"skippedSteps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *RolloutInfo) Reset()         { *m = RolloutInfo{} }
 func (m *RolloutInfo) String() string { return proto.CompactTextString(m) }
 func (*RolloutInfo) ProtoMessage()    {}
 func (*RolloutInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{12}
+	return fileDescriptor_99101d942e8912a7, []int{13}
 }
 func (m *RolloutInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,6 +1026,13 @@ func (m *RolloutInfo) GetSteps() []*v1alpha1.CanaryStep {
 	return nil
 }
 
+func (m *RolloutInfo) GetSkippedSteps() []*v1alpha1.SkippedCanaryStep {
+	if m != nil {
+		return m.SkippedSteps
+	}
+	return nil
+}
+
 type ExperimentInfo struct {
 	ObjectMeta           *v1.ObjectMeta     `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
 	Icon                 string             `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
@@ -922,7 +1050,7 @@ func (m *ExperimentInfo) Reset()         { *m = ExperimentInfo{} }
 func (m *ExperimentInfo) String() string { return proto.CompactTextString(m) }
 func (*ExperimentInfo) ProtoMessage()    {}
 func (*ExperimentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{13}
+	return fileDescriptor_99101d942e8912a7, []int{14}
 }
 func (m *ExperimentInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1152,7 @@ func (m *ReplicaSetInfo) Reset()         { *m = ReplicaSetInfo{} }
 func (m *ReplicaSetInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplicaSetInfo) ProtoMessage()    {}
 func (*ReplicaSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{14}
+	return fileDescriptor_99101d942e8912a7, []int{15}
 }
 func (m *ReplicaSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1294,7 @@ func (m *PodInfo) Reset()         { *m = PodInfo{} }
 func (m *PodInfo) String() string { return proto.CompactTextString(m) }
 func (*PodInfo) ProtoMessage()    {}
 func (*PodInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{15}
+	return fileDescriptor_99101d942e8912a7, []int{16}
 }
 func (m *PodInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1242,7 +1370,7 @@ func (m *ContainerInfo) Reset()         { *m = ContainerInfo{} }
 func (m *ContainerInfo) String() string { return proto.CompactTextString(m) }
 func (*ContainerInfo) ProtoMessage()    {}
 func (*ContainerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{16}
+	return fileDescriptor_99101d942e8912a7, []int{17}
 }
 func (m *ContainerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1298,7 +1426,7 @@ func (m *JobInfo) Reset()         { *m = JobInfo{} }
 func (m *JobInfo) String() string { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()    {}
 func (*JobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{17}
+	return fileDescriptor_99101d942e8912a7, []int{18}
 }
 func (m *JobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,7 +1495,7 @@ func (m *AnalysisRunInfo) Reset()         { *m = AnalysisRunInfo{} }
 func (m *AnalysisRunInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalysisRunInfo) ProtoMessage()    {}
 func (*AnalysisRunInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{18}
+	return fileDescriptor_99101d942e8912a7, []int{19}
 }
 func (m *AnalysisRunInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1468,6 +1596,7 @@ func init() {
 	proto.RegisterType((*PromoteRolloutRequest)(nil), "rollout.PromoteRolloutRequest")
 	proto.RegisterType((*AbortRolloutRequest)(nil), "rollout.AbortRolloutRequest")
 	proto.RegisterType((*RetryRolloutRequest)(nil), "rollout.RetryRolloutRequest")
+	proto.RegisterType((*JudgeAnalysisRunRequest)(nil), "rollout.JudgeAnalysisRunRequest")
 	proto.RegisterType((*RolloutWatchEvent)(nil), "rollout.RolloutWatchEvent")
 	proto.RegisterType((*NamespaceInfo)(nil), "rollout.NamespaceInfo")
 	proto.RegisterType((*RolloutInfoList)(nil), "rollout.RolloutInfoList")
@@ -1486,107 +1615,113 @@ func init() {
 }
 
 var fileDescriptor_99101d942e8912a7 = []byte{
-
-	// 1576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x18, 0xcd, 0x6f, 0xdc, 0xc4,
-	0x57, 0xde, 0xcd, 0x26, 0x9b, 0xd9, 0x7c, 0x4e, 0xd2, 0xd6, 0xdd, 0xf6, 0x17, 0xe5, 0xe7, 0xfe,
-	0xa4, 0x5f, 0x1a, 0xc0, 0x4e, 0x4a, 0x95, 0x52, 0x3e, 0x0e, 0xa1, 0x8d, 0x42, 0x51, 0x81, 0xe0,
-	0x08, 0x2a, 0x90, 0xa0, 0x9a, 0xf5, 0x4e, 0x36, 0x6e, 0xbd, 0x1e, 0xe3, 0x19, 0x6f, 0x59, 0x45,
-	0x7b, 0x80, 0x0b, 0x47, 0x0e, 0xfc, 0x11, 0x88, 0x13, 0x17, 0x2e, 0x1c, 0x38, 0x21, 0x21, 0x4e,
-	0x08, 0x89, 0x7f, 0x00, 0x55, 0x5c, 0xf8, 0x2f, 0xd0, 0x3c, 0x8f, 0xc7, 0xf6, 0x66, 0xd3, 0xa6,
-	0x4a, 0x20, 0x9c, 0x3c, 0xef, 0xbd, 0x79, 0x1f, 0xe3, 0xf7, 0x31, 0xef, 0x0d, 0xba, 0x12, 0x3d,
-	0xec, 0x38, 0x24, 0xf2, 0xbd, 0xc0, 0xa7, 0xa1, 0x70, 0x62, 0x16, 0x04, 0x2c, 0xd1, 0x5f, 0x3b,
-	0x8a, 0x99, 0x60, 0x78, 0x42, 0x81, 0xcd, 0xcb, 0x1d, 0xc6, 0x3a, 0x01, 0x95, 0x0c, 0x0e, 0x09,
-	0x43, 0x26, 0x88, 0xf0, 0x59, 0xc8, 0xd3, 0x6d, 0xcd, 0xbb, 0x1d, 0x5f, 0xec, 0x27, 0x2d, 0xdb,
-	0x63, 0x5d, 0x87, 0xc4, 0x1d, 0x16, 0xc5, 0xec, 0x01, 0x2c, 0x5e, 0x50, 0xfc, 0xdc, 0x51, 0xda,
-	0xb8, 0xa3, 0x31, 0xbd, 0x75, 0x12, 0x44, 0xfb, 0x64, 0xdd, 0xe9, 0xd0, 0x90, 0xc6, 0x44, 0xd0,
-	0xb6, 0x92, 0x76, 0xfd, 0xe1, 0x4b, 0xdc, 0xf6, 0x99, 0xdc, 0xde, 0x25, 0xde, 0xbe, 0x1f, 0xd2,
-	0xb8, 0x9f, 0xf3, 0x77, 0xa9, 0x20, 0x4e, 0xef, 0x30, 0xd7, 0x25, 0x65, 0x21, 0x40, 0xad, 0x64,
-	0xcf, 0xa1, 0xdd, 0x48, 0xf4, 0x53, 0xa2, 0x75, 0x1b, 0xcd, 0xb9, 0xa9, 0xde, 0x3b, 0xe1, 0x1e,
-	0x7b, 0x37, 0xa1, 0x71, 0x1f, 0x63, 0x34, 0x16, 0x92, 0x2e, 0x35, 0x8d, 0x65, 0x63, 0x65, 0xd2,
-	0x85, 0x35, 0xbe, 0x8c, 0x26, 0xe5, 0x97, 0x47, 0xc4, 0xa3, 0x66, 0x05, 0x08, 0x39, 0xc2, 0xba,
-	0x8e, 0x16, 0x0b, 0x52, 0xee, 0xfa, 0x5c, 0xa4, 0x92, 0x4a, 0x5c, 0xc6, 0x30, 0xd7, 0x97, 0x06,
-	0x9a, 0xdd, 0xa5, 0xe2, 0x4e, 0x97, 0x74, 0xa8, 0x4b, 0x3f, 0x49, 0x28, 0x17, 0xd8, 0x44, 0xd9,
-	0x9f, 0x55, 0xfb, 0x33, 0x50, 0xca, 0xf2, 0x58, 0x28, 0x88, 0x3c, 0x75, 0x66, 0x81, 0x46, 0xe0,
-	0x45, 0x54, 0xf3, 0xa5, 0x1c, 0xb3, 0x0a, 0x94, 0x14, 0xc0, 0x73, 0xa8, 0x2a, 0x48, 0xc7, 0x1c,
-	0x03, 0x9c, 0x5c, 0x96, 0x2d, 0xaa, 0x0d, 0x5b, 0xb4, 0x8f, 0xf0, 0x7b, 0x61, 0x9b, 0xa9, 0xb3,
-	0x3c, 0xdd, 0xa6, 0x26, 0xaa, 0xc7, 0xb4, 0xe7, 0x73, 0x9f, 0x85, 0x60, 0x52, 0xd5, 0xd5, 0x70,
-	0x59, 0x53, 0x75, 0x58, 0xd3, 0x1d, 0x74, 0xce, 0xa5, 0x5c, 0x90, 0x58, 0x0c, 0x29, 0x7b, 0xf6,
-	0x9f, 0xff, 0x11, 0x3a, 0xb7, 0x13, 0xb3, 0x2e, 0x13, 0xf4, 0xa4, 0xa2, 0x24, 0xc7, 0x5e, 0x12,
-	0x04, 0x60, 0x6e, 0xdd, 0x85, 0xb5, 0xb5, 0x8d, 0x16, 0x36, 0x5b, 0xec, 0x14, 0xec, 0xdc, 0x46,
-	0x0b, 0x2e, 0x15, 0x71, 0xff, 0xc4, 0x82, 0xee, 0xa3, 0x79, 0x25, 0xe3, 0x1e, 0x11, 0xde, 0xfe,
-	0x56, 0x8f, 0x86, 0x20, 0x46, 0xf4, 0x23, 0x2d, 0x46, 0xae, 0xf1, 0x06, 0x6a, 0xc4, 0x79, 0x58,
-	0x82, 0xa0, 0xc6, 0xb5, 0x45, 0x3b, 0xcb, 0xe4, 0x42, 0xc8, 0xba, 0xc5, 0x8d, 0xd6, 0x7d, 0x34,
-	0xfd, 0x76, 0xa6, 0x4d, 0x22, 0x9e, 0x1c, 0xc7, 0x78, 0x0d, 0x2d, 0x90, 0x1e, 0xf1, 0x03, 0xd2,
-	0x0a, 0xa8, 0xe6, 0xe3, 0x66, 0x65, 0xb9, 0xba, 0x32, 0xe9, 0x8e, 0x22, 0x59, 0xb7, 0xd0, 0xec,
-	0x50, 0xbe, 0xe0, 0x35, 0x54, 0xcf, 0x0a, 0x80, 0x69, 0x2c, 0x57, 0x8f, 0x34, 0x54, 0xef, 0xb2,
-	0x6e, 0xa0, 0xc6, 0xfb, 0x34, 0x96, 0xb1, 0x06, 0x36, 0xae, 0xa0, 0xd9, 0x8c, 0xa4, 0xd0, 0xca,
-	0xd2, 0x61, 0xb4, 0xf5, 0xf5, 0x38, 0x6a, 0x14, 0x44, 0xe2, 0x1d, 0x84, 0x58, 0xeb, 0x01, 0xf5,
-	0xc4, 0x5b, 0x54, 0x10, 0x60, 0x6a, 0x5c, 0x5b, 0xb3, 0xd3, 0x5a, 0x63, 0x17, 0x6b, 0x8d, 0x1d,
-	0x3d, 0xec, 0x48, 0x04, 0xb7, 0x65, 0xad, 0xb1, 0x7b, 0xeb, 0xf6, 0x3b, 0x9a, 0xcf, 0x2d, 0xc8,
-	0xc0, 0xe7, 0xd1, 0x38, 0x17, 0x44, 0x24, 0x5c, 0x39, 0x4f, 0x41, 0x32, 0x93, 0xba, 0x94, 0xf3,
-	0x3c, 0x4f, 0x33, 0x50, 0xba, 0xcf, 0xf7, 0x58, 0xa8, 0x52, 0x15, 0xd6, 0x32, 0xbb, 0xb8, 0x90,
-	0x95, 0xac, 0xd3, 0x57, 0xa9, 0xaa, 0x61, 0xb9, 0x9f, 0x0b, 0x1a, 0x99, 0xe3, 0xe9, 0x7e, 0xb9,
-	0x96, 0x5e, 0xe2, 0x54, 0xdc, 0xa3, 0x7e, 0x67, 0x5f, 0x98, 0x13, 0xa9, 0x97, 0x34, 0x02, 0x5b,
-	0x68, 0x8a, 0x78, 0x22, 0x21, 0x81, 0xda, 0x50, 0x87, 0x0d, 0x25, 0x9c, 0xac, 0x22, 0x31, 0x25,
-	0xed, 0xbe, 0x39, 0xb9, 0x6c, 0xac, 0xd4, 0xdc, 0x14, 0x90, 0x56, 0x7b, 0x49, 0x1c, 0xd3, 0x50,
-	0x98, 0x08, 0xf0, 0x19, 0x28, 0x29, 0x6d, 0xca, 0xfd, 0x98, 0xb6, 0xcd, 0x46, 0x4a, 0x51, 0xa0,
-	0xa4, 0x24, 0x51, 0x5b, 0x56, 0x61, 0x73, 0x2a, 0xa5, 0x28, 0x50, 0x5a, 0xa9, 0x43, 0xc2, 0x9c,
-	0x06, 0x5a, 0x8e, 0xc0, 0xcb, 0xa8, 0x11, 0xa7, 0x75, 0x81, 0xb6, 0x37, 0x85, 0x39, 0x03, 0x46,
-	0x16, 0x51, 0x78, 0x09, 0x21, 0x55, 0xe1, 0xa5, 0x8b, 0x67, 0x61, 0x43, 0x01, 0x83, 0x6f, 0x4a,
-	0x09, 0x51, 0xe0, 0x7b, 0x64, 0x97, 0x0a, 0x6e, 0xce, 0x41, 0x2c, 0x5d, 0xc8, 0x63, 0x49, 0xd3,
-	0x54, 0xdc, 0xe7, 0x7b, 0x25, 0x2b, 0xfd, 0x34, 0xa2, 0xb1, 0xdf, 0xa5, 0xa1, 0xe0, 0xe6, 0xfc,
-	0x10, 0xeb, 0x96, 0xa6, 0xa5, 0xac, 0x85, 0xbd, 0xf8, 0x55, 0x34, 0x45, 0x42, 0x12, 0xf4, 0xb9,
-	0xcf, 0xdd, 0x24, 0xe4, 0x26, 0x06, 0x5e, 0x53, 0xf3, 0x6e, 0xe6, 0x44, 0x60, 0x2e, 0xed, 0xc6,
-	0x1b, 0x08, 0xe9, 0x52, 0xce, 0xcd, 0x05, 0xe0, 0x3d, 0xaf, 0x79, 0x6f, 0x65, 0x24, 0xe0, 0x2c,
-	0xec, 0xc4, 0x1f, 0xa3, 0x9a, 0xf4, 0x3c, 0x37, 0x17, 0x81, 0xe5, 0x0d, 0x3b, 0xbf, 0x6e, 0xed,
-	0xec, 0xba, 0x85, 0xc5, 0xfd, 0x2c, 0x07, 0xf2, 0x10, 0xd6, 0x98, 0xec, 0xba, 0xb5, 0x6f, 0x91,
-	0x90, 0xc4, 0xfd, 0x5d, 0x41, 0x23, 0x37, 0x15, 0x6b, 0xfd, 0x50, 0x41, 0x33, 0xe5, 0x53, 0xff,
-	0x0d, 0xc9, 0x92, 0x85, 0x7e, 0xa5, 0x1c, 0xfa, 0xfa, 0x62, 0xa9, 0x42, 0x8c, 0xe4, 0x17, 0x4b,
-	0x9e, 0x5c, 0x63, 0x47, 0x25, 0x57, 0xad, 0x9c, 0x5c, 0x43, 0x21, 0x31, 0xfe, 0x0c, 0x21, 0x31,
-	0xec, 0xd7, 0x89, 0x67, 0xf1, 0xab, 0xf5, 0x4b, 0x15, 0xcd, 0x94, 0xa5, 0xff, 0x83, 0xc5, 0x26,
-	0xfb, 0xaf, 0xd5, 0x23, 0xfe, 0xeb, 0xd8, 0xc8, 0xff, 0x2a, 0xb3, 0xb2, 0x06, 0xd7, 0x9f, 0x82,
-	0x24, 0xde, 0x83, 0xc8, 0x80, 0x62, 0x53, 0x77, 0x15, 0x24, 0xf1, 0xc4, 0x13, 0x7e, 0x8f, 0x42,
-	0xad, 0xa9, 0xbb, 0x0a, 0x92, 0x7e, 0x88, 0xa4, 0x50, 0xfa, 0x08, 0x6a, 0x4c, 0xdd, 0xcd, 0xc0,
-	0x54, 0x3b, 0xfc, 0x0d, 0xae, 0x2a, 0x8c, 0x86, 0xcb, 0x65, 0x01, 0x0d, 0x97, 0x85, 0x26, 0xaa,
-	0x0b, 0xda, 0x8d, 0x02, 0x22, 0x28, 0x54, 0x9a, 0x49, 0x57, 0xc3, 0xf8, 0x79, 0x34, 0xcf, 0x3d,
-	0x12, 0xd0, 0xdb, 0xec, 0x51, 0x78, 0x9b, 0x92, 0x76, 0xe0, 0x87, 0x14, 0x8a, 0xce, 0xa4, 0x7b,
-	0x98, 0x20, 0xad, 0x86, 0xde, 0x88, 0x9b, 0xd3, 0x70, 0x3f, 0x29, 0x08, 0xff, 0x0f, 0x8d, 0x45,
-	0xac, 0xcd, 0xcd, 0x19, 0x70, 0xf0, 0x9c, 0x76, 0xf0, 0x0e, 0x6b, 0x83, 0x63, 0x81, 0x6a, 0x7d,
-	0x6f, 0xa0, 0x09, 0x85, 0x39, 0x63, 0x4f, 0xea, 0x52, 0x9d, 0x26, 0x81, 0x2a, 0xd5, 0xf0, 0x87,
-	0xa1, 0x56, 0x72, 0xf0, 0x22, 0xfc, 0xe1, 0x14, 0xb6, 0x6e, 0xa2, 0xe9, 0x52, 0x25, 0x19, 0xd9,
-	0x79, 0xe8, 0x3e, 0xb2, 0x52, 0xe8, 0x23, 0xad, 0x2f, 0x0c, 0x34, 0xf1, 0x26, 0x6b, 0x9d, 0xfd,
-	0xb1, 0xad, 0x1f, 0x2b, 0x68, 0x76, 0x28, 0xe7, 0xfe, 0xc5, 0x25, 0x69, 0x09, 0x21, 0x9e, 0x78,
-	0x1e, 0xe5, 0x7c, 0x2f, 0x09, 0x94, 0x43, 0x0a, 0x18, 0xc9, 0xb7, 0x47, 0xfc, 0x80, 0xb6, 0x21,
-	0xb5, 0x6a, 0xae, 0x82, 0xe4, 0x5d, 0xed, 0x87, 0x1e, 0x0b, 0xbd, 0x20, 0xe1, 0x59, 0x82, 0xd5,
-	0xdc, 0x12, 0x4e, 0x7a, 0x8a, 0xc6, 0x31, 0x8b, 0x21, 0xc9, 0x6a, 0x6e, 0x0a, 0xc8, 0x30, 0x7e,
-	0xc0, 0x5a, 0x32, 0xbd, 0xca, 0x61, 0xac, 0xbc, 0xe7, 0x02, 0xf5, 0xda, 0x9f, 0xd3, 0x68, 0x46,
-	0x75, 0x40, 0xbb, 0x34, 0xee, 0xf9, 0x1e, 0xc5, 0x1c, 0xcd, 0x6c, 0x53, 0x51, 0x6c, 0x8b, 0x2e,
-	0x8e, 0xea, 0xbf, 0x60, 0xae, 0x69, 0x8e, 0x6c, 0xcd, 0xac, 0xb5, 0xcf, 0x7f, 0xfb, 0xe3, 0xab,
-	0xca, 0x2a, 0x5e, 0x81, 0x61, 0xb0, 0xb7, 0x9e, 0x4f, 0x74, 0x07, 0xba, 0x59, 0x1c, 0xa4, 0xeb,
-	0x81, 0xe3, 0x4b, 0x15, 0x03, 0x34, 0x07, 0x2d, 0xec, 0x89, 0xd4, 0x6e, 0x80, 0xda, 0x35, 0x6c,
-	0x1f, 0x57, 0xad, 0xf3, 0x48, 0xea, 0x5c, 0x33, 0x70, 0x0f, 0xcd, 0xc9, 0xde, 0xb3, 0x20, 0x8c,
-	0xe3, 0xff, 0x8c, 0xd2, 0xa1, 0x27, 0xba, 0xa6, 0x79, 0x14, 0xd9, 0xba, 0x0a, 0x66, 0x5c, 0xc1,
-	0xff, 0x7d, 0xa2, 0x19, 0x70, 0xec, 0xcf, 0x0c, 0x34, 0x3f, 0x7c, 0xee, 0xa7, 0x6a, 0x6e, 0x0e,
-	0x93, 0xf3, 0xe6, 0xdf, 0x72, 0x40, 0xf7, 0x55, 0xfc, 0xff, 0xa7, 0xea, 0xd6, 0x67, 0xff, 0x00,
-	0x4d, 0x6d, 0x53, 0xa1, 0x7b, 0x72, 0x7c, 0xde, 0x4e, 0xc7, 0x64, 0x3b, 0x1b, 0x93, 0xed, 0x2d,
-	0x39, 0x26, 0x37, 0xf3, 0x36, 0xa4, 0x34, 0x12, 0x58, 0x17, 0x41, 0xe5, 0x02, 0x9e, 0xcf, 0x54,
-	0xe6, 0xf3, 0xc0, 0xb7, 0x86, 0xbc, 0xf5, 0x8a, 0xc3, 0x1d, 0x5e, 0x2a, 0x5c, 0xb6, 0x23, 0xa6,
-	0xbe, 0xe6, 0xd6, 0xc9, 0x3a, 0x17, 0x25, 0x2d, 0x0b, 0x85, 0xe6, 0x73, 0xc7, 0x09, 0x05, 0x55,
-	0x18, 0x5f, 0x36, 0x56, 0xc1, 0xe2, 0xf2, 0x0c, 0x59, 0xb0, 0x78, 0xe4, 0x70, 0x79, 0x26, 0x16,
-	0x47, 0xa9, 0x25, 0xd2, 0xe2, 0x6f, 0x0c, 0x34, 0x55, 0x1c, 0x4b, 0xf1, 0xe5, 0xbc, 0x25, 0x39,
-	0x3c, 0xad, 0x9e, 0x96, 0xb5, 0xd7, 0xc1, 0x5a, 0xbb, 0x79, 0xf5, 0x38, 0xd6, 0x12, 0x69, 0x87,
-	0xb4, 0xf5, 0xa7, 0xf4, 0x9d, 0x23, 0x8b, 0x6a, 0x78, 0x99, 0xc8, 0xf3, 0x68, 0xe8, 0x05, 0xe4,
-	0xb4, 0x4c, 0x75, 0xc1, 0xd4, 0xbb, 0xcd, 0xed, 0x27, 0x9b, 0xaa, 0xb0, 0x03, 0x87, 0x53, 0xe1,
-	0x1c, 0xe8, 0xd6, 0x7a, 0xe0, 0x1c, 0xc0, 0xcd, 0xf7, 0xda, 0xea, 0xea, 0xc0, 0x39, 0x10, 0xa4,
-	0x33, 0x90, 0x07, 0xf9, 0xce, 0x40, 0x8d, 0xc2, 0xfb, 0x08, 0xbe, 0xa4, 0x0f, 0x71, 0xf8, 0xd5,
-	0xe4, 0xb4, 0xce, 0xb1, 0x09, 0xe7, 0x78, 0xa5, 0xb9, 0x71, 0xcc, 0x73, 0x24, 0x61, 0x9b, 0x39,
-	0x07, 0xd9, 0xcd, 0x34, 0xc8, 0x62, 0xa5, 0xf8, 0xf2, 0x50, 0x88, 0x95, 0x11, 0x0f, 0x12, 0x67,
-	0x12, 0x2b, 0xb1, 0xb4, 0x43, 0xda, 0xba, 0x83, 0x26, 0xd4, 0x98, 0x7e, 0x64, 0x45, 0xca, 0x6f,
-	0x81, 0xc2, 0xf8, 0x6f, 0x5d, 0x00, 0x75, 0xf3, 0x78, 0x36, 0x53, 0xd7, 0x4b, 0x89, 0xaf, 0x6f,
-	0xfd, 0xfc, 0x78, 0xc9, 0xf8, 0xf5, 0xf1, 0x92, 0xf1, 0xfb, 0xe3, 0x25, 0xe3, 0xc3, 0x1b, 0xc7,
-	0x7e, 0x90, 0x2c, 0x3f, 0x7f, 0xb6, 0xc6, 0xc1, 0x8a, 0x17, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0xc0, 0xbd, 0xfe, 0xe3, 0x1e, 0x15, 0x00, 0x00,
+	// 1684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5f, 0x6f, 0xdc, 0xc4,
+	0x16, 0x97, 0xb3, 0xd9, 0x64, 0x33, 0x9b, 0xbf, 0x93, 0xb4, 0x75, 0xdd, 0xde, 0x28, 0xd7, 0xbd,
+	0xd2, 0x4d, 0x73, 0x6f, 0xed, 0x24, 0xb7, 0x4a, 0xdb, 0x0b, 0x3c, 0x84, 0x36, 0x2a, 0xad, 0x4a,
+	0x1b, 0x1c, 0x41, 0x05, 0x0f, 0x44, 0xb3, 0xde, 0xc9, 0xc6, 0x89, 0xd7, 0x36, 0x9e, 0xf1, 0x96,
+	0x55, 0xb4, 0x12, 0xf0, 0xc2, 0x07, 0xe0, 0x95, 0x57, 0x84, 0x78, 0xe2, 0x85, 0x97, 0x3e, 0xf0,
+	0x84, 0x84, 0x78, 0x42, 0x48, 0x7c, 0x01, 0x54, 0xf1, 0x41, 0xd0, 0x1c, 0x8f, 0xc7, 0xf6, 0x66,
+	0x93, 0xa6, 0xa4, 0x25, 0x3c, 0xd9, 0xe7, 0x9c, 0x39, 0x73, 0x7e, 0x33, 0xe7, 0xcf, 0x9c, 0x19,
+	0x74, 0x25, 0xda, 0x6f, 0xd9, 0x24, 0xf2, 0x5c, 0xdf, 0xa3, 0x01, 0xb7, 0xe3, 0xd0, 0xf7, 0xc3,
+	0x44, 0x7d, 0xad, 0x28, 0x0e, 0x79, 0x88, 0x47, 0x25, 0x69, 0x5c, 0x6e, 0x85, 0x61, 0xcb, 0xa7,
+	0x42, 0xc1, 0x26, 0x41, 0x10, 0x72, 0xc2, 0xbd, 0x30, 0x60, 0xe9, 0x30, 0xe3, 0x41, 0xcb, 0xe3,
+	0xbb, 0x49, 0xc3, 0x72, 0xc3, 0xb6, 0x4d, 0xe2, 0x56, 0x18, 0xc5, 0xe1, 0x1e, 0xfc, 0x5c, 0x93,
+	0xfa, 0xcc, 0x96, 0xd6, 0x98, 0xad, 0x38, 0x9d, 0x15, 0xe2, 0x47, 0xbb, 0x64, 0xc5, 0x6e, 0xd1,
+	0x80, 0xc6, 0x84, 0xd3, 0xa6, 0x9c, 0xed, 0xfa, 0xfe, 0x4d, 0x66, 0x79, 0xa1, 0x18, 0xde, 0x26,
+	0xee, 0xae, 0x17, 0xd0, 0xb8, 0x9b, 0xeb, 0xb7, 0x29, 0x27, 0x76, 0xe7, 0xb0, 0xd6, 0x25, 0x89,
+	0x10, 0xa8, 0x46, 0xb2, 0x63, 0xd3, 0x76, 0xc4, 0xbb, 0xa9, 0xd0, 0xbc, 0x83, 0xa6, 0x9d, 0xd4,
+	0xee, 0xbd, 0x60, 0x27, 0x7c, 0x27, 0xa1, 0x71, 0x17, 0x63, 0x34, 0x1c, 0x90, 0x36, 0xd5, 0xb5,
+	0x05, 0x6d, 0x71, 0xcc, 0x81, 0x7f, 0x7c, 0x19, 0x8d, 0x89, 0x2f, 0x8b, 0x88, 0x4b, 0xf5, 0x21,
+	0x10, 0xe4, 0x0c, 0xf3, 0x3a, 0x9a, 0x2b, 0xcc, 0xf2, 0xc0, 0x63, 0x3c, 0x9d, 0xa9, 0xa4, 0xa5,
+	0xf5, 0x6b, 0x7d, 0xad, 0xa1, 0xa9, 0x2d, 0xca, 0xef, 0xb5, 0x49, 0x8b, 0x3a, 0xf4, 0xa3, 0x84,
+	0x32, 0x8e, 0x75, 0x94, 0xed, 0xac, 0x1c, 0x9f, 0x91, 0x62, 0x2e, 0x37, 0x0c, 0x38, 0x11, 0xab,
+	0xce, 0x10, 0x28, 0x06, 0x9e, 0x43, 0x55, 0x4f, 0xcc, 0xa3, 0x57, 0x40, 0x92, 0x12, 0x78, 0x1a,
+	0x55, 0x38, 0x69, 0xe9, 0xc3, 0xc0, 0x13, 0xbf, 0x65, 0x44, 0xd5, 0x3e, 0x44, 0xf8, 0x3c, 0x1a,
+	0x89, 0x29, 0x61, 0x61, 0xa0, 0x8f, 0x80, 0x48, 0x52, 0xe6, 0x27, 0x1a, 0xc2, 0xef, 0x06, 0xcd,
+	0x50, 0x2e, 0xf2, 0xf9, 0x60, 0x0d, 0x54, 0x8b, 0x69, 0xc7, 0x63, 0x5e, 0x18, 0x00, 0xd6, 0x8a,
+	0xa3, 0xe8, 0x32, 0x84, 0xca, 0xd1, 0x10, 0x86, 0x4b, 0x10, 0x08, 0x3a, 0xe7, 0x50, 0xc6, 0x49,
+	0xcc, 0xfb, 0x40, 0xbc, 0xb0, 0xb7, 0x0a, 0x26, 0x2a, 0x25, 0x13, 0x09, 0x3a, 0xb7, 0x19, 0x87,
+	0xed, 0x90, 0xd3, 0x53, 0x9b, 0xc0, 0x68, 0x78, 0x27, 0xf1, 0x7d, 0x30, 0x50, 0x73, 0xe0, 0xff,
+	0xc8, 0x95, 0x6d, 0xa3, 0xd9, 0xf5, 0x46, 0xf8, 0x0a, 0xd7, 0xb5, 0x8d, 0x66, 0x1d, 0xca, 0xe3,
+	0xee, 0x2b, 0x33, 0xd0, 0x45, 0x17, 0xee, 0x27, 0xcd, 0x16, 0x5d, 0x0f, 0x88, 0xdf, 0x65, 0x1e,
+	0x73, 0x92, 0xe0, 0xcf, 0x1b, 0x99, 0x43, 0xd5, 0x68, 0x97, 0x30, 0x15, 0xc9, 0x40, 0x1c, 0xb3,
+	0x79, 0x33, 0x72, 0x59, 0x8f, 0x09, 0x77, 0x77, 0x37, 0x3a, 0x34, 0x00, 0xa3, 0xbc, 0x1b, 0x29,
+	0xa3, 0xe2, 0x1f, 0xaf, 0xa1, 0x7a, 0x9c, 0xa7, 0x28, 0x98, 0xad, 0xaf, 0xce, 0x59, 0x92, 0x67,
+	0x15, 0xd2, 0xd7, 0x29, 0x0e, 0x34, 0xb7, 0xd1, 0xc4, 0xc3, 0x0c, 0x9b, 0x60, 0x1c, 0x9f, 0xd3,
+	0x78, 0x19, 0xcd, 0x92, 0x0e, 0xf1, 0x7c, 0xd2, 0xf0, 0xa9, 0xd2, 0x63, 0xfa, 0xd0, 0x42, 0x65,
+	0x71, 0xcc, 0x19, 0x24, 0x32, 0x6f, 0xa3, 0xa9, 0xbe, 0xda, 0x81, 0x97, 0x51, 0x2d, 0x2b, 0x86,
+	0xba, 0xb6, 0x50, 0x39, 0x12, 0xa8, 0x1a, 0x65, 0xde, 0x40, 0xf5, 0xf7, 0x68, 0x2c, 0xd2, 0x0b,
+	0x30, 0x2e, 0xa2, 0xa9, 0x4c, 0x24, 0xd9, 0x12, 0x69, 0x3f, 0xdb, 0xfc, 0x72, 0x14, 0xd5, 0x0b,
+	0x53, 0xe2, 0x4d, 0x84, 0xc2, 0xc6, 0x1e, 0x75, 0xf9, 0xdb, 0x94, 0x13, 0x50, 0xaa, 0xaf, 0x2e,
+	0x5b, 0x69, 0xdd, 0xb5, 0x8a, 0x75, 0xd7, 0x8a, 0xf6, 0x5b, 0x82, 0xc1, 0x2c, 0x51, 0x77, 0xad,
+	0xce, 0x8a, 0xf5, 0x48, 0xe9, 0x39, 0x85, 0x39, 0x84, 0xe7, 0x18, 0x27, 0x3c, 0x61, 0xd2, 0xd5,
+	0x92, 0x12, 0xc5, 0xa3, 0x4d, 0x19, 0xcb, 0x6b, 0x56, 0x46, 0x0a, 0xf7, 0x79, 0xae, 0xf2, 0x34,
+	0xfc, 0x8b, 0x82, 0xc2, 0xb8, 0xa8, 0xea, 0xad, 0xae, 0x2c, 0x5b, 0x8a, 0x16, 0xe3, 0x19, 0xa7,
+	0x91, 0xac, 0x59, 0xf0, 0x2f, 0xbc, 0xc4, 0x28, 0x7f, 0x4c, 0xbd, 0xd6, 0x2e, 0xd7, 0x47, 0x53,
+	0x2f, 0x29, 0x06, 0x36, 0xd1, 0x38, 0x71, 0x79, 0x42, 0x7c, 0x39, 0xa0, 0x06, 0x03, 0x4a, 0x3c,
+	0x11, 0x87, 0x31, 0x25, 0xcd, 0xae, 0x3e, 0xb6, 0xa0, 0x2d, 0x56, 0x9d, 0x94, 0x10, 0xa8, 0xdd,
+	0x24, 0x8e, 0x69, 0xc0, 0x75, 0x04, 0xfc, 0x8c, 0x14, 0x92, 0x26, 0x65, 0x5e, 0x4c, 0x9b, 0x7a,
+	0x3d, 0x95, 0x48, 0x52, 0x48, 0x92, 0xa8, 0x29, 0x4e, 0x24, 0x7d, 0x3c, 0x95, 0x48, 0x52, 0xa0,
+	0x54, 0x21, 0xa1, 0x4f, 0x80, 0x2c, 0x67, 0xe0, 0x05, 0x54, 0x8f, 0xd3, 0x92, 0x47, 0x9b, 0xeb,
+	0x5c, 0x9f, 0x04, 0x90, 0x45, 0x16, 0x9e, 0x47, 0x48, 0x9e, 0x76, 0xc2, 0xc5, 0x53, 0x30, 0xa0,
+	0xc0, 0xc1, 0xb7, 0xc4, 0x0c, 0x91, 0xef, 0xb9, 0x64, 0x8b, 0x72, 0xa6, 0x4f, 0x43, 0x2c, 0x5d,
+	0xc8, 0x63, 0x49, 0xc9, 0x64, 0xdc, 0xe7, 0x63, 0x85, 0x2a, 0xfd, 0x38, 0xa2, 0xb1, 0xd7, 0xa6,
+	0x01, 0x67, 0xfa, 0x4c, 0x9f, 0xea, 0x86, 0x92, 0xa5, 0xaa, 0x85, 0xb1, 0xf8, 0x75, 0x34, 0x4e,
+	0xf2, 0x4a, 0xc0, 0x74, 0x0c, 0xba, 0xba, 0xd2, 0x2d, 0x94, 0x09, 0x50, 0x2e, 0x8d, 0xc6, 0x6b,
+	0x08, 0xa9, 0x63, 0x8d, 0xe9, 0xb3, 0xa0, 0x7b, 0x5e, 0xe9, 0xde, 0xce, 0x44, 0xa0, 0x59, 0x18,
+	0x89, 0x3f, 0x44, 0x55, 0xe1, 0x79, 0xa6, 0xcf, 0x81, 0xca, 0x5b, 0x56, 0xde, 0x7a, 0x58, 0x59,
+	0xeb, 0x01, 0x3f, 0xdb, 0x59, 0x0e, 0xe4, 0x21, 0xac, 0x38, 0x59, 0xeb, 0x61, 0xdd, 0x26, 0x01,
+	0x89, 0xbb, 0x5b, 0x9c, 0x46, 0x4e, 0x3a, 0x2d, 0x66, 0x68, 0x9c, 0xed, 0x7b, 0x51, 0x44, 0x9b,
+	0x5b, 0x60, 0xe6, 0x1c, 0x98, 0x79, 0x74, 0x3a, 0x33, 0x5b, 0xe9, 0x8c, 0x05, 0x6b, 0x25, 0x23,
+	0xe6, 0xf7, 0x43, 0x68, 0xb2, 0xbc, 0xd5, 0xaf, 0x20, 0x43, 0xb3, 0x7c, 0x1b, 0x2a, 0xe7, 0x9b,
+	0x3a, 0xc0, 0x2b, 0x10, 0x98, 0x8a, 0x2e, 0x64, 0xf4, 0xf0, 0x51, 0x19, 0x5d, 0x2d, 0x67, 0x74,
+	0x5f, 0x1c, 0x8e, 0xbc, 0x40, 0x1c, 0xf6, 0x07, 0xd3, 0xe8, 0x8b, 0x04, 0x93, 0xf9, 0x73, 0x05,
+	0x4d, 0x96, 0x67, 0xff, 0x0b, 0x2b, 0x5c, 0xb6, 0xaf, 0x95, 0x23, 0xf6, 0x75, 0x78, 0xe0, 0xbe,
+	0x36, 0xfc, 0x74, 0xfb, 0x6a, 0x8e, 0xa4, 0x04, 0xdf, 0x85, 0x00, 0x81, 0x0a, 0x57, 0x73, 0x24,
+	0x25, 0xf8, 0xc4, 0xe5, 0x5e, 0x87, 0x42, 0x81, 0xab, 0x39, 0x92, 0x12, 0x7e, 0x88, 0xc4, 0xa4,
+	0xf4, 0x09, 0x14, 0xb6, 0x9a, 0x93, 0x91, 0xa9, 0x75, 0xd8, 0x0d, 0x26, 0xcb, 0x9a, 0xa2, 0xcb,
+	0xb5, 0x08, 0xf5, 0xd7, 0x22, 0x03, 0xd5, 0x38, 0x6d, 0x47, 0x3e, 0xe1, 0x14, 0xca, 0xdb, 0x98,
+	0xa3, 0x68, 0xfc, 0x5f, 0x34, 0xc3, 0x5c, 0xe2, 0xd3, 0x3b, 0xe1, 0x93, 0xe0, 0x0e, 0x25, 0x4d,
+	0xdf, 0x0b, 0x28, 0x54, 0xba, 0x31, 0xe7, 0xb0, 0x40, 0xa0, 0x86, 0xe6, 0x94, 0xe9, 0x13, 0x70,
+	0x28, 0x4a, 0x0a, 0xff, 0x0b, 0x0d, 0x47, 0x61, 0x93, 0xe9, 0x93, 0xe0, 0xe0, 0x69, 0xe5, 0xe0,
+	0xcd, 0xb0, 0x09, 0x8e, 0x05, 0xa9, 0xf9, 0x54, 0x43, 0xa3, 0x92, 0x73, 0xc6, 0x9e, 0x54, 0xe7,
+	0x43, 0x9a, 0x04, 0x29, 0x91, 0xee, 0x30, 0x14, 0x68, 0xa6, 0x57, 0xb3, 0x1d, 0x4e, 0x69, 0xf3,
+	0x16, 0x9a, 0x28, 0x95, 0xaf, 0x81, 0xcd, 0x91, 0x6a, 0xe4, 0x87, 0x0a, 0x8d, 0xbc, 0xf9, 0xb9,
+	0x86, 0x46, 0xef, 0x87, 0x8d, 0xb3, 0x5f, 0xb6, 0xf9, 0xc3, 0x10, 0x9a, 0xea, 0xcb, 0xb9, 0xbf,
+	0x71, 0x49, 0x9a, 0x47, 0x88, 0x25, 0xae, 0x4b, 0x19, 0xdb, 0x49, 0x7c, 0xe9, 0x90, 0x02, 0x47,
+	0xe8, 0xed, 0x10, 0xcf, 0xa7, 0x4d, 0x48, 0xad, 0xaa, 0x23, 0x29, 0xd1, 0x20, 0x78, 0x81, 0x1b,
+	0x06, 0xae, 0x9f, 0xb0, 0x2c, 0xc1, 0xaa, 0x4e, 0x89, 0x27, 0x3c, 0x45, 0xe3, 0x38, 0x8c, 0x21,
+	0xc9, 0xaa, 0x4e, 0x4a, 0x88, 0x30, 0xde, 0x0b, 0x1b, 0x22, 0xbd, 0xca, 0x61, 0x2c, 0xbd, 0xe7,
+	0x80, 0x74, 0xf5, 0xab, 0x29, 0x34, 0x29, 0xdb, 0xae, 0x2d, 0x1a, 0x77, 0x3c, 0x97, 0x62, 0x86,
+	0x26, 0xef, 0x52, 0x5e, 0xec, 0xc5, 0x2e, 0x0e, 0x6a, 0xfa, 0xe0, 0x62, 0x69, 0x0c, 0xec, 0x07,
+	0xcd, 0xe5, 0xcf, 0x7e, 0xfd, 0xfd, 0x8b, 0xa1, 0x25, 0xbc, 0x08, 0xb7, 0xf1, 0xce, 0x4a, 0x7e,
+	0xa5, 0x3e, 0x50, 0x1d, 0x6a, 0x2f, 0xfd, 0xef, 0xd9, 0x9e, 0x30, 0xd1, 0x43, 0xd3, 0xd0, 0x37,
+	0x9f, 0xca, 0xec, 0x1a, 0x98, 0x5d, 0xc6, 0xd6, 0x49, 0xcd, 0xda, 0x4f, 0x84, 0xcd, 0x65, 0x0d,
+	0x77, 0xd0, 0xb4, 0x68, 0x78, 0x0b, 0x93, 0x31, 0xfc, 0x8f, 0x41, 0x36, 0xd4, 0x95, 0xda, 0xd0,
+	0x8f, 0x12, 0x9b, 0x57, 0x01, 0xc6, 0x15, 0xfc, 0xcf, 0x63, 0x61, 0xc0, 0xb2, 0x3f, 0xd5, 0xd0,
+	0x4c, 0xff, 0xba, 0x9f, 0x6b, 0xd9, 0xe8, 0x17, 0xe7, 0x37, 0x0e, 0xd3, 0x06, 0xdb, 0x57, 0xf1,
+	0xbf, 0x9f, 0x6b, 0x5b, 0xad, 0xfd, 0x7d, 0x34, 0x7e, 0x97, 0xf2, 0x87, 0xf9, 0xe5, 0xca, 0x4a,
+	0xdf, 0x29, 0xac, 0xec, 0x9d, 0xc2, 0xda, 0x10, 0xef, 0x14, 0x46, 0xde, 0xfb, 0x94, 0xee, 0x21,
+	0xe6, 0x45, 0x30, 0x39, 0x8b, 0x67, 0x32, 0x93, 0xca, 0x10, 0xfe, 0x56, 0x13, 0xa7, 0x5e, 0xf1,
+	0xb2, 0x8c, 0xe7, 0x73, 0xf0, 0x83, 0x6e, 0xd1, 0xc6, 0xc6, 0xe9, 0xfa, 0x18, 0x39, 0x5b, 0x16,
+	0x0a, 0xc6, 0x7f, 0x4e, 0x12, 0x0a, 0xb2, 0x30, 0xfe, 0x5f, 0x5b, 0x02, 0xc4, 0xe5, 0xbb, 0x77,
+	0x01, 0xf1, 0xc0, 0x4b, 0xf9, 0x99, 0x20, 0x8e, 0x52, 0x24, 0x02, 0xf1, 0x37, 0x1a, 0x1a, 0x2f,
+	0x5e, 0xdb, 0xf1, 0xe5, 0xbc, 0x25, 0x39, 0x7c, 0x9b, 0x7f, 0x59, 0x68, 0xaf, 0x03, 0x5a, 0xcb,
+	0xb8, 0x7a, 0x12, 0xb4, 0x44, 0xe0, 0x10, 0x58, 0x7f, 0x4c, 0x1f, 0x9a, 0xb2, 0xa8, 0x86, 0xa7,
+	0xa1, 0x3c, 0x8f, 0xfa, 0x9e, 0xa0, 0x5e, 0x16, 0x54, 0x07, 0xa0, 0x3e, 0x30, 0xee, 0x1e, 0x0f,
+	0x55, 0x72, 0x7b, 0x36, 0xa3, 0xdc, 0x3e, 0x50, 0xfd, 0x7c, 0xcf, 0x3e, 0x80, 0x93, 0xef, 0x8d,
+	0xa5, 0xa5, 0x9e, 0x7d, 0xc0, 0x49, 0xab, 0x27, 0x16, 0xf2, 0x9d, 0x86, 0xea, 0x85, 0x77, 0x28,
+	0x7c, 0x49, 0x2d, 0xe2, 0xf0, 0xeb, 0xd4, 0xcb, 0x5a, 0xc7, 0x3a, 0xac, 0xe3, 0x35, 0x63, 0xed,
+	0x84, 0xeb, 0x48, 0x82, 0x66, 0x68, 0x1f, 0x64, 0x27, 0x53, 0x2f, 0x8b, 0x95, 0xe2, 0x0b, 0x4c,
+	0x21, 0x56, 0x06, 0x3c, 0xcc, 0x9c, 0x49, 0xac, 0xc4, 0x02, 0x87, 0xc0, 0xfa, 0x54, 0x43, 0xd3,
+	0xfd, 0x8f, 0x39, 0x78, 0x21, 0x3f, 0xc6, 0x06, 0xbf, 0xf3, 0x18, 0xf7, 0x4e, 0x87, 0xb9, 0x30,
+	0xa3, 0x79, 0x13, 0x70, 0xaf, 0x1a, 0xd7, 0x32, 0xdc, 0x59, 0x47, 0x1f, 0x27, 0xc1, 0x40, 0xec,
+	0x7b, 0x02, 0x93, 0xc0, 0xbe, 0x89, 0x46, 0xe5, 0xbb, 0xc6, 0x91, 0xd5, 0x34, 0x3f, 0xc1, 0x0a,
+	0xef, 0x25, 0xe6, 0x05, 0x30, 0x39, 0x83, 0xa7, 0x32, 0x93, 0x9d, 0x54, 0xf8, 0xe6, 0xc6, 0x4f,
+	0xcf, 0xe6, 0xb5, 0x5f, 0x9e, 0xcd, 0x6b, 0xbf, 0x3d, 0x9b, 0xd7, 0x3e, 0xb8, 0x71, 0xe2, 0xd7,
+	0xec, 0xf2, 0xdb, 0x79, 0x63, 0x04, 0x50, 0xfc, 0xef, 0x8f, 0x01, 0x00, 0xeb, 0x63, 0x0e, 0xda,
+	0x5b, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1612,6 +1747,7 @@ type RolloutServiceClient interface {
 	SetRolloutImage(ctx context.Context, in *SetImageRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
 	UndoRollout(ctx context.Context, in *UndoRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
 	RetryRollout(ctx context.Context, in *RetryRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.Rollout, error)
+	JudgeAnalysisRun(ctx context.Context, in *JudgeAnalysisRunRequest, opts ...grpc.CallOption) (*v1alpha1.AnalysisRun, error)
 	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionInfo, error)
 }
 
@@ -1768,6 +1904,15 @@ func (c *rolloutServiceClient) RetryRollout(ctx context.Context, in *RetryRollou
 	return out, nil
 }
 
+func (c *rolloutServiceClient) JudgeAnalysisRun(ctx context.Context, in *JudgeAnalysisRunRequest, opts ...grpc.CallOption) (*v1alpha1.AnalysisRun, error) {
+	out := new(v1alpha1.AnalysisRun)
+	err := c.cc.Invoke(ctx, "/rollout.RolloutService/JudgeAnalysisRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rolloutServiceClient) Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionInfo, error) {
 	out := new(VersionInfo)
 	err := c.cc.Invoke(ctx, "/rollout.RolloutService/Version", in, out, opts...)
@@ -1790,6 +1935,7 @@ type RolloutServiceServer interface {
 	SetRolloutImage(context.Context, *SetImageRequest) (*v1alpha1.Rollout, error)
 	UndoRollout(context.Context, *UndoRolloutRequest) (*v1alpha1.Rollout, error)
 	RetryRollout(context.Context, *RetryRolloutRequest) (*v1alpha1.Rollout, error)
+	JudgeAnalysisRun(context.Context, *JudgeAnalysisRunRequest) (*v1alpha1.AnalysisRun, error)
 	Version(context.Context, *emptypb.Empty) (*VersionInfo, error)
 }
 
@@ -1830,6 +1976,9 @@ func (*UnimplementedRolloutServiceServer) UndoRollout(ctx context.Context, req *
 func (*UnimplementedRolloutServiceServer) RetryRollout(ctx context.Context, req *RetryRolloutRequest) (*v1alpha1.Rollout, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryRollout not implemented")
 }
+func (*UnimplementedRolloutServiceServer) JudgeAnalysisRun(ctx context.Context, req *JudgeAnalysisRunRequest) (*v1alpha1.AnalysisRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JudgeAnalysisRun not implemented")
+}
 func (*UnimplementedRolloutServiceServer) Version(ctx context.Context, req *emptypb.Empty) (*VersionInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
@@ -2042,6 +2191,24 @@ func _RolloutService_RetryRollout_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolloutService_JudgeAnalysisRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JudgeAnalysisRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).JudgeAnalysisRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollout.RolloutService/JudgeAnalysisRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).JudgeAnalysisRun(ctx, req.(*JudgeAnalysisRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RolloutService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -2100,6 +2267,10 @@ var _RolloutService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetryRollout",
 			Handler:    _RolloutService_RetryRollout_Handler,
 		},
+		{
+			MethodName: "JudgeAnalysisRun",
+			Handler:    _RolloutService_JudgeAnalysisRun_Handler,
+		},
 		{
 			MethodName: "Version",
 			Handler:    _RolloutService_Version_Handler,
@@ -2219,6 +2390,13 @@ func (m *SetImageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -2281,6 +2459,13 @@ func (m *UndoRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -2327,6 +2512,13 @@ func (m *RestartRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -2368,6 +2560,13 @@ func (m *PromoteRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Full {
 		i--
 		if m.Full {
@@ -2419,6 +2618,13 @@ func (m *AbortRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -2460,6 +2666,68 @@ func (m *RetryRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JudgeAnalysisRunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JudgeAnalysisRunRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JudgeAnalysisRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
 		copy(dAtA[i:], m.Namespace)
@@ -2665,6 +2933,22 @@ func (m *RolloutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SkippedSteps) > 0 {
+		for iNdEx := len(m.SkippedSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkippedSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
 	if len(m.Steps) > 0 {
 		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3410,6 +3694,10 @@ func (m *SetImageRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3433,6 +3721,10 @@ func (m *UndoRolloutRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3453,6 +3745,10 @@ func (m *RestartRolloutRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3476,6 +3772,10 @@ func (m *PromoteRolloutRequest) Size() (n int) {
 	if m.Full {
 		n += 2
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3496,6 +3796,10 @@ func (m *AbortRolloutRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3516,6 +3820,38 @@ func (m *RetryRolloutRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRollout(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JudgeAnalysisRunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3689,6 +4025,12 @@ func (m *RolloutInfo) Size() (n int) {
 			n += 2 + l + sovRollout(uint64(l))
 		}
 	}
+	if len(m.SkippedSteps) > 0 {
+		for _, e := range m.SkippedSteps {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4316,14 +4658,46 @@ func (m *SetImageRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRollout(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRollout
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -4450,6 +4824,38 @@ func (m *UndoRolloutRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
@@ -4565,6 +4971,38 @@ func (m *RestartRolloutRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
@@ -4700,6 +5138,38 @@ func (m *PromoteRolloutRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Full = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
@@ -4815,6 +5285,38 @@ func (m *AbortRolloutRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
@@ -4930,6 +5432,217 @@ func (m *RetryRolloutRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JudgeAnalysisRunRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JudgeAnalysisRunRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JudgeAnalysisRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
@@ -5972,6 +6685,40 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedSteps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkippedSteps = append(m.SkippedSteps, &v1alpha1.SkippedCanaryStep{})
+			if err := m.SkippedSteps[len(m.SkippedSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
@@ -53,12 +53,30 @@ type AnalysisTemplateSpec struct {
 	// Metrics contains the list of metrics to query as part of an analysis run
 	// +patchMergeKey=name
 	// +patchStrategy=merge
-	Metrics []Metric `json:"metrics" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,1,rep,name=metrics"`
+	// +optional
+	Metrics []Metric `json:"metrics,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,1,rep,name=metrics"`
 	// Args are the list of arguments to the template
 	// +patchMergeKey=name
 	// +patchStrategy=merge
 	// +optional
 	Args []Argument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=args"`
+	// Includes are other templates whose metrics and args are merged into this template
+	// +optional
+	Includes []AnalysisTemplateInclude `json:"includes,omitempty" protobuf:"bytes,3,rep,name=includes"`
+}
+
+// AnalysisTemplateInclude references a template to include in another template
+type AnalysisTemplateInclude struct {
+	// TemplateName name of the template to include
+	TemplateName string `json:"templateName" protobuf:"bytes,1,opt,name=templateName"`
+	// Whether to look for the templateName at cluster scope or namespace scope
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,2,opt,name=clusterScope"`
+	// Args overrides the values of the args of the included template
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Args []Argument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=args"`
 }
 
 // DurationString is a string representing a duration (e.g. 30s, 5m, 1h)
@@ -104,8 +122,25 @@ type Metric struct {
 	ConsecutiveErrorLimit *intstrutil.IntOrString `json:"consecutiveErrorLimit,omitempty" protobuf:"bytes,9,opt,name=consecutiveErrorLimit"`
 	// Provider configuration to the external system to use to verify the analysis
 	Provider MetricProvider `json:"provider" protobuf:"bytes,10,opt,name=provider"`
+	// EvaluationMode determines how the success and failure conditions are applied to a result
+	// containing multiple series (e.g. a Prometheus vector). Defaults to Aggregate, which evaluates
+	// the conditions once against an array of all the values. PerSeries evaluates the conditions
+	// against each series individually and records the labels of failing series in the measurement
+	// metadata. Only supported by the Prometheus, Datadog and Wavefront providers.
+	// +optional
+	EvaluationMode MetricEvaluationMode `json:"evaluationMode,omitempty" protobuf:"bytes,11,opt,name=evaluationMode,casttype=MetricEvaluationMode"`
 }
 
+// MetricEvaluationMode defines how the conditions of a metric are applied to a multi-series result
+type MetricEvaluationMode string
+
+const (
+	// EvaluationModeAggregate evaluates the conditions once against the entire result
+	EvaluationModeAggregate MetricEvaluationMode = "Aggregate"
+	// EvaluationModePerSeries evaluates the conditions against each series of the result
+	EvaluationModePerSeries MetricEvaluationMode = "PerSeries"
+)
+
 // EffectiveCount is the effective count based on whether or not count/interval is specified
 // If neither count or interval is specified, the effective count is 1
 // If only interval is specified, metric runs indefinitely and there is no effective count (nil)
@@ -226,6 +261,25 @@ type AnalysisRunSpec struct {
 	Args []Argument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=args"`
 	// Terminate is used to prematurely stop the run (e.g. rollout completed and analysis is no longer desired)
 	Terminate bool `json:"terminate,omitempty" protobuf:"varint,3,opt,name=terminate"`
+	// Judgment is a manual judgment of the outcome of an Inconclusive run. The run concludes with
+	// the phase of the judgment
+	// +optional
+	Judgment *AnalysisRunJudgment `json:"judgment,omitempty" protobuf:"bytes,4,opt,name=judgment"`
+}
+
+// AnalysisRunJudgment is a manual judgment of the outcome of an AnalysisRun
+type AnalysisRunJudgment struct {
+	// Phase is the judged outcome of the run, either Successful or Failed
+	Phase AnalysisPhase `json:"phase" protobuf:"bytes,1,opt,name=phase,casttype=AnalysisPhase"`
+	// Reason explains the judgment
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+	// User is the user who made the judgment
+	// +optional
+	User string `json:"user,omitempty" protobuf:"bytes,3,opt,name=user"`
+	// JudgedAt is the time the judgment was made
+	// +optional
+	JudgedAt *metav1.Time `json:"judgedAt,omitempty" protobuf:"bytes,4,opt,name=judgedAt"`
 }
 
 // Argument is an argument to an AnalysisRun
@@ -248,6 +302,9 @@ type ValueFrom struct {
 	//valueFrom
 	// +optional
 	FieldRef *FieldRef `json:"fieldRef,omitempty" protobuf:"bytes,2,opt,name=fieldRef"`
+	// ConfigMapKeyRef is a reference to a key of a ConfigMap. This field is one of the fields with valueFrom
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeyRef `json:"configMapKeyRef,omitempty" protobuf:"bytes,3,opt,name=configMapKeyRef"`
 }
 
 type SecretKeyRef struct {
@@ -257,6 +314,13 @@ type SecretKeyRef struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 }
 
+type ConfigMapKeyRef struct {
+	// Name is the name of the ConfigMap
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Key is the key of the ConfigMap to select from.
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+}
+
 // AnalysisRunStatus is the status for a AnalysisRun resource
 type AnalysisRunStatus struct {
 	// Phase is the status of the analysis run
@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterRolloutFreeze defines windows during which the selected rollouts of all namespaces are
+// held at their current step
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusterrolloutfreezes,shortName=crf
+type ClusterRolloutFreeze struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutFreezeSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// ClusterRolloutFreezeList is a list of ClusterRolloutFreeze resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterRolloutFreezeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ClusterRolloutFreeze `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RolloutFreeze defines windows during which the selected rollouts of its namespace are held at
+// their current step
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=rolloutfreezes,shortName=rf
+type RolloutFreeze struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutFreezeSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// RolloutFreezeList is a list of RolloutFreeze resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RolloutFreezeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RolloutFreeze `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RolloutFreezeSpec is the specification for a RolloutFreeze resource
+type RolloutFreezeSpec struct {
+	// Selector is a label query over the rollouts the freeze applies to. All rollouts are selected
+	// if omitted
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,1,opt,name=selector"`
+	// Windows are the recurring periods during which rollouts are frozen
+	Windows []FreezeWindow `json:"windows" protobuf:"bytes,2,rep,name=windows"`
+}
+
+// FreezeWindow is a recurring period during which rollouts are frozen
+type FreezeWindow struct {
+	// Schedule is a cron expression (minute, hour, day of month, month, day of week) at which the
+	// window starts
+	Schedule string `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+	// Duration is how long the window lasts after each start (e.g. 8h)
+	Duration DurationString `json:"duration" protobuf:"bytes,2,opt,name=duration,casttype=DurationString"`
+	// TimeZone is the IANA time zone in which the schedule is evaluated (e.g. Europe/Berlin).
+	// Defaults to UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,3,opt,name=timeZone"`
+}
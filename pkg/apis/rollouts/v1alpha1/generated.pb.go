@@ -27,6 +27,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
+	v12 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
@@ -160,10 +161,38 @@ func (m *AnalysisRunArgument) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AnalysisRunArgument proto.InternalMessageInfo
 
+func (m *AnalysisRunJudgment) Reset()      { *m = AnalysisRunJudgment{} }
+func (*AnalysisRunJudgment) ProtoMessage() {}
+func (*AnalysisRunJudgment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{4}
+}
+func (m *AnalysisRunJudgment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnalysisRunJudgment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AnalysisRunJudgment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalysisRunJudgment.Merge(m, src)
+}
+func (m *AnalysisRunJudgment) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnalysisRunJudgment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalysisRunJudgment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalysisRunJudgment proto.InternalMessageInfo
+
 func (m *AnalysisRunList) Reset()      { *m = AnalysisRunList{} }
 func (*AnalysisRunList) ProtoMessage() {}
 func (*AnalysisRunList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{4}
+	return fileDescriptor_e0e705f843545fab, []int{5}
 }
 func (m *AnalysisRunList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +220,7 @@ var xxx_messageInfo_AnalysisRunList proto.InternalMessageInfo
 func (m *AnalysisRunSpec) Reset()      { *m = AnalysisRunSpec{} }
 func (*AnalysisRunSpec) ProtoMessage() {}
 func (*AnalysisRunSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{5}
+	return fileDescriptor_e0e705f843545fab, []int{6}
 }
 func (m *AnalysisRunSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,7 +248,7 @@ var xxx_messageInfo_AnalysisRunSpec proto.InternalMessageInfo
 func (m *AnalysisRunStatus) Reset()      { *m = AnalysisRunStatus{} }
 func (*AnalysisRunStatus) ProtoMessage() {}
 func (*AnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{6}
+	return fileDescriptor_e0e705f843545fab, []int{7}
 }
 func (m *AnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +276,7 @@ var xxx_messageInfo_AnalysisRunStatus proto.InternalMessageInfo
 func (m *AnalysisTemplate) Reset()      { *m = AnalysisTemplate{} }
 func (*AnalysisTemplate) ProtoMessage() {}
 func (*AnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{7}
+	return fileDescriptor_e0e705f843545fab, []int{8}
 }
 func (m *AnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,10 +301,38 @@ func (m *AnalysisTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AnalysisTemplate proto.InternalMessageInfo
 
+func (m *AnalysisTemplateInclude) Reset()      { *m = AnalysisTemplateInclude{} }
+func (*AnalysisTemplateInclude) ProtoMessage() {}
+func (*AnalysisTemplateInclude) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{9}
+}
+func (m *AnalysisTemplateInclude) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnalysisTemplateInclude) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AnalysisTemplateInclude) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalysisTemplateInclude.Merge(m, src)
+}
+func (m *AnalysisTemplateInclude) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnalysisTemplateInclude) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalysisTemplateInclude.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalysisTemplateInclude proto.InternalMessageInfo
+
 func (m *AnalysisTemplateList) Reset()      { *m = AnalysisTemplateList{} }
 func (*AnalysisTemplateList) ProtoMessage() {}
 func (*AnalysisTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{8}
+	return fileDescriptor_e0e705f843545fab, []int{10}
 }
 func (m *AnalysisTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +360,7 @@ var xxx_messageInfo_AnalysisTemplateList proto.InternalMessageInfo
 func (m *AnalysisTemplateSpec) Reset()      { *m = AnalysisTemplateSpec{} }
 func (*AnalysisTemplateSpec) ProtoMessage() {}
 func (*AnalysisTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{9}
+	return fileDescriptor_e0e705f843545fab, []int{11}
 }
 func (m *AnalysisTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +388,7 @@ var xxx_messageInfo_AnalysisTemplateSpec proto.InternalMessageInfo
 func (m *AntiAffinity) Reset()      { *m = AntiAffinity{} }
 func (*AntiAffinity) ProtoMessage() {}
 func (*AntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{10}
+	return fileDescriptor_e0e705f843545fab, []int{12}
 }
 func (m *AntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +416,7 @@ var xxx_messageInfo_AntiAffinity proto.InternalMessageInfo
 func (m *Argument) Reset()      { *m = Argument{} }
 func (*Argument) ProtoMessage() {}
 func (*Argument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{11}
+	return fileDescriptor_e0e705f843545fab, []int{13}
 }
 func (m *Argument) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +444,7 @@ var xxx_messageInfo_Argument proto.InternalMessageInfo
 func (m *ArgumentValueFrom) Reset()      { *m = ArgumentValueFrom{} }
 func (*ArgumentValueFrom) ProtoMessage() {}
 func (*ArgumentValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{12}
+	return fileDescriptor_e0e705f843545fab, []int{14}
 }
 func (m *ArgumentValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +472,7 @@ var xxx_messageInfo_ArgumentValueFrom proto.InternalMessageInfo
 func (m *BlueGreenStatus) Reset()      { *m = BlueGreenStatus{} }
 func (*BlueGreenStatus) ProtoMessage() {}
 func (*BlueGreenStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{13}
+	return fileDescriptor_e0e705f843545fab, []int{15}
 }
 func (m *BlueGreenStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +500,7 @@ var xxx_messageInfo_BlueGreenStatus proto.InternalMessageInfo
 func (m *BlueGreenStrategy) Reset()      { *m = BlueGreenStrategy{} }
 func (*BlueGreenStrategy) ProtoMessage() {}
 func (*BlueGreenStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{14}
+	return fileDescriptor_e0e705f843545fab, []int{16}
 }
 func (m *BlueGreenStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,7 +528,7 @@ var xxx_messageInfo_BlueGreenStrategy proto.InternalMessageInfo
 func (m *CanaryStatus) Reset()      { *m = CanaryStatus{} }
 func (*CanaryStatus) ProtoMessage() {}
 func (*CanaryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{15}
+	return fileDescriptor_e0e705f843545fab, []int{17}
 }
 func (m *CanaryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +556,7 @@ var xxx_messageInfo_CanaryStatus proto.InternalMessageInfo
 func (m *CanaryStep) Reset()      { *m = CanaryStep{} }
 func (*CanaryStep) ProtoMessage() {}
 func (*CanaryStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{16}
+	return fileDescriptor_e0e705f843545fab, []int{18}
 }
 func (m *CanaryStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +584,7 @@ var xxx_messageInfo_CanaryStep proto.InternalMessageInfo
 func (m *CanaryStrategy) Reset()      { *m = CanaryStrategy{} }
 func (*CanaryStrategy) ProtoMessage() {}
 func (*CanaryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{17}
+	return fileDescriptor_e0e705f843545fab, []int{19}
 }
 func (m *CanaryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +612,7 @@ var xxx_messageInfo_CanaryStrategy proto.InternalMessageInfo
 func (m *ClusterAnalysisTemplate) Reset()      { *m = ClusterAnalysisTemplate{} }
 func (*ClusterAnalysisTemplate) ProtoMessage() {}
 func (*ClusterAnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{18}
+	return fileDescriptor_e0e705f843545fab, []int{20}
 }
 func (m *ClusterAnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +640,7 @@ var xxx_messageInfo_ClusterAnalysisTemplate proto.InternalMessageInfo
 func (m *ClusterAnalysisTemplateList) Reset()      { *m = ClusterAnalysisTemplateList{} }
 func (*ClusterAnalysisTemplateList) ProtoMessage() {}
 func (*ClusterAnalysisTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{19}
+	return fileDescriptor_e0e705f843545fab, []int{21}
 }
 func (m *ClusterAnalysisTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,10 +665,94 @@ func (m *ClusterAnalysisTemplateList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterAnalysisTemplateList proto.InternalMessageInfo
 
+func (m *ClusterRolloutFreeze) Reset()      { *m = ClusterRolloutFreeze{} }
+func (*ClusterRolloutFreeze) ProtoMessage() {}
+func (*ClusterRolloutFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{22}
+}
+func (m *ClusterRolloutFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterRolloutFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterRolloutFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRolloutFreeze.Merge(m, src)
+}
+func (m *ClusterRolloutFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterRolloutFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRolloutFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRolloutFreeze proto.InternalMessageInfo
+
+func (m *ClusterRolloutFreezeList) Reset()      { *m = ClusterRolloutFreezeList{} }
+func (*ClusterRolloutFreezeList) ProtoMessage() {}
+func (*ClusterRolloutFreezeList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{23}
+}
+func (m *ClusterRolloutFreezeList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterRolloutFreezeList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterRolloutFreezeList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRolloutFreezeList.Merge(m, src)
+}
+func (m *ClusterRolloutFreezeList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterRolloutFreezeList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRolloutFreezeList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRolloutFreezeList proto.InternalMessageInfo
+
+func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
+func (*ConfigMapKeyRef) ProtoMessage() {}
+func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{24}
+}
+func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigMapKeyRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConfigMapKeyRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigMapKeyRef.Merge(m, src)
+}
+func (m *ConfigMapKeyRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigMapKeyRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigMapKeyRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
+
 func (m *DatadogMetric) Reset()      { *m = DatadogMetric{} }
 func (*DatadogMetric) ProtoMessage() {}
 func (*DatadogMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{20}
+	return fileDescriptor_e0e705f843545fab, []int{25}
 }
 func (m *DatadogMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +780,7 @@ var xxx_messageInfo_DatadogMetric proto.InternalMessageInfo
 func (m *Experiment) Reset()      { *m = Experiment{} }
 func (*Experiment) ProtoMessage() {}
 func (*Experiment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{21}
+	return fileDescriptor_e0e705f843545fab, []int{26}
 }
 func (m *Experiment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +808,7 @@ var xxx_messageInfo_Experiment proto.InternalMessageInfo
 func (m *ExperimentAnalysisRunStatus) Reset()      { *m = ExperimentAnalysisRunStatus{} }
 func (*ExperimentAnalysisRunStatus) ProtoMessage() {}
 func (*ExperimentAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{22}
+	return fileDescriptor_e0e705f843545fab, []int{27}
 }
 func (m *ExperimentAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +836,7 @@ var xxx_messageInfo_ExperimentAnalysisRunStatus proto.InternalMessageInfo
 func (m *ExperimentAnalysisTemplateRef) Reset()      { *m = ExperimentAnalysisTemplateRef{} }
 func (*ExperimentAnalysisTemplateRef) ProtoMessage() {}
 func (*ExperimentAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{23}
+	return fileDescriptor_e0e705f843545fab, []int{28}
 }
 func (m *ExperimentAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +864,7 @@ var xxx_messageInfo_ExperimentAnalysisTemplateRef proto.InternalMessageInfo
 func (m *ExperimentCondition) Reset()      { *m = ExperimentCondition{} }
 func (*ExperimentCondition) ProtoMessage() {}
 func (*ExperimentCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{24}
+	return fileDescriptor_e0e705f843545fab, []int{29}
 }
 func (m *ExperimentCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +892,7 @@ var xxx_messageInfo_ExperimentCondition proto.InternalMessageInfo
 func (m *ExperimentList) Reset()      { *m = ExperimentList{} }
 func (*ExperimentList) ProtoMessage() {}
 func (*ExperimentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{25}
+	return fileDescriptor_e0e705f843545fab, []int{30}
 }
 func (m *ExperimentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +920,7 @@ var xxx_messageInfo_ExperimentList proto.InternalMessageInfo
 func (m *ExperimentSpec) Reset()      { *m = ExperimentSpec{} }
 func (*ExperimentSpec) ProtoMessage() {}
 func (*ExperimentSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{26}
+	return fileDescriptor_e0e705f843545fab, []int{31}
 }
 func (m *ExperimentSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +948,7 @@ var xxx_messageInfo_ExperimentSpec proto.InternalMessageInfo
 func (m *ExperimentStatus) Reset()      { *m = ExperimentStatus{} }
 func (*ExperimentStatus) ProtoMessage() {}
 func (*ExperimentStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{27}
+	return fileDescriptor_e0e705f843545fab, []int{32}
 }
 func (m *ExperimentStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +976,7 @@ var xxx_messageInfo_ExperimentStatus proto.InternalMessageInfo
 func (m *FieldRef) Reset()      { *m = FieldRef{} }
 func (*FieldRef) ProtoMessage() {}
 func (*FieldRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{28}
+	return fileDescriptor_e0e705f843545fab, []int{33}
 }
 func (m *FieldRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -860,10 +1001,38 @@ func (m *FieldRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FieldRef proto.InternalMessageInfo
 
+func (m *FreezeWindow) Reset()      { *m = FreezeWindow{} }
+func (*FreezeWindow) ProtoMessage() {}
+func (*FreezeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{34}
+}
+func (m *FreezeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreezeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FreezeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeWindow.Merge(m, src)
+}
+func (m *FreezeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreezeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeWindow proto.InternalMessageInfo
+
 func (m *IstioDestinationRule) Reset()      { *m = IstioDestinationRule{} }
 func (*IstioDestinationRule) ProtoMessage() {}
 func (*IstioDestinationRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{29}
+	return fileDescriptor_e0e705f843545fab, []int{35}
 }
 func (m *IstioDestinationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1060,7 @@ var xxx_messageInfo_IstioDestinationRule proto.InternalMessageInfo
 func (m *IstioTrafficRouting) Reset()      { *m = IstioTrafficRouting{} }
 func (*IstioTrafficRouting) ProtoMessage() {}
 func (*IstioTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{30}
+	return fileDescriptor_e0e705f843545fab, []int{36}
 }
 func (m *IstioTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1088,7 @@ var xxx_messageInfo_IstioTrafficRouting proto.InternalMessageInfo
 func (m *IstioVirtualService) Reset()      { *m = IstioVirtualService{} }
 func (*IstioVirtualService) ProtoMessage() {}
 func (*IstioVirtualService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{31}
+	return fileDescriptor_e0e705f843545fab, []int{37}
 }
 func (m *IstioVirtualService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1116,7 @@ var xxx_messageInfo_IstioVirtualService proto.InternalMessageInfo
 func (m *JobMetric) Reset()      { *m = JobMetric{} }
 func (*JobMetric) ProtoMessage() {}
 func (*JobMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{32}
+	return fileDescriptor_e0e705f843545fab, []int{38}
 }
 func (m *JobMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1144,7 @@ var xxx_messageInfo_JobMetric proto.InternalMessageInfo
 func (m *KayentaMetric) Reset()      { *m = KayentaMetric{} }
 func (*KayentaMetric) ProtoMessage() {}
 func (*KayentaMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{33}
+	return fileDescriptor_e0e705f843545fab, []int{39}
 }
 func (m *KayentaMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1172,7 @@ var xxx_messageInfo_KayentaMetric proto.InternalMessageInfo
 func (m *KayentaScope) Reset()      { *m = KayentaScope{} }
 func (*KayentaScope) ProtoMessage() {}
 func (*KayentaScope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{34}
+	return fileDescriptor_e0e705f843545fab, []int{40}
 }
 func (m *KayentaScope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1200,7 @@ var xxx_messageInfo_KayentaScope proto.InternalMessageInfo
 func (m *KayentaThreshold) Reset()      { *m = KayentaThreshold{} }
 func (*KayentaThreshold) ProtoMessage() {}
 func (*KayentaThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{35}
+	return fileDescriptor_e0e705f843545fab, []int{41}
 }
 func (m *KayentaThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,10 +1225,38 @@ func (m *KayentaThreshold) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KayentaThreshold proto.InternalMessageInfo
 
+func (m *ManagedServices) Reset()      { *m = ManagedServices{} }
+func (*ManagedServices) ProtoMessage() {}
+func (*ManagedServices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{42}
+}
+func (m *ManagedServices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedServices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ManagedServices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedServices.Merge(m, src)
+}
+func (m *ManagedServices) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedServices) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedServices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedServices proto.InternalMessageInfo
+
 func (m *Measurement) Reset()      { *m = Measurement{} }
 func (*Measurement) ProtoMessage() {}
 func (*Measurement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{36}
+	return fileDescriptor_e0e705f843545fab, []int{43}
 }
 func (m *Measurement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1284,7 @@ var xxx_messageInfo_Measurement proto.InternalMessageInfo
 func (m *Metric) Reset()      { *m = Metric{} }
 func (*Metric) ProtoMessage() {}
 func (*Metric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{37}
+	return fileDescriptor_e0e705f843545fab, []int{44}
 }
 func (m *Metric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1312,7 @@ var xxx_messageInfo_Metric proto.InternalMessageInfo
 func (m *MetricProvider) Reset()      { *m = MetricProvider{} }
 func (*MetricProvider) ProtoMessage() {}
 func (*MetricProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{38}
+	return fileDescriptor_e0e705f843545fab, []int{45}
 }
 func (m *MetricProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1340,7 @@ var xxx_messageInfo_MetricProvider proto.InternalMessageInfo
 func (m *MetricResult) Reset()      { *m = MetricResult{} }
 func (*MetricResult) ProtoMessage() {}
 func (*MetricResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{39}
+	return fileDescriptor_e0e705f843545fab, []int{46}
 }
 func (m *MetricResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1368,7 @@ var xxx_messageInfo_MetricResult proto.InternalMessageInfo
 func (m *NewRelicMetric) Reset()      { *m = NewRelicMetric{} }
 func (*NewRelicMetric) ProtoMessage() {}
 func (*NewRelicMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{40}
+	return fileDescriptor_e0e705f843545fab, []int{47}
 }
 func (m *NewRelicMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1396,7 @@ var xxx_messageInfo_NewRelicMetric proto.InternalMessageInfo
 func (m *NginxTrafficRouting) Reset()      { *m = NginxTrafficRouting{} }
 func (*NginxTrafficRouting) ProtoMessage() {}
 func (*NginxTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{41}
+	return fileDescriptor_e0e705f843545fab, []int{48}
 }
 func (m *NginxTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,7 +1424,7 @@ var xxx_messageInfo_NginxTrafficRouting proto.InternalMessageInfo
 func (m *ObjectRef) Reset()      { *m = ObjectRef{} }
 func (*ObjectRef) ProtoMessage() {}
 func (*ObjectRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{42}
+	return fileDescriptor_e0e705f843545fab, []int{49}
 }
 func (m *ObjectRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1452,7 @@ var xxx_messageInfo_ObjectRef proto.InternalMessageInfo
 func (m *PauseCondition) Reset()      { *m = PauseCondition{} }
 func (*PauseCondition) ProtoMessage() {}
 func (*PauseCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{43}
+	return fileDescriptor_e0e705f843545fab, []int{50}
 }
 func (m *PauseCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1480,7 @@ var xxx_messageInfo_PauseCondition proto.InternalMessageInfo
 func (m *PodTemplateMetadata) Reset()      { *m = PodTemplateMetadata{} }
 func (*PodTemplateMetadata) ProtoMessage() {}
 func (*PodTemplateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{44}
+	return fileDescriptor_e0e705f843545fab, []int{51}
 }
 func (m *PodTemplateMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1313,7 +1510,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*PreferredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*PreferredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{45}
+	return fileDescriptor_e0e705f843545fab, []int{52}
 }
 func (m *PreferredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1538,7 @@ var xxx_messageInfo_PreferredDuringSchedulingIgnoredDuringExecution proto.Intern
 func (m *PrometheusMetric) Reset()      { *m = PrometheusMetric{} }
 func (*PrometheusMetric) ProtoMessage() {}
 func (*PrometheusMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{46}
+	return fileDescriptor_e0e705f843545fab, []int{53}
 }
 func (m *PrometheusMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1371,7 +1568,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*RequiredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*RequiredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{47}
+	return fileDescriptor_e0e705f843545fab, []int{54}
 }
 func (m *RequiredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,15 +1593,15 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RequiredDuringSchedulingIgnoredDuringExecution proto.InternalMessageInfo
 
-func (m *Rollout) Reset()      { *m = Rollout{} }
-func (*Rollout) ProtoMessage() {}
-func (*Rollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{48}
+func (m *RevisionAnalysis) Reset()      { *m = RevisionAnalysis{} }
+func (*RevisionAnalysis) ProtoMessage() {}
+func (*RevisionAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{55}
 }
-func (m *Rollout) XXX_Unmarshal(b []byte) error {
+func (m *RevisionAnalysis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Rollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevisionAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1412,27 +1609,27 @@ func (m *Rollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Rollout) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Rollout.Merge(m, src)
+func (m *RevisionAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionAnalysis.Merge(m, src)
 }
-func (m *Rollout) XXX_Size() int {
+func (m *RevisionAnalysis) XXX_Size() int {
 	return m.Size()
 }
-func (m *Rollout) XXX_DiscardUnknown() {
-	xxx_messageInfo_Rollout.DiscardUnknown(m)
+func (m *RevisionAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionAnalysis.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Rollout proto.InternalMessageInfo
+var xxx_messageInfo_RevisionAnalysis proto.InternalMessageInfo
 
-func (m *RolloutAnalysis) Reset()      { *m = RolloutAnalysis{} }
-func (*RolloutAnalysis) ProtoMessage() {}
-func (*RolloutAnalysis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{49}
+func (m *RevisionImage) Reset()      { *m = RevisionImage{} }
+func (*RevisionImage) ProtoMessage() {}
+func (*RevisionImage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{56}
 }
-func (m *RolloutAnalysis) XXX_Unmarshal(b []byte) error {
+func (m *RevisionImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevisionImage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1440,27 +1637,27 @@ func (m *RolloutAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 	}
 	return b[:n], nil
 }
-func (m *RolloutAnalysis) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutAnalysis.Merge(m, src)
+func (m *RevisionImage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionImage.Merge(m, src)
 }
-func (m *RolloutAnalysis) XXX_Size() int {
+func (m *RevisionImage) XXX_Size() int {
 	return m.Size()
 }
-func (m *RolloutAnalysis) XXX_DiscardUnknown() {
-	xxx_messageInfo_RolloutAnalysis.DiscardUnknown(m)
+func (m *RevisionImage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionImage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RolloutAnalysis proto.InternalMessageInfo
+var xxx_messageInfo_RevisionImage proto.InternalMessageInfo
 
-func (m *RolloutAnalysisBackground) Reset()      { *m = RolloutAnalysisBackground{} }
-func (*RolloutAnalysisBackground) ProtoMessage() {}
-func (*RolloutAnalysisBackground) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{50}
+func (m *RevisionStep) Reset()      { *m = RevisionStep{} }
+func (*RevisionStep) ProtoMessage() {}
+func (*RevisionStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{57}
 }
-func (m *RolloutAnalysisBackground) XXX_Unmarshal(b []byte) error {
+func (m *RevisionStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutAnalysisBackground) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevisionStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1468,27 +1665,27 @@ func (m *RolloutAnalysisBackground) XXX_Marshal(b []byte, deterministic bool) ([
 	}
 	return b[:n], nil
 }
-func (m *RolloutAnalysisBackground) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutAnalysisBackground.Merge(m, src)
+func (m *RevisionStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionStep.Merge(m, src)
 }
-func (m *RolloutAnalysisBackground) XXX_Size() int {
+func (m *RevisionStep) XXX_Size() int {
 	return m.Size()
 }
-func (m *RolloutAnalysisBackground) XXX_DiscardUnknown() {
-	xxx_messageInfo_RolloutAnalysisBackground.DiscardUnknown(m)
+func (m *RevisionStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionStep.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RolloutAnalysisBackground proto.InternalMessageInfo
+var xxx_messageInfo_RevisionStep proto.InternalMessageInfo
 
-func (m *RolloutAnalysisRunStatus) Reset()      { *m = RolloutAnalysisRunStatus{} }
-func (*RolloutAnalysisRunStatus) ProtoMessage() {}
-func (*RolloutAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{51}
+func (m *RollbackWindowSpec) Reset()      { *m = RollbackWindowSpec{} }
+func (*RollbackWindowSpec) ProtoMessage() {}
+func (*RollbackWindowSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{58}
 }
-func (m *RolloutAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
+func (m *RollbackWindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutAnalysisRunStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RollbackWindowSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1496,10 +1693,122 @@ func (m *RolloutAnalysisRunStatus) XXX_Marshal(b []byte, deterministic bool) ([]
 	}
 	return b[:n], nil
 }
-func (m *RolloutAnalysisRunStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutAnalysisRunStatus.Merge(m, src)
+func (m *RollbackWindowSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackWindowSpec.Merge(m, src)
 }
-func (m *RolloutAnalysisRunStatus) XXX_Size() int {
+func (m *RollbackWindowSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackWindowSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackWindowSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackWindowSpec proto.InternalMessageInfo
+
+func (m *Rollout) Reset()      { *m = Rollout{} }
+func (*Rollout) ProtoMessage() {}
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{59}
+}
+func (m *Rollout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Rollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Rollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rollout.Merge(m, src)
+}
+func (m *Rollout) XXX_Size() int {
+	return m.Size()
+}
+func (m *Rollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rollout proto.InternalMessageInfo
+
+func (m *RolloutAnalysis) Reset()      { *m = RolloutAnalysis{} }
+func (*RolloutAnalysis) ProtoMessage() {}
+func (*RolloutAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{60}
+}
+func (m *RolloutAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutAnalysis.Merge(m, src)
+}
+func (m *RolloutAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutAnalysis proto.InternalMessageInfo
+
+func (m *RolloutAnalysisBackground) Reset()      { *m = RolloutAnalysisBackground{} }
+func (*RolloutAnalysisBackground) ProtoMessage() {}
+func (*RolloutAnalysisBackground) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{61}
+}
+func (m *RolloutAnalysisBackground) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutAnalysisBackground) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutAnalysisBackground) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutAnalysisBackground.Merge(m, src)
+}
+func (m *RolloutAnalysisBackground) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutAnalysisBackground) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutAnalysisBackground.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutAnalysisBackground proto.InternalMessageInfo
+
+func (m *RolloutAnalysisRunStatus) Reset()      { *m = RolloutAnalysisRunStatus{} }
+func (*RolloutAnalysisRunStatus) ProtoMessage() {}
+func (*RolloutAnalysisRunStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{62}
+}
+func (m *RolloutAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutAnalysisRunStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutAnalysisRunStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutAnalysisRunStatus.Merge(m, src)
+}
+func (m *RolloutAnalysisRunStatus) XXX_Size() int {
 	return m.Size()
 }
 func (m *RolloutAnalysisRunStatus) XXX_DiscardUnknown() {
@@ -1511,7 +1820,7 @@ var xxx_messageInfo_RolloutAnalysisRunStatus proto.InternalMessageInfo
 func (m *RolloutAnalysisTemplate) Reset()      { *m = RolloutAnalysisTemplate{} }
 func (*RolloutAnalysisTemplate) ProtoMessage() {}
 func (*RolloutAnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{52}
+	return fileDescriptor_e0e705f843545fab, []int{63}
 }
 func (m *RolloutAnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1536,10 +1845,122 @@ func (m *RolloutAnalysisTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutAnalysisTemplate proto.InternalMessageInfo
 
+func (m *RolloutApprovalHeader) Reset()      { *m = RolloutApprovalHeader{} }
+func (*RolloutApprovalHeader) ProtoMessage() {}
+func (*RolloutApprovalHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{64}
+}
+func (m *RolloutApprovalHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutApprovalHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutApprovalHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutApprovalHeader.Merge(m, src)
+}
+func (m *RolloutApprovalHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutApprovalHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutApprovalHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutApprovalHeader proto.InternalMessageInfo
+
+func (m *RolloutApprovalHeaderSource) Reset()      { *m = RolloutApprovalHeaderSource{} }
+func (*RolloutApprovalHeaderSource) ProtoMessage() {}
+func (*RolloutApprovalHeaderSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{65}
+}
+func (m *RolloutApprovalHeaderSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutApprovalHeaderSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutApprovalHeaderSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutApprovalHeaderSource.Merge(m, src)
+}
+func (m *RolloutApprovalHeaderSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutApprovalHeaderSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutApprovalHeaderSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutApprovalHeaderSource proto.InternalMessageInfo
+
+func (m *RolloutApprovalStatus) Reset()      { *m = RolloutApprovalStatus{} }
+func (*RolloutApprovalStatus) ProtoMessage() {}
+func (*RolloutApprovalStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{66}
+}
+func (m *RolloutApprovalStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutApprovalStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutApprovalStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutApprovalStatus.Merge(m, src)
+}
+func (m *RolloutApprovalStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutApprovalStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutApprovalStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutApprovalStatus proto.InternalMessageInfo
+
+func (m *RolloutApprovalStep) Reset()      { *m = RolloutApprovalStep{} }
+func (*RolloutApprovalStep) ProtoMessage() {}
+func (*RolloutApprovalStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{67}
+}
+func (m *RolloutApprovalStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutApprovalStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutApprovalStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutApprovalStep.Merge(m, src)
+}
+func (m *RolloutApprovalStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutApprovalStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutApprovalStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutApprovalStep proto.InternalMessageInfo
+
 func (m *RolloutCondition) Reset()      { *m = RolloutCondition{} }
 func (*RolloutCondition) ProtoMessage() {}
 func (*RolloutCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{53}
+	return fileDescriptor_e0e705f843545fab, []int{68}
 }
 func (m *RolloutCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1567,7 +1988,7 @@ var xxx_messageInfo_RolloutCondition proto.InternalMessageInfo
 func (m *RolloutExperimentStep) Reset()      { *m = RolloutExperimentStep{} }
 func (*RolloutExperimentStep) ProtoMessage() {}
 func (*RolloutExperimentStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{54}
+	return fileDescriptor_e0e705f843545fab, []int{69}
 }
 func (m *RolloutExperimentStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1597,7 +2018,7 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Reset() {
 }
 func (*RolloutExperimentStepAnalysisTemplateRef) ProtoMessage() {}
 func (*RolloutExperimentStepAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{55}
+	return fileDescriptor_e0e705f843545fab, []int{70}
 }
 func (m *RolloutExperimentStepAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1625,7 +2046,7 @@ var xxx_messageInfo_RolloutExperimentStepAnalysisTemplateRef proto.InternalMessa
 func (m *RolloutExperimentTemplate) Reset()      { *m = RolloutExperimentTemplate{} }
 func (*RolloutExperimentTemplate) ProtoMessage() {}
 func (*RolloutExperimentTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{56}
+	return fileDescriptor_e0e705f843545fab, []int{71}
 }
 func (m *RolloutExperimentTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1650,15 +2071,15 @@ func (m *RolloutExperimentTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutExperimentTemplate proto.InternalMessageInfo
 
-func (m *RolloutList) Reset()      { *m = RolloutList{} }
-func (*RolloutList) ProtoMessage() {}
-func (*RolloutList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{57}
+func (m *RolloutFreeze) Reset()      { *m = RolloutFreeze{} }
+func (*RolloutFreeze) ProtoMessage() {}
+func (*RolloutFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{72}
 }
-func (m *RolloutList) XXX_Unmarshal(b []byte) error {
+func (m *RolloutFreeze) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RolloutFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1666,27 +2087,27 @@ func (m *RolloutList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *RolloutList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutList.Merge(m, src)
+func (m *RolloutFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutFreeze.Merge(m, src)
 }
-func (m *RolloutList) XXX_Size() int {
+func (m *RolloutFreeze) XXX_Size() int {
 	return m.Size()
 }
-func (m *RolloutList) XXX_DiscardUnknown() {
-	xxx_messageInfo_RolloutList.DiscardUnknown(m)
+func (m *RolloutFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutFreeze.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RolloutList proto.InternalMessageInfo
+var xxx_messageInfo_RolloutFreeze proto.InternalMessageInfo
 
-func (m *RolloutPause) Reset()      { *m = RolloutPause{} }
-func (*RolloutPause) ProtoMessage() {}
-func (*RolloutPause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{58}
+func (m *RolloutFreezeList) Reset()      { *m = RolloutFreezeList{} }
+func (*RolloutFreezeList) ProtoMessage() {}
+func (*RolloutFreezeList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{73}
 }
-func (m *RolloutPause) XXX_Unmarshal(b []byte) error {
+func (m *RolloutFreezeList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RolloutFreezeList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1694,27 +2115,27 @@ func (m *RolloutPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *RolloutPause) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutPause.Merge(m, src)
+func (m *RolloutFreezeList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutFreezeList.Merge(m, src)
 }
-func (m *RolloutPause) XXX_Size() int {
+func (m *RolloutFreezeList) XXX_Size() int {
 	return m.Size()
 }
-func (m *RolloutPause) XXX_DiscardUnknown() {
-	xxx_messageInfo_RolloutPause.DiscardUnknown(m)
+func (m *RolloutFreezeList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutFreezeList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RolloutPause proto.InternalMessageInfo
+var xxx_messageInfo_RolloutFreezeList proto.InternalMessageInfo
 
-func (m *RolloutSpec) Reset()      { *m = RolloutSpec{} }
-func (*RolloutSpec) ProtoMessage() {}
-func (*RolloutSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{59}
+func (m *RolloutFreezeSpec) Reset()      { *m = RolloutFreezeSpec{} }
+func (*RolloutFreezeSpec) ProtoMessage() {}
+func (*RolloutFreezeSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{74}
 }
-func (m *RolloutSpec) XXX_Unmarshal(b []byte) error {
+func (m *RolloutFreezeSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RolloutFreezeSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1722,27 +2143,27 @@ func (m *RolloutSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *RolloutSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutSpec.Merge(m, src)
+func (m *RolloutFreezeSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutFreezeSpec.Merge(m, src)
 }
-func (m *RolloutSpec) XXX_Size() int {
+func (m *RolloutFreezeSpec) XXX_Size() int {
 	return m.Size()
 }
-func (m *RolloutSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_RolloutSpec.DiscardUnknown(m)
+func (m *RolloutFreezeSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutFreezeSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RolloutSpec proto.InternalMessageInfo
+var xxx_messageInfo_RolloutFreezeSpec proto.InternalMessageInfo
 
-func (m *RolloutStatus) Reset()      { *m = RolloutStatus{} }
-func (*RolloutStatus) ProtoMessage() {}
-func (*RolloutStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{60}
+func (m *RolloutHook) Reset()      { *m = RolloutHook{} }
+func (*RolloutHook) ProtoMessage() {}
+func (*RolloutHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{75}
 }
-func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
+func (m *RolloutHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RolloutHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1750,27 +2171,27 @@ func (m *RolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 	return b[:n], nil
 }
-func (m *RolloutStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutStatus.Merge(m, src)
+func (m *RolloutHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutHook.Merge(m, src)
 }
-func (m *RolloutStatus) XXX_Size() int {
+func (m *RolloutHook) XXX_Size() int {
 	return m.Size()
 }
-func (m *RolloutStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_RolloutStatus.DiscardUnknown(m)
+func (m *RolloutHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutHook.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
+var xxx_messageInfo_RolloutHook proto.InternalMessageInfo
 
-func (m *RolloutStrategy) Reset()      { *m = RolloutStrategy{} }
-func (*RolloutStrategy) ProtoMessage() {}
-func (*RolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{61}
+func (m *RolloutHookStatus) Reset()      { *m = RolloutHookStatus{} }
+func (*RolloutHookStatus) ProtoMessage() {}
+func (*RolloutHookStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{76}
 }
-func (m *RolloutStrategy) XXX_Unmarshal(b []byte) error {
+func (m *RolloutHookStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RolloutHookStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1778,27 +2199,27 @@ func (m *RolloutStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 	}
 	return b[:n], nil
 }
-func (m *RolloutStrategy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutStrategy.Merge(m, src)
+func (m *RolloutHookStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutHookStatus.Merge(m, src)
 }
-func (m *RolloutStrategy) XXX_Size() int {
+func (m *RolloutHookStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *RolloutStrategy) XXX_DiscardUnknown() {
-	xxx_messageInfo_RolloutStrategy.DiscardUnknown(m)
+func (m *RolloutHookStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutHookStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RolloutStrategy proto.InternalMessageInfo
+var xxx_messageInfo_RolloutHookStatus proto.InternalMessageInfo
 
-func (m *RolloutTrafficRouting) Reset()      { *m = RolloutTrafficRouting{} }
-func (*RolloutTrafficRouting) ProtoMessage() {}
-func (*RolloutTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{62}
+func (m *RolloutHooks) Reset()      { *m = RolloutHooks{} }
+func (*RolloutHooks) ProtoMessage() {}
+func (*RolloutHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{77}
 }
-func (m *RolloutTrafficRouting) XXX_Unmarshal(b []byte) error {
+func (m *RolloutHooks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RolloutHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1806,8 +2227,288 @@ func (m *RolloutTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byt
 	}
 	return b[:n], nil
 }
-func (m *RolloutTrafficRouting) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutTrafficRouting.Merge(m, src)
+func (m *RolloutHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutHooks.Merge(m, src)
+}
+func (m *RolloutHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutHooks proto.InternalMessageInfo
+
+func (m *RolloutList) Reset()      { *m = RolloutList{} }
+func (*RolloutList) ProtoMessage() {}
+func (*RolloutList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{78}
+}
+func (m *RolloutList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutList.Merge(m, src)
+}
+func (m *RolloutList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutList proto.InternalMessageInfo
+
+func (m *RolloutPause) Reset()      { *m = RolloutPause{} }
+func (*RolloutPause) ProtoMessage() {}
+func (*RolloutPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{79}
+}
+func (m *RolloutPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutPause.Merge(m, src)
+}
+func (m *RolloutPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutPause proto.InternalMessageInfo
+
+func (m *RolloutRevision) Reset()      { *m = RolloutRevision{} }
+func (*RolloutRevision) ProtoMessage() {}
+func (*RolloutRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{80}
+}
+func (m *RolloutRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutRevision.Merge(m, src)
+}
+func (m *RolloutRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutRevision proto.InternalMessageInfo
+
+func (m *RolloutRevisionList) Reset()      { *m = RolloutRevisionList{} }
+func (*RolloutRevisionList) ProtoMessage() {}
+func (*RolloutRevisionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{81}
+}
+func (m *RolloutRevisionList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutRevisionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutRevisionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutRevisionList.Merge(m, src)
+}
+func (m *RolloutRevisionList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutRevisionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutRevisionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutRevisionList proto.InternalMessageInfo
+
+func (m *RolloutRevisionSpec) Reset()      { *m = RolloutRevisionSpec{} }
+func (*RolloutRevisionSpec) ProtoMessage() {}
+func (*RolloutRevisionSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{82}
+}
+func (m *RolloutRevisionSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutRevisionSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutRevisionSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutRevisionSpec.Merge(m, src)
+}
+func (m *RolloutRevisionSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutRevisionSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutRevisionSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutRevisionSpec proto.InternalMessageInfo
+
+func (m *RolloutSpec) Reset()      { *m = RolloutSpec{} }
+func (*RolloutSpec) ProtoMessage() {}
+func (*RolloutSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{83}
+}
+func (m *RolloutSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutSpec.Merge(m, src)
+}
+func (m *RolloutSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutSpec proto.InternalMessageInfo
+
+func (m *RolloutStatus) Reset()      { *m = RolloutStatus{} }
+func (*RolloutStatus) ProtoMessage() {}
+func (*RolloutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{84}
+}
+func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStatus.Merge(m, src)
+}
+func (m *RolloutStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
+
+func (m *RolloutStrategy) Reset()      { *m = RolloutStrategy{} }
+func (*RolloutStrategy) ProtoMessage() {}
+func (*RolloutStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{85}
+}
+func (m *RolloutStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStrategy.Merge(m, src)
+}
+func (m *RolloutStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStrategy proto.InternalMessageInfo
+
+func (m *RolloutTimeWindow) Reset()      { *m = RolloutTimeWindow{} }
+func (*RolloutTimeWindow) ProtoMessage() {}
+func (*RolloutTimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{86}
+}
+func (m *RolloutTimeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutTimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutTimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutTimeWindow.Merge(m, src)
+}
+func (m *RolloutTimeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutTimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutTimeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutTimeWindow proto.InternalMessageInfo
+
+func (m *RolloutTrafficRouting) Reset()      { *m = RolloutTrafficRouting{} }
+func (*RolloutTrafficRouting) ProtoMessage() {}
+func (*RolloutTrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{87}
+}
+func (m *RolloutTrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutTrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutTrafficRouting.Merge(m, src)
 }
 func (m *RolloutTrafficRouting) XXX_Size() int {
 	return m.Size()
@@ -1821,7 +2522,7 @@ var xxx_messageInfo_RolloutTrafficRouting proto.InternalMessageInfo
 func (m *SMITrafficRouting) Reset()      { *m = SMITrafficRouting{} }
 func (*SMITrafficRouting) ProtoMessage() {}
 func (*SMITrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{63}
+	return fileDescriptor_e0e705f843545fab, []int{88}
 }
 func (m *SMITrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1849,7 +2550,7 @@ var xxx_messageInfo_SMITrafficRouting proto.InternalMessageInfo
 func (m *ScopeDetail) Reset()      { *m = ScopeDetail{} }
 func (*ScopeDetail) ProtoMessage() {}
 func (*ScopeDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{64}
+	return fileDescriptor_e0e705f843545fab, []int{89}
 }
 func (m *ScopeDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1877,7 +2578,7 @@ var xxx_messageInfo_ScopeDetail proto.InternalMessageInfo
 func (m *SecretKeyRef) Reset()      { *m = SecretKeyRef{} }
 func (*SecretKeyRef) ProtoMessage() {}
 func (*SecretKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{65}
+	return fileDescriptor_e0e705f843545fab, []int{90}
 }
 func (m *SecretKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1905,7 +2606,7 @@ var xxx_messageInfo_SecretKeyRef proto.InternalMessageInfo
 func (m *SetCanaryScale) Reset()      { *m = SetCanaryScale{} }
 func (*SetCanaryScale) ProtoMessage() {}
 func (*SetCanaryScale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{66}
+	return fileDescriptor_e0e705f843545fab, []int{91}
 }
 func (m *SetCanaryScale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1930,10 +2631,38 @@ func (m *SetCanaryScale) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetCanaryScale proto.InternalMessageInfo
 
+func (m *SkippedCanaryStep) Reset()      { *m = SkippedCanaryStep{} }
+func (*SkippedCanaryStep) ProtoMessage() {}
+func (*SkippedCanaryStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{92}
+}
+func (m *SkippedCanaryStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkippedCanaryStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SkippedCanaryStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkippedCanaryStep.Merge(m, src)
+}
+func (m *SkippedCanaryStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkippedCanaryStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkippedCanaryStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkippedCanaryStep proto.InternalMessageInfo
+
 func (m *TemplateSpec) Reset()      { *m = TemplateSpec{} }
 func (*TemplateSpec) ProtoMessage() {}
 func (*TemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{67}
+	return fileDescriptor_e0e705f843545fab, []int{93}
 }
 func (m *TemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1961,7 +2690,7 @@ var xxx_messageInfo_TemplateSpec proto.InternalMessageInfo
 func (m *TemplateStatus) Reset()      { *m = TemplateStatus{} }
 func (*TemplateStatus) ProtoMessage() {}
 func (*TemplateStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{68}
+	return fileDescriptor_e0e705f843545fab, []int{94}
 }
 func (m *TemplateStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1989,7 +2718,7 @@ var xxx_messageInfo_TemplateStatus proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{69}
+	return fileDescriptor_e0e705f843545fab, []int{95}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2017,7 +2746,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *WavefrontMetric) Reset()      { *m = WavefrontMetric{} }
 func (*WavefrontMetric) ProtoMessage() {}
 func (*WavefrontMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{70}
+	return fileDescriptor_e0e705f843545fab, []int{96}
 }
 func (m *WavefrontMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2045,7 +2774,7 @@ var xxx_messageInfo_WavefrontMetric proto.InternalMessageInfo
 func (m *WebMetric) Reset()      { *m = WebMetric{} }
 func (*WebMetric) ProtoMessage() {}
 func (*WebMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{71}
+	return fileDescriptor_e0e705f843545fab, []int{97}
 }
 func (m *WebMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2073,7 +2802,7 @@ var xxx_messageInfo_WebMetric proto.InternalMessageInfo
 func (m *WebMetricHeader) Reset()      { *m = WebMetricHeader{} }
 func (*WebMetricHeader) ProtoMessage() {}
 func (*WebMetricHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{72}
+	return fileDescriptor_e0e705f843545fab, []int{98}
 }
 func (m *WebMetricHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2103,10 +2832,12 @@ func init() {
 	proto.RegisterType((*AmbassadorTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AmbassadorTrafficRouting")
 	proto.RegisterType((*AnalysisRun)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRun")
 	proto.RegisterType((*AnalysisRunArgument)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunArgument")
+	proto.RegisterType((*AnalysisRunJudgment)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunJudgment")
 	proto.RegisterType((*AnalysisRunList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunList")
 	proto.RegisterType((*AnalysisRunSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunSpec")
 	proto.RegisterType((*AnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunStatus")
 	proto.RegisterType((*AnalysisTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisTemplate")
+	proto.RegisterType((*AnalysisTemplateInclude)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisTemplateInclude")
 	proto.RegisterType((*AnalysisTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisTemplateList")
 	proto.RegisterType((*AnalysisTemplateSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisTemplateSpec")
 	proto.RegisterType((*AntiAffinity)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AntiAffinity")
@@ -2119,6 +2850,9 @@ func init() {
 	proto.RegisterType((*CanaryStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStrategy")
 	proto.RegisterType((*ClusterAnalysisTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplate")
 	proto.RegisterType((*ClusterAnalysisTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplateList")
+	proto.RegisterType((*ClusterRolloutFreeze)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterRolloutFreeze")
+	proto.RegisterType((*ClusterRolloutFreezeList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterRolloutFreezeList")
+	proto.RegisterType((*ConfigMapKeyRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ConfigMapKeyRef")
 	proto.RegisterType((*DatadogMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogMetric")
 	proto.RegisterType((*Experiment)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Experiment")
 	proto.RegisterType((*ExperimentAnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentAnalysisRunStatus")
@@ -2128,6 +2862,7 @@ func init() {
 	proto.RegisterType((*ExperimentSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentSpec")
 	proto.RegisterType((*ExperimentStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentStatus")
 	proto.RegisterType((*FieldRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.FieldRef")
+	proto.RegisterType((*FreezeWindow)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.FreezeWindow")
 	proto.RegisterType((*IstioDestinationRule)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioDestinationRule")
 	proto.RegisterType((*IstioTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioTrafficRouting")
 	proto.RegisterType((*IstioVirtualService)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioVirtualService")
@@ -2135,6 +2870,7 @@ func init() {
 	proto.RegisterType((*KayentaMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaMetric")
 	proto.RegisterType((*KayentaScope)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaScope")
 	proto.RegisterType((*KayentaThreshold)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaThreshold")
+	proto.RegisterType((*ManagedServices)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ManagedServices")
 	proto.RegisterType((*Measurement)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement.MetadataEntry")
 	proto.RegisterType((*Metric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Metric")
@@ -2151,25 +2887,44 @@ func init() {
 	proto.RegisterType((*PreferredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution")
 	proto.RegisterType((*PrometheusMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusMetric")
 	proto.RegisterType((*RequiredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution")
+	proto.RegisterType((*RevisionAnalysis)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RevisionAnalysis")
+	proto.RegisterType((*RevisionImage)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RevisionImage")
+	proto.RegisterType((*RevisionStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RevisionStep")
+	proto.RegisterType((*RollbackWindowSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RollbackWindowSpec")
 	proto.RegisterType((*Rollout)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Rollout")
 	proto.RegisterType((*RolloutAnalysis)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysis")
 	proto.RegisterType((*RolloutAnalysisBackground)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisBackground")
 	proto.RegisterType((*RolloutAnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisRunStatus")
 	proto.RegisterType((*RolloutAnalysisTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisTemplate")
+	proto.RegisterType((*RolloutApprovalHeader)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutApprovalHeader")
+	proto.RegisterType((*RolloutApprovalHeaderSource)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutApprovalHeaderSource")
+	proto.RegisterType((*RolloutApprovalStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutApprovalStatus")
+	proto.RegisterType((*RolloutApprovalStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutApprovalStep")
 	proto.RegisterType((*RolloutCondition)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutCondition")
 	proto.RegisterType((*RolloutExperimentStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStep")
 	proto.RegisterType((*RolloutExperimentStepAnalysisTemplateRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStepAnalysisTemplateRef")
 	proto.RegisterType((*RolloutExperimentTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentTemplate")
+	proto.RegisterType((*RolloutFreeze)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutFreeze")
+	proto.RegisterType((*RolloutFreezeList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutFreezeList")
+	proto.RegisterType((*RolloutFreezeSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutFreezeSpec")
+	proto.RegisterType((*RolloutHook)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutHook")
+	proto.RegisterType((*RolloutHookStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutHookStatus")
+	proto.RegisterType((*RolloutHooks)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutHooks")
 	proto.RegisterType((*RolloutList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutList")
 	proto.RegisterType((*RolloutPause)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutPause")
+	proto.RegisterType((*RolloutRevision)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutRevision")
+	proto.RegisterType((*RolloutRevisionList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutRevisionList")
+	proto.RegisterType((*RolloutRevisionSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutRevisionSpec")
 	proto.RegisterType((*RolloutSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutSpec")
 	proto.RegisterType((*RolloutStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStatus")
 	proto.RegisterType((*RolloutStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategy")
+	proto.RegisterType((*RolloutTimeWindow)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutTimeWindow")
 	proto.RegisterType((*RolloutTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutTrafficRouting")
 	proto.RegisterType((*SMITrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SMITrafficRouting")
 	proto.RegisterType((*ScopeDetail)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ScopeDetail")
 	proto.RegisterType((*SecretKeyRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SecretKeyRef")
 	proto.RegisterType((*SetCanaryScale)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetCanaryScale")
+	proto.RegisterType((*SkippedCanaryStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SkippedCanaryStep")
 	proto.RegisterType((*TemplateSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateSpec")
 	proto.RegisterType((*TemplateStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateStatus")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ValueFrom")
@@ -2183,359 +2938,455 @@ func init() {
 }
 
 var fileDescriptor_e0e705f843545fab = []byte{
-	// 5629 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x6c, 0x24, 0xc7,
-	0x71, 0xb0, 0x66, 0x7f, 0xc8, 0x65, 0x2d, 0x7f, 0xfb, 0x78, 0xbe, 0xf5, 0xe9, 0x8e, 0x7b, 0x1e,
-	0x19, 0xfa, 0xce, 0x5f, 0x6c, 0xd2, 0x3e, 0x49, 0x89, 0x62, 0x19, 0x87, 0xec, 0x92, 0x77, 0x3a,
-	0x52, 0xe4, 0x1d, 0xaf, 0x97, 0xa7, 0x83, 0x65, 0x29, 0xf1, 0x70, 0xb7, 0xb9, 0x9c, 0xbb, 0xd9,
-	0x99, 0xf5, 0xcc, 0x2c, 0xef, 0x28, 0x1b, 0xb6, 0x64, 0x41, 0xb6, 0x12, 0xd8, 0x90, 0xf2, 0xf3,
-	0x62, 0x04, 0x09, 0x82, 0x20, 0x0f, 0x41, 0xf2, 0xe2, 0x07, 0x3f, 0xc6, 0x88, 0xe1, 0x24, 0x80,
-	0x82, 0x20, 0x89, 0xf3, 0x12, 0x39, 0x01, 0xbc, 0xb1, 0xd6, 0x01, 0x82, 0xe4, 0x25, 0x70, 0x60,
-	0x20, 0xf0, 0x3d, 0x05, 0xfd, 0x33, 0x3d, 0xd3, 0xb3, 0xb3, 0xe4, 0xee, 0xed, 0xf0, 0x62, 0x24,
-	0x79, 0xe3, 0x56, 0x55, 0x57, 0x75, 0x4f, 0x57, 0x57, 0x55, 0x77, 0x55, 0x37, 0x61, 0xb3, 0x69,
-	0xfa, 0xfb, 0x9d, 0xdd, 0xe5, 0xba, 0xd3, 0x5a, 0x31, 0xdc, 0xa6, 0xd3, 0x76, 0x9d, 0x3b, 0xec,
-	0x8f, 0x8f, 0xb9, 0x8e, 0x65, 0x39, 0x1d, 0xdf, 0x5b, 0x69, 0xdf, 0x6d, 0xae, 0x18, 0x6d, 0xd3,
-	0x5b, 0x91, 0x90, 0x83, 0x4f, 0x18, 0x56, 0x7b, 0xdf, 0xf8, 0xc4, 0x4a, 0x93, 0xd8, 0xc4, 0x35,
-	0x7c, 0xd2, 0x58, 0x6e, 0xbb, 0x8e, 0xef, 0xa0, 0x4f, 0x85, 0xdc, 0x96, 0x03, 0x6e, 0xec, 0x8f,
-	0x5f, 0x09, 0xda, 0x2e, 0xb7, 0xef, 0x36, 0x97, 0x29, 0xb7, 0x65, 0x09, 0x09, 0xb8, 0x9d, 0xfd,
-	0x58, 0xa4, 0x2f, 0x4d, 0xa7, 0xe9, 0xac, 0x30, 0xa6, 0xbb, 0x9d, 0x3d, 0xf6, 0x8b, 0xfd, 0x60,
-	0x7f, 0x71, 0x61, 0x67, 0x9f, 0xb8, 0xfb, 0xac, 0xb7, 0x6c, 0x3a, 0xb4, 0x6f, 0x2b, 0xbb, 0x86,
-	0x5f, 0xdf, 0x5f, 0x39, 0xe8, 0xeb, 0xd1, 0x59, 0x3d, 0x42, 0x54, 0x77, 0x5c, 0x92, 0x44, 0xf3,
-	0x74, 0x48, 0xd3, 0x32, 0xea, 0xfb, 0xa6, 0x4d, 0xdc, 0xc3, 0x70, 0xd4, 0x2d, 0xe2, 0x1b, 0x49,
-	0xad, 0x56, 0x06, 0xb5, 0x72, 0x3b, 0xb6, 0x6f, 0xb6, 0x48, 0x5f, 0x83, 0x9f, 0x3f, 0xae, 0x81,
-	0x57, 0xdf, 0x27, 0x2d, 0xa3, 0xaf, 0xdd, 0x53, 0x83, 0xda, 0x75, 0x7c, 0xd3, 0x5a, 0x31, 0x6d,
-	0xdf, 0xf3, 0xdd, 0x78, 0x23, 0xfd, 0x3f, 0x34, 0x58, 0xa8, 0x6c, 0x56, 0x77, 0x5c, 0x63, 0x6f,
-	0xcf, 0xac, 0x63, 0xa7, 0xe3, 0x9b, 0x76, 0x13, 0x7d, 0x04, 0x26, 0x4d, 0xbb, 0xe9, 0x12, 0xcf,
-	0x2b, 0x69, 0x17, 0xb4, 0x8b, 0x53, 0xd5, 0xb9, 0x77, 0xbb, 0xe5, 0xc7, 0x7a, 0xdd, 0xf2, 0xe4,
-	0x3a, 0x07, 0xe3, 0x00, 0x8f, 0x9e, 0x81, 0xa2, 0x47, 0xdc, 0x03, 0xb3, 0x4e, 0xb6, 0x1d, 0xd7,
-	0x2f, 0x65, 0x2e, 0x68, 0x17, 0xf3, 0xd5, 0x53, 0x82, 0xbc, 0x58, 0x0b, 0x51, 0x38, 0x4a, 0x47,
-	0x9b, 0xb9, 0x8e, 0xe3, 0x0b, 0x7c, 0x29, 0xcb, 0xa4, 0xc8, 0x66, 0x38, 0x44, 0xe1, 0x28, 0x1d,
-	0x5a, 0x83, 0x79, 0xc3, 0xb6, 0x1d, 0xdf, 0xf0, 0x4d, 0xc7, 0xde, 0x76, 0xc9, 0x9e, 0x79, 0xbf,
-	0x94, 0x63, 0x6d, 0x4b, 0xa2, 0xed, 0x7c, 0x25, 0x86, 0xc7, 0x7d, 0x2d, 0xf4, 0x35, 0x28, 0x55,
-	0x5a, 0xbb, 0x86, 0xe7, 0x19, 0x0d, 0xc7, 0x8d, 0x0d, 0xfd, 0x22, 0x14, 0x5a, 0x46, 0xbb, 0x6d,
-	0xda, 0x4d, 0x3a, 0xf6, 0xec, 0xc5, 0xa9, 0xea, 0x74, 0xaf, 0x5b, 0x2e, 0x6c, 0x09, 0x18, 0x96,
-	0x58, 0xfd, 0x1f, 0x32, 0x50, 0xac, 0xd8, 0x86, 0x75, 0xe8, 0x99, 0x1e, 0xee, 0xd8, 0xe8, 0xb3,
-	0x50, 0xa0, 0x3a, 0xd0, 0x30, 0x7c, 0x83, 0x7d, 0xb5, 0xe2, 0xa5, 0x8f, 0x2f, 0xf3, 0x29, 0x59,
-	0x8e, 0x4e, 0x49, 0xa8, 0xd9, 0x94, 0x7a, 0xf9, 0xe0, 0x13, 0xcb, 0x37, 0x76, 0xef, 0x90, 0xba,
-	0xbf, 0x45, 0x7c, 0xa3, 0x8a, 0xc4, 0x28, 0x20, 0x84, 0x61, 0xc9, 0x15, 0x39, 0x90, 0xf3, 0xda,
-	0xa4, 0xce, 0x3e, 0x72, 0xf1, 0xd2, 0xd6, 0xf2, 0x38, 0xab, 0x68, 0x39, 0xd2, 0xf5, 0x5a, 0x9b,
-	0xd4, 0xab, 0xd3, 0x42, 0x74, 0x8e, 0xfe, 0xc2, 0x4c, 0x10, 0xba, 0x07, 0x13, 0x9e, 0x6f, 0xf8,
-	0x1d, 0x8f, 0x4d, 0x50, 0xf1, 0xd2, 0x8d, 0xf4, 0x44, 0x32, 0xb6, 0xd5, 0x59, 0x21, 0x74, 0x82,
-	0xff, 0xc6, 0x42, 0x9c, 0xfe, 0x8f, 0x1a, 0x9c, 0x8a, 0x50, 0x57, 0xdc, 0x66, 0xa7, 0x45, 0x6c,
-	0x1f, 0x5d, 0x80, 0x9c, 0x6d, 0xb4, 0x88, 0xd0, 0x4a, 0xd9, 0xe5, 0xeb, 0x46, 0x8b, 0x60, 0x86,
-	0x41, 0x4f, 0x40, 0xfe, 0xc0, 0xb0, 0x3a, 0x84, 0x7d, 0xa4, 0xa9, 0xea, 0x8c, 0x20, 0xc9, 0xbf,
-	0x48, 0x81, 0x98, 0xe3, 0xd0, 0x17, 0x60, 0x8a, 0xfd, 0x71, 0xd5, 0x75, 0x5a, 0x29, 0x0d, 0x4d,
-	0xf4, 0xf0, 0xc5, 0x80, 0x6d, 0x75, 0xa6, 0xd7, 0x2d, 0x4f, 0xc9, 0x9f, 0x38, 0x14, 0xa8, 0xff,
-	0x93, 0x06, 0x73, 0x91, 0xc1, 0x6d, 0x9a, 0x9e, 0x8f, 0x5e, 0xee, 0x53, 0x9e, 0xe5, 0xe1, 0x94,
-	0x87, 0xb6, 0x66, 0xaa, 0x33, 0x2f, 0x46, 0x5a, 0x08, 0x20, 0x11, 0xc5, 0xb1, 0x21, 0x6f, 0xfa,
-	0xa4, 0xe5, 0x95, 0x32, 0x17, 0xb2, 0x17, 0x8b, 0x97, 0xd6, 0x53, 0x9b, 0xc6, 0xf0, 0xfb, 0xae,
-	0x53, 0xfe, 0x98, 0x8b, 0xd1, 0x7f, 0x27, 0xa3, 0x8c, 0x90, 0x6a, 0x14, 0x72, 0x60, 0xb2, 0x45,
-	0x7c, 0xd7, 0xac, 0xf3, 0x75, 0x55, 0xbc, 0xb4, 0x36, 0x5e, 0x2f, 0xb6, 0x18, 0xb3, 0xd0, 0x32,
-	0xf1, 0xdf, 0x1e, 0x0e, 0xa4, 0xa0, 0x7d, 0xc8, 0x19, 0x6e, 0x33, 0x18, 0xf3, 0xd5, 0x74, 0xe6,
-	0x37, 0xd4, 0xb9, 0x8a, 0xdb, 0xf4, 0x30, 0x93, 0x80, 0x56, 0x60, 0xca, 0x27, 0x6e, 0xcb, 0xb4,
-	0x0d, 0x9f, 0x9b, 0xb2, 0x42, 0x75, 0x41, 0x90, 0x4d, 0xed, 0x04, 0x08, 0x1c, 0xd2, 0xe8, 0xef,
-	0x65, 0x60, 0xa1, 0x6f, 0x31, 0xa0, 0xa7, 0x21, 0xdf, 0xde, 0x37, 0xbc, 0x40, 0xbb, 0x97, 0x82,
-	0x4f, 0xbb, 0x4d, 0x81, 0x0f, 0xba, 0xe5, 0x99, 0xa0, 0x09, 0x03, 0x60, 0x4e, 0x4c, 0x6d, 0x75,
-	0x8b, 0x78, 0x9e, 0xd1, 0x0c, 0x54, 0x3e, 0xf2, 0x45, 0x18, 0x18, 0x07, 0x78, 0xf4, 0x55, 0x0d,
-	0x66, 0xf8, 0xd7, 0xc1, 0xc4, 0xeb, 0x58, 0x3e, 0x5d, 0xd6, 0xf4, 0xdb, 0x6c, 0xa4, 0x31, 0x13,
-	0x9c, 0x65, 0xf5, 0xb4, 0x90, 0x3e, 0x13, 0x85, 0x7a, 0x58, 0x95, 0x8b, 0x6e, 0xc3, 0x94, 0xe7,
-	0x1b, 0xae, 0x4f, 0x1a, 0x15, 0x9f, 0x19, 0xf0, 0xe2, 0xa5, 0xff, 0x3f, 0x9c, 0xbe, 0xef, 0x98,
-	0x2d, 0xc2, 0xd7, 0x56, 0x2d, 0x60, 0x80, 0x43, 0x5e, 0xfa, 0xbf, 0x69, 0x30, 0x1f, 0x7c, 0xa6,
-	0x1d, 0xd2, 0x6a, 0x5b, 0x86, 0x4f, 0x1e, 0x81, 0x65, 0xf6, 0x15, 0xcb, 0x8c, 0xd3, 0x59, 0x5f,
-	0x41, 0xff, 0x07, 0x99, 0x67, 0xfd, 0x5f, 0x35, 0x58, 0x8c, 0x13, 0x3f, 0x02, 0x6b, 0xe2, 0xa9,
-	0xd6, 0xe4, 0x7a, 0xba, 0xa3, 0x1d, 0x60, 0x52, 0x7e, 0x9c, 0x30, 0xd6, 0xff, 0xe1, 0x76, 0x45,
-	0xff, 0xc3, 0x1c, 0x4c, 0x57, 0x6c, 0xdf, 0xac, 0xec, 0xed, 0x99, 0xb6, 0xe9, 0x1f, 0xa2, 0xaf,
-	0x65, 0x60, 0xa5, 0xed, 0x92, 0x3d, 0xe2, 0xba, 0xa4, 0xb1, 0xd6, 0x71, 0x4d, 0xbb, 0x59, 0xab,
-	0xef, 0x93, 0x46, 0xc7, 0x32, 0xed, 0xe6, 0x7a, 0xd3, 0x76, 0x24, 0xf8, 0xca, 0x7d, 0x52, 0xef,
-	0xd0, 0x90, 0x47, 0xcc, 0x7f, 0x6b, 0xbc, 0x6e, 0x6e, 0x8f, 0x26, 0xb4, 0xfa, 0x54, 0xaf, 0x5b,
-	0x5e, 0x19, 0xb1, 0x11, 0x1e, 0x75, 0x68, 0xe8, 0xad, 0x0c, 0x2c, 0xbb, 0xe4, 0x73, 0x1d, 0x73,
-	0xf8, 0xaf, 0xc1, 0x17, 0xa8, 0x35, 0xde, 0xd7, 0xc0, 0x23, 0xc9, 0xac, 0x5e, 0xea, 0x75, 0xcb,
-	0x23, 0xb6, 0xc1, 0x23, 0x8e, 0x4b, 0xff, 0x33, 0x0d, 0x0a, 0x23, 0x44, 0x49, 0x65, 0x35, 0x4a,
-	0x9a, 0xea, 0x8b, 0x90, 0xfc, 0xfe, 0x08, 0xe9, 0xf9, 0xf1, 0x3e, 0xda, 0x30, 0x91, 0xd1, 0xbf,
-	0xd3, 0xdd, 0x48, 0x3c, 0x92, 0x42, 0xfb, 0xb0, 0xd8, 0x76, 0x1a, 0xc1, 0xa2, 0xbf, 0x66, 0x78,
-	0xfb, 0x0c, 0x27, 0x86, 0xf7, 0x74, 0xaf, 0x5b, 0x5e, 0xdc, 0x4e, 0xc0, 0x3f, 0xe8, 0x96, 0x4b,
-	0x92, 0x49, 0x8c, 0x00, 0x27, 0x72, 0x44, 0x6d, 0x28, 0xec, 0x99, 0xc4, 0x6a, 0x60, 0xb2, 0x27,
-	0x34, 0x65, 0xcc, 0xe5, 0x7d, 0x55, 0x70, 0xe3, 0x9b, 0x88, 0xe0, 0x17, 0x96, 0x52, 0xf4, 0x9f,
-	0xe6, 0x60, 0xae, 0x6a, 0x75, 0xc8, 0xf3, 0x2e, 0x21, 0x41, 0x1c, 0x50, 0x81, 0xb9, 0xb6, 0x4b,
-	0x0e, 0x4c, 0x72, 0xaf, 0x46, 0x2c, 0x52, 0xf7, 0x1d, 0x57, 0x0c, 0xf5, 0x8c, 0x98, 0xc9, 0xb9,
-	0x6d, 0x15, 0x8d, 0xe3, 0xf4, 0xe8, 0x32, 0xcc, 0x1a, 0x75, 0xdf, 0x3c, 0x20, 0x92, 0x03, 0x9f,
-	0xe8, 0x0f, 0x08, 0x0e, 0xb3, 0x15, 0x05, 0x8b, 0x63, 0xd4, 0xe8, 0x65, 0x28, 0x79, 0x75, 0xc3,
-	0x22, 0xb7, 0xda, 0x42, 0xd4, 0xea, 0x3e, 0xa9, 0xdf, 0xdd, 0x76, 0x4c, 0xdb, 0x17, 0x01, 0xce,
-	0x05, 0xc1, 0xa9, 0x54, 0x1b, 0x40, 0x87, 0x07, 0x72, 0x40, 0x7f, 0xaa, 0xc1, 0xf9, 0xb6, 0x4b,
-	0xb6, 0x5d, 0xa7, 0xe5, 0x50, 0xed, 0xed, 0x0b, 0x85, 0x44, 0x48, 0xf0, 0xe2, 0x98, 0xcb, 0x94,
-	0x43, 0xfa, 0x77, 0x1d, 0x1f, 0xea, 0x75, 0xcb, 0xe7, 0xb7, 0x8f, 0xea, 0x00, 0x3e, 0xba, 0x7f,
-	0xe8, 0xbb, 0x1a, 0x2c, 0xb5, 0x1d, 0xcf, 0x3f, 0x62, 0x08, 0xf9, 0x13, 0x1d, 0x82, 0xde, 0xeb,
-	0x96, 0x97, 0xb6, 0x8f, 0xec, 0x01, 0x3e, 0xa6, 0x87, 0xfa, 0x97, 0x8b, 0xb0, 0x10, 0xd1, 0x3d,
-	0xd7, 0xf0, 0x49, 0xf3, 0x10, 0x3d, 0x07, 0x33, 0x81, 0x32, 0xf0, 0xbd, 0x39, 0xd7, 0x3d, 0x19,
-	0xd7, 0x55, 0xa2, 0x48, 0xac, 0xd2, 0x52, 0xbd, 0x93, 0xaa, 0xc8, 0x5b, 0xc7, 0xf4, 0x6e, 0x5b,
-	0xc1, 0xe2, 0x18, 0x35, 0x5a, 0x87, 0x53, 0x02, 0x82, 0x49, 0xdb, 0x32, 0xeb, 0xc6, 0xaa, 0xd3,
-	0x11, 0x2a, 0x97, 0xaf, 0x9e, 0xe9, 0x75, 0xcb, 0xa7, 0xb6, 0xfb, 0xd1, 0x38, 0xa9, 0x0d, 0xda,
-	0x84, 0x45, 0xa3, 0xe3, 0x3b, 0x72, 0xfc, 0x57, 0x6c, 0x63, 0xd7, 0x22, 0x0d, 0xa6, 0x5a, 0x85,
-	0x6a, 0x89, 0x5a, 0x8d, 0x4a, 0x02, 0x1e, 0x27, 0xb6, 0x42, 0xdb, 0x31, 0x6e, 0x35, 0x52, 0x77,
-	0xec, 0x06, 0x9f, 0xe5, 0x7c, 0xf5, 0x9c, 0x18, 0x9e, 0xca, 0x51, 0xd0, 0xe0, 0xc4, 0x96, 0xc8,
-	0x82, 0xd9, 0x96, 0x71, 0xff, 0x96, 0x6d, 0x1c, 0x18, 0xa6, 0x45, 0x85, 0x94, 0x26, 0x8e, 0x09,
-	0x4d, 0x3b, 0xbe, 0x69, 0x2d, 0xf3, 0x73, 0x9c, 0xe5, 0x75, 0xdb, 0xbf, 0xe1, 0xd6, 0x7c, 0xea,
-	0x04, 0xaa, 0x88, 0x7e, 0xd8, 0x2d, 0x85, 0x17, 0x8e, 0xf1, 0x46, 0x37, 0xe0, 0x34, 0x5b, 0x8e,
-	0x6b, 0xce, 0x3d, 0x7b, 0x8d, 0x58, 0xc6, 0x61, 0x30, 0x80, 0x49, 0x36, 0x80, 0x0f, 0xf6, 0xba,
-	0xe5, 0xd3, 0xb5, 0x24, 0x02, 0x9c, 0xdc, 0x0e, 0x19, 0xf0, 0xb8, 0x8a, 0xc0, 0xe4, 0xc0, 0xf4,
-	0x4c, 0xc7, 0xde, 0x34, 0x5b, 0xa6, 0x5f, 0x2a, 0x30, 0xb6, 0xe5, 0x5e, 0xb7, 0xfc, 0x78, 0x6d,
-	0x30, 0x19, 0x3e, 0x8a, 0x07, 0xfa, 0x6d, 0x0d, 0x16, 0x93, 0x96, 0x61, 0x69, 0x2a, 0x8d, 0xf3,
-	0x8f, 0xd8, 0xd2, 0xe2, 0x1a, 0x91, 0x68, 0x14, 0x12, 0x3b, 0x81, 0x5e, 0xd3, 0x60, 0xda, 0x88,
-	0x04, 0x67, 0x25, 0x60, 0xbd, 0xda, 0x18, 0x37, 0x1a, 0x0e, 0x39, 0x56, 0xe7, 0x7b, 0xdd, 0xb2,
-	0x12, 0x00, 0x62, 0x45, 0x22, 0xfa, 0x5d, 0x0d, 0x4e, 0x27, 0xae, 0xf1, 0x52, 0xf1, 0x24, 0xbe,
-	0x10, 0x53, 0x92, 0x64, 0x9b, 0x93, 0xdc, 0x0d, 0xf4, 0x8e, 0x26, 0x5d, 0xd9, 0x56, 0xb0, 0x1f,
-	0x99, 0x66, 0x5d, 0xbb, 0x39, 0x66, 0x3c, 0x1a, 0x7a, 0xef, 0x80, 0x71, 0xf5, 0x54, 0xc4, 0x33,
-	0x06, 0x40, 0x1c, 0x17, 0x8f, 0xbe, 0xae, 0x05, 0xae, 0x51, 0xf6, 0x68, 0xe6, 0xa4, 0x7a, 0x84,
-	0x42, 0x4f, 0x2b, 0x3b, 0x14, 0x13, 0xae, 0xff, 0x4b, 0x16, 0xa6, 0x57, 0x0d, 0xdb, 0x70, 0x0f,
-	0x85, 0x6b, 0xf9, 0x13, 0x0d, 0xce, 0xd5, 0x3b, 0xae, 0x4b, 0x6c, 0xbf, 0xe6, 0x93, 0x76, 0xbf,
-	0x63, 0xd1, 0x4e, 0xd4, 0xb1, 0x5c, 0xe8, 0x75, 0xcb, 0xe7, 0x56, 0x8f, 0x90, 0x8f, 0x8f, 0xec,
-	0x1d, 0xfa, 0x1b, 0x0d, 0x74, 0x41, 0x50, 0x35, 0xea, 0x77, 0x9b, 0xae, 0xd3, 0xb1, 0x1b, 0xfd,
-	0x83, 0xc8, 0x9c, 0xe8, 0x20, 0x9e, 0xec, 0x75, 0xcb, 0xfa, 0xea, 0xb1, 0xbd, 0xc0, 0x43, 0xf4,
-	0x14, 0x3d, 0x0f, 0x0b, 0x82, 0xea, 0xca, 0xfd, 0x36, 0x71, 0x4d, 0x1a, 0x9b, 0x8a, 0xf3, 0xea,
-	0x0f, 0x0a, 0xb3, 0xbf, 0xb0, 0x1a, 0x27, 0xc0, 0xfd, 0x6d, 0xf4, 0x6f, 0xe6, 0x00, 0x82, 0x99,
-	0x26, 0x6d, 0xf4, 0x73, 0x30, 0xe5, 0x11, 0xff, 0x36, 0x31, 0x9b, 0xfb, 0x3e, 0x9b, 0xd3, 0xbc,
-	0x38, 0xd6, 0x08, 0x80, 0x38, 0xc4, 0xa3, 0xbb, 0x90, 0x6f, 0x1b, 0x1d, 0x8f, 0x88, 0xef, 0xb6,
-	0x91, 0xca, 0x77, 0xdb, 0xa6, 0x1c, 0x79, 0xec, 0xcf, 0xfe, 0xc4, 0x5c, 0x06, 0x7a, 0x43, 0x03,
-	0x20, 0xea, 0x58, 0x8b, 0x97, 0x6a, 0xa9, 0x88, 0x0c, 0x3f, 0x07, 0xfd, 0x06, 0xd5, 0xd9, 0x5e,
-	0xb7, 0x0c, 0x91, 0xaf, 0x16, 0x11, 0x8b, 0xee, 0x41, 0xc1, 0x08, 0xcc, 0x59, 0xee, 0x24, 0xcc,
-	0x19, 0x0b, 0xc9, 0xe5, 0x7c, 0x4b, 0x61, 0xe8, 0x2d, 0x0d, 0x66, 0x3d, 0xe2, 0x8b, 0xa9, 0xa2,
-	0xfe, 0x49, 0xc4, 0x72, 0x9b, 0xe3, 0xc9, 0xaf, 0x29, 0x3c, 0xb9, 0x71, 0x50, 0x61, 0x38, 0x26,
-	0x57, 0xff, 0x26, 0xc0, 0x6c, 0xa0, 0x32, 0x61, 0x78, 0x56, 0xe7, 0x90, 0xe4, 0xf0, 0x6c, 0x35,
-	0x8a, 0xc4, 0x2a, 0x2d, 0x6d, 0xec, 0xf9, 0x34, 0x1e, 0x50, 0xa3, 0x33, 0xd9, 0xb8, 0x16, 0x45,
-	0x62, 0x95, 0x16, 0xb5, 0x20, 0xef, 0xf9, 0xa4, 0x1d, 0x1c, 0x1a, 0x5e, 0x1b, 0xef, 0x6b, 0x84,
-	0x2b, 0x21, 0x3c, 0xf0, 0xa1, 0xbf, 0x3c, 0xcc, 0xa5, 0xa0, 0xb7, 0x35, 0x98, 0xf5, 0x95, 0xdc,
-	0x8c, 0x50, 0x83, 0x74, 0x34, 0x51, 0x4d, 0xfb, 0xf0, 0xd9, 0x50, 0x61, 0x38, 0x26, 0x3e, 0x21,
-	0x62, 0xcb, 0x9f, 0x60, 0xc4, 0xf6, 0x12, 0x14, 0x5a, 0xc6, 0xfd, 0x5a, 0xc7, 0x6d, 0x3e, 0x7c,
-	0x64, 0x28, 0x52, 0x57, 0x9c, 0x0b, 0x96, 0xfc, 0xd0, 0xeb, 0x5a, 0x64, 0x71, 0x4d, 0x32, 0xe6,
-	0xb7, 0xd3, 0x5d, 0x5c, 0xd2, 0xa0, 0x0e, 0x5c, 0x66, 0x7d, 0xf1, 0x53, 0xe1, 0x91, 0xc7, 0x4f,
-	0x34, 0x16, 0xe0, 0x0b, 0x44, 0xc6, 0x02, 0x53, 0x27, 0x1a, 0x0b, 0xac, 0x2a, 0xc2, 0x70, 0x4c,
-	0x38, 0xeb, 0x0f, 0x5f, 0x73, 0xb2, 0x3f, 0x70, 0xa2, 0xfd, 0xa9, 0x29, 0xc2, 0x70, 0x4c, 0xf8,
-	0xe0, 0x4d, 0x43, 0xf1, 0x64, 0x36, 0x0d, 0xd3, 0xe3, 0x6f, 0x1a, 0xf4, 0x1f, 0x6b, 0x70, 0x66,
-	0xd5, 0xea, 0x78, 0x3e, 0x71, 0xff, 0xd7, 0xe4, 0x01, 0xfe, 0x53, 0x83, 0xc7, 0x07, 0x8c, 0xf9,
-	0x11, 0xa4, 0x03, 0x5e, 0x55, 0xd3, 0x01, 0xb7, 0xc6, 0xf4, 0x0b, 0xc9, 0xe3, 0x18, 0x90, 0x15,
-	0xf0, 0x61, 0x66, 0xcd, 0xf0, 0x8d, 0x86, 0xd3, 0xe4, 0xc7, 0xf4, 0xe8, 0x32, 0x14, 0x4c, 0xdb,
-	0x27, 0xee, 0x81, 0x61, 0x09, 0xcf, 0xa8, 0x07, 0x5d, 0x5f, 0x17, 0xf0, 0x07, 0xdd, 0xf2, 0xec,
-	0x5a, 0xc7, 0x65, 0x05, 0x01, 0xdc, 0x4e, 0x62, 0xd9, 0x06, 0x3d, 0x01, 0xf9, 0xcf, 0x75, 0x88,
-	0x7b, 0x18, 0x4f, 0x1f, 0xdf, 0xa4, 0x40, 0xcc, 0x71, 0xfa, 0xdf, 0x67, 0x20, 0x12, 0xb5, 0x3c,
-	0x02, 0xb5, 0xb2, 0x15, 0xb5, 0x1a, 0x33, 0x0e, 0x89, 0xc4, 0x60, 0x83, 0xf2, 0xfe, 0x07, 0xb1,
-	0xbc, 0xff, 0xf5, 0xd4, 0x24, 0x1e, 0x9d, 0xf6, 0x7f, 0x4f, 0x83, 0xc7, 0x43, 0xe2, 0xfe, 0x58,
-	0xfc, 0xf8, 0x83, 0xed, 0x67, 0xa0, 0x68, 0x84, 0xcd, 0xc4, 0x2c, 0xca, 0xba, 0x92, 0x08, 0x47,
-	0x1c, 0xa5, 0x0b, 0x53, 0xaf, 0xd9, 0x87, 0x4c, 0xbd, 0xe6, 0x8e, 0x4e, 0xbd, 0xea, 0x3f, 0xc9,
-	0xc0, 0xf9, 0xfe, 0x91, 0x05, 0xda, 0x8d, 0xc9, 0xde, 0x10, 0x63, 0x7b, 0x16, 0xa6, 0x7d, 0xd1,
-	0x80, 0x42, 0xc5, 0xe0, 0x16, 0x05, 0xe5, 0xf4, 0x4e, 0x04, 0x87, 0x15, 0x4a, 0xda, 0xb2, 0xce,
-	0xd7, 0x55, 0xad, 0xee, 0xb4, 0x83, 0x1c, 0xb5, 0x6c, 0xb9, 0x1a, 0xc1, 0x61, 0x85, 0x52, 0x26,
-	0xbb, 0x72, 0x27, 0x9e, 0x44, 0xaf, 0xc1, 0xe9, 0x20, 0xe7, 0x71, 0xd5, 0x71, 0x57, 0x9d, 0x56,
-	0xdb, 0x22, 0x2c, 0x65, 0x93, 0x67, 0x9d, 0x3d, 0x2f, 0x9a, 0x9c, 0xc6, 0x49, 0x44, 0x38, 0xb9,
-	0xad, 0xfe, 0x5e, 0x16, 0x4e, 0x85, 0x9f, 0x7d, 0xd5, 0xb1, 0x1b, 0x26, 0xcb, 0x1c, 0x3d, 0x07,
-	0x39, 0xff, 0xb0, 0x1d, 0x7c, 0xec, 0xff, 0x17, 0x74, 0x67, 0xe7, 0xb0, 0x4d, 0x67, 0xfb, 0x4c,
-	0x42, 0x13, 0x8a, 0xc2, 0xac, 0x11, 0xda, 0x94, 0xab, 0x83, 0xcf, 0xc0, 0xd3, 0xaa, 0x36, 0x3f,
-	0xe8, 0x96, 0x13, 0xaa, 0xc9, 0x96, 0x25, 0x27, 0x55, 0xe7, 0xd1, 0x1d, 0x98, 0xb5, 0x0c, 0xcf,
-	0xbf, 0xd5, 0x6e, 0x18, 0x3e, 0xd9, 0x31, 0x5b, 0x44, 0xac, 0xb9, 0x51, 0xf2, 0xe1, 0xf2, 0x78,
-	0x75, 0x53, 0xe1, 0x84, 0x63, 0x9c, 0xd1, 0x01, 0x20, 0x0a, 0xd9, 0x71, 0x0d, 0xdb, 0xe3, 0xa3,
-	0xa2, 0xf2, 0x46, 0xcf, 0xbf, 0x9f, 0x15, 0xf2, 0xd0, 0x66, 0x1f, 0x37, 0x9c, 0x20, 0x01, 0x3d,
-	0x09, 0x13, 0x2e, 0x31, 0x3c, 0x31, 0x99, 0x53, 0xe1, 0xfa, 0xc7, 0x0c, 0x8a, 0x05, 0x36, 0xba,
-	0xa0, 0x26, 0x8e, 0x59, 0x50, 0x3f, 0xd0, 0x60, 0x36, 0x9c, 0xa6, 0x47, 0xe0, 0xe6, 0x5a, 0xaa,
-	0x9b, 0xbb, 0x96, 0x96, 0x49, 0x1c, 0xe0, 0xd9, 0xde, 0xcf, 0x46, 0xc7, 0xc7, 0x32, 0xdd, 0x9f,
-	0x87, 0xa9, 0x60, 0x55, 0x07, 0xb9, 0xee, 0x31, 0xa3, 0x65, 0x25, 0xb2, 0x88, 0x94, 0xac, 0x08,
-	0x21, 0x38, 0x94, 0x47, 0x1d, 0x6b, 0x43, 0x38, 0x4d, 0xa1, 0xf6, 0xd2, 0xb1, 0x06, 0xce, 0x34,
-	0xc9, 0xb1, 0x06, 0x6d, 0xd0, 0x2d, 0x38, 0xd3, 0x76, 0x1d, 0x56, 0x33, 0xb8, 0x46, 0x8c, 0x86,
-	0x65, 0xda, 0x24, 0x88, 0x26, 0xf9, 0xe9, 0xfe, 0xe3, 0xbd, 0x6e, 0xf9, 0xcc, 0x76, 0x32, 0x09,
-	0x1e, 0xd4, 0x56, 0x2d, 0xbd, 0xc9, 0x1d, 0x5f, 0x7a, 0x83, 0x7e, 0x55, 0x6e, 0x7d, 0x88, 0x57,
-	0xca, 0xb3, 0x8f, 0xf8, 0x99, 0xb4, 0xa6, 0x32, 0xc1, 0xac, 0x87, 0x2a, 0x55, 0x11, 0x42, 0xb1,
-	0x14, 0xaf, 0xbf, 0x99, 0x87, 0xf9, 0xb8, 0x6f, 0x3c, 0xf9, 0x2a, 0xa0, 0xdf, 0xd0, 0x60, 0x3e,
-	0x98, 0x57, 0x2e, 0x93, 0x04, 0x7b, 0xfa, 0xcd, 0x94, 0xd4, 0x89, 0x7b, 0x79, 0x59, 0x92, 0xb9,
-	0x13, 0x93, 0x86, 0xfb, 0xe4, 0xa3, 0x57, 0xa0, 0x28, 0xb7, 0xbe, 0x0f, 0x55, 0x12, 0x34, 0xc7,
-	0xfc, 0x7b, 0xc8, 0x02, 0x47, 0xf9, 0xa1, 0x37, 0x35, 0x80, 0x7a, 0x60, 0x80, 0x83, 0x79, 0xbf,
-	0x99, 0xd6, 0xbc, 0x4b, 0xd3, 0x1e, 0x86, 0x71, 0x12, 0xe4, 0xe1, 0x88, 0x60, 0xf4, 0x9b, 0x6c,
-	0xd3, 0x2b, 0xe3, 0x0e, 0xaf, 0x34, 0xc1, 0x7a, 0xf2, 0xe9, 0xb4, 0x35, 0x30, 0x3c, 0x0a, 0x95,
-	0x4e, 0x3e, 0x82, 0xf2, 0xb0, 0xd2, 0x09, 0xfd, 0x39, 0x90, 0xa9, 0x69, 0xba, 0xa0, 0x58, 0x72,
-	0x7a, 0xdb, 0xf0, 0xf7, 0x85, 0x0a, 0xca, 0x05, 0x75, 0x35, 0x40, 0xe0, 0x90, 0x46, 0xff, 0x73,
-	0x0d, 0x16, 0xd7, 0x3d, 0xdf, 0x74, 0xd6, 0x88, 0xe7, 0xd3, 0x35, 0x46, 0xdd, 0x71, 0xc7, 0x22,
-	0x43, 0x04, 0x34, 0x6b, 0x30, 0x2f, 0xce, 0xa7, 0x3a, 0xbb, 0x1e, 0xf1, 0x23, 0x41, 0x8d, 0x54,
-	0x9d, 0xd5, 0x18, 0x1e, 0xf7, 0xb5, 0xa0, 0x5c, 0xc4, 0x41, 0x55, 0xc8, 0x25, 0xab, 0x72, 0xa9,
-	0xc5, 0xf0, 0xb8, 0xaf, 0x85, 0xfe, 0xed, 0x0c, 0x9c, 0x62, 0xc3, 0x88, 0xd5, 0x03, 0xff, 0xba,
-	0x06, 0xb3, 0x07, 0xa6, 0xeb, 0x77, 0x0c, 0x2b, 0x7a, 0xe2, 0x36, 0xb6, 0xf6, 0x30, 0x59, 0x2f,
-	0x2a, 0x8c, 0x43, 0x37, 0xae, 0xc2, 0x71, 0xac, 0x03, 0xb4, 0x4f, 0x73, 0x0d, 0xf5, 0x6b, 0xa7,
-	0xb3, 0xe3, 0x4c, 0x9a, 0x47, 0x9e, 0x57, 0x89, 0x01, 0x71, 0x5c, 0xbe, 0xfe, 0x19, 0xf1, 0xf9,
-	0xd4, 0xae, 0x0f, 0xa1, 0x04, 0x3a, 0x4c, 0xb8, 0x4e, 0x87, 0xba, 0xb4, 0x0c, 0x2b, 0xb7, 0x06,
-	0x16, 0x17, 0x30, 0x08, 0x16, 0x18, 0xfd, 0x8f, 0x35, 0x98, 0xda, 0x70, 0x76, 0xc5, 0x1e, 0xef,
-	0x97, 0x53, 0xd8, 0x6f, 0x49, 0xb3, 0x2c, 0x0f, 0x3f, 0x42, 0x4f, 0x7f, 0x59, 0xd9, 0x6d, 0x9d,
-	0x8b, 0xf0, 0x5e, 0x66, 0xf7, 0x07, 0x28, 0xab, 0x0d, 0x67, 0x77, 0xe0, 0x76, 0xfc, 0xf7, 0xf3,
-	0x30, 0xf3, 0x82, 0x71, 0x48, 0x6c, 0xdf, 0x10, 0x3d, 0xfe, 0x08, 0x4c, 0x1a, 0x8d, 0x46, 0x52,
-	0x3d, 0x7d, 0x85, 0x83, 0x71, 0x80, 0x67, 0x1b, 0x98, 0x36, 0x4b, 0x63, 0x47, 0x5c, 0x6d, 0xb8,
-	0x81, 0x09, 0x51, 0x38, 0x4a, 0x17, 0x2e, 0xa5, 0x55, 0xc7, 0xde, 0x33, 0x9b, 0x49, 0x8b, 0x60,
-	0x35, 0x86, 0xc7, 0x7d, 0x2d, 0xd0, 0x06, 0x20, 0x51, 0xe5, 0x56, 0xa9, 0xd7, 0x9d, 0x8e, 0xcd,
-	0x17, 0x13, 0xdf, 0xdb, 0xc8, 0x98, 0x6f, 0xab, 0x8f, 0x02, 0x27, 0xb4, 0x42, 0x2f, 0x43, 0xa9,
-	0xce, 0x38, 0x8b, 0x08, 0x20, 0xca, 0x91, 0x47, 0x81, 0xb2, 0x84, 0x64, 0x75, 0x00, 0x1d, 0x1e,
-	0xc8, 0x81, 0xf6, 0xd4, 0xf3, 0x1d, 0xd7, 0x68, 0x92, 0x28, 0xdf, 0x09, 0xb5, 0xa7, 0xb5, 0x3e,
-	0x0a, 0x9c, 0xd0, 0x0a, 0x7d, 0x09, 0xa6, 0xfc, 0x7d, 0x97, 0x78, 0xfb, 0x8e, 0xd5, 0x10, 0xa7,
-	0xa1, 0x63, 0x6e, 0x78, 0xc5, 0xec, 0xef, 0x04, 0x5c, 0x23, 0x31, 0x49, 0x00, 0xc2, 0xa1, 0x4c,
-	0xe4, 0xc2, 0x84, 0x47, 0x77, 0x5b, 0x5e, 0xa9, 0x90, 0x46, 0x54, 0x27, 0xa4, 0xb3, 0x0d, 0x5c,
-	0x64, 0xab, 0xcd, 0x24, 0x60, 0x21, 0x49, 0xff, 0x8b, 0x0c, 0x4c, 0x47, 0x09, 0x87, 0x58, 0xa9,
-	0x6f, 0x68, 0x30, 0x5d, 0x77, 0x6c, 0xdf, 0x75, 0x2c, 0xbe, 0x8d, 0xe4, 0x0b, 0x64, 0xcc, 0x6a,
-	0x72, 0xc6, 0x6a, 0x8d, 0xf8, 0x86, 0x69, 0x45, 0x76, 0xa4, 0x11, 0x31, 0x58, 0x11, 0x8a, 0xbe,
-	0xa6, 0xc1, 0x5c, 0x98, 0x26, 0x0a, 0xf7, 0xb3, 0xa9, 0x76, 0x44, 0x56, 0x5a, 0x5d, 0x51, 0x25,
-	0xe1, 0xb8, 0x68, 0x7d, 0x17, 0xe6, 0xe3, 0xb3, 0x4d, 0x3f, 0x65, 0xdb, 0x10, 0x6b, 0x3d, 0x1b,
-	0x7e, 0xca, 0x6d, 0xc3, 0xf3, 0x30, 0xc3, 0xa0, 0x8f, 0x42, 0xa1, 0x65, 0xb8, 0x4d, 0xd3, 0x36,
-	0x2c, 0xf6, 0x15, 0xb3, 0x11, 0x83, 0x24, 0xe0, 0x58, 0x52, 0xe8, 0x3f, 0xca, 0x41, 0x71, 0x8b,
-	0x18, 0x5e, 0xc7, 0x25, 0xec, 0xc0, 0xe9, 0xc4, 0x43, 0x44, 0xa5, 0x3c, 0x3b, 0x9b, 0x5e, 0x79,
-	0x36, 0x7a, 0x09, 0x60, 0xcf, 0xb4, 0x4d, 0x6f, 0xff, 0x21, 0x0b, 0xbf, 0x59, 0xc2, 0xf0, 0xaa,
-	0xe4, 0x80, 0x23, 0xdc, 0xc2, 0x9b, 0x1f, 0xf9, 0x23, 0x6e, 0x7e, 0xbc, 0xa9, 0x45, 0x9c, 0x07,
-	0x0f, 0xbe, 0x6e, 0x8f, 0x5b, 0x2f, 0x2c, 0x27, 0x66, 0x39, 0x70, 0x26, 0x57, 0x6c, 0xdf, 0x3d,
-	0x3c, 0xd2, 0xc7, 0xec, 0x40, 0xc1, 0x25, 0x5e, 0xa7, 0x45, 0x83, 0xdd, 0xc9, 0x91, 0x3f, 0x03,
-	0xcb, 0xa9, 0x60, 0xd1, 0x1e, 0x4b, 0x4e, 0x67, 0x9f, 0x83, 0x19, 0xa5, 0x0b, 0x68, 0x1e, 0xb2,
-	0x77, 0xc9, 0x21, 0xd7, 0x13, 0x4c, 0xff, 0x44, 0x8b, 0x4a, 0xe5, 0xa7, 0xf8, 0x2c, 0x9f, 0xcc,
-	0x3c, 0xab, 0xe9, 0x3f, 0x99, 0x80, 0x09, 0xe1, 0xaf, 0x8e, 0xb7, 0x05, 0xd1, 0x73, 0xd6, 0xcc,
-	0x43, 0x9c, 0xb3, 0x6e, 0xc0, 0xb4, 0x69, 0x9b, 0xbe, 0x69, 0x58, 0xec, 0x0c, 0x5f, 0xf8, 0xaa,
-	0x27, 0x83, 0xf5, 0xbf, 0x1e, 0xc1, 0x25, 0xf0, 0x51, 0xda, 0xa2, 0x9b, 0x90, 0x67, 0xc6, 0x5c,
-	0xe8, 0xd3, 0xe8, 0x69, 0x32, 0x96, 0x02, 0xe7, 0xa5, 0x64, 0x9c, 0x13, 0x8b, 0x29, 0x3b, 0xf5,
-	0x3a, 0xf1, 0x3c, 0x19, 0xc8, 0x0b, 0xb5, 0x0a, 0x63, 0xca, 0x18, 0x1e, 0xf7, 0xb5, 0xa0, 0x5c,
-	0xf6, 0x0c, 0xd3, 0xea, 0xb8, 0x24, 0xe4, 0x32, 0xa1, 0x72, 0xb9, 0x1a, 0xc3, 0xe3, 0xbe, 0x16,
-	0x68, 0x0f, 0xa6, 0x05, 0x8c, 0x67, 0x49, 0x26, 0x1f, 0x72, 0x94, 0x2c, 0x1b, 0x76, 0x35, 0xc2,
-	0x09, 0x2b, 0x7c, 0x51, 0x07, 0x16, 0x4c, 0xbb, 0xee, 0xd8, 0x75, 0xab, 0xe3, 0x99, 0x07, 0x24,
-	0xac, 0xe3, 0x7a, 0x18, 0x61, 0xa7, 0x7b, 0xdd, 0xf2, 0xc2, 0x7a, 0x9c, 0x1d, 0xee, 0x97, 0x80,
-	0x5e, 0xd7, 0xe0, 0x74, 0xdd, 0xb1, 0x3d, 0x56, 0xc9, 0x7c, 0x40, 0xae, 0xb8, 0xae, 0xe3, 0x72,
-	0xd9, 0x53, 0x0f, 0x29, 0x9b, 0xe5, 0xa5, 0x56, 0x93, 0x58, 0xe2, 0x64, 0x49, 0xe8, 0x55, 0x28,
-	0xb4, 0x5d, 0xe7, 0xc0, 0x6c, 0x10, 0x57, 0x64, 0xdc, 0x36, 0xd3, 0xb8, 0x44, 0xb0, 0x2d, 0x78,
-	0x86, 0x96, 0x20, 0x80, 0x60, 0x29, 0x4f, 0xff, 0xd6, 0x04, 0xcc, 0xaa, 0xe4, 0xe8, 0x8b, 0x00,
-	0x6d, 0xd7, 0x69, 0x11, 0x7f, 0x9f, 0xc8, 0x7a, 0x9f, 0xeb, 0xe3, 0x16, 0xf0, 0x07, 0xfc, 0xc4,
-	0xfd, 0x06, 0x66, 0x49, 0x43, 0x28, 0x8e, 0x48, 0x44, 0x2e, 0x4c, 0xde, 0xe5, 0x3e, 0x4d, 0xb8,
-	0xf8, 0x17, 0x52, 0x09, 0x48, 0x84, 0xe4, 0x22, 0x75, 0x39, 0x02, 0x84, 0x03, 0x41, 0x68, 0x17,
-	0xb2, 0xf7, 0xc8, 0x6e, 0x3a, 0xa5, 0xe6, 0xb7, 0x89, 0xd8, 0x2a, 0x54, 0x27, 0x7b, 0xdd, 0x72,
-	0xf6, 0x36, 0xd9, 0xc5, 0x94, 0x39, 0x1d, 0x57, 0x83, 0x67, 0x8b, 0x84, 0xa9, 0x18, 0x73, 0x5c,
-	0x4a, 0xea, 0x89, 0x8f, 0x4b, 0x80, 0x70, 0x20, 0x08, 0xbd, 0x0a, 0x53, 0xf7, 0x8c, 0x03, 0xb2,
-	0xe7, 0x3a, 0xb6, 0x2f, 0xea, 0x05, 0xc6, 0xac, 0x63, 0xb9, 0x1d, 0xb0, 0x13, 0x72, 0x99, 0xb7,
-	0x95, 0x40, 0x1c, 0x8a, 0x43, 0x07, 0x50, 0xb0, 0xc9, 0x3d, 0x4c, 0x2c, 0xb3, 0x2e, 0x4a, 0x08,
-	0xc6, 0x54, 0xeb, 0xeb, 0x82, 0x9b, 0x90, 0xcc, 0xdc, 0x50, 0x00, 0xc3, 0x52, 0x16, 0x9d, 0xcb,
-	0x3b, 0xce, 0xae, 0x30, 0x54, 0x63, 0xce, 0xa5, 0xdc, 0xf6, 0xf1, 0xb9, 0xdc, 0x70, 0x76, 0x31,
-	0x65, 0xae, 0x7f, 0x3b, 0x07, 0xd3, 0xd1, 0x2b, 0x66, 0x43, 0xf8, 0x2c, 0x19, 0x36, 0x65, 0x46,
-	0x09, 0x9b, 0x68, 0xd4, 0xdb, 0x0a, 0x7d, 0x7c, 0x70, 0x54, 0xb6, 0x9e, 0x5a, 0xd4, 0x10, 0x46,
-	0xbd, 0x11, 0xa0, 0x87, 0x15, 0xa1, 0x23, 0xa4, 0x9a, 0x68, 0x1c, 0xc4, 0xdd, 0x21, 0xaf, 0x4d,
-	0x96, 0x71, 0x90, 0xe2, 0xe0, 0x2e, 0x01, 0x08, 0x77, 0xb5, 0xd7, 0xb1, 0x98, 0x72, 0xe4, 0xc3,
-	0xc3, 0xab, 0x9a, 0xc4, 0xe0, 0x08, 0x15, 0x7a, 0x12, 0x26, 0xa8, 0xc3, 0x20, 0x0d, 0x51, 0x34,
-	0x2c, 0xb7, 0x16, 0x57, 0x19, 0x14, 0x0b, 0x2c, 0x7a, 0x96, 0xfa, 0xf6, 0xd0, 0xcc, 0x8b, 0x5a,
-	0xe0, 0xc5, 0xd0, 0xb7, 0x87, 0x38, 0xac, 0x50, 0xd2, 0xae, 0x13, 0x6a, 0x95, 0x99, 0xe9, 0x8f,
-	0x74, 0x9d, 0x99, 0x6a, 0xcc, 0x71, 0x6c, 0xab, 0x1b, 0xb3, 0xe2, 0xcc, 0x68, 0xe7, 0x23, 0x5b,
-	0xdd, 0x18, 0x1e, 0xf7, 0xb5, 0xd0, 0x3f, 0x0b, 0xb3, 0xaa, 0x36, 0xd3, 0x4f, 0xdc, 0x76, 0x9d,
-	0x3d, 0xd3, 0x22, 0xf1, 0x4d, 0xfa, 0x36, 0x07, 0xe3, 0x00, 0x3f, 0x5c, 0x96, 0xf8, 0x2f, 0xb3,
-	0x70, 0xea, 0x7a, 0xd3, 0xb4, 0xef, 0xc7, 0x4e, 0x94, 0x92, 0xee, 0xb0, 0x6b, 0xa3, 0xde, 0x61,
-	0x0f, 0x4b, 0xb9, 0xc4, 0x8d, 0xfc, 0xe4, 0x52, 0xae, 0xe0, 0xba, 0xbe, 0x4a, 0x8b, 0x7e, 0xa0,
-	0xc1, 0x39, 0xa3, 0xc1, 0xe3, 0x0b, 0xc3, 0x12, 0xd0, 0x50, 0x68, 0xa0, 0xe3, 0xde, 0x98, 0xd6,
-	0xa2, 0x7f, 0xf0, 0xcb, 0x95, 0x23, 0xa4, 0xf2, 0xa8, 0xf9, 0xc3, 0x62, 0x04, 0xe7, 0x8e, 0x22,
-	0xc5, 0x47, 0x76, 0xff, 0xec, 0x0d, 0xf8, 0xd0, 0xb1, 0x82, 0x46, 0x8a, 0x8d, 0xdf, 0xd0, 0x60,
-	0x8a, 0x9f, 0x1e, 0x61, 0xb2, 0x47, 0x17, 0x8f, 0xd1, 0x36, 0x5f, 0x24, 0xae, 0x17, 0x5c, 0xb0,
-	0x9b, 0x0a, 0x17, 0x4f, 0x65, 0x7b, 0x5d, 0x60, 0x70, 0x84, 0x8a, 0x9a, 0xa7, 0xbb, 0xa6, 0xdd,
-	0x10, 0xd3, 0x24, 0xcd, 0xd3, 0x0b, 0xa6, 0xdd, 0xc0, 0x0c, 0x23, 0x0d, 0x58, 0x76, 0x90, 0x01,
-	0xd3, 0xff, 0x40, 0x83, 0x59, 0x56, 0xa9, 0x19, 0x06, 0x87, 0xcf, 0xc8, 0xcc, 0x1a, 0xef, 0xc6,
-	0x79, 0x35, 0xb3, 0xf6, 0xa0, 0x5b, 0x2e, 0xf2, 0xda, 0x4e, 0x35, 0xd1, 0xf6, 0x19, 0xb1, 0xc1,
-	0x63, 0xf9, 0xbf, 0xcc, 0xc8, 0xfb, 0x0f, 0x79, 0x9c, 0x51, 0x0b, 0x98, 0xe0, 0x90, 0x9f, 0xfe,
-	0xad, 0x2c, 0x9c, 0x4a, 0x28, 0x39, 0xa2, 0x7b, 0xaf, 0x09, 0xcb, 0xd8, 0x25, 0x56, 0x90, 0xbd,
-	0x7a, 0x25, 0xf5, 0xb2, 0xa6, 0xe5, 0x4d, 0xc6, 0x9f, 0x6b, 0x92, 0xb4, 0x4f, 0x1c, 0x88, 0x85,
-	0x70, 0xf4, 0x0d, 0x0d, 0x8a, 0x46, 0x44, 0xd9, 0x79, 0x42, 0x6f, 0x37, 0xfd, 0xce, 0xf4, 0xe9,
-	0x76, 0xa4, 0x10, 0x21, 0x54, 0xe5, 0x68, 0x5f, 0xce, 0xfe, 0x22, 0x14, 0x23, 0x43, 0x18, 0x45,
-	0x47, 0xcf, 0x5e, 0x86, 0xf9, 0xb1, 0x74, 0xfc, 0xd3, 0x30, 0xea, 0x8d, 0x4d, 0xea, 0x11, 0xee,
-	0x45, 0x0b, 0x98, 0xe5, 0x17, 0x17, 0x15, 0xcc, 0x02, 0xab, 0xef, 0xc2, 0x7c, 0x3c, 0x00, 0x1d,
-	0xe5, 0x4c, 0x74, 0x28, 0x73, 0xfb, 0x71, 0x18, 0xf1, 0x8e, 0xa5, 0xfe, 0xd7, 0x19, 0x98, 0x14,
-	0x75, 0x8b, 0x8f, 0xa0, 0x86, 0xe7, 0xae, 0x72, 0xaa, 0xbc, 0x9e, 0x4a, 0xb9, 0xe5, 0xc0, 0x02,
-	0x1e, 0x2f, 0x56, 0xc0, 0xf3, 0x42, 0x3a, 0xe2, 0x8e, 0xae, 0xde, 0x79, 0x3b, 0x03, 0x73, 0xb1,
-	0x3a, 0x50, 0xf4, 0x15, 0xad, 0x3f, 0x69, 0x7d, 0x2b, 0xd5, 0x52, 0x53, 0x59, 0x21, 0x76, 0x74,
-	0xfe, 0xda, 0x53, 0x6e, 0x6d, 0xdf, 0x4c, 0xed, 0x05, 0x8c, 0x23, 0x2f, 0x70, 0xff, 0xb3, 0x06,
-	0x1f, 0x1c, 0x58, 0x19, 0xcb, 0x6e, 0xc7, 0xb8, 0x2a, 0x56, 0xe8, 0x5e, 0xca, 0x95, 0xee, 0xf2,
-	0x34, 0x33, 0x7e, 0x61, 0x22, 0x2e, 0x1e, 0x3d, 0x0d, 0xd3, 0xcc, 0x8e, 0xd3, 0xe5, 0xe3, 0x93,
-	0xb6, 0x78, 0xce, 0x87, 0x9d, 0x1c, 0xd4, 0x22, 0x70, 0xac, 0x50, 0xe9, 0xbf, 0xa7, 0x41, 0x69,
-	0xd0, 0x5d, 0x8c, 0x21, 0xe2, 0xf2, 0x5f, 0x88, 0xd5, 0xd3, 0x94, 0xfb, 0xea, 0x69, 0x62, 0x91,
-	0x79, 0x50, 0x3a, 0x13, 0x09, 0x8a, 0xb3, 0xc7, 0x94, 0x8b, 0x7c, 0x5d, 0x83, 0x33, 0x03, 0x14,
-	0xa7, 0xaf, 0xae, 0x4a, 0x7b, 0xe8, 0xba, 0xaa, 0xcc, 0xb0, 0x75, 0x55, 0xfa, 0xdf, 0x65, 0x61,
-	0x5e, 0xf4, 0x27, 0x74, 0xe6, 0xcf, 0x2a, 0x55, 0x49, 0x1f, 0x8e, 0x55, 0x25, 0x2d, 0xc6, 0xe9,
-	0xff, 0xaf, 0x24, 0xe9, 0x67, 0xab, 0x24, 0xe9, 0xa7, 0x19, 0x38, 0x9d, 0x78, 0xcf, 0x05, 0xbd,
-	0x95, 0x60, 0x05, 0x6f, 0xa7, 0x7c, 0xa1, 0x66, 0x48, 0x3b, 0x38, 0x6e, 0x1d, 0xcf, 0x6f, 0x45,
-	0xeb, 0x67, 0xf8, 0x36, 0x61, 0xef, 0x04, 0xae, 0x06, 0x8d, 0x5a, 0x4a, 0xf3, 0x6b, 0x59, 0xb8,
-	0x38, 0x2c, 0xa3, 0x9f, 0xd1, 0x52, 0x4b, 0x4f, 0x29, 0xb5, 0x7c, 0x34, 0x1e, 0xea, 0x64, 0xaa,
-	0x2e, 0xbf, 0x9a, 0x95, 0x6e, 0xaf, 0x5f, 0x3f, 0x87, 0x4a, 0x2e, 0x4c, 0xd2, 0x28, 0x26, 0x78,
-	0x85, 0x21, 0x34, 0x85, 0x93, 0x35, 0x0e, 0x7e, 0xd0, 0x2d, 0x2f, 0x88, 0xcb, 0xde, 0x35, 0xe2,
-	0x0b, 0x20, 0x0e, 0x1a, 0xa1, 0x8b, 0x50, 0x70, 0x39, 0x36, 0x28, 0x2e, 0x13, 0x09, 0x13, 0x0e,
-	0xc3, 0x12, 0x8b, 0xbe, 0x14, 0x09, 0xfb, 0x72, 0x27, 0x75, 0xd5, 0xe2, 0xa8, 0x3c, 0xd0, 0x2b,
-	0x50, 0xf0, 0x82, 0x27, 0x1a, 0xf8, 0xe9, 0xe0, 0x53, 0x43, 0xd6, 0x2c, 0xd2, 0x5d, 0x42, 0xf0,
-	0x5e, 0x03, 0x1f, 0x9f, 0x7c, 0xcd, 0x41, 0xb2, 0xd4, 0xdf, 0xd3, 0xa0, 0x28, 0x66, 0xe2, 0x11,
-	0x94, 0x48, 0xde, 0x51, 0x4b, 0x24, 0xaf, 0xa4, 0x62, 0x17, 0x06, 0xd4, 0x47, 0xde, 0x81, 0xe9,
-	0xe8, 0x35, 0x46, 0xf4, 0x52, 0xc4, 0xae, 0x69, 0xe3, 0x5c, 0x97, 0x0a, 0x2c, 0x5f, 0x68, 0xf3,
-	0xf4, 0xbf, 0x9a, 0x90, 0x5f, 0x91, 0x15, 0x62, 0x46, 0xf5, 0x4b, 0x3b, 0x52, 0xbf, 0xa2, 0xd3,
-	0x9b, 0x49, 0x7d, 0x7a, 0xd1, 0x4d, 0x28, 0x04, 0xc6, 0x47, 0xb8, 0xe8, 0x27, 0xa2, 0xd5, 0x2a,
-	0xd4, 0xcf, 0x53, 0x66, 0x11, 0xa5, 0x64, 0x3b, 0x06, 0x39, 0x87, 0xd2, 0x28, 0x4a, 0x36, 0xe8,
-	0x55, 0x28, 0xde, 0x73, 0xdc, 0xbb, 0x96, 0x63, 0xb0, 0x57, 0x50, 0x20, 0x8d, 0x33, 0x5c, 0x79,
-	0x72, 0xc2, 0xab, 0xf4, 0x6e, 0x87, 0xfc, 0x71, 0x54, 0x18, 0xaa, 0xc0, 0x5c, 0xcb, 0xb4, 0x31,
-	0x31, 0x1a, 0xf2, 0xa6, 0x51, 0x8e, 0xbf, 0xfc, 0x10, 0x04, 0xb0, 0x5b, 0x2a, 0x1a, 0xc7, 0xe9,
-	0xd1, 0xe7, 0xa1, 0xe0, 0x89, 0xab, 0x92, 0xe9, 0x9c, 0xb6, 0xcb, 0xad, 0x0f, 0x67, 0x1a, 0x7e,
-	0xbb, 0x00, 0x82, 0xa5, 0x40, 0xb4, 0x09, 0x8b, 0xae, 0xb8, 0x8c, 0x74, 0xcd, 0xf4, 0x7c, 0xc7,
-	0x3d, 0xe4, 0x89, 0x2c, 0x7e, 0xbc, 0xca, 0x1e, 0x18, 0xc0, 0x09, 0x78, 0x9c, 0xd8, 0x8a, 0x46,
-	0x28, 0xec, 0x3e, 0x2e, 0x3f, 0x6e, 0x2d, 0x84, 0x11, 0x0a, 0x53, 0xf8, 0x06, 0x16, 0xd8, 0xa3,
-	0x2a, 0x6b, 0x0b, 0x63, 0x54, 0xd6, 0xde, 0x86, 0x29, 0x97, 0xb0, 0x30, 0xbf, 0x12, 0xa4, 0xe2,
-	0x46, 0xae, 0x01, 0xc0, 0x01, 0x03, 0x1c, 0xf2, 0xd2, 0xbf, 0x31, 0x03, 0x33, 0xca, 0x86, 0x92,
-	0xee, 0xef, 0x8d, 0x5d, 0xc7, 0xe5, 0xa7, 0x08, 0x85, 0x70, 0xc1, 0x57, 0x28, 0x10, 0x73, 0x1c,
-	0x7a, 0x5b, 0x83, 0xb9, 0xb6, 0x72, 0xf8, 0x15, 0xd8, 0x99, 0x31, 0x93, 0x1a, 0xea, 0x89, 0x5a,
-	0xe4, 0x91, 0x1d, 0x55, 0x18, 0x8e, 0x4b, 0xa7, 0xea, 0x2a, 0x2a, 0x53, 0x2c, 0xe2, 0x32, 0x6a,
-	0xe1, 0xed, 0x25, 0x8b, 0x55, 0x15, 0x8d, 0xe3, 0xf4, 0xf4, 0x23, 0xb3, 0xd1, 0x8d, 0xf3, 0x0e,
-	0x5e, 0x25, 0x60, 0x80, 0x43, 0x5e, 0xe8, 0x32, 0xcc, 0x8a, 0x1b, 0xe8, 0xdb, 0x4e, 0xe3, 0x9a,
-	0xe1, 0xed, 0x8b, 0x30, 0x57, 0x86, 0xe5, 0xab, 0x0a, 0x16, 0xc7, 0xa8, 0xd9, 0xd8, 0xc2, 0x6b,
-	0xfe, 0x8c, 0xc1, 0x84, 0xfa, 0x06, 0xd1, 0xaa, 0x8a, 0xc6, 0x71, 0x7a, 0xf4, 0xd1, 0x88, 0x95,
-	0xe4, 0x09, 0x03, 0xb9, 0x76, 0x12, 0x2c, 0x65, 0x05, 0xe6, 0x3a, 0x6c, 0x57, 0xd0, 0x08, 0x90,
-	0x42, 0x7b, 0xa5, 0xc0, 0x5b, 0x2a, 0x1a, 0xc7, 0xe9, 0xd1, 0x73, 0x30, 0xe3, 0x52, 0x5b, 0x20,
-	0x19, 0xf0, 0x2c, 0x82, 0x3c, 0x12, 0xc7, 0x51, 0x24, 0x56, 0x69, 0xd1, 0xf3, 0xb0, 0x10, 0xde,
-	0xc5, 0x0d, 0x18, 0xf0, 0xb4, 0x82, 0xbc, 0xe6, 0x5f, 0x89, 0x13, 0xe0, 0xfe, 0x36, 0xe8, 0x97,
-	0x60, 0x3e, 0xf2, 0x25, 0xd6, 0xed, 0x06, 0xb9, 0x2f, 0xee, 0x4b, 0x2e, 0xb2, 0xd4, 0x44, 0x0c,
-	0x87, 0xfb, 0xa8, 0xd1, 0x27, 0x61, 0xb6, 0xee, 0x58, 0x16, 0xb3, 0x08, 0xfc, 0xfd, 0x1b, 0x7e,
-	0x31, 0x92, 0x5f, 0x21, 0x55, 0x30, 0x38, 0x46, 0x89, 0x36, 0x00, 0x39, 0xbb, 0x1e, 0x71, 0x0f,
-	0x48, 0xe3, 0x79, 0xfe, 0xd4, 0x2f, 0x75, 0x88, 0x33, 0x6a, 0x5d, 0xdc, 0x8d, 0x3e, 0x0a, 0x9c,
-	0xd0, 0x0a, 0x7d, 0x59, 0x2d, 0x9a, 0x9e, 0x4d, 0xe3, 0xb5, 0xbf, 0xf8, 0x1e, 0xf6, 0xd8, 0x8a,
-	0x69, 0x17, 0x26, 0x78, 0x99, 0x62, 0x69, 0x2e, 0x8d, 0xfb, 0xc1, 0xd1, 0xa7, 0x36, 0x42, 0x8b,
-	0xca, 0xa1, 0x58, 0x48, 0x42, 0x5f, 0x84, 0xa9, 0xdd, 0xe0, 0x5d, 0xa4, 0xd2, 0x7c, 0x1a, 0x5e,
-	0x24, 0xf6, 0xc4, 0x57, 0xb8, 0x47, 0x93, 0x08, 0x1c, 0x8a, 0x44, 0x4f, 0x42, 0xf1, 0xda, 0x76,
-	0x45, 0x6a, 0xe1, 0x02, 0x9b, 0xfd, 0x1c, 0x6d, 0x82, 0xa3, 0x08, 0xba, 0xc2, 0x64, 0x74, 0x81,
-	0xd8, 0x14, 0x87, 0xde, 0xa9, 0x3f, 0x58, 0xa0, 0xd4, 0x2c, 0x0b, 0x84, 0x6b, 0xa5, 0x53, 0x31,
-	0x6a, 0x01, 0xc7, 0x92, 0x02, 0xbd, 0x02, 0x45, 0x61, 0xb2, 0x99, 0x6d, 0x5a, 0x7c, 0xb8, 0x82,
-	0x7c, 0x1c, 0xb2, 0xc0, 0x51, 0x7e, 0xe8, 0x19, 0x28, 0xb6, 0xd9, 0x73, 0x31, 0xe4, 0x6a, 0xc7,
-	0xb2, 0x4a, 0xa7, 0x99, 0xdd, 0x94, 0xc7, 0xe3, 0xdb, 0x21, 0x0a, 0x47, 0xe9, 0xd0, 0x53, 0x41,
-	0x0a, 0xf7, 0x03, 0x4a, 0xb6, 0x43, 0xa6, 0x70, 0x65, 0x4c, 0x38, 0xa0, 0xf0, 0xed, 0xcc, 0x31,
-	0x5b, 0xf8, 0xd7, 0xc3, 0x23, 0x4c, 0xf9, 0xe2, 0xc2, 0x17, 0xa2, 0xda, 0xa0, 0xa5, 0xf1, 0x58,
-	0x70, 0xdf, 0xa3, 0x5b, 0xdc, 0x90, 0x27, 0xea, 0x42, 0x5b, 0xea, 0x7f, 0x2a, 0x97, 0x3f, 0xd5,
-	0xd7, 0x24, 0x78, 0xb1, 0xb5, 0xaa, 0xfd, 0xfa, 0x0f, 0x73, 0xf2, 0x18, 0x23, 0x96, 0xb9, 0x74,
-	0x21, 0x6f, 0x7a, 0xbe, 0xe9, 0xa4, 0x58, 0x01, 0x1f, 0x7b, 0x86, 0x81, 0x55, 0x62, 0x31, 0x04,
-	0xe6, 0xa2, 0xa8, 0x4c, 0xbb, 0x69, 0xda, 0xf7, 0xc5, 0xf0, 0x6f, 0xa6, 0x9e, 0x92, 0xe4, 0x32,
-	0x19, 0x02, 0x73, 0x51, 0xe8, 0x0e, 0x64, 0x0d, 0x6b, 0x37, 0xa5, 0x87, 0xa1, 0xe3, 0x8f, 0xab,
-	0xf3, 0x3a, 0x86, 0xca, 0x66, 0x15, 0x53, 0x21, 0x54, 0x96, 0xd7, 0x32, 0x85, 0xef, 0x1f, 0x53,
-	0x56, 0x6d, 0x6b, 0x3d, 0x49, 0x56, 0x6d, 0x6b, 0x1d, 0x53, 0x21, 0xe8, 0x2b, 0x1a, 0x80, 0x21,
-	0x1f, 0x3e, 0x4f, 0xe7, 0x85, 0xba, 0x41, 0x0f, 0xa9, 0xf3, 0x02, 0xa3, 0x10, 0x8b, 0x23, 0x92,
-	0xf5, 0x77, 0x34, 0x58, 0xe8, 0xeb, 0x6c, 0xfc, 0x4d, 0x78, 0x6d, 0xf8, 0x37, 0xe1, 0xc5, 0x43,
-	0x1d, 0xb5, 0xb6, 0x65, 0x26, 0xde, 0x22, 0xd9, 0x89, 0xe1, 0x71, 0x5f, 0x0b, 0xfd, 0x3b, 0x1a,
-	0x14, 0x23, 0x15, 0xc0, 0x34, 0x26, 0x65, 0x95, 0xd2, 0xa2, 0x1b, 0xe1, 0x1b, 0x25, 0xec, 0xe4,
-	0x86, 0xe3, 0xf8, 0x21, 0x62, 0x33, 0x3c, 0x4a, 0x8b, 0x1c, 0x22, 0x52, 0x28, 0x16, 0x58, 0x74,
-	0x01, 0x72, 0x9e, 0x4f, 0xda, 0x4c, 0xa3, 0x22, 0x05, 0xc1, 0xec, 0x28, 0x9d, 0x61, 0x98, 0x38,
-	0x6a, 0x1c, 0x45, 0x75, 0x47, 0xe4, 0x49, 0x14, 0x83, 0x86, 0xc0, 0x0c, 0x87, 0xce, 0x43, 0x96,
-	0xd8, 0x0d, 0x11, 0xc9, 0x15, 0x05, 0x49, 0xf6, 0x8a, 0xdd, 0xc0, 0x14, 0xae, 0xdf, 0x80, 0xe9,
-	0x1a, 0xa9, 0xbb, 0xc4, 0x7f, 0x81, 0x1c, 0x0e, 0x77, 0xcc, 0x75, 0x9e, 0xa7, 0x07, 0x33, 0x2a,
-	0x43, 0xda, 0x9c, 0xc2, 0xf5, 0x3f, 0xd2, 0x20, 0xf6, 0x42, 0x0d, 0xd2, 0x63, 0x19, 0x3f, 0xe8,
-	0xcf, 0xf6, 0x29, 0xdb, 0xe3, 0xcc, 0x91, 0xdb, 0xe3, 0x0d, 0x40, 0x2d, 0xc3, 0xaf, 0xef, 0x8b,
-	0xf9, 0x11, 0x8f, 0x21, 0xf1, 0x20, 0x3a, 0xbc, 0x6f, 0xd0, 0x47, 0x81, 0x13, 0x5a, 0xe9, 0x7f,
-	0x9b, 0x81, 0x69, 0xe5, 0x61, 0xe0, 0xe3, 0x87, 0x3f, 0x7c, 0x47, 0x13, 0x76, 0xa6, 0xd9, 0x11,
-	0x77, 0xa6, 0xd1, 0xa3, 0x80, 0xdc, 0xc9, 0x1e, 0x05, 0xe4, 0x53, 0x39, 0x0a, 0xd0, 0xbf, 0x9b,
-	0x83, 0x59, 0xf5, 0xea, 0xde, 0x10, 0xdf, 0xf4, 0xa3, 0x7d, 0xdf, 0x74, 0xc4, 0xa8, 0x3f, 0x3b,
-	0x6e, 0xd4, 0x9f, 0x1b, 0x37, 0xea, 0xcf, 0x3f, 0x44, 0xd4, 0xdf, 0x1f, 0xb3, 0x4f, 0x0c, 0x1d,
-	0xb3, 0x7f, 0x4a, 0x26, 0x6f, 0x26, 0x95, 0xd3, 0xce, 0x30, 0x79, 0x83, 0xd4, 0x69, 0x58, 0x75,
-	0x1a, 0x89, 0x49, 0xb0, 0xc2, 0x31, 0x95, 0x61, 0x6e, 0x62, 0xae, 0x65, 0xf4, 0xbd, 0xfd, 0x07,
-	0x86, 0xcf, 0xb3, 0xe8, 0xaf, 0x65, 0x20, 0x7c, 0xeb, 0x97, 0x3d, 0xfa, 0xe3, 0x45, 0x6c, 0x94,
-	0x88, 0x24, 0x36, 0xc6, 0x7d, 0x59, 0x2b, 0xe4, 0x28, 0x92, 0x95, 0x11, 0x08, 0x56, 0x24, 0xfe,
-	0x37, 0xbc, 0xf1, 0x6b, 0xc0, 0x5c, 0xac, 0x66, 0x33, 0xf5, 0xe2, 0x87, 0xef, 0x64, 0x60, 0x4a,
-	0x56, 0xbd, 0x52, 0xb3, 0xde, 0x71, 0x83, 0xf7, 0x4f, 0xa4, 0x59, 0xbf, 0x85, 0x37, 0x31, 0x85,
-	0xa3, 0xfb, 0x30, 0xb9, 0x4f, 0x8c, 0x06, 0x71, 0x83, 0x03, 0x94, 0xad, 0x94, 0xca, 0x6d, 0xaf,
-	0x31, 0xae, 0xe1, 0x58, 0xf8, 0x6f, 0x0f, 0x07, 0xe2, 0xd0, 0x65, 0x98, 0xf5, 0xcd, 0x16, 0xa1,
-	0xd1, 0x75, 0xc4, 0x8a, 0x66, 0xc3, 0x53, 0x89, 0x1d, 0x05, 0x8b, 0x63, 0xd4, 0xd4, 0xb8, 0xdc,
-	0xf1, 0x1c, 0x9b, 0xdd, 0x4d, 0xcd, 0xa9, 0x5b, 0x98, 0x8d, 0xda, 0x8d, 0xeb, 0xec, 0x6a, 0xaa,
-	0xa4, 0xa0, 0xd4, 0x26, 0xab, 0xfa, 0x73, 0x89, 0x48, 0x67, 0xcc, 0x87, 0x77, 0x14, 0x38, 0x1c,
-	0x4b, 0x0a, 0xfd, 0x16, 0xcc, 0xc5, 0x06, 0x12, 0xb8, 0x47, 0x2d, 0xd9, 0x3d, 0x0e, 0xf5, 0xaf,
-	0x46, 0xaa, 0xcb, 0xef, 0xbe, 0xbf, 0xf4, 0xd8, 0xf7, 0xde, 0x5f, 0x7a, 0xec, 0xfb, 0xef, 0x2f,
-	0x3d, 0xf6, 0x5a, 0x6f, 0x49, 0x7b, 0xb7, 0xb7, 0xa4, 0x7d, 0xaf, 0xb7, 0xa4, 0x7d, 0xbf, 0xb7,
-	0xa4, 0xfd, 0xb0, 0xb7, 0xa4, 0xbd, 0xf3, 0xa3, 0xa5, 0xc7, 0x5e, 0x2a, 0x04, 0x1f, 0xf3, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0xa2, 0x8b, 0x56, 0x69, 0x69, 0x00, 0x00,
+	// 7156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x8c, 0x24, 0x57,
+	0x75, 0xb0, 0xab, 0x7b, 0x7a, 0xa6, 0xe7, 0xce, 0xef, 0xde, 0x9d, 0xf5, 0xb6, 0xd7, 0xde, 0xed,
+	0xa5, 0x8c, 0xfc, 0x19, 0x3e, 0x98, 0x81, 0xb5, 0x49, 0x1c, 0x8c, 0xac, 0x74, 0xcf, 0xee, 0xda,
+	0xb3, 0x9e, 0xd9, 0x6d, 0x9f, 0x9e, 0xf5, 0x06, 0x1b, 0x88, 0x6b, 0xba, 0xef, 0xf4, 0xd4, 0x4e,
+	0x77, 0x55, 0x53, 0x55, 0x3d, 0xbb, 0x63, 0x08, 0x3f, 0x41, 0x04, 0x88, 0x40, 0x10, 0x92, 0x07,
+	0x92, 0x48, 0x09, 0x8a, 0xf2, 0x80, 0xc8, 0x2b, 0x8f, 0x41, 0x41, 0x24, 0x91, 0x88, 0x14, 0x12,
+	0xf2, 0x12, 0x93, 0x48, 0x4c, 0xf0, 0x80, 0x92, 0x00, 0x4f, 0x90, 0x48, 0x88, 0x7d, 0x8a, 0xee,
+	0x4f, 0xdd, 0x9f, 0xea, 0xea, 0x9e, 0x9f, 0xae, 0x59, 0x50, 0xc8, 0x5b, 0xf7, 0x3d, 0xe7, 0x9e,
+	0x73, 0x7f, 0xce, 0xbd, 0xf7, 0x9c, 0x73, 0xcf, 0x3d, 0x85, 0x56, 0x5b, 0x6e, 0xb4, 0xd5, 0xdb,
+	0x58, 0x6c, 0xf8, 0x9d, 0x25, 0x27, 0x68, 0xf9, 0xdd, 0xc0, 0xbf, 0xcd, 0x7e, 0xbc, 0x35, 0xf0,
+	0xdb, 0x6d, 0xbf, 0x17, 0x85, 0x4b, 0xdd, 0xed, 0xd6, 0x92, 0xd3, 0x75, 0xc3, 0x25, 0x59, 0xb2,
+	0xf3, 0x76, 0xa7, 0xdd, 0xdd, 0x72, 0xde, 0xbe, 0xd4, 0x22, 0x1e, 0x09, 0x9c, 0x88, 0x34, 0x17,
+	0xbb, 0x81, 0x1f, 0xf9, 0xf8, 0x5d, 0x8a, 0xda, 0x62, 0x4c, 0x8d, 0xfd, 0xf8, 0xcd, 0xb8, 0xee,
+	0x62, 0x77, 0xbb, 0xb5, 0x48, 0xa9, 0x2d, 0xca, 0x92, 0x98, 0xda, 0xb9, 0xb7, 0x6a, 0x6d, 0x69,
+	0xf9, 0x2d, 0x7f, 0x89, 0x11, 0xdd, 0xe8, 0x6d, 0xb2, 0x7f, 0xec, 0x0f, 0xfb, 0xc5, 0x99, 0x9d,
+	0x7b, 0x74, 0xfb, 0xa9, 0x70, 0xd1, 0xf5, 0x69, 0xdb, 0x96, 0x36, 0x9c, 0xa8, 0xb1, 0xb5, 0xb4,
+	0xd3, 0xd7, 0xa2, 0x73, 0xb6, 0x86, 0xd4, 0xf0, 0x03, 0x92, 0x86, 0xf3, 0xa4, 0xc2, 0xe9, 0x38,
+	0x8d, 0x2d, 0xd7, 0x23, 0xc1, 0xae, 0xea, 0x75, 0x87, 0x44, 0x4e, 0x5a, 0xad, 0xa5, 0x41, 0xb5,
+	0x82, 0x9e, 0x17, 0xb9, 0x1d, 0xd2, 0x57, 0xe1, 0x57, 0x0e, 0xaa, 0x10, 0x36, 0xb6, 0x48, 0xc7,
+	0xe9, 0xab, 0xf7, 0xc4, 0xa0, 0x7a, 0xbd, 0xc8, 0x6d, 0x2f, 0xb9, 0x5e, 0x14, 0x46, 0x41, 0xb2,
+	0x92, 0xfd, 0x13, 0x0b, 0x9d, 0xaa, 0xac, 0x56, 0xd7, 0x03, 0x67, 0x73, 0xd3, 0x6d, 0x80, 0xdf,
+	0x8b, 0x5c, 0xaf, 0x85, 0xdf, 0x84, 0x26, 0x5c, 0xaf, 0x15, 0x90, 0x30, 0x2c, 0x59, 0x17, 0xad,
+	0xc7, 0x27, 0xab, 0x73, 0xdf, 0xd8, 0x2b, 0x3f, 0xb0, 0xbf, 0x57, 0x9e, 0x58, 0xe1, 0xc5, 0x10,
+	0xc3, 0xf1, 0x3b, 0xd0, 0x54, 0x48, 0x82, 0x1d, 0xb7, 0x41, 0x6a, 0x7e, 0x10, 0x95, 0x72, 0x17,
+	0xad, 0xc7, 0x0b, 0xd5, 0xd3, 0x02, 0x7d, 0xaa, 0xae, 0x40, 0xa0, 0xe3, 0xd1, 0x6a, 0x81, 0xef,
+	0x47, 0x02, 0x5e, 0xca, 0x33, 0x2e, 0xb2, 0x1a, 0x28, 0x10, 0xe8, 0x78, 0xf8, 0x32, 0x9a, 0x77,
+	0x3c, 0xcf, 0x8f, 0x9c, 0xc8, 0xf5, 0xbd, 0x5a, 0x40, 0x36, 0xdd, 0xbb, 0xa5, 0x31, 0x56, 0xb7,
+	0x24, 0xea, 0xce, 0x57, 0x12, 0x70, 0xe8, 0xab, 0x61, 0x5f, 0x46, 0xa5, 0x4a, 0x67, 0xc3, 0x09,
+	0x43, 0xa7, 0xe9, 0x07, 0x89, 0xae, 0x3f, 0x8e, 0x8a, 0x1d, 0xa7, 0xdb, 0x75, 0xbd, 0x16, 0xed,
+	0x7b, 0xfe, 0xf1, 0xc9, 0xea, 0xf4, 0xfe, 0x5e, 0xb9, 0xb8, 0x26, 0xca, 0x40, 0x42, 0xed, 0x7f,
+	0xc9, 0xa1, 0xa9, 0x8a, 0xe7, 0xb4, 0x77, 0x43, 0x37, 0x84, 0x9e, 0x87, 0x5f, 0x41, 0x45, 0x2a,
+	0x03, 0x4d, 0x27, 0x72, 0xd8, 0xa8, 0x4d, 0x5d, 0x7a, 0xdb, 0x22, 0x9f, 0x92, 0x45, 0x7d, 0x4a,
+	0x94, 0x64, 0x53, 0xec, 0xc5, 0x9d, 0xb7, 0x2f, 0xde, 0xd8, 0xb8, 0x4d, 0x1a, 0xd1, 0x1a, 0x89,
+	0x9c, 0x2a, 0x16, 0xbd, 0x40, 0xaa, 0x0c, 0x24, 0x55, 0xec, 0xa3, 0xb1, 0xb0, 0x4b, 0x1a, 0x6c,
+	0x90, 0xa7, 0x2e, 0xad, 0x2d, 0x8e, 0xb2, 0x8a, 0x16, 0xb5, 0xa6, 0xd7, 0xbb, 0xa4, 0x51, 0x9d,
+	0x16, 0xac, 0xc7, 0xe8, 0x3f, 0x60, 0x8c, 0xf0, 0x1d, 0x34, 0x1e, 0x46, 0x4e, 0xd4, 0x0b, 0xd9,
+	0x04, 0x4d, 0x5d, 0xba, 0x91, 0x1d, 0x4b, 0x46, 0xb6, 0x3a, 0x2b, 0x98, 0x8e, 0xf3, 0xff, 0x20,
+	0xd8, 0xd9, 0xff, 0x6a, 0xa1, 0xd3, 0x1a, 0x76, 0x25, 0x68, 0xf5, 0x3a, 0xc4, 0x8b, 0xf0, 0x45,
+	0x34, 0xe6, 0x39, 0x1d, 0x22, 0xa4, 0x52, 0x36, 0xf9, 0xba, 0xd3, 0x21, 0xc0, 0x20, 0xf8, 0x51,
+	0x54, 0xd8, 0x71, 0xda, 0x3d, 0xc2, 0x06, 0x69, 0xb2, 0x3a, 0x23, 0x50, 0x0a, 0x2f, 0xd2, 0x42,
+	0xe0, 0x30, 0xfc, 0x41, 0x34, 0xc9, 0x7e, 0x5c, 0x0d, 0xfc, 0x4e, 0x46, 0x5d, 0x13, 0x2d, 0x7c,
+	0x31, 0x26, 0x5b, 0x9d, 0xd9, 0xdf, 0x2b, 0x4f, 0xca, 0xbf, 0xa0, 0x18, 0xda, 0x3f, 0x32, 0x3b,
+	0x77, 0xad, 0xd7, 0x6c, 0xb1, 0xce, 0x3d, 0x89, 0x0a, 0xdd, 0x2d, 0x27, 0x8c, 0x7b, 0x77, 0x21,
+	0x6e, 0x7a, 0x8d, 0x16, 0xde, 0xdb, 0x2b, 0xcf, 0xc4, 0x95, 0x58, 0x01, 0x70, 0x64, 0xfc, 0x18,
+	0x1a, 0x0f, 0x88, 0x13, 0xfa, 0x9e, 0xe8, 0xb1, 0x1c, 0x52, 0x60, 0xa5, 0x20, 0xa0, 0x74, 0xe8,
+	0x7a, 0x21, 0x09, 0x4a, 0x79, 0x73, 0xe8, 0x6e, 0x86, 0x24, 0x00, 0x06, 0xc1, 0xeb, 0xa8, 0x78,
+	0xbb, 0xd7, 0x6c, 0x91, 0x66, 0x25, 0x62, 0x8b, 0x6a, 0xea, 0xd2, 0x9b, 0x0f, 0x27, 0xc0, 0xeb,
+	0x6e, 0x87, 0xf0, 0x65, 0x72, 0x4d, 0xd4, 0x07, 0x49, 0xc9, 0xfe, 0x37, 0x0b, 0xcd, 0x69, 0xbd,
+	0x5d, 0x75, 0xc3, 0x08, 0xbf, 0xa7, 0x6f, 0xa9, 0x2c, 0x1e, 0x8e, 0x13, 0xad, 0xcd, 0x16, 0xca,
+	0xbc, 0x68, 0x7f, 0x31, 0x2e, 0xd1, 0x96, 0x89, 0x87, 0x0a, 0x6e, 0x44, 0x3a, 0x61, 0x29, 0x77,
+	0x31, 0xff, 0xf8, 0xd4, 0xa5, 0x95, 0xcc, 0x84, 0x56, 0x49, 0xd3, 0x0a, 0xa5, 0x0f, 0x9c, 0x8d,
+	0xfd, 0x47, 0x79, 0xa3, 0x87, 0x74, 0xfd, 0x60, 0x1f, 0x4d, 0x74, 0x48, 0x14, 0xb8, 0x0d, 0xbe,
+	0x8b, 0x4c, 0x5d, 0xba, 0x3c, 0x5a, 0x2b, 0xd6, 0x18, 0x31, 0xb5, 0x0f, 0xf3, 0xff, 0x21, 0xc4,
+	0x5c, 0xf0, 0x16, 0x1a, 0x73, 0x82, 0x56, 0xdc, 0xe7, 0xab, 0xd9, 0x48, 0xb3, 0x12, 0x93, 0x4a,
+	0xd0, 0x0a, 0x81, 0x71, 0xc0, 0x4b, 0x68, 0x32, 0x22, 0x41, 0xc7, 0xf5, 0x9c, 0x88, 0x6f, 0xdc,
+	0xc5, 0xea, 0x29, 0x81, 0x36, 0xb9, 0x1e, 0x03, 0x40, 0xe1, 0xe0, 0x0f, 0x70, 0xb9, 0xa2, 0x04,
+	0x85, 0x5c, 0xbd, 0x90, 0xd9, 0x94, 0xc4, 0x8b, 0x47, 0x89, 0x1f, 0xfd, 0x07, 0x92, 0xa1, 0xfd,
+	0x5a, 0x0e, 0x9d, 0xea, 0xdb, 0x77, 0x8e, 0xb9, 0xd4, 0xde, 0x44, 0x27, 0x35, 0x0c, 0x9d, 0x56,
+	0xbc, 0xbb, 0x68, 0xd3, 0xc1, 0x8a, 0x21, 0x86, 0xe3, 0x4f, 0x58, 0x68, 0x86, 0x4f, 0x0d, 0x90,
+	0xb0, 0xd7, 0x8e, 0xe8, 0x0e, 0x4a, 0x27, 0xe6, 0x5a, 0x16, 0x62, 0xc0, 0x49, 0x56, 0xcf, 0x08,
+	0xee, 0x33, 0x7a, 0x69, 0x08, 0x26, 0x5f, 0x7c, 0x0b, 0x4d, 0x86, 0x91, 0x13, 0x44, 0xc7, 0x5c,
+	0xd6, 0x6c, 0x1b, 0xab, 0xc7, 0x04, 0x40, 0xd1, 0xb2, 0x7f, 0x68, 0xa1, 0xf9, 0x78, 0x98, 0xd6,
+	0x49, 0xa7, 0xdb, 0xa6, 0x73, 0x7d, 0xf2, 0x87, 0x60, 0x64, 0x1c, 0x82, 0x90, 0x8d, 0x24, 0xc5,
+	0xed, 0x1f, 0x74, 0x12, 0xda, 0x3f, 0xb5, 0xd0, 0xd9, 0x24, 0xf2, 0x8a, 0xd7, 0x68, 0xf7, 0x9a,
+	0x04, 0x3f, 0x85, 0xa6, 0x23, 0x51, 0x74, 0x5d, 0x1d, 0x4e, 0x0b, 0x82, 0xca, 0xf4, 0xba, 0x06,
+	0x03, 0x03, 0x93, 0xd6, 0x6c, 0xb4, 0x7b, 0x61, 0x44, 0x82, 0x7a, 0xc3, 0xef, 0x72, 0xa9, 0x2a,
+	0xaa, 0x9a, 0xcb, 0x1a, 0x0c, 0x0c, 0x4c, 0xb9, 0xdc, 0xf3, 0x27, 0xbd, 0xdc, 0xed, 0x1f, 0x58,
+	0x68, 0x21, 0xd9, 0xf3, 0xfb, 0xb0, 0x89, 0x87, 0xe6, 0x26, 0x7e, 0x3d, 0xdb, 0x79, 0x1e, 0xb0,
+	0x93, 0xff, 0x34, 0xd7, 0xdf, 0xd7, 0xff, 0xed, 0xdb, 0xf9, 0xc7, 0x2c, 0x54, 0x74, 0xb9, 0x24,
+	0xc7, 0xe2, 0x74, 0x33, 0xdb, 0xc1, 0x16, 0xeb, 0x44, 0x4d, 0xb7, 0x28, 0x08, 0x41, 0x32, 0xb6,
+	0xbf, 0x34, 0x86, 0xa6, 0x2b, 0x5e, 0xe4, 0x56, 0x36, 0x37, 0x5d, 0xcf, 0x8d, 0x76, 0xf1, 0xa7,
+	0x73, 0x68, 0xa9, 0x1b, 0x90, 0x4d, 0x12, 0x04, 0xa4, 0x79, 0xb9, 0x17, 0xb8, 0x5e, 0xab, 0xde,
+	0xd8, 0x22, 0xcd, 0x5e, 0xdb, 0xf5, 0x5a, 0x2b, 0x2d, 0xcf, 0x97, 0xc5, 0x57, 0xee, 0x92, 0x46,
+	0x8f, 0x6a, 0xf7, 0x42, 0x0a, 0x3b, 0xa3, 0xb5, 0xbe, 0x76, 0x34, 0xa6, 0xd5, 0x27, 0xf6, 0xf7,
+	0xca, 0x4b, 0x47, 0xac, 0x04, 0x47, 0xed, 0x1a, 0xfe, 0x64, 0x0e, 0x2d, 0x06, 0xe4, 0xfd, 0x3d,
+	0xf7, 0xf0, 0xa3, 0xc1, 0x37, 0xc8, 0xf6, 0x68, 0xa3, 0x01, 0x47, 0xe2, 0x59, 0xbd, 0xb4, 0xbf,
+	0x57, 0x3e, 0x62, 0x1d, 0x38, 0x62, 0xbf, 0xec, 0xbf, 0xb6, 0x50, 0xf1, 0x08, 0x06, 0x41, 0xd9,
+	0x34, 0x08, 0x26, 0xfb, 0x8c, 0x81, 0xa8, 0xdf, 0x18, 0x78, 0x76, 0xb4, 0x41, 0x3b, 0x8c, 0x11,
+	0xf0, 0xdd, 0x3c, 0x3a, 0xd5, 0x67, 0x34, 0xe0, 0x2d, 0xb4, 0xd0, 0xf5, 0x9b, 0xf1, 0xc2, 0x79,
+	0xce, 0x09, 0xb7, 0x18, 0x4c, 0x74, 0xef, 0xc9, 0xfd, 0xbd, 0xf2, 0x42, 0x2d, 0x05, 0x7e, 0x6f,
+	0xaf, 0x5c, 0x92, 0x44, 0x12, 0x08, 0x90, 0x4a, 0x11, 0x77, 0x51, 0x71, 0xd3, 0x25, 0xed, 0x26,
+	0x90, 0x4d, 0x21, 0x29, 0x23, 0x6e, 0x32, 0x57, 0x05, 0x35, 0xae, 0x89, 0xc5, 0xff, 0x40, 0x72,
+	0xc1, 0x9f, 0xb6, 0xd0, 0x5c, 0xc3, 0xf7, 0x36, 0xdd, 0xd6, 0x9a, 0xd3, 0x7d, 0x9e, 0xec, 0x52,
+	0xce, 0xf9, 0x2c, 0x2c, 0xd9, 0x65, 0x93, 0x68, 0xf5, 0xf4, 0xfe, 0x5e, 0x79, 0x2e, 0x51, 0x08,
+	0x49, 0xd6, 0xf8, 0x15, 0x84, 0x05, 0x29, 0xae, 0x13, 0xf2, 0x81, 0xe6, 0xce, 0x84, 0xb7, 0xed,
+	0xef, 0x95, 0x31, 0xf4, 0x41, 0xef, 0xed, 0x95, 0x1f, 0x54, 0x93, 0xa9, 0x83, 0x21, 0x85, 0x96,
+	0xfd, 0xb3, 0x31, 0x34, 0x57, 0x6d, 0xf7, 0xc8, 0xb3, 0x01, 0x21, 0xb1, 0xe2, 0x59, 0x41, 0x73,
+	0xdd, 0x80, 0xec, 0xb8, 0xe4, 0x4e, 0x9d, 0xb4, 0x49, 0x23, 0xf2, 0x03, 0x31, 0xb7, 0x67, 0x85,
+	0xe8, 0xce, 0xd5, 0x4c, 0x30, 0x24, 0xf1, 0xf1, 0x33, 0x68, 0xd6, 0x69, 0x44, 0xee, 0x0e, 0x91,
+	0x14, 0xb8, 0x64, 0x3f, 0x28, 0x28, 0xcc, 0x56, 0x0c, 0x28, 0x24, 0xb0, 0xf1, 0x7b, 0x50, 0x29,
+	0x6c, 0x38, 0x6d, 0x72, 0xb3, 0x2b, 0x58, 0x2d, 0x6f, 0x91, 0xc6, 0x76, 0xcd, 0x77, 0xbd, 0x48,
+	0xa8, 0xf3, 0x17, 0x05, 0xa5, 0x52, 0x7d, 0x00, 0x1e, 0x0c, 0xa4, 0x80, 0xff, 0xca, 0x42, 0xe7,
+	0xbb, 0x01, 0xa9, 0x05, 0x7e, 0xc7, 0xa7, 0xcb, 0xb5, 0x4f, 0xf7, 0x16, 0x3a, 0xe8, 0x8b, 0x23,
+	0xee, 0x4b, 0xbc, 0xa4, 0x8f, 0x7a, 0xf5, 0x0d, 0xfb, 0x7b, 0xe5, 0xf3, 0xb5, 0x61, 0x0d, 0x80,
+	0xe1, 0xed, 0xc3, 0x5f, 0xb7, 0xd0, 0x85, 0xae, 0x1f, 0x46, 0x43, 0xba, 0x50, 0x38, 0xd1, 0x2e,
+	0xd8, 0xfb, 0x7b, 0xe5, 0x0b, 0xb5, 0xa1, 0x2d, 0x80, 0x03, 0x5a, 0x68, 0x7f, 0x69, 0x16, 0x9d,
+	0xd2, 0x64, 0x2f, 0x70, 0x22, 0xd2, 0xda, 0xc5, 0x4f, 0xa3, 0x99, 0x58, 0x18, 0xb8, 0xdf, 0x8d,
+	0xcb, 0x9e, 0x34, 0x24, 0x2a, 0x3a, 0x10, 0x4c, 0x5c, 0x2a, 0x77, 0x52, 0x14, 0x79, 0xed, 0x84,
+	0xdc, 0xd5, 0x0c, 0x28, 0x24, 0xb0, 0xf1, 0x0a, 0x3a, 0x2d, 0x4a, 0x80, 0x74, 0xdb, 0x6e, 0xc3,
+	0x59, 0xf6, 0x7b, 0x42, 0xe4, 0x0a, 0xd5, 0xb3, 0xfb, 0x7b, 0xe5, 0xd3, 0xb5, 0x7e, 0x30, 0xa4,
+	0xd5, 0xc1, 0xab, 0x68, 0xc1, 0xe9, 0x45, 0xbe, 0xec, 0xff, 0x15, 0xcf, 0xd9, 0x68, 0x93, 0x26,
+	0x13, 0xad, 0x62, 0xb5, 0x44, 0xb7, 0xc9, 0x4a, 0x0a, 0x1c, 0x52, 0x6b, 0xe1, 0x5a, 0x82, 0x5a,
+	0x9d, 0x34, 0x7c, 0xaf, 0xc9, 0x67, 0xb9, 0x50, 0x7d, 0x44, 0x74, 0x6f, 0xa1, 0x92, 0x82, 0x03,
+	0xa9, 0x35, 0x71, 0x1b, 0xcd, 0x76, 0x9c, 0xbb, 0x37, 0x3d, 0x67, 0xc7, 0x71, 0xdb, 0x94, 0x49,
+	0x69, 0xfc, 0x00, 0x5b, 0x88, 0xfa, 0x68, 0x17, 0xb9, 0x8f, 0x76, 0x71, 0xc5, 0x8b, 0x6e, 0x04,
+	0xf5, 0x88, 0x9e, 0x7a, 0x55, 0x4c, 0x07, 0x76, 0xcd, 0xa0, 0x05, 0x09, 0xda, 0xf8, 0x06, 0x3a,
+	0xc3, 0x96, 0xe3, 0x65, 0xff, 0x8e, 0x77, 0x99, 0xb4, 0x9d, 0xdd, 0xb8, 0x03, 0x13, 0xac, 0x03,
+	0x0f, 0xed, 0xef, 0x95, 0xcf, 0xd4, 0xd3, 0x10, 0x20, 0xbd, 0x1e, 0x76, 0xd0, 0xc3, 0x26, 0x00,
+	0xc8, 0x8e, 0x1b, 0xba, 0xbe, 0xb7, 0xea, 0x76, 0xdc, 0xa8, 0x54, 0x64, 0x64, 0xcb, 0xfb, 0x7b,
+	0xe5, 0x87, 0xeb, 0x83, 0xd1, 0x60, 0x18, 0x0d, 0xfc, 0xc7, 0x16, 0x5a, 0x48, 0x5b, 0x86, 0xa5,
+	0xc9, 0x2c, 0x4e, 0x84, 0xc4, 0xd2, 0xe2, 0x12, 0x91, 0xba, 0x29, 0xa4, 0x36, 0x02, 0x7f, 0xc4,
+	0x42, 0xd3, 0x8e, 0xa6, 0x8d, 0x96, 0xd0, 0x45, 0x6b, 0x74, 0xe3, 0x5d, 0xd7, 0x6f, 0xab, 0xf3,
+	0xd4, 0xc0, 0xd3, 0x4b, 0xc0, 0xe0, 0x88, 0xff, 0xc4, 0x42, 0x67, 0x52, 0xd7, 0x78, 0x69, 0xea,
+	0x24, 0x46, 0x88, 0x09, 0x49, 0xfa, 0x9e, 0x93, 0xde, 0x0c, 0xfc, 0x39, 0x4b, 0x1e, 0x65, 0x6b,
+	0xb1, 0x19, 0x38, 0x9d, 0x85, 0x77, 0x47, 0xd3, 0x5f, 0x62, 0xc2, 0xfc, 0x48, 0xaf, 0x99, 0xdc,
+	0x20, 0xc9, 0x1e, 0x7f, 0xc6, 0x8a, 0x8f, 0x46, 0xd9, 0xa2, 0x99, 0x93, 0x6a, 0x11, 0x56, 0x27,
+	0xad, 0x6c, 0x50, 0x82, 0x39, 0x7e, 0x1f, 0x3a, 0xe7, 0x6c, 0xf8, 0x41, 0x94, 0xba, 0xf8, 0x4a,
+	0xb3, 0x6c, 0x19, 0x5d, 0xd8, 0xdf, 0x2b, 0x9f, 0xab, 0x0c, 0xc4, 0x82, 0x21, 0x14, 0xf0, 0x6f,
+	0xa0, 0x92, 0xd3, 0x6c, 0xba, 0x74, 0x5a, 0x9c, 0xb6, 0xb1, 0x77, 0x87, 0xa5, 0x39, 0x76, 0x77,
+	0xf1, 0x08, 0x3d, 0xc5, 0x2b, 0x03, 0x70, 0x60, 0x60, 0x6d, 0xfc, 0x32, 0x7a, 0x48, 0xc1, 0xcc,
+	0x7d, 0x3d, 0x2c, 0xcd, 0x33, 0xd2, 0xe7, 0xf7, 0xf7, 0xca, 0x0f, 0x55, 0x06, 0x21, 0xc1, 0xe0,
+	0xfa, 0x4c, 0x11, 0xec, 0x38, 0x9e, 0xd3, 0x22, 0x4d, 0x49, 0xf3, 0x54, 0x16, 0x42, 0xbd, 0x66,
+	0x12, 0xe5, 0x52, 0x93, 0x28, 0x84, 0x24, 0x6b, 0xfb, 0x27, 0x05, 0x34, 0xbd, 0xec, 0x78, 0x4e,
+	0xb0, 0x2b, 0x14, 0x80, 0xbf, 0xb4, 0xd0, 0x23, 0x8d, 0x5e, 0x10, 0x10, 0x2f, 0xaa, 0x47, 0xa4,
+	0xdb, 0x7f, 0xfc, 0x5b, 0x27, 0x7a, 0xfc, 0x5f, 0xdc, 0xdf, 0x2b, 0x3f, 0xb2, 0x3c, 0x84, 0x3f,
+	0x0c, 0x6d, 0x1d, 0xfe, 0x07, 0x0b, 0xd9, 0x02, 0xa1, 0xea, 0x34, 0xb6, 0x5b, 0x81, 0xdf, 0xf3,
+	0x9a, 0xfd, 0x9d, 0xc8, 0x9d, 0x68, 0x27, 0x1e, 0xdb, 0xdf, 0x2b, 0xdb, 0xcb, 0x07, 0xb6, 0x02,
+	0x0e, 0xd1, 0x52, 0xfc, 0x2c, 0x3a, 0x25, 0xb0, 0xae, 0xdc, 0xed, 0x92, 0xc0, 0xed, 0x10, 0xa1,
+	0x36, 0x4c, 0x56, 0x1f, 0x12, 0x87, 0xf3, 0xa9, 0xe5, 0x24, 0x02, 0xf4, 0xd7, 0xc1, 0x9f, 0xb2,
+	0xd0, 0x74, 0xb8, 0xed, 0x76, 0xbb, 0xa4, 0x49, 0x87, 0x8e, 0xaa, 0xa2, 0xf9, 0xd1, 0xaf, 0x7e,
+	0xea, 0x9c, 0x62, 0x2c, 0x42, 0xa4, 0xab, 0x1c, 0x78, 0x75, 0x8d, 0x19, 0x18, 0xac, 0xf1, 0x6f,
+	0xa1, 0xa2, 0xd3, 0xed, 0x06, 0xfe, 0x8e, 0xd3, 0x16, 0xea, 0x64, 0x3d, 0x9b, 0xa9, 0x10, 0x44,
+	0xc5, 0x3c, 0x30, 0x63, 0x2c, 0x2e, 0x03, 0xc9, 0xd2, 0xfe, 0xd6, 0x38, 0x42, 0xaa, 0xc5, 0xf8,
+	0xff, 0xa3, 0xc9, 0x90, 0x44, 0xb7, 0x88, 0xdb, 0xda, 0x8a, 0x98, 0x78, 0x17, 0x84, 0xe3, 0x37,
+	0x2e, 0x04, 0x05, 0xc7, 0xdb, 0xa8, 0xd0, 0x75, 0x7a, 0x21, 0x29, 0xe5, 0xb2, 0x38, 0x15, 0x45,
+	0xbb, 0x6b, 0x94, 0x22, 0xb7, 0xce, 0xd9, 0x4f, 0xe0, 0x3c, 0xa8, 0x7b, 0x0a, 0x11, 0x73, 0xda,
+	0xb3, 0x1a, 0x2a, 0x25, 0x19, 0x6c, 0xd6, 0x66, 0xa9, 0xcb, 0x59, 0x95, 0x81, 0xc6, 0x16, 0xdf,
+	0x41, 0x45, 0x27, 0x3e, 0x7f, 0xc7, 0x4e, 0xe2, 0xfc, 0xe5, 0xf3, 0x24, 0xfe, 0x81, 0x64, 0x86,
+	0x3f, 0x69, 0xa1, 0xd9, 0x90, 0x44, 0x62, 0xaa, 0xe8, 0x29, 0x20, 0xa4, 0x65, 0x75, 0x44, 0xa1,
+	0x35, 0x68, 0xf2, 0xd3, 0xcc, 0x2c, 0x83, 0x04, 0x5f, 0xfc, 0x61, 0x84, 0x22, 0xb7, 0x43, 0x6e,
+	0xb9, 0x5e, 0xd3, 0xbf, 0x23, 0x14, 0xda, 0x1b, 0x99, 0x8c, 0xc2, 0xba, 0x24, 0xcb, 0x27, 0x41,
+	0xfd, 0x07, 0x8d, 0x25, 0xf5, 0xf5, 0xdc, 0xd9, 0x22, 0x5e, 0x69, 0xc2, 0xf4, 0xf5, 0xdc, 0xda,
+	0x22, 0x1e, 0x30, 0x08, 0xbd, 0x69, 0x92, 0x8b, 0xaa, 0x98, 0xc5, 0xc9, 0xdf, 0xb7, 0xa8, 0x48,
+	0x77, 0xe0, 0x92, 0xfa, 0xd3, 0x69, 0x34, 0x1b, 0x2f, 0x29, 0x65, 0x6f, 0x35, 0x78, 0x49, 0xba,
+	0xbd, 0xb5, 0xac, 0x03, 0xc1, 0xc4, 0xa5, 0x95, 0xc3, 0x88, 0x2a, 0xf8, 0xa6, 0xb9, 0x25, 0x2b,
+	0xd7, 0x75, 0x20, 0x98, 0xb8, 0xb8, 0x83, 0x0a, 0x21, 0xdb, 0xe2, 0xb8, 0x47, 0xf7, 0xb9, 0x11,
+	0x3d, 0x2c, 0x6a, 0x6f, 0x93, 0x8e, 0x73, 0xbe, 0xa9, 0x71, 0x2e, 0xf8, 0xb3, 0x16, 0x9a, 0x8d,
+	0x8c, 0x40, 0x8a, 0xd2, 0x58, 0x86, 0x2b, 0xd5, 0x8c, 0xd1, 0xe0, 0xd2, 0x6a, 0x96, 0x41, 0x82,
+	0x7d, 0x8a, 0x09, 0x56, 0x38, 0x41, 0x13, 0xec, 0x25, 0x1a, 0x35, 0x72, 0xb7, 0xde, 0x0b, 0x5a,
+	0xc7, 0x37, 0xf5, 0x44, 0x9c, 0x09, 0xa7, 0x02, 0x92, 0x1e, 0xfe, 0xa8, 0xa5, 0x6d, 0x3e, 0x13,
+	0x8c, 0xf8, 0xad, 0x6c, 0x37, 0x1f, 0x79, 0xf6, 0x0e, 0xdc, 0x86, 0xfa, 0x0c, 0xa2, 0xe2, 0x7d,
+	0x37, 0x88, 0xa8, 0x72, 0xcf, 0x17, 0x88, 0x54, 0xee, 0x27, 0x4f, 0x54, 0xb9, 0x5f, 0x36, 0x98,
+	0x41, 0x82, 0x39, 0x6b, 0x0f, 0x5f, 0x73, 0xb2, 0x3d, 0xe8, 0x44, 0xdb, 0x53, 0x37, 0x98, 0x41,
+	0x82, 0xf9, 0x60, 0x2f, 0xc0, 0xd4, 0xc9, 0x78, 0x01, 0xa6, 0x33, 0xf0, 0x02, 0x0c, 0x37, 0x90,
+	0x66, 0x46, 0x36, 0x90, 0xd2, 0x2c, 0x8d, 0xd9, 0x9f, 0x9f, 0xa5, 0xf1, 0x63, 0x0b, 0x9d, 0x15,
+	0x77, 0xba, 0xbf, 0x4c, 0x17, 0xe7, 0x0f, 0x0f, 0xe8, 0xf3, 0x7d, 0xb8, 0x45, 0x7e, 0xd5, 0xbc,
+	0x45, 0x1e, 0xf1, 0x62, 0x73, 0x40, 0x3f, 0x06, 0x5c, 0x26, 0xff, 0xc8, 0x42, 0x0b, 0xa2, 0x86,
+	0xd8, 0x70, 0xaf, 0x06, 0x84, 0xbc, 0x7a, 0x3f, 0xa6, 0xfa, 0xfd, 0xc6, 0x54, 0x67, 0xa3, 0xa4,
+	0xf1, 0xc6, 0x0f, 0x9c, 0xe7, 0x1f, 0x5b, 0xa8, 0x94, 0xd6, 0xdb, 0xfb, 0x30, 0xc9, 0x77, 0xcc,
+	0x49, 0x86, 0x4c, 0x26, 0xd9, 0xe8, 0xc4, 0x80, 0x19, 0x06, 0x94, 0xbc, 0x66, 0x3a, 0xc4, 0x7d,
+	0xe4, 0x79, 0x94, 0xdf, 0x26, 0xbb, 0x42, 0x99, 0x9b, 0x12, 0x08, 0x79, 0x5a, 0x9d, 0x96, 0xdb,
+	0x11, 0x9a, 0xb9, 0xec, 0x44, 0x4e, 0xd3, 0x6f, 0xf1, 0x98, 0x00, 0xfc, 0x0c, 0xbd, 0x9e, 0x8f,
+	0x48, 0x40, 0x75, 0x5a, 0x4e, 0xd5, 0x56, 0xf7, 0xe8, 0xbc, 0xfc, 0xde, 0x5e, 0x79, 0xf6, 0x72,
+	0x2f, 0x60, 0x21, 0xae, 0x5c, 0x99, 0x00, 0x59, 0x87, 0x06, 0x44, 0xbe, 0xbf, 0x47, 0x82, 0xdd,
+	0x64, 0x40, 0xe4, 0x0b, 0xb4, 0x10, 0x38, 0xcc, 0xfe, 0xe7, 0x1c, 0xd2, 0x4c, 0x9f, 0xfb, 0x20,
+	0xa1, 0x9e, 0x21, 0xa1, 0x23, 0x1a, 0x33, 0x9a, 0x21, 0x37, 0x28, 0x92, 0x75, 0x27, 0x11, 0xc9,
+	0x7a, 0x3d, 0x33, 0x8e, 0xc3, 0x03, 0x59, 0x5f, 0xb3, 0xd0, 0xc3, 0x0a, 0xb9, 0xdf, 0xb7, 0x71,
+	0xb0, 0xbc, 0xbc, 0x03, 0x4d, 0x39, 0xaa, 0x5a, 0x29, 0x67, 0x46, 0x4a, 0x6b, 0x14, 0x41, 0xc7,
+	0x53, 0x11, 0x6e, 0xf9, 0x63, 0x46, 0xb8, 0x8d, 0x0d, 0x8f, 0x70, 0xb3, 0xff, 0x3b, 0x87, 0xce,
+	0xf7, 0xf7, 0x2c, 0xde, 0x13, 0x0f, 0xb7, 0x16, 0x92, 0x91, 0x53, 0xb9, 0x63, 0x47, 0x4e, 0xe5,
+	0x8f, 0x1c, 0x39, 0x35, 0x76, 0xe2, 0x91, 0x35, 0x75, 0x74, 0x26, 0x0e, 0x6d, 0xb8, 0xea, 0x07,
+	0xcb, 0x7e, 0xa7, 0xdb, 0x26, 0x2c, 0x32, 0xa3, 0xc0, 0x1a, 0x7b, 0x5e, 0x54, 0x39, 0x03, 0x69,
+	0x48, 0x90, 0x5e, 0xd7, 0x7e, 0x2d, 0x8f, 0x4e, 0xab, 0x61, 0x5f, 0xf6, 0x3d, 0xee, 0x65, 0xc5,
+	0x4f, 0xa3, 0xb1, 0x68, 0xb7, 0x1b, 0x0f, 0xf6, 0xff, 0x8b, 0x9b, 0xb3, 0xbe, 0xdb, 0xa5, 0xb3,
+	0x7d, 0x36, 0xa5, 0x0a, 0x05, 0x01, 0xab, 0x84, 0x57, 0xe5, 0xea, 0xe0, 0x33, 0xf0, 0xa4, 0x29,
+	0xcd, 0xf7, 0xf6, 0xca, 0x29, 0xef, 0x23, 0x16, 0x25, 0x25, 0x53, 0xe6, 0xf1, 0x6d, 0x34, 0xdb,
+	0x76, 0xc2, 0xe8, 0x66, 0xb7, 0xe9, 0x44, 0x84, 0xda, 0xf2, 0xa5, 0xfc, 0x91, 0xc3, 0x0e, 0xe5,
+	0xa5, 0xe2, 0xaa, 0x41, 0x09, 0x12, 0x94, 0xf1, 0x0e, 0xc2, 0xb4, 0x64, 0x3d, 0x70, 0xbc, 0x90,
+	0xf7, 0xca, 0xed, 0x70, 0xd9, 0x3d, 0x1a, 0xbf, 0x73, 0x82, 0x1f, 0x5e, 0xed, 0xa3, 0x06, 0x29,
+	0x1c, 0xb4, 0xa8, 0xeb, 0xc2, 0xd0, 0xa8, 0x6b, 0x6d, 0x41, 0x8d, 0x1f, 0xb0, 0xa0, 0xbe, 0x63,
+	0xa1, 0x59, 0x35, 0x4d, 0xf7, 0xe1, 0xdc, 0xec, 0x98, 0xe7, 0xe6, 0x73, 0x59, 0x6d, 0x89, 0x03,
+	0x4e, 0xcb, 0xd7, 0xf3, 0x7a, 0xff, 0x58, 0x58, 0xdd, 0x07, 0xd0, 0x64, 0xbc, 0xaa, 0xe3, 0xc0,
+	0xba, 0x11, 0x4d, 0x4a, 0x43, 0x1f, 0xd5, 0xc2, 0x92, 0x05, 0x13, 0x50, 0xfc, 0xe8, 0xc1, 0xda,
+	0x14, 0x87, 0x66, 0x29, 0x67, 0x1e, 0xac, 0xf1, 0x61, 0x9a, 0x76, 0xb0, 0xc6, 0x75, 0xf0, 0x4d,
+	0x74, 0xb6, 0x1b, 0xf8, 0xec, 0x15, 0xcc, 0x65, 0xe2, 0x34, 0xdb, 0xae, 0x47, 0x62, 0xcb, 0x85,
+	0xdf, 0x69, 0x3f, 0xbc, 0xbf, 0x57, 0x3e, 0x5b, 0x4b, 0x47, 0x81, 0x41, 0x75, 0xcd, 0xf0, 0xea,
+	0xb1, 0x43, 0x84, 0x57, 0x7f, 0x4a, 0xfa, 0x07, 0x08, 0xbd, 0xb3, 0xa6, 0x83, 0xf8, 0x72, 0x56,
+	0x53, 0x99, 0xb2, 0xad, 0x2b, 0x91, 0xaa, 0x08, 0xa6, 0x20, 0xd9, 0xdb, 0x1f, 0x2f, 0xa0, 0xf9,
+	0xe4, 0xd9, 0x78, 0xf2, 0xc1, 0xd6, 0x9f, 0xb7, 0xd0, 0x7c, 0x3c, 0xaf, 0x9c, 0xa7, 0x0c, 0x65,
+	0x5c, 0xcd, 0x48, 0x9c, 0xf8, 0x29, 0x2f, 0x1f, 0x19, 0xad, 0x27, 0xb8, 0x41, 0x1f, 0x7f, 0xfc,
+	0x5e, 0x34, 0x25, 0xfd, 0x43, 0xc7, 0x8a, 0xbc, 0x9e, 0x63, 0xe7, 0xbb, 0x22, 0x01, 0x3a, 0x3d,
+	0xfc, 0x71, 0x0b, 0xa1, 0x46, 0xbc, 0x01, 0xc7, 0xf3, 0xfe, 0x42, 0x56, 0xf3, 0x2e, 0xb7, 0x76,
+	0xa5, 0xc6, 0xc9, 0xa2, 0x10, 0x34, 0xc6, 0xf8, 0xf7, 0x99, 0x67, 0x48, 0xea, 0x1d, 0x61, 0x69,
+	0x9c, 0xb5, 0xe4, 0xdd, 0x59, 0x4b, 0xa0, 0xba, 0x5a, 0x92, 0x87, 0xbc, 0x06, 0x0a, 0xc1, 0x68,
+	0x84, 0xfd, 0x34, 0x92, 0x11, 0x68, 0x74, 0x41, 0xb1, 0x18, 0xb4, 0x9a, 0x13, 0x6d, 0x09, 0x11,
+	0x94, 0x0b, 0xea, 0x6a, 0x0c, 0x00, 0x85, 0x63, 0x7f, 0xd9, 0x42, 0xd3, 0x5c, 0xef, 0x17, 0x8e,
+	0xe7, 0xb7, 0xa0, 0x62, 0xc8, 0xa3, 0x12, 0x63, 0x19, 0x96, 0x6b, 0x40, 0x44, 0x2b, 0x12, 0x90,
+	0x18, 0x23, 0xef, 0x2b, 0x6f, 0x41, 0x45, 0xea, 0xf4, 0x7e, 0xc9, 0xf7, 0x62, 0xe5, 0x4d, 0x72,
+	0x5b, 0x17, 0xe5, 0x20, 0x31, 0xec, 0xbf, 0xb1, 0xd0, 0xc2, 0x4a, 0x18, 0xb9, 0xfe, 0x65, 0x12,
+	0x46, 0x74, 0x43, 0xa0, 0xba, 0x03, 0x6d, 0xc6, 0xc1, 0xda, 0xd7, 0x65, 0x34, 0x2f, 0x3c, 0xce,
+	0xbd, 0x8d, 0x90, 0x44, 0x9a, 0x06, 0x26, 0xe5, 0x7c, 0x39, 0x01, 0x87, 0xbe, 0x1a, 0x94, 0x8a,
+	0x70, 0x3d, 0x2b, 0x2a, 0x79, 0x93, 0x4a, 0x3d, 0x01, 0x87, 0xbe, 0x1a, 0xf6, 0x57, 0x73, 0xe8,
+	0x34, 0xeb, 0x46, 0xe2, 0x39, 0xde, 0xef, 0x59, 0x68, 0x76, 0xc7, 0x0d, 0xa2, 0x9e, 0xd3, 0xd6,
+	0x7d, 0xe8, 0x23, 0x8b, 0x3a, 0xe3, 0xf5, 0xa2, 0x41, 0x58, 0xe9, 0x1c, 0x66, 0x39, 0x24, 0x1a,
+	0x40, 0xdb, 0x34, 0xd7, 0x34, 0x47, 0x3b, 0x1b, 0xa7, 0x4a, 0xda, 0x3c, 0x72, 0xd7, 0x52, 0xa2,
+	0x10, 0x92, 0xfc, 0xed, 0x97, 0xc5, 0xf0, 0x99, 0x4d, 0x3f, 0x84, 0x10, 0xd8, 0x68, 0x3c, 0xf0,
+	0x7b, 0x11, 0xe1, 0x5a, 0xc0, 0x64, 0x15, 0x31, 0x25, 0x86, 0x95, 0x80, 0x80, 0xd8, 0x7f, 0x61,
+	0xa1, 0xc9, 0x6b, 0xfe, 0x86, 0x30, 0x48, 0xdf, 0x97, 0x81, 0x71, 0x28, 0x25, 0x5a, 0xba, 0x33,
+	0x95, 0x5a, 0xf2, 0x8c, 0x61, 0x1a, 0x3e, 0xa2, 0xd1, 0x5e, 0x64, 0xcf, 0x77, 0x29, 0xa9, 0x6b,
+	0xfe, 0xc6, 0x40, 0x4f, 0xc4, 0x9f, 0x15, 0xd0, 0xcc, 0xf3, 0xce, 0x2e, 0xf1, 0x22, 0x47, 0xb4,
+	0xf8, 0x4d, 0x68, 0xc2, 0x69, 0x36, 0xd3, 0x9e, 0xb3, 0x56, 0x78, 0x31, 0xc4, 0x70, 0x66, 0x6d,
+	0x75, 0x59, 0xa4, 0x99, 0xb6, 0x7e, 0x95, 0xb5, 0xa5, 0x40, 0xa0, 0xe3, 0xa9, 0xa5, 0xc4, 0xfd,
+	0x01, 0x69, 0x8b, 0x60, 0x39, 0x01, 0x87, 0xbe, 0x1a, 0xf8, 0x1a, 0xc2, 0x22, 0xfe, 0xbf, 0xd2,
+	0x68, 0xf8, 0x3d, 0x8f, 0x2f, 0x26, 0x6e, 0x88, 0x49, 0x05, 0x75, 0xad, 0x0f, 0x03, 0x52, 0x6a,
+	0xd1, 0x28, 0x4f, 0x1e, 0xf1, 0x2a, 0xb6, 0x15, 0x9d, 0x22, 0x57, 0x59, 0x65, 0x94, 0xe7, 0xf2,
+	0x00, 0x3c, 0x18, 0x48, 0x81, 0xb6, 0x34, 0x8c, 0xfc, 0xc0, 0x69, 0x11, 0x9d, 0xee, 0xb8, 0xd9,
+	0xd2, 0x7a, 0x1f, 0x06, 0xa4, 0xd4, 0xc2, 0x1f, 0x46, 0x93, 0xd1, 0x56, 0x40, 0xc2, 0x2d, 0xbf,
+	0xdd, 0x2c, 0x4d, 0x64, 0x61, 0x9d, 0x8b, 0xd9, 0x5f, 0x8f, 0xa9, 0x6a, 0x0a, 0x54, 0x5c, 0x04,
+	0x8a, 0x27, 0x0e, 0xd0, 0x78, 0x48, 0x4d, 0xc3, 0xb0, 0x54, 0xcc, 0x42, 0x05, 0x15, 0xdc, 0x99,
+	0xb5, 0xa9, 0xf9, 0x05, 0x18, 0x07, 0x10, 0x9c, 0xec, 0xbf, 0xcd, 0xa1, 0x69, 0x1d, 0xf1, 0x10,
+	0x2b, 0xf5, 0x63, 0x16, 0x9a, 0x6e, 0xf8, 0x5e, 0x14, 0xf8, 0x6d, 0xf5, 0x5a, 0x68, 0xe4, 0xe7,
+	0x8d, 0x8c, 0xd4, 0x65, 0x12, 0x39, 0x6e, 0x5b, 0x33, 0x9f, 0x35, 0x36, 0x60, 0x30, 0x65, 0x2e,
+	0x75, 0x75, 0x31, 0xae, 0x8c, 0xef, 0x4c, 0x1b, 0x22, 0x83, 0xa1, 0xaf, 0x98, 0x9c, 0x20, 0xc9,
+	0xda, 0xde, 0x40, 0xf3, 0xc9, 0xd9, 0xa6, 0x43, 0xd9, 0x75, 0xc4, 0x5a, 0xcf, 0xab, 0xa1, 0xac,
+	0x39, 0x61, 0x08, 0x0c, 0x42, 0x8f, 0xd8, 0x8e, 0x13, 0xb4, 0x5c, 0xcf, 0x69, 0xb3, 0x51, 0xcc,
+	0x6b, 0x1b, 0x92, 0x28, 0x07, 0x89, 0x61, 0x7f, 0x21, 0x87, 0x92, 0xbe, 0x7d, 0x7c, 0x19, 0x15,
+	0xba, 0x7e, 0x10, 0xc5, 0x56, 0x4b, 0x59, 0xdf, 0xa5, 0xa8, 0x7d, 0x4c, 0x37, 0x29, 0xed, 0xf1,
+	0xbb, 0x32, 0x89, 0xe8, 0xbf, 0x10, 0x78, 0x65, 0x5c, 0x15, 0x46, 0x3b, 0xdf, 0x66, 0x16, 0x13,
+	0x46, 0xfb, 0x85, 0x14, 0x9b, 0x5b, 0xd0, 0xd4, 0x6c, 0xf7, 0x0f, 0x68, 0xdb, 0x71, 0xfe, 0xa4,
+	0x2e, 0xa0, 0xa6, 0xd3, 0xf7, 0x6a, 0xfb, 0x7b, 0x63, 0x68, 0x6a, 0x8d, 0x38, 0x61, 0x2f, 0x20,
+	0x23, 0x3c, 0x61, 0x3e, 0x82, 0xaa, 0x6f, 0xbc, 0x66, 0xcc, 0x67, 0xf7, 0x9a, 0x11, 0xbf, 0x84,
+	0x10, 0xbd, 0x68, 0x0c, 0xb7, 0x8e, 0xf9, 0x4e, 0x92, 0x05, 0x2e, 0x5c, 0x95, 0x14, 0x40, 0xa3,
+	0xa6, 0xde, 0xa4, 0x17, 0x86, 0xbc, 0x49, 0xff, 0xb8, 0xa5, 0x4d, 0x24, 0x57, 0xa2, 0x6f, 0x8d,
+	0xfa, 0xc8, 0x4c, 0x4e, 0xcc, 0x62, 0x3c, 0x77, 0x57, 0xbc, 0x28, 0xd8, 0x1d, 0x7a, 0xfc, 0xae,
+	0xa3, 0x62, 0x40, 0xc2, 0x5e, 0x87, 0x1a, 0x2d, 0x13, 0xc7, 0x7b, 0x05, 0x0e, 0xa2, 0x3e, 0x48,
+	0x4a, 0xe7, 0x9e, 0x46, 0x33, 0x46, 0x13, 0xf0, 0x3c, 0x77, 0x83, 0x33, 0x39, 0x61, 0x9e, 0x6f,
+	0xbc, 0x60, 0x3c, 0xd4, 0x11, 0xc3, 0xf2, 0xce, 0xdc, 0x53, 0x96, 0xfd, 0xf7, 0x13, 0x68, 0x5c,
+	0x1c, 0xe5, 0x07, 0x6f, 0x93, 0xba, 0xbf, 0x3c, 0x77, 0x0c, 0x7f, 0xf9, 0x35, 0x34, 0x4d, 0x2f,
+	0x9c, 0x5d, 0xa7, 0xcd, 0xae, 0x12, 0xc5, 0x31, 0xfe, 0x58, 0xbc, 0x35, 0xae, 0x68, 0xb0, 0x14,
+	0x3a, 0x46, 0x5d, 0xfc, 0x02, 0x2a, 0xb0, 0x73, 0xae, 0x34, 0x76, 0x80, 0x9e, 0x34, 0x28, 0x26,
+	0x80, 0xc5, 0x43, 0xf1, 0x40, 0x78, 0x4e, 0x89, 0xa9, 0xdb, 0xbd, 0x46, 0x83, 0x84, 0xa1, 0x34,
+	0xc8, 0x4a, 0x05, 0x53, 0xd3, 0xa8, 0x27, 0xe0, 0xd0, 0x57, 0x83, 0x52, 0xd9, 0x74, 0xdc, 0x76,
+	0x2f, 0x20, 0x8a, 0xca, 0xb8, 0x49, 0xe5, 0x6a, 0x02, 0x0e, 0x7d, 0x35, 0xf0, 0x26, 0x9a, 0x16,
+	0x65, 0xfc, 0x4a, 0x78, 0xe2, 0x98, 0xbd, 0x64, 0x57, 0xff, 0x57, 0x35, 0x4a, 0x60, 0xd0, 0xc5,
+	0x3d, 0x74, 0xca, 0xf5, 0x1a, 0x3e, 0x7d, 0x2b, 0x18, 0xba, 0x3b, 0x44, 0x45, 0xa1, 0x1f, 0x87,
+	0xd9, 0x19, 0x1a, 0x2e, 0xb8, 0x92, 0x24, 0x07, 0xfd, 0x1c, 0x68, 0xe0, 0xc5, 0x99, 0x86, 0xef,
+	0x85, 0xec, 0xe1, 0xd9, 0x0e, 0xb9, 0x12, 0x04, 0x7e, 0xc0, 0x79, 0x4f, 0x1e, 0x93, 0x37, 0xbb,
+	0x84, 0x5f, 0x4e, 0x23, 0x09, 0xe9, 0x9c, 0xf0, 0xab, 0xa8, 0x48, 0xc3, 0x8b, 0xdc, 0x26, 0x09,
+	0x44, 0x78, 0xc1, 0x6a, 0x16, 0x2f, 0x4f, 0x6b, 0x82, 0xa6, 0xda, 0x09, 0xe2, 0x12, 0x90, 0xfc,
+	0xf0, 0x8b, 0x68, 0x96, 0xd0, 0x45, 0xc8, 0xe4, 0x7b, 0xcd, 0x6f, 0x92, 0xd2, 0x94, 0x71, 0x4e,
+	0xcd, 0x5e, 0x31, 0xa0, 0xf7, 0xf6, 0xca, 0x0b, 0x9c, 0xba, 0x59, 0x0e, 0x09, 0x2a, 0xf6, 0x57,
+	0xc6, 0xd1, 0xac, 0xd9, 0x0c, 0xfc, 0x21, 0x84, 0xba, 0x81, 0xdf, 0x21, 0xd1, 0x16, 0x91, 0xf1,
+	0xb5, 0xd7, 0x47, 0x7d, 0xc7, 0x19, 0xd3, 0xe3, 0xbc, 0xf8, 0x0e, 0xad, 0x4a, 0x41, 0xe3, 0x88,
+	0x03, 0x34, 0xb1, 0xcd, 0xd5, 0x08, 0xa1, 0x55, 0x3d, 0x9f, 0x89, 0x0e, 0x28, 0x38, 0x4f, 0xd1,
+	0xa3, 0x4c, 0x14, 0x41, 0xcc, 0x08, 0x6f, 0xa0, 0xfc, 0x1d, 0xb2, 0x91, 0xcd, 0x8b, 0xc3, 0x5b,
+	0x44, 0x58, 0x67, 0xd5, 0x09, 0x7a, 0x9b, 0x78, 0x8b, 0x6c, 0x00, 0x25, 0x4e, 0xfb, 0xd5, 0xe4,
+	0xb7, 0x89, 0xa5, 0xb1, 0x2c, 0xfa, 0x65, 0x5c, 0x4d, 0xf2, 0x7e, 0x89, 0x22, 0x88, 0x19, 0xe1,
+	0x57, 0xd1, 0xe4, 0x1d, 0x67, 0x87, 0x6c, 0x06, 0xbe, 0x17, 0x95, 0x0a, 0x59, 0x44, 0x5b, 0xdc,
+	0x8a, 0xc9, 0x09, 0xbe, 0xec, 0x14, 0x97, 0x85, 0xa0, 0xd8, 0xe1, 0x1d, 0x54, 0xf4, 0xe8, 0x5b,
+	0xa1, 0xb6, 0xdb, 0x28, 0x8d, 0x67, 0xb1, 0x5c, 0xae, 0x0b, 0x6a, 0x82, 0x33, 0x3b, 0xde, 0xe2,
+	0x32, 0x90, 0xbc, 0xe8, 0x5c, 0xde, 0xf6, 0x37, 0x4a, 0x13, 0x59, 0xcc, 0xe5, 0x35, 0xdf, 0x98,
+	0xcb, 0x6b, 0xfe, 0x06, 0x50, 0xe2, 0xf6, 0x57, 0xc7, 0xd0, 0xb4, 0x9e, 0xe9, 0xe1, 0x10, 0x67,
+	0xa1, 0x54, 0xc7, 0x72, 0x47, 0x51, 0xc7, 0xa8, 0xa1, 0xd1, 0x51, 0xba, 0x43, 0xec, 0x4a, 0x5d,
+	0xc9, 0x4c, 0x1b, 0x51, 0x86, 0x86, 0x56, 0x18, 0x82, 0xc1, 0xf4, 0x08, 0x57, 0x91, 0x54, 0xbf,
+	0xe2, 0xc7, 0x2c, 0x7f, 0xb1, 0x25, 0xf5, 0x2b, 0xe3, 0xe0, 0xbc, 0x84, 0x90, 0x38, 0x06, 0x37,
+	0x7b, 0x6d, 0x26, 0x1c, 0x05, 0xe5, 0xdc, 0xac, 0x4b, 0x08, 0x68, 0x58, 0xf4, 0x96, 0x87, 0x1e,
+	0x44, 0xa4, 0x29, 0x9e, 0x52, 0x49, 0x6b, 0xee, 0x2a, 0x2b, 0x05, 0x01, 0xa5, 0xb7, 0x91, 0xfa,
+	0xf1, 0x21, 0x5e, 0x48, 0x2d, 0x28, 0x9d, 0x41, 0xc1, 0xc0, 0xc0, 0xa4, 0x4d, 0x27, 0x41, 0xe0,
+	0x07, 0xa5, 0x49, 0xb3, 0xe9, 0xec, 0x08, 0x00, 0x0e, 0x63, 0xde, 0x85, 0xc4, 0xe9, 0xc0, 0x0e,
+	0x83, 0x82, 0xe6, 0x5d, 0x48, 0xc0, 0xa1, 0xaf, 0x86, 0xfd, 0x0a, 0x9a, 0x35, 0xa5, 0x99, 0x0e,
+	0x71, 0x37, 0xf0, 0x37, 0x5d, 0xe9, 0xd6, 0x94, 0x43, 0x5c, 0xe3, 0xc5, 0x10, 0xc3, 0x0f, 0x17,
+	0x45, 0xf0, 0x77, 0x79, 0x74, 0xfa, 0x7a, 0xcb, 0xf5, 0xee, 0x26, 0x9c, 0x78, 0x69, 0x59, 0xbb,
+	0xac, 0xa3, 0x66, 0xed, 0x52, 0xf1, 0xb0, 0x22, 0x07, 0x59, 0x7a, 0x3c, 0xac, 0x00, 0x82, 0x89,
+	0x8b, 0xbf, 0x63, 0xa1, 0x47, 0xd4, 0x8b, 0x14, 0x51, 0xaa, 0x98, 0xc6, 0x32, 0x1e, 0x8e, 0xb8,
+	0x5b, 0xf4, 0x77, 0x7e, 0xb1, 0x32, 0x84, 0x2b, 0xd7, 0xc6, 0xdf, 0x28, 0x7a, 0xf0, 0xc8, 0x30,
+	0x54, 0x18, 0xda, 0xfc, 0x73, 0x37, 0xd0, 0x1b, 0x0e, 0x64, 0x74, 0x24, 0x9d, 0xfb, 0x63, 0x16,
+	0x9a, 0xe4, 0x0e, 0x3b, 0xea, 0x43, 0xbf, 0x84, 0x90, 0xd3, 0x75, 0x5f, 0x24, 0x41, 0x18, 0xe7,
+	0x59, 0x98, 0x54, 0x8b, 0xa7, 0x52, 0x5b, 0x11, 0x10, 0xd0, 0xb0, 0xe8, 0xf6, 0xb4, 0xed, 0x7a,
+	0xcd, 0x52, 0xce, 0xdc, 0x9e, 0x9e, 0x77, 0xbd, 0x26, 0x30, 0x88, 0xdc, 0xc0, 0xf2, 0x83, 0x36,
+	0x30, 0xfb, 0xcf, 0x2d, 0x34, 0xcb, 0x9e, 0x03, 0x28, 0xa5, 0xf3, 0x1d, 0xf2, 0xe6, 0x95, 0x37,
+	0xe3, 0xbc, 0x79, 0xf3, 0x7a, 0x6f, 0xaf, 0x3c, 0xc5, 0x6a, 0x24, 0x2e, 0x62, 0x5f, 0x16, 0x86,
+	0x23, 0xbb, 0x1f, 0xce, 0x1d, 0xd9, 0xae, 0x91, 0x1e, 0xa4, 0x7a, 0x4c, 0x04, 0x14, 0x3d, 0xfb,
+	0x2b, 0x79, 0x74, 0x3a, 0xc5, 0x6c, 0xa6, 0x36, 0xdd, 0x78, 0xdb, 0xd9, 0x20, 0xed, 0xd8, 0x4f,
+	0xf0, 0xde, 0xcc, 0x4d, 0xf3, 0xc5, 0x55, 0x46, 0x9f, 0x4b, 0x92, 0xdc, 0x9f, 0x78, 0x21, 0x08,
+	0xe6, 0xf8, 0x0f, 0x2d, 0x1a, 0x44, 0xa2, 0x84, 0x9d, 0x5f, 0xf8, 0x6e, 0x64, 0xdf, 0x98, 0x3e,
+	0xd9, 0xd6, 0x02, 0x55, 0x94, 0x28, 0xeb, 0x6d, 0x39, 0xf7, 0x6b, 0x68, 0x4a, 0xeb, 0xc2, 0x51,
+	0x64, 0xf4, 0xdc, 0x33, 0x68, 0x7e, 0x24, 0x19, 0x7f, 0x37, 0x3a, 0x6a, 0xe2, 0x0e, 0x7a, 0x22,
+	0xdc, 0xd1, 0x5f, 0xc9, 0xc8, 0x11, 0x17, 0xcf, 0x64, 0x04, 0x94, 0xfa, 0xa5, 0x92, 0x0a, 0xe8,
+	0x51, 0xdc, 0xd0, 0x87, 0xda, 0x6e, 0xdf, 0x86, 0x8e, 0x98, 0x6a, 0xc3, 0xfe, 0xbe, 0x85, 0xe6,
+	0xe3, 0x08, 0x5c, 0xf9, 0x90, 0xf3, 0x60, 0x35, 0xe2, 0xa2, 0xe1, 0xa6, 0x9a, 0xd6, 0xdd, 0x54,
+	0xc2, 0x09, 0x45, 0xdf, 0x0f, 0x45, 0xa4, 0xbb, 0xe2, 0x35, 0xc9, 0x5d, 0x71, 0xfb, 0x2d, 0x5c,
+	0x2d, 0xa2, 0x10, 0x14, 0x5c, 0x69, 0x25, 0x63, 0xc7, 0x74, 0x12, 0x15, 0x0e, 0x88, 0xa4, 0x20,
+	0x68, 0x26, 0xee, 0xe5, 0x4a, 0x87, 0x2a, 0x08, 0x4b, 0x68, 0x92, 0x3a, 0x31, 0x1d, 0xba, 0xc2,
+	0x93, 0x57, 0x80, 0xcb, 0x31, 0x00, 0x14, 0x0e, 0x1d, 0x7f, 0xb7, 0xa3, 0xfc, 0x51, 0x2a, 0xa0,
+	0x81, 0x16, 0x02, 0x87, 0xd9, 0x9f, 0xcf, 0xa1, 0xe9, 0x98, 0x0f, 0xed, 0x28, 0xab, 0xc5, 0x46,
+	0xc0, 0x32, 0x0f, 0x73, 0x3e, 0x02, 0x1c, 0x76, 0x88, 0xc1, 0x7c, 0x79, 0x34, 0x1f, 0x97, 0xb9,
+	0x55, 0x25, 0xfd, 0x5c, 0xef, 0x1b, 0xd1, 0xcf, 0x25, 0x77, 0xfd, 0x74, 0x5f, 0x97, 0x7d, 0x05,
+	0xb1, 0xc4, 0x19, 0x1b, 0x4e, 0x63, 0x9b, 0xdf, 0x9e, 0xb2, 0x40, 0x8f, 0x25, 0x34, 0x19, 0x88,
+	0x91, 0x0a, 0xc5, 0xe8, 0xc8, 0x66, 0xc6, 0x43, 0x18, 0x82, 0xc2, 0xb1, 0xbf, 0x99, 0x43, 0x13,
+	0x22, 0x04, 0xf3, 0x3e, 0x44, 0x23, 0x6e, 0x1b, 0x57, 0x4e, 0x2b, 0x99, 0xc4, 0xcb, 0x0e, 0x0c,
+	0x45, 0x0c, 0x13, 0xa1, 0x88, 0xcf, 0x67, 0xc3, 0x6e, 0x78, 0x1c, 0xe2, 0x67, 0x73, 0x68, 0x2e,
+	0xf1, 0xec, 0x03, 0xff, 0x8e, 0xd5, 0x1f, 0x7e, 0x73, 0x33, 0xd3, 0x97, 0x25, 0x32, 0x42, 0x7a,
+	0x78, 0x24, 0x4e, 0x68, 0x24, 0xbb, 0xca, 0x2e, 0x39, 0xe0, 0xd0, 0xbc, 0x66, 0xdf, 0xb7, 0xd0,
+	0x43, 0x03, 0x1f, 0xc2, 0xb0, 0xd7, 0xed, 0x81, 0x09, 0x2d, 0x59, 0x59, 0xd8, 0xb2, 0x49, 0x96,
+	0xf2, 0xaa, 0x23, 0x01, 0x80, 0x24, 0x7b, 0xfc, 0x24, 0x9a, 0x66, 0xcb, 0x98, 0x6e, 0xf4, 0x11,
+	0xe9, 0x8a, 0x54, 0xbb, 0xcc, 0x77, 0x56, 0xd7, 0xca, 0xc1, 0xc0, 0xb2, 0xbf, 0x68, 0xa1, 0xd2,
+	0xa0, 0x57, 0xba, 0x87, 0xd8, 0xfa, 0x7f, 0x35, 0x11, 0x19, 0x58, 0xee, 0x8b, 0x0c, 0x4c, 0xec,
+	0xd6, 0x02, 0x5d, 0xdf, 0xae, 0xf3, 0x07, 0x6c, 0xd7, 0x9f, 0xb1, 0xd0, 0xd9, 0x01, 0x82, 0xf3,
+	0xf3, 0xc8, 0xad, 0x47, 0x25, 0xe3, 0x4c, 0xe2, 0xe1, 0xdf, 0x73, 0xc4, 0xa1, 0x6e, 0xaa, 0xf3,
+	0x9a, 0x72, 0xd1, 0x1f, 0xbb, 0x7d, 0xb8, 0xdc, 0xb3, 0x74, 0xd5, 0x25, 0xf3, 0x4d, 0xbd, 0x3b,
+	0xd3, 0x57, 0x8a, 0xbc, 0xb1, 0x75, 0xbf, 0x17, 0x34, 0xc8, 0x90, 0x0c, 0x54, 0x5f, 0xb4, 0xd0,
+	0xc3, 0x43, 0x6a, 0xb2, 0x37, 0x5f, 0x21, 0x69, 0x04, 0x24, 0x12, 0xc9, 0x9a, 0xac, 0x2c, 0xde,
+	0x7c, 0xd5, 0x35, 0x8a, 0x42, 0x78, 0xb5, 0x12, 0x30, 0x38, 0xda, 0x5f, 0xc8, 0xf7, 0xcd, 0x84,
+	0x90, 0xdc, 0x25, 0x5d, 0xe1, 0x48, 0x1c, 0x28, 0xc3, 0x95, 0x8e, 0x81, 0xae, 0x10, 0xc1, 0x60,
+	0x90, 0xd2, 0x71, 0x80, 0x14, 0xd3, 0x78, 0x2f, 0x1a, 0xb1, 0x4b, 0xc2, 0xe3, 0x66, 0xda, 0x64,
+	0xf1, 0x5e, 0xa0, 0x48, 0x80, 0x4e, 0x0f, 0xbb, 0x68, 0xae, 0xed, 0x84, 0x91, 0x06, 0x2f, 0x15,
+	0x8e, 0xcc, 0x82, 0xc5, 0x92, 0xac, 0x9a, 0x64, 0x20, 0x49, 0x57, 0xf9, 0x24, 0xc6, 0x4d, 0x31,
+	0xd6, 0x7d, 0x12, 0x2c, 0x60, 0x27, 0xe5, 0x75, 0x2c, 0x5d, 0x22, 0xbd, 0xa0, 0x9d, 0x5c, 0x22,
+	0x37, 0x61, 0x15, 0x68, 0x39, 0xfe, 0x10, 0x9a, 0xd8, 0x62, 0x42, 0x16, 0xef, 0xf6, 0xf5, 0x13,
+	0x10, 0x7d, 0x35, 0x4b, 0xfc, 0x7f, 0x08, 0x31, 0x53, 0x7a, 0xbb, 0xd3, 0xf5, 0xdb, 0xed, 0xf8,
+	0x26, 0x28, 0x79, 0xbb, 0x53, 0xd3, 0x60, 0x69, 0xb7, 0x3b, 0x7a, 0x5d, 0xfc, 0x34, 0x9a, 0x88,
+	0xdc, 0x0e, 0xf1, 0x7b, 0x91, 0xd0, 0x64, 0xdf, 0x10, 0xb3, 0x5d, 0xe7, 0xc5, 0x29, 0x14, 0xe2,
+	0x1a, 0xf6, 0x3f, 0xe5, 0xd1, 0xbc, 0x68, 0xbc, 0xb2, 0x6d, 0x9f, 0x32, 0x82, 0xb8, 0xdf, 0x98,
+	0xb8, 0x0f, 0x5e, 0x48, 0xe2, 0xff, 0x5f, 0x04, 0xf7, 0x2f, 0x56, 0x04, 0xf7, 0xcf, 0x72, 0x72,
+	0xbb, 0x32, 0x73, 0x0b, 0xd0, 0x67, 0xfc, 0x7d, 0xaa, 0xd6, 0xad, 0x8c, 0x93, 0x18, 0x1c, 0x52,
+	0xd9, 0x1a, 0x35, 0x3c, 0xf1, 0x0f, 0xf4, 0x70, 0x63, 0xee, 0x35, 0xdb, 0x3c, 0x81, 0x74, 0x0c,
+	0x47, 0x8d, 0x3c, 0xfe, 0xdd, 0x3c, 0x7a, 0xfc, 0xb0, 0x84, 0x7e, 0x41, 0x5f, 0xa6, 0x84, 0xc6,
+	0xcb, 0x94, 0xfb, 0xa3, 0x06, 0x9f, 0xcc, 0x23, 0x95, 0x4f, 0xe4, 0xd1, 0x43, 0x7d, 0x93, 0x21,
+	0x75, 0xba, 0xc3, 0xdc, 0xe1, 0x4f, 0x50, 0x53, 0x29, 0xce, 0x4d, 0xa9, 0xb6, 0xc2, 0x89, 0x3a,
+	0x2f, 0xbe, 0xb7, 0x57, 0x3e, 0x25, 0x32, 0xc2, 0xd5, 0x49, 0x24, 0x0a, 0x21, 0xae, 0x44, 0x3f,
+	0xe2, 0x10, 0x70, 0x68, 0x1c, 0x8b, 0x2f, 0xe2, 0x12, 0x78, 0x19, 0x48, 0x28, 0xfe, 0xb0, 0x66,
+	0x5b, 0x8e, 0x9d, 0x54, 0xf4, 0xcc, 0xb0, 0x70, 0x8b, 0xf7, 0xa2, 0x62, 0x18, 0xe7, 0x71, 0xe4,
+	0x07, 0xfa, 0x13, 0x87, 0x7c, 0xe2, 0x41, 0x9d, 0x66, 0x71, 0x52, 0x47, 0xde, 0xbf, 0xf8, 0x1f,
+	0x48, 0x92, 0x54, 0x97, 0x9d, 0xf9, 0x25, 0x78, 0x7d, 0xfa, 0x3d, 0x0b, 0x9d, 0xba, 0xdf, 0xcf,
+	0x4e, 0xbb, 0xe6, 0xf3, 0x99, 0xe7, 0x33, 0xec, 0xe7, 0x80, 0x17, 0x34, 0x3f, 0x48, 0xf6, 0x92,
+	0xf9, 0x56, 0x74, 0x09, 0xb2, 0x32, 0x97, 0x20, 0xdc, 0x43, 0x13, 0x77, 0x98, 0x23, 0x27, 0xee,
+	0xe8, 0x88, 0x06, 0x80, 0x1e, 0x59, 0xaf, 0xce, 0x52, 0xfe, 0x3f, 0x84, 0x98, 0x17, 0xed, 0xeb,
+	0x94, 0xe8, 0xeb, 0x73, 0xbe, 0xbf, 0x7d, 0x88, 0x4d, 0x63, 0x93, 0xdf, 0xc1, 0xe6, 0xb2, 0xbd,
+	0x83, 0x95, 0x2a, 0x6c, 0x7c, 0x0f, 0x8b, 0x6b, 0x68, 0x46, 0x44, 0xa7, 0xd4, 0xfc, 0xb6, 0xdb,
+	0x88, 0x23, 0x84, 0xde, 0x1c, 0xdf, 0x43, 0x5d, 0xd5, 0x81, 0x74, 0xa3, 0xa2, 0xed, 0x37, 0x0a,
+	0xc1, 0x24, 0x60, 0xff, 0x7b, 0x4e, 0xce, 0x2b, 0xc5, 0x3d, 0xb4, 0x71, 0xfe, 0x16, 0xc3, 0x95,
+	0x58, 0x4a, 0xa8, 0x8b, 0x45, 0x4a, 0x4b, 0x53, 0x11, 0x69, 0xea, 0x59, 0x33, 0x13, 0xb0, 0x68,
+	0xb9, 0x4a, 0x3d, 0x6b, 0x82, 0x21, 0x89, 0x4f, 0x75, 0xa1, 0xdb, 0xfe, 0x86, 0x16, 0x95, 0x2c,
+	0xe7, 0xef, 0x1a, 0x2f, 0x86, 0x18, 0xae, 0xec, 0xad, 0xc2, 0x31, 0x9d, 0xbc, 0x07, 0x28, 0x5b,
+	0xf4, 0x4a, 0xca, 0x6f, 0xf0, 0x1c, 0x5f, 0x0d, 0x22, 0xee, 0x67, 0xd5, 0xbe, 0x24, 0x21, 0xa0,
+	0x61, 0xd9, 0x9f, 0xcf, 0xa3, 0x69, 0x6d, 0xa0, 0x69, 0x16, 0x2e, 0xd4, 0x0d, 0x88, 0x28, 0x12,
+	0x7a, 0x59, 0x36, 0xee, 0x3f, 0x4a, 0x5f, 0xb5, 0xa7, 0x26, 0x99, 0x80, 0xc6, 0x90, 0xfa, 0x02,
+	0x66, 0x8c, 0xec, 0x8a, 0xd9, 0x7c, 0xb2, 0x44, 0x6f, 0x82, 0xbc, 0x1e, 0x35, 0x92, 0x3b, 0x82,
+	0xc9, 0x96, 0xc6, 0x6c, 0xd0, 0x02, 0x96, 0x66, 0xa3, 0x94, 0xcf, 0xba, 0x0d, 0x52, 0x21, 0xad,
+	0xc5, 0x3c, 0x40, 0xb1, 0xb3, 0x5f, 0x53, 0x2b, 0xfd, 0x3e, 0xec, 0xda, 0xb7, 0xcd, 0x5d, 0xfb,
+	0x4a, 0x26, 0xbd, 0x1c, 0xb0, 0x5f, 0xdf, 0x96, 0xd2, 0xc6, 0x6e, 0x24, 0x69, 0x96, 0x20, 0xa9,
+	0x7a, 0x5b, 0xa3, 0x64, 0x09, 0x8a, 0x95, 0x73, 0xa5, 0x96, 0xdb, 0xff, 0x69, 0x49, 0x07, 0x6f,
+	0xec, 0x50, 0xbf, 0x0f, 0x47, 0x7d, 0x68, 0x1c, 0xf5, 0xd9, 0x24, 0xdb, 0x92, 0x57, 0x2a, 0x83,
+	0x0e, 0xfb, 0xff, 0xb0, 0xd0, 0xe9, 0x04, 0xee, 0x7d, 0x10, 0x9c, 0xc0, 0x14, 0x9c, 0xb5, 0x4c,
+	0xfb, 0x3a, 0x40, 0x80, 0xbe, 0x3c, 0xd1, 0xd7, 0x53, 0x76, 0xe4, 0xb3, 0xaf, 0xa7, 0xb1, 0x62,
+	0xcd, 0x29, 0xaa, 0x7d, 0x3d, 0x4d, 0x82, 0x40, 0xc7, 0xa3, 0x61, 0xef, 0xf1, 0x0d, 0x4b, 0x32,
+	0xec, 0x3d, 0x26, 0x0f, 0x12, 0x23, 0x8b, 0xf3, 0x22, 0x44, 0xe3, 0xec, 0xaa, 0x2c, 0xb6, 0x69,
+	0x46, 0xd5, 0x91, 0xf4, 0x4b, 0x3d, 0x65, 0xb0, 0xb3, 0xbf, 0x21, 0x08, 0x56, 0xf8, 0x29, 0x6a,
+	0xd8, 0x87, 0xbd, 0x76, 0x94, 0x78, 0xe7, 0x32, 0xce, 0xc3, 0xa6, 0xa8, 0x71, 0x2b, 0x7b, 0xcb,
+	0x4a, 0x40, 0xe0, 0x1f, 0xf1, 0xf4, 0xe9, 0xb2, 0xdd, 0x93, 0x34, 0xab, 0xbb, 0x22, 0x23, 0x9d,
+	0xb6, 0xdb, 0xc7, 0x10, 0xd0, 0xb0, 0xe8, 0xac, 0xb1, 0x5c, 0x45, 0xdc, 0xc1, 0x50, 0x2a, 0x9a,
+	0xb3, 0x56, 0x51, 0x20, 0xd0, 0xf1, 0xcc, 0x90, 0xf7, 0xc9, 0x0c, 0x43, 0xde, 0xcd, 0xab, 0x40,
+	0x94, 0xf5, 0x55, 0x20, 0xf6, 0xe3, 0x1c, 0x74, 0x53, 0x59, 0xe8, 0x8d, 0xfa, 0x4d, 0xeb, 0x80,
+	0x2c, 0x74, 0x1f, 0xd4, 0x3c, 0x13, 0xd3, 0x59, 0x7c, 0x36, 0x26, 0x79, 0x57, 0x3e, 0xd4, 0x03,
+	0xf1, 0xc3, 0x49, 0x79, 0x8e, 0xb1, 0x45, 0xaa, 0x1b, 0xa1, 0xd6, 0x50, 0x23, 0x54, 0xd7, 0xe0,
+	0x73, 0xd9, 0x6b, 0xf0, 0x2f, 0xa0, 0x62, 0xec, 0xa1, 0x10, 0x7e, 0xbc, 0x47, 0xd3, 0x9e, 0xab,
+	0x68, 0xab, 0x99, 0x6d, 0xbd, 0xea, 0xd5, 0xa9, 0x28, 0x05, 0x49, 0x06, 0xbf, 0x8a, 0xa6, 0xee,
+	0xf8, 0xc1, 0x76, 0xdb, 0x77, 0xd8, 0x07, 0x24, 0x50, 0x16, 0x3a, 0xb7, 0x8c, 0x36, 0xe2, 0x9e,
+	0xf0, 0x5b, 0x8a, 0x3e, 0xe8, 0xcc, 0xe8, 0xbe, 0xd4, 0x71, 0x3d, 0x20, 0x4e, 0x53, 0x66, 0x0a,
+	0x1b, 0xe3, 0x39, 0xe4, 0xe3, 0x7d, 0x69, 0xcd, 0x04, 0x43, 0x12, 0x9f, 0xbe, 0x99, 0x09, 0x45,
+	0x8e, 0xc6, 0x6c, 0x22, 0x54, 0xe3, 0x79, 0x17, 0x44, 0xb5, 0xf7, 0xc1, 0xa2, 0x04, 0x24, 0x43,
+	0x9a, 0xbc, 0x3e, 0xde, 0x63, 0x9f, 0x73, 0xc3, 0xc8, 0x0f, 0x76, 0x79, 0x50, 0x39, 0x0f, 0x49,
+	0x64, 0xa9, 0xca, 0x21, 0x05, 0x0e, 0xa9, 0xb5, 0xa8, 0x1b, 0x93, 0x25, 0x4a, 0xe5, 0x21, 0x8a,
+	0x45, 0xb5, 0x2b, 0x32, 0x95, 0xa3, 0x09, 0x02, 0x3a, 0x2c, 0x5b, 0x41, 0x71, 0x84, 0x6c, 0x05,
+	0xb7, 0xe8, 0xc5, 0x3e, 0xdb, 0x52, 0x8e, 0xbf, 0x39, 0x41, 0x4c, 0x00, 0x14, 0x2d, 0xea, 0x97,
+	0x4a, 0xf2, 0xe4, 0xda, 0xe9, 0x94, 0xe9, 0x97, 0xaa, 0xa5, 0x21, 0x41, 0x7a, 0x5d, 0xfa, 0x78,
+	0x6d, 0x36, 0x30, 0xa2, 0x13, 0x44, 0xca, 0xf2, 0xda, 0xe8, 0xd3, 0x6f, 0x46, 0x3c, 0xf0, 0x94,
+	0x7d, 0x66, 0x39, 0x24, 0x78, 0xd3, 0x44, 0xba, 0x5b, 0xd4, 0x0c, 0x11, 0x59, 0xca, 0xaf, 0x65,
+	0xa6, 0x71, 0x87, 0xfc, 0xe1, 0x08, 0xfb, 0x09, 0x9c, 0x07, 0x13, 0xbb, 0xa4, 0xd2, 0x44, 0xc5,
+	0x6e, 0x56, 0x13, 0xbb, 0x14, 0x38, 0xa4, 0xd6, 0xb2, 0xff, 0x6b, 0x56, 0xfa, 0x95, 0x84, 0xb9,
+	0xfa, 0x28, 0x2a, 0xb0, 0x53, 0x8b, 0xed, 0x75, 0x45, 0xb5, 0x43, 0xf3, 0x09, 0xe1, 0x30, 0x9a,
+	0x27, 0x74, 0xae, 0x6b, 0xc4, 0xf3, 0xc5, 0xfa, 0xd4, 0x88, 0x71, 0xda, 0x66, 0x90, 0xa0, 0xa6,
+	0xa2, 0x98, 0xcc, 0x20, 0xc9, 0x9d, 0xee, 0x26, 0xe2, 0x7d, 0x63, 0x9b, 0x04, 0x0c, 0x5b, 0x78,
+	0x6c, 0x25, 0x89, 0x65, 0x13, 0x0c, 0x49, 0x7c, 0xba, 0x06, 0x58, 0xef, 0x46, 0xf9, 0xc2, 0x5e,
+	0x25, 0x26, 0x00, 0x8a, 0x16, 0xfd, 0xe2, 0x86, 0x48, 0x62, 0x5d, 0xf3, 0x9b, 0x4c, 0x01, 0x2b,
+	0x98, 0x5f, 0xdc, 0x58, 0x36, 0xa0, 0x90, 0xc0, 0x66, 0x7d, 0x53, 0x99, 0xc2, 0x19, 0x81, 0x71,
+	0x53, 0x83, 0x5b, 0x36, 0xc1, 0x90, 0xc4, 0xe7, 0x2a, 0xa3, 0x38, 0xc4, 0xb8, 0x8d, 0xad, 0xa9,
+	0x8c, 0x7d, 0x07, 0x59, 0x05, 0xcd, 0xf5, 0xd8, 0xcd, 0x4e, 0x33, 0x06, 0x8a, 0xcd, 0x45, 0x32,
+	0xbc, 0x69, 0x82, 0x21, 0x89, 0x4f, 0xa3, 0x7c, 0x03, 0xba, 0x55, 0x4b, 0x02, 0x3c, 0x30, 0x5a,
+	0x9a, 0xb1, 0xa0, 0x03, 0xc1, 0xc4, 0xa5, 0x99, 0xc2, 0x55, 0x8e, 0xd6, 0x98, 0x00, 0x8f, 0x94,
+	0x96, 0x99, 0xc2, 0x2b, 0x49, 0x04, 0xe8, 0xaf, 0x83, 0x7f, 0x1d, 0xcd, 0x6b, 0x23, 0xc1, 0x6f,
+	0x99, 0x79, 0x1e, 0xcd, 0x05, 0x16, 0x6d, 0x9d, 0x80, 0x41, 0x1f, 0x36, 0x7e, 0x27, 0x9a, 0x6d,
+	0xf8, 0xed, 0x36, 0x5b, 0x32, 0xfc, 0x43, 0x27, 0x3c, 0x61, 0x26, 0x4f, 0x2d, 0x6a, 0x40, 0x20,
+	0x81, 0x49, 0x5f, 0x57, 0xfb, 0x1b, 0x21, 0x09, 0x76, 0x48, 0xf3, 0x59, 0xfe, 0xbd, 0x66, 0xaa,
+	0xb1, 0xcf, 0x98, 0xaf, 0xab, 0x6f, 0xf4, 0x61, 0x40, 0x4a, 0x2d, 0xbc, 0x81, 0xce, 0xc5, 0x87,
+	0x67, 0x7f, 0x8d, 0x52, 0xc9, 0xb8, 0x00, 0x3a, 0x77, 0x6b, 0x20, 0x26, 0x0c, 0xa1, 0x82, 0x7f,
+	0xdb, 0xcc, 0x45, 0x32, 0x9b, 0x89, 0xea, 0x95, 0xb8, 0xeb, 0x3c, 0x30, 0x11, 0x49, 0x80, 0xc6,
+	0xf9, 0x83, 0xfa, 0xd2, 0x5c, 0x16, 0xbb, 0xa9, 0xfe, 0x45, 0x00, 0x75, 0xa8, 0xf2, 0x52, 0x10,
+	0x9c, 0xf0, 0x87, 0xd0, 0xe4, 0x46, 0xfc, 0x91, 0x9d, 0xd2, 0x7c, 0x16, 0x8a, 0x44, 0xe2, 0x7b,
+	0x51, 0xca, 0x75, 0x22, 0x01, 0xa0, 0x58, 0xe2, 0xc7, 0xd0, 0xd4, 0x73, 0xb5, 0x8a, 0x94, 0xf4,
+	0x53, 0x4c, 0xc2, 0xc6, 0x68, 0x15, 0xd0, 0x01, 0x74, 0x15, 0x4b, 0x05, 0x13, 0x27, 0x12, 0x98,
+	0xf4, 0xeb, 0x8b, 0x14, 0x9b, 0x05, 0xcf, 0x43, 0xbd, 0x74, 0x3a, 0x81, 0x2d, 0xca, 0x41, 0x62,
+	0xf0, 0xb8, 0x07, 0x65, 0xa0, 0x2c, 0x1c, 0x37, 0xee, 0x41, 0x92, 0x00, 0x9d, 0x1e, 0x35, 0x9a,
+	0x84, 0x09, 0x75, 0xb5, 0xd7, 0x6e, 0x97, 0xce, 0xb0, 0xbd, 0x59, 0x1a, 0x4d, 0x35, 0x05, 0x02,
+	0x1d, 0x0f, 0x3f, 0x11, 0xbb, 0x1f, 0x1f, 0x34, 0x82, 0xc4, 0xa5, 0xfb, 0x51, 0x3a, 0x66, 0x06,
+	0x78, 0x1f, 0xcf, 0x1e, 0x60, 0xff, 0x45, 0xf1, 0xd1, 0xfd, 0x50, 0x16, 0x9f, 0x10, 0xe8, 0x73,
+	0xfe, 0xaa, 0xe3, 0x53, 0x3f, 0xc3, 0xed, 0x8f, 0xaa, 0x28, 0x3e, 0x99, 0x63, 0xfc, 0x83, 0xba,
+	0x0c, 0x5a, 0x59, 0x5c, 0xb9, 0xf4, 0x7d, 0x37, 0x8a, 0x1f, 0x51, 0xa9, 0x12, 0xd8, 0x95, 0xab,
+	0x2e, 0x93, 0x4c, 0x8e, 0x66, 0xfe, 0x74, 0x9e, 0x8c, 0xc4, 0x5c, 0x73, 0xf6, 0x37, 0xd5, 0x25,
+	0x88, 0xca, 0x13, 0xcf, 0xe3, 0x81, 0xe2, 0xf0, 0xfe, 0x44, 0x84, 0x6f, 0x5a, 0xc8, 0x3e, 0x9d,
+	0x6b, 0xe2, 0x35, 0xe5, 0x6b, 0x00, 0x6d, 0xae, 0xaf, 0xf0, 0x62, 0x88, 0xe1, 0x78, 0x11, 0xa1,
+	0xa6, 0xb3, 0x1b, 0xde, 0xd8, 0xbc, 0x45, 0xc8, 0x36, 0xf3, 0x8e, 0x4e, 0xf2, 0xc7, 0x84, 0x97,
+	0x65, 0x29, 0x68, 0x18, 0x46, 0x02, 0x9f, 0xb1, 0x03, 0x13, 0xf8, 0x7c, 0x77, 0x4c, 0x06, 0x0d,
+	0x24, 0x9e, 0xcd, 0x50, 0x8f, 0x53, 0x18, 0xb9, 0x7e, 0x86, 0x19, 0x6f, 0x4c, 0x0e, 0x5c, 0x4b,
+	0x64, 0x00, 0xe0, 0xac, 0x28, 0x4f, 0x8f, 0x3e, 0x62, 0xc9, 0xc6, 0xa3, 0x97, 0xf2, 0x1e, 0x86,
+	0xf3, 0x64, 0x00, 0xe0, 0xac, 0xf0, 0x6d, 0x94, 0x77, 0xda, 0x1b, 0x19, 0x7d, 0x87, 0x7d, 0xb5,
+	0x9a, 0xe0, 0xc7, 0x1e, 0xd1, 0x55, 0x56, 0xab, 0x40, 0x99, 0x50, 0x5e, 0x61, 0xc7, 0x2d, 0x8d,
+	0x65, 0xc1, 0xab, 0xbe, 0xb6, 0x92, 0xc6, 0xab, 0xbe, 0xb6, 0x02, 0x94, 0x09, 0xf5, 0xee, 0x23,
+	0xa7, 0xb3, 0xe1, 0x84, 0xa1, 0xd3, 0x94, 0xb7, 0xbb, 0x23, 0x7e, 0x70, 0xa5, 0x22, 0xe9, 0x25,
+	0x58, 0x33, 0x81, 0x54, 0x50, 0xd0, 0x38, 0xdb, 0x9f, 0xb3, 0xd0, 0xa9, 0xbe, 0xc6, 0x72, 0x27,
+	0xa2, 0x1f, 0x99, 0x9f, 0x26, 0xd0, 0x9c, 0x88, 0x12, 0x04, 0x3a, 0x1e, 0x7d, 0xcc, 0x25, 0x52,
+	0xed, 0xd7, 0xbb, 0x6d, 0x37, 0x35, 0x6b, 0xd4, 0x7a, 0x02, 0x0e, 0x7d, 0x35, 0xec, 0xaf, 0x59,
+	0x68, 0x4a, 0xcb, 0xf8, 0x41, 0xad, 0x07, 0x96, 0x19, 0x45, 0x34, 0x43, 0xf9, 0x77, 0x68, 0x21,
+	0x70, 0x18, 0x0f, 0xd9, 0x69, 0xb9, 0x69, 0x9f, 0xba, 0xa7, 0xa5, 0x20, 0xa0, 0xf4, 0xe6, 0x2c,
+	0x8c, 0x48, 0xb7, 0x94, 0x37, 0x13, 0x80, 0xb0, 0xe8, 0x58, 0x06, 0x61, 0xec, 0x22, 0x27, 0x88,
+	0x03, 0xb7, 0x14, 0x3b, 0x5a, 0x08, 0x1c, 0x46, 0x43, 0xd9, 0x88, 0xd7, 0x14, 0x3a, 0xb7, 0xbc,
+	0x07, 0xbc, 0xe2, 0x35, 0x81, 0x96, 0xdb, 0x37, 0x90, 0x11, 0xba, 0x38, 0x7a, 0xea, 0xdf, 0x2f,
+	0x5b, 0x28, 0xf1, 0x0d, 0x0e, 0x9a, 0x9d, 0xc9, 0x78, 0x6e, 0x82, 0xfa, 0x9f, 0x9a, 0x18, 0x7e,
+	0xa6, 0xdc, 0x50, 0x3f, 0x13, 0xcd, 0x2f, 0xe4, 0x44, 0x8d, 0x2d, 0x31, 0x3f, 0x9c, 0x8e, 0x30,
+	0x77, 0x54, 0x7e, 0xa1, 0x3e, 0x0c, 0x48, 0xa9, 0x65, 0xbf, 0x82, 0x4e, 0xf5, 0x7d, 0xf8, 0xe6,
+	0x70, 0x0f, 0x20, 0x54, 0xe0, 0x55, 0x6e, 0x58, 0xe0, 0x95, 0xfd, 0x8f, 0x39, 0x34, 0x6d, 0x7c,
+	0x84, 0xf9, 0xe0, 0x01, 0x3e, 0xfc, 0x50, 0xa4, 0x38, 0x91, 0xf2, 0x47, 0x74, 0x22, 0xe9, 0x5e,
+	0xbb, 0xb1, 0x93, 0xf5, 0xda, 0x15, 0x32, 0xf1, 0xda, 0xd9, 0x5f, 0x1f, 0x43, 0xb3, 0x66, 0xe6,
+	0xc2, 0x43, 0x5d, 0x32, 0x27, 0xc7, 0xf4, 0x88, 0x16, 0x60, 0x7e, 0x54, 0x0b, 0x70, 0x6c, 0x54,
+	0x0b, 0xb0, 0x70, 0x0c, 0x0b, 0xb0, 0xdf, 0x7e, 0x1b, 0x3f, 0xb4, 0xfd, 0xf6, 0x2e, 0x19, 0x8c,
+	0x39, 0x61, 0x44, 0x2f, 0xa9, 0x60, 0x4c, 0x6c, 0x4e, 0xc3, 0xb2, 0xdf, 0x4c, 0x8d, 0x9c, 0x2f,
+	0x1e, 0xa0, 0x85, 0x06, 0xa9, 0xb1, 0x93, 0x47, 0x77, 0xc3, 0x3d, 0x78, 0xf8, 0xb8, 0x49, 0xfb,
+	0xb3, 0x79, 0xa4, 0xe2, 0xc9, 0x7f, 0x01, 0x82, 0xc4, 0x7f, 0xe9, 0xbf, 0x64, 0x6c, 0x3b, 0x68,
+	0x2e, 0x91, 0x21, 0x21, 0xf3, 0xa7, 0x86, 0x5f, 0xcb, 0xa1, 0x49, 0x99, 0x63, 0xe2, 0xa0, 0x18,
+	0xef, 0xbb, 0xc9, 0x18, 0xef, 0xb5, 0x8c, 0x92, 0x5b, 0x1c, 0x18, 0xdd, 0xfd, 0x0c, 0x9a, 0x15,
+	0xf1, 0xd5, 0xfa, 0xa6, 0x9e, 0x57, 0x0e, 0xb3, 0x75, 0x03, 0x0a, 0x09, 0x6c, 0xba, 0xd7, 0xdd,
+	0x0e, 0x7d, 0x8f, 0x65, 0x0a, 0x4d, 0x68, 0xee, 0xd7, 0xea, 0x37, 0xae, 0xd3, 0x72, 0x90, 0x18,
+	0x14, 0xdb, 0x65, 0x6f, 0xec, 0x03, 0x22, 0xa2, 0x25, 0xb5, 0x2f, 0xdc, 0xf3, 0x72, 0x90, 0x18,
+	0xf6, 0x4d, 0x34, 0x97, 0xe8, 0x48, 0x16, 0xcf, 0x49, 0xaa, 0x8b, 0xdf, 0x78, 0xfd, 0xc2, 0x03,
+	0xdf, 0x7a, 0xfd, 0xc2, 0x03, 0xdf, 0x7e, 0xfd, 0xc2, 0x03, 0x1f, 0xd9, 0xbf, 0x60, 0x7d, 0x63,
+	0xff, 0x82, 0xf5, 0xad, 0xfd, 0x0b, 0xd6, 0xb7, 0xf7, 0x2f, 0x58, 0xdf, 0xdd, 0xbf, 0x60, 0x7d,
+	0xee, 0x7b, 0x17, 0x1e, 0x78, 0xa9, 0x18, 0x0f, 0xe6, 0xff, 0x0c, 0x00, 0x6c, 0x47, 0x91, 0xa0,
+	0xc9, 0x8d, 0x00, 0x00,
 }
 
 func (m *ALBTrafficRouting) Marshal() (dAtA []byte, err error) {
@@ -2709,7 +3560,7 @@ func (m *AnalysisRunArgument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRunList) Marshal() (dAtA []byte, err error) {
+func (m *AnalysisRunJudgment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2719,21 +3570,71 @@ func (m *AnalysisRunList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AnalysisRunList) MarshalTo(dAtA []byte) (int, error) {
+func (m *AnalysisRunJudgment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AnalysisRunList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AnalysisRunJudgment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
+	if m.JudgedAt != nil {
+		{
+			size, err := m.JudgedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.User)
+	copy(dAtA[i:], m.User)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.User)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AnalysisRunList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalysisRunList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnalysisRunList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
 					return 0, err
 				}
 				i -= size
@@ -2776,6 +3677,18 @@ func (m *AnalysisRunSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Judgment != nil {
+		{
+			size, err := m.Judgment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	i--
 	if m.Terminate {
 		dAtA[i] = 1
@@ -2917,6 +3830,56 @@ func (m *AnalysisTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AnalysisTemplateInclude) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalysisTemplateInclude) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnalysisTemplateInclude) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i--
+	if m.ClusterScope {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.TemplateName)
+	copy(dAtA[i:], m.TemplateName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AnalysisTemplateList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2984,6 +3947,20 @@ func (m *AnalysisTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Includes) > 0 {
+		for iNdEx := len(m.Includes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Includes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Args) > 0 {
 		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3129,6 +4106,25 @@ func (m *ArgumentValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RolloutStatusValue != nil {
+		i -= len(*m.RolloutStatusValue)
+		copy(dAtA[i:], *m.RolloutStatusValue)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RolloutStatusValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.FieldRef != nil {
 		{
 			size, err := m.FieldRef.MarshalToSizedBuffer(dAtA[:i])
@@ -3236,6 +4232,45 @@ func (m *BlueGreenStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ManagedServices != nil {
+		{
+			size, err := m.ManagedServices.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.AdditionalPreviewServices) > 0 {
+		for iNdEx := len(m.AdditionalPreviewServices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalPreviewServices[iNdEx])
+			copy(dAtA[i:], m.AdditionalPreviewServices[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AdditionalPreviewServices[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AdditionalActiveServices) > 0 {
+		for iNdEx := len(m.AdditionalActiveServices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AdditionalActiveServices[iNdEx])
+			copy(dAtA[i:], m.AdditionalActiveServices[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AdditionalActiveServices[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.AbortScaleDownDelaySeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.AbortScaleDownDelaySeconds))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.ActiveMetadata != nil {
 		{
 			size, err := m.ActiveMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -3369,6 +4404,32 @@ func (m *CanaryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SkippedSteps) > 0 {
+		for iNdEx := len(m.SkippedSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkippedSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	i -= len(m.CurrentExperiment)
 	copy(dAtA[i:], m.CurrentExperiment)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentExperiment)))
@@ -3421,6 +4482,35 @@ func (m *CanaryStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Approval != nil {
+		{
+			size, err := m.Approval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x3a
+	if m.TimeWindow != nil {
+		{
+			size, err := m.TimeWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.SetCanaryScale != nil {
 		{
 			size, err := m.SetCanaryScale.MarshalToSizedBuffer(dAtA[:i])
@@ -3497,6 +4587,23 @@ func (m *CanaryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ManagedServices != nil {
+		{
+			size, err := m.ManagedServices.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.AbortScaleDownDelaySeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.AbortScaleDownDelaySeconds))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.ScaleDownDelayRevisionLimit != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.ScaleDownDelayRevisionLimit))
 		i--
@@ -3708,40 +4815,7 @@ func (m *ClusterAnalysisTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *DatadogMetric) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DatadogMetric) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DatadogMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Query)
-	copy(dAtA[i:], m.Query)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Interval)
-	copy(dAtA[i:], m.Interval)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Experiment) Marshal() (dAtA []byte, err error) {
+func (m *ClusterRolloutFreeze) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3751,26 +4825,16 @@ func (m *Experiment) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Experiment) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterRolloutFreeze) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Experiment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterRolloutFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3794,7 +4858,7 @@ func (m *Experiment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExperimentAnalysisRunStatus) Marshal() (dAtA []byte, err error) {
+func (m *ClusterRolloutFreezeList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3804,18 +4868,184 @@ func (m *ExperimentAnalysisRunStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExperimentAnalysisRunStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterRolloutFreezeList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExperimentAnalysisRunStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterRolloutFreezeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigMapKeyRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigMapKeyRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigMapKeyRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DatadogMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatadogMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatadogMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Experiment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Experiment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Experiment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExperimentAnalysisRunStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExperimentAnalysisRunStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExperimentAnalysisRunStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
 	dAtA[i] = 0x22
@@ -4194,6 +5424,44 @@ func (m *FieldRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FreezeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreezeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreezeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *IstioDestinationRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4511,6 +5779,60 @@ func (m *KayentaThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManagedServices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedServices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedServices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Ports) > 0 {
+		for iNdEx := len(m.Ports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Measurement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4629,6 +5951,11 @@ func (m *Metric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.EvaluationMode)
+	copy(dAtA[i:], m.EvaluationMode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EvaluationMode)))
+	i--
+	dAtA[i] = 0x5a
 	{
 		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -5211,7 +6538,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
-func (m *Rollout) Marshal() (dAtA []byte, err error) {
+func (m *RevisionAnalysis) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5221,26 +6548,184 @@ func (m *Rollout) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Rollout) MarshalTo(dAtA []byte) (int, error) {
+func (m *RevisionAnalysis) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Rollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RevisionAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x2a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x22
+	if m.StepIndex != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.StepIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RevisionImage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionImage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionImage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RevisionStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevisionStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackWindowSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackWindowSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackWindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Revisions))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Rollout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rollout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Rollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -5427,7 +6912,7 @@ func (m *RolloutAnalysisTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutCondition) Marshal() (dAtA []byte, err error) {
+func (m *RolloutApprovalHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5437,60 +6922,42 @@ func (m *RolloutCondition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutCondition) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutApprovalHeader) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutApprovalHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.Reason)
-	copy(dAtA[i:], m.Reason)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.ValueFrom != nil {
+		{
+			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Status)
-	copy(dAtA[i:], m.Status)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Type)
-	copy(dAtA[i:], m.Type)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutExperimentStep) Marshal() (dAtA []byte, err error) {
+func (m *RolloutApprovalHeaderSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5500,53 +6967,32 @@ func (m *RolloutExperimentStep) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutExperimentStep) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutApprovalHeaderSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutExperimentStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutApprovalHeaderSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Analyses) > 0 {
-		for iNdEx := len(m.Analyses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Analyses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	i -= len(m.Duration)
-	copy(dAtA[i:], m.Duration)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Templates) > 0 {
-		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.SecretKeyRef != nil {
+		{
+			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutExperimentStepAnalysisTemplateRef) Marshal() (dAtA []byte, err error) {
+func (m *RolloutApprovalStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5556,23 +7002,259 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *RolloutExperimentStepAnalysisTemplateRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutApprovalStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutExperimentStepAnalysisTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutApprovalStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Error)
+	copy(dAtA[i:], m.Error)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Error)))
 	i--
-	if m.RequiredForCompletion {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	dAtA[i] = 0x32
+	if m.LastRequestedAt != nil {
+		{
+			size, err := m.LastRequestedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	i--
+	if m.RequestedAt != nil {
+		{
+			size, err := m.RequestedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StepIndex))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutApprovalStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutApprovalStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutApprovalStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.PollInterval)
+	copy(dAtA[i:], m.PollInterval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PollInterval)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutExperimentStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutExperimentStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutExperimentStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Analyses) > 0 {
+		for iNdEx := len(m.Analyses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Analyses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutExperimentStepAnalysisTemplateRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutExperimentStepAnalysisTemplateRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutExperimentStepAnalysisTemplateRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.RequiredForCompletion {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
 	dAtA[i] = 0x28
 	if len(m.Args) > 0 {
 		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
@@ -5669,7 +7351,7 @@ func (m *RolloutExperimentTemplate) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutList) Marshal() (dAtA []byte, err error) {
+func (m *RolloutFreeze) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5679,32 +7361,28 @@ func (m *RolloutList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutList) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutFreeze) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5716,7 +7394,7 @@ func (m *RolloutList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutPause) Marshal() (dAtA []byte, err error) {
+func (m *RolloutFreezeList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5726,32 +7404,44 @@ func (m *RolloutPause) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutPause) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutFreezeList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutFreezeList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Duration != nil {
-		{
-			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0xa
 	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutSpec) Marshal() (dAtA []byte, err error) {
+func (m *RolloutFreezeSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5761,31 +7451,33 @@ func (m *RolloutSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutFreezeSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutFreezeSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.WorkloadRef != nil {
-		{
-			size, err := m.WorkloadRef.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	if m.RestartAt != nil {
+	if m.Selector != nil {
 		{
-			size, err := m.RestartAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5793,28 +7485,38 @@ func (m *RolloutSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
-	}
-	if m.ProgressDeadlineSeconds != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.ProgressDeadlineSeconds))
-		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0xa
 	}
-	i--
-	if m.Paused {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.FailurePolicy)
+	copy(dAtA[i:], m.FailurePolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailurePolicy)))
 	i--
-	dAtA[i] = 0x38
-	if m.RevisionHistoryLimit != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
-		i--
-		dAtA[i] = 0x30
-	}
+	dAtA[i] = 0x1a
 	{
-		size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5822,41 +7524,16 @@ func (m *RolloutSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	i = encodeVarintGenerated(dAtA, i, uint64(m.MinReadySeconds))
-	i--
-	dAtA[i] = 0x20
-	{
-		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0x1a
-	if m.Selector != nil {
-		{
-			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Replicas != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutStatus) Marshal() (dAtA []byte, err error) {
+func (m *RolloutHookStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5866,99 +7543,104 @@ func (m *RolloutStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutHookStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutHookStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Occurrence))
+	i--
+	dAtA[i] = 0x38
 	i -= len(m.Message)
 	copy(dAtA[i:], m.Message)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xba
+	dAtA[i] = 0x32
 	i -= len(m.Phase)
 	copy(dAtA[i:], m.Phase)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x2a
+	i -= len(m.JobName)
+	copy(dAtA[i:], m.JobName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JobName)))
 	i--
-	dAtA[i] = 0xb2
+	dAtA[i] = 0x22
+	i -= len(m.PodTemplateHash)
+	copy(dAtA[i:], m.PodTemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
 	i--
-	if m.PromoteFull {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0xa8
-	if m.RestartedAt != nil {
-		{
-			size, err := m.RestartedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i -= len(m.StableRS)
-	copy(dAtA[i:], m.StableRS)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StableRS)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x9a
-	i -= len(m.Selector)
-	copy(dAtA[i:], m.Selector)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x92
-	i = encodeVarintGenerated(dAtA, i, uint64(m.HPAReplicas))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x88
-	{
-		size, err := m.BlueGreen.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	return dAtA[:n], nil
+}
+
+func (m *RolloutHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostAbort) > 0 {
+		for iNdEx := len(m.PostAbort) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostAbort[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size, err := m.Canary.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.PostPromotion) > 0 {
+		for iNdEx := len(m.PostPromotion) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostPromotion[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x7a
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PreRollout) > 0 {
+		for iNdEx := len(m.PreRollout) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PreRollout[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5966,139 +7648,60 @@ func (m *RolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x72
+			dAtA[i] = 0xa
 		}
 	}
-	i -= len(m.ObservedGeneration)
-	copy(dAtA[i:], m.ObservedGeneration)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ObservedGeneration)))
-	i--
-	dAtA[i] = 0x6a
-	if m.CollisionCount != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.CollisionCount))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.CurrentStepIndex != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.CurrentStepIndex))
-		i--
-		dAtA[i] = 0x58
-	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.AvailableReplicas))
-	i--
-	dAtA[i] = 0x50
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
-	i--
-	dAtA[i] = 0x48
-	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedReplicas))
-	i--
-	dAtA[i] = 0x40
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
-	i--
-	dAtA[i] = 0x38
-	i -= len(m.CurrentStepHash)
-	copy(dAtA[i:], m.CurrentStepHash)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentStepHash)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.CurrentPodHash)
-	copy(dAtA[i:], m.CurrentPodHash)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentPodHash)))
-	i--
-	dAtA[i] = 0x2a
-	if m.AbortedAt != nil {
-		{
-			size, err := m.AbortedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	i--
-	if m.ControllerPause {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x18
-	if len(m.PauseConditions) > 0 {
-		for iNdEx := len(m.PauseConditions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PauseConditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	i--
-	if m.Abort {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *RolloutStrategy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *RolloutStrategy) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Canary != nil {
-		{
-			size, err := m.Canary.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.BlueGreen != nil {
-		{
-			size, err := m.BlueGreen.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutTrafficRouting) Marshal() (dAtA []byte, err error) {
+func (m *RolloutPause) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6108,67 +7711,19 @@ func (m *RolloutTrafficRouting) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutPause) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Ambassador != nil {
-		{
-			size, err := m.Ambassador.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SMI != nil {
-		{
-			size, err := m.SMI.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ALB != nil {
-		{
-			size, err := m.ALB.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Nginx != nil {
-		{
-			size, err := m.Nginx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Istio != nil {
+	if m.Duration != nil {
 		{
-			size, err := m.Istio.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6181,7 +7736,7 @@ func (m *RolloutTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SMITrafficRouting) Marshal() (dAtA []byte, err error) {
+func (m *RolloutRevision) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6191,30 +7746,40 @@ func (m *SMITrafficRouting) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SMITrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutRevision) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SMITrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.TrafficSplitName)
-	copy(dAtA[i:], m.TrafficSplitName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TrafficSplitName)))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.RootService)
-	copy(dAtA[i:], m.RootService)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RootService)))
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ScopeDetail) Marshal() (dAtA []byte, err error) {
+func (m *RolloutRevisionList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6224,43 +7789,44 @@ func (m *ScopeDetail) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScopeDetail) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutRevisionList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScopeDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutRevisionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.End)
-	copy(dAtA[i:], m.End)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Start)
-	copy(dAtA[i:], m.Start)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
-	i--
-	dAtA[i] = 0x22
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Step))
-	i--
-	dAtA[i] = 0x18
-	i -= len(m.Region)
-	copy(dAtA[i:], m.Region)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Scope)
-	copy(dAtA[i:], m.Scope)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scope)))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SecretKeyRef) Marshal() (dAtA []byte, err error) {
+func (m *RolloutRevisionSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6270,30 +7836,117 @@ func (m *SecretKeyRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SecretKeyRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutRevisionSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SecretKeyRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutRevisionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	if len(m.Analyses) > 0 {
+		for iNdEx := len(m.Analyses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Analyses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	i -= len(m.AbortReason)
+	copy(dAtA[i:], m.AbortReason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AbortReason)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.PromotedBy)
+	copy(dAtA[i:], m.PromotedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PromotedBy)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Result)
+	copy(dAtA[i:], m.Result)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Result)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.PodTemplateHash)
+	copy(dAtA[i:], m.PodTemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Revision))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.RolloutName)
+	copy(dAtA[i:], m.RolloutName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RolloutName)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SetCanaryScale) Marshal() (dAtA []byte, err error) {
+func (m *RolloutSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6303,59 +7956,97 @@ func (m *SetCanaryScale) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetCanaryScale) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetCanaryScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.RolloutRevisionLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RolloutRevisionLimit))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Hooks != nil {
+		{
+			size, err := m.Hooks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RollbackWindow != nil {
+		{
+			size, err := m.RollbackWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	i--
-	if m.MatchTrafficWeight {
+	if m.ProgressDeadlineAbort {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0x18
-	if m.Replicas != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
+	dAtA[i] = 0x58
+	if m.WorkloadRef != nil {
+		{
+			size, err := m.WorkloadRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x52
 	}
-	if m.Weight != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Weight))
+	if m.RestartAt != nil {
+		{
+			size, err := m.RestartAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x4a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TemplateSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ProgressDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ProgressDeadlineSeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	i--
+	if m.Paused {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if m.RevisionHistoryLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
+		i--
+		dAtA[i] = 0x30
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TemplateSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6364,6 +8055,19 @@ func (m *TemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MinReadySeconds))
+	i--
+	dAtA[i] = 0x20
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Selector != nil {
 		{
 			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
@@ -6374,25 +8078,17 @@ func (m *TemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.MinReadySeconds))
-	i--
-	dAtA[i] = 0x18
 	if m.Replicas != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x8
 	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TemplateStatus) Marshal() (dAtA []byte, err error) {
+func (m *RolloutStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6402,64 +8098,215 @@ func (m *TemplateStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TemplateStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TemplateStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastTransitionTime != nil {
-		{
-			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
-		i--
-		dAtA[i] = 0x4a
 	}
+	i -= len(m.WorkloadObservedGeneration)
+	copy(dAtA[i:], m.WorkloadObservedGeneration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WorkloadObservedGeneration)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
 	i -= len(m.Message)
 	copy(dAtA[i:], m.Message)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
-	dAtA[i] = 0x42
-	i -= len(m.Status)
-	copy(dAtA[i:], m.Status)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x3a
-	if m.CollisionCount != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.CollisionCount))
-		i--
-		dAtA[i] = 0x30
-	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.AvailableReplicas))
+	dAtA[i] = 0xba
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i--
-	dAtA[i] = 0x28
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
+	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0x20
-	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedReplicas))
+	dAtA[i] = 0xb2
 	i--
-	dAtA[i] = 0x18
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	if m.PromoteFull {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
 	i--
-	dAtA[i] = 0x10
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0xa8
+	if m.RestartedAt != nil {
+		{
+			size, err := m.RestartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	i -= len(m.StableRS)
+	copy(dAtA[i:], m.StableRS)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StableRS)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	i -= len(m.Selector)
+	copy(dAtA[i:], m.Selector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selector)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	i = encodeVarintGenerated(dAtA, i, uint64(m.HPAReplicas))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x88
+	{
+		size, err := m.BlueGreen.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.Canary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	i -= len(m.ObservedGeneration)
+	copy(dAtA[i:], m.ObservedGeneration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ObservedGeneration)))
+	i--
+	dAtA[i] = 0x6a
+	if m.CollisionCount != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.CollisionCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CurrentStepIndex != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.CurrentStepIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.AvailableReplicas))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedReplicas))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.CurrentStepHash)
+	copy(dAtA[i:], m.CurrentStepHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentStepHash)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.CurrentPodHash)
+	copy(dAtA[i:], m.CurrentPodHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentPodHash)))
+	i--
+	dAtA[i] = 0x2a
+	if m.AbortedAt != nil {
+		{
+			size, err := m.AbortedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i--
+	if m.ControllerPause {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if len(m.PauseConditions) > 0 {
+		for iNdEx := len(m.PauseConditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PauseConditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i--
+	if m.Abort {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *ValueFrom) Marshal() (dAtA []byte, err error) {
+func (m *RolloutStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6469,19 +8316,19 @@ func (m *ValueFrom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValueFrom) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutStrategy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FieldRef != nil {
+	if m.Canary != nil {
 		{
-			size, err := m.FieldRef.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Canary.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6491,9 +8338,9 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.SecretKeyRef != nil {
+	if m.BlueGreen != nil {
 		{
-			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BlueGreen.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6506,7 +8353,7 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WavefrontMetric) Marshal() (dAtA []byte, err error) {
+func (m *RolloutTimeWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6516,30 +8363,44 @@ func (m *WavefrontMetric) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WavefrontMetric) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutTimeWindow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WavefrontMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutTimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Query)
-	copy(dAtA[i:], m.Query)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.DaysOfWeek) > 0 {
+		for iNdEx := len(m.DaysOfWeek) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DaysOfWeek[iNdEx])
+			copy(dAtA[i:], m.DaysOfWeek[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DaysOfWeek[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.EndTime)
+	copy(dAtA[i:], m.EndTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EndTime)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Address)
-	copy(dAtA[i:], m.Address)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i -= len(m.StartTime)
+	copy(dAtA[i:], m.StartTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StartTime)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WebMetric) Marshal() (dAtA []byte, err error) {
+func (m *RolloutTrafficRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6549,513 +8410,665 @@ func (m *WebMetric) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WebMetric) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WebMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.Insecure {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x28
-	i -= len(m.JSONPath)
-	copy(dAtA[i:], m.JSONPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
-	i--
-	dAtA[i] = 0x22
-	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
-	i--
-	dAtA[i] = 0x18
-	if len(m.Headers) > 0 {
-		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Ambassador != nil {
+		{
+			size, err := m.Ambassador.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *WebMetricHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.SMI != nil {
+		{
+			size, err := m.SMI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return dAtA[:n], nil
-}
-
-func (m *WebMetricHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *WebMetricHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	if m.ALB != nil {
+		{
+			size, err := m.ALB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nginx != nil {
+		{
+			size, err := m.Nginx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Istio != nil {
+		{
+			size, err := m.Istio.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SMITrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SMITrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SMITrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i -= len(m.TrafficSplitName)
+	copy(dAtA[i:], m.TrafficSplitName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TrafficSplitName)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Key)
-	copy(dAtA[i:], m.Key)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i -= len(m.RootService)
+	copy(dAtA[i:], m.RootService)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RootService)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScopeDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ALBTrafficRouting) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Ingress)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.ServicePort))
-	l = len(m.RootService)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AnnotationPrefix)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+
+func (m *ScopeDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AmbassadorTrafficRouting) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScopeDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Mappings) > 0 {
-		for _, s := range m.Mappings {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+	i -= len(m.End)
+	copy(dAtA[i:], m.End)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Start)
+	copy(dAtA[i:], m.Start)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Step))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Scope)
+	copy(dAtA[i:], m.Scope)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Scope)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRun) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SecretKeyRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AnalysisRunArgument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.ValueFrom != nil {
-		l = m.ValueFrom.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+func (m *SecretKeyRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AnalysisRunList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SecretKeyRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRunSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetCanaryScale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCanaryScale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCanaryScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Metrics) > 0 {
-		for _, e := range m.Metrics {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i--
+	if m.MatchTrafficWeight {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if len(m.Args) > 0 {
-		for _, e := range m.Args {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x18
+	if m.Replicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
+		i--
+		dAtA[i] = 0x10
 	}
-	n += 2
-	return n
+	if m.Weight != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Weight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRunStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.MetricResults) > 0 {
-		for _, e := range m.MetricResults {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.StartedAt != nil {
-		l = m.StartedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *SkippedCanaryStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AnalysisTemplate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *SkippedCanaryStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AnalysisTemplateList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SkippedCanaryStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisTemplateSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TemplateSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Metrics) > 0 {
-		for _, e := range m.Metrics {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Args) > 0 {
-		for _, e := range m.Args {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MinReadySeconds))
+	i--
+	dAtA[i] = 0x18
+	if m.Replicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AntiAffinity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PreferredDuringSchedulingIgnoredDuringExecution != nil {
-		l = m.PreferredDuringSchedulingIgnoredDuringExecution.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.RequiredDuringSchedulingIgnoredDuringExecution != nil {
-		l = m.RequiredDuringSchedulingIgnoredDuringExecution.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *TemplateStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Argument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Value != nil {
-		l = len(*m.Value)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.ValueFrom != nil {
-		l = m.ValueFrom.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+func (m *TemplateStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ArgumentValueFrom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TemplateStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PodTemplateHashValue != nil {
-		l = len(*m.PodTemplateHashValue)
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.LastTransitionTime != nil {
+		{
+			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.FieldRef != nil {
-		l = m.FieldRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x3a
+	if m.CollisionCount != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.CollisionCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	i = encodeVarintGenerated(dAtA, i, uint64(m.AvailableReplicas))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ReadyReplicas))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedReplicas))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BlueGreenStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PreviewSelector)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ActiveSelector)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.PrePromotionAnalysisRunStatus != nil {
-		l = m.PrePromotionAnalysisRunStatus.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.PostPromotionAnalysisRunStatus != nil {
-		l = m.PostPromotionAnalysisRunStatus.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *ValueFrom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BlueGreenStrategy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValueFrom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ActiveService)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.PreviewService)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.PreviewReplicaCount != nil {
-		n += 1 + sovGenerated(uint64(*m.PreviewReplicaCount))
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AutoPromotionEnabled != nil {
-		n += 2
+	if m.FieldRef != nil {
+		{
+			size, err := m.FieldRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	n += 1 + sovGenerated(uint64(m.AutoPromotionSeconds))
-	if m.MaxUnavailable != nil {
-		l = m.MaxUnavailable.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.SecretKeyRef != nil {
+		{
+			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.ScaleDownDelaySeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.ScaleDownDelaySeconds))
+	return len(dAtA) - i, nil
+}
+
+func (m *WavefrontMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ScaleDownDelayRevisionLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.ScaleDownDelayRevisionLimit))
+	return dAtA[:n], nil
+}
+
+func (m *WavefrontMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WavefrontMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WebMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PrePromotionAnalysis != nil {
-		l = m.PrePromotionAnalysis.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *WebMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if m.AntiAffinity != nil {
-		l = m.AntiAffinity.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	i--
+	dAtA[i] = 0x18
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.PostPromotionAnalysis != nil {
-		l = m.PostPromotionAnalysis.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WebMetricHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PreviewMetadata != nil {
-		l = m.PreviewMetadata.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *WebMetricHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebMetricHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	if m.ActiveMetadata != nil {
-		l = m.ActiveMetadata.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ALBTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
+	l = len(m.Ingress)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ServicePort))
+	l = len(m.RootService)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AnnotationPrefix)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *CanaryStatus) Size() (n int) {
+func (m *AmbassadorTrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CurrentStepAnalysisRunStatus != nil {
-		l = m.CurrentStepAnalysisRunStatus.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.CurrentBackgroundAnalysisRunStatus != nil {
-		l = m.CurrentBackgroundAnalysisRunStatus.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Mappings) > 0 {
+		for _, s := range m.Mappings {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	l = len(m.CurrentExperiment)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *CanaryStep) Size() (n int) {
+func (m *AnalysisRun) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SetWeight != nil {
-		n += 1 + sovGenerated(uint64(*m.SetWeight))
-	}
-	if m.Pause != nil {
-		l = m.Pause.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Experiment != nil {
-		l = m.Experiment.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Analysis != nil {
-		l = m.Analysis.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SetCanaryScale != nil {
-		l = m.SetCanaryScale.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *CanaryStrategy) Size() (n int) {
+func (m *AnalysisRunArgument) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.CanaryService)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.StableService)
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.TrafficRouting != nil {
-		l = m.TrafficRouting.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.MaxUnavailable != nil {
-		l = m.MaxUnavailable.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.MaxSurge != nil {
-		l = m.MaxSurge.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Analysis != nil {
-		l = m.Analysis.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.AntiAffinity != nil {
-		l = m.AntiAffinity.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.CanaryMetadata != nil {
-		l = m.CanaryMetadata.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.StableMetadata != nil {
-		l = m.StableMetadata.Size()
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.ScaleDownDelaySeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.ScaleDownDelaySeconds))
-	}
-	if m.ScaleDownDelayRevisionLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.ScaleDownDelayRevisionLimit))
-	}
 	return n
 }
 
-func (m *ClusterAnalysisTemplate) Size() (n int) {
+func (m *AnalysisRunJudgment) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.User)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.JudgedAt != nil {
+		l = m.JudgedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *ClusterAnalysisTemplateList) Size() (n int) {
+func (m *AnalysisRunList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7072,103 +9085,79 @@ func (m *ClusterAnalysisTemplateList) Size() (n int) {
 	return n
 }
 
-func (m *DatadogMetric) Size() (n int) {
+func (m *AnalysisRunSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Interval)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Query)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *Experiment) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Metrics) > 0 {
+		for _, e := range m.Metrics {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if m.Judgment != nil {
+		l = m.Judgment.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ExperimentAnalysisRunStatus) Size() (n int) {
+func (m *AnalysisRunStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AnalysisRun)
-	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ExperimentAnalysisTemplateRef) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TemplateName)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if len(m.Args) > 0 {
-		for _, e := range m.Args {
+	if len(m.MetricResults) > 0 {
+		for _, e := range m.MetricResults {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	n += 2
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *ExperimentCondition) Size() (n int) {
+func (m *AnalysisTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastUpdateTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastTransitionTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ExperimentList) Size() (n int) {
+func (m *AnalysisTemplateInclude) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.TemplateName)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	n += 2
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7176,26 +9165,16 @@ func (m *ExperimentList) Size() (n int) {
 	return n
 }
 
-func (m *ExperimentSpec) Size() (n int) {
+func (m *AnalysisTemplateList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Templates) > 0 {
-		for _, e := range m.Templates {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.Duration)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.ProgressDeadlineSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.ProgressDeadlineSeconds))
-	}
-	n += 2
-	if len(m.Analyses) > 0 {
-		for _, e := range m.Analyses {
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7203,34 +9182,26 @@ func (m *ExperimentSpec) Size() (n int) {
 	return n
 }
 
-func (m *ExperimentStatus) Size() (n int) {
+func (m *AnalysisTemplateSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.TemplateStatuses) > 0 {
-		for _, e := range m.TemplateStatuses {
+	if len(m.Metrics) > 0 {
+		for _, e := range m.Metrics {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.AvailableAt != nil {
-		l = m.AvailableAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.AnalysisRuns) > 0 {
-		for _, e := range m.AnalysisRuns {
+	if len(m.Includes) > 0 {
+		for _, e := range m.Includes {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7238,18 +9209,24 @@ func (m *ExperimentStatus) Size() (n int) {
 	return n
 }
 
-func (m *FieldRef) Size() (n int) {
+func (m *AntiAffinity) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.FieldPath)
-	n += 1 + l + sovGenerated(uint64(l))
+	if m.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		l = m.PreferredDuringSchedulingIgnoredDuringExecution.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		l = m.RequiredDuringSchedulingIgnoredDuringExecution.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *IstioDestinationRule) Size() (n int) {
+func (m *Argument) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7257,384 +9234,438 @@ func (m *IstioDestinationRule) Size() (n int) {
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CanarySubsetName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.StableSubsetName)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *IstioTrafficRouting) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Value != nil {
+		l = len(*m.Value)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	var l int
-	_ = l
-	l = m.VirtualService.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.DestinationRule != nil {
-		l = m.DestinationRule.Size()
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *IstioVirtualService) Size() (n int) {
+func (m *ArgumentValueFrom) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Routes) > 0 {
-		for _, s := range m.Routes {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.PodTemplateHashValue != nil {
+		l = len(*m.PodTemplateHashValue)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FieldRef != nil {
+		l = m.FieldRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RolloutStatusValue != nil {
+		l = len(*m.RolloutStatusValue)
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *JobMetric) Size() (n int) {
+func (m *BlueGreenStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Metadata.Size()
+	l = len(m.PreviewSelector)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.ActiveSelector)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.PrePromotionAnalysisRunStatus != nil {
+		l = m.PrePromotionAnalysisRunStatus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PostPromotionAnalysisRunStatus != nil {
+		l = m.PostPromotionAnalysisRunStatus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *KayentaMetric) Size() (n int) {
+func (m *BlueGreenStrategy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Application)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CanaryConfigName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MetricsAccountName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ConfigurationAccountName)
+	l = len(m.ActiveService)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.StorageAccountName)
+	l = len(m.PreviewService)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Threshold.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Scopes) > 0 {
-		for _, e := range m.Scopes {
-			l = e.Size()
+	if m.PreviewReplicaCount != nil {
+		n += 1 + sovGenerated(uint64(*m.PreviewReplicaCount))
+	}
+	if m.AutoPromotionEnabled != nil {
+		n += 2
+	}
+	n += 1 + sovGenerated(uint64(m.AutoPromotionSeconds))
+	if m.MaxUnavailable != nil {
+		l = m.MaxUnavailable.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ScaleDownDelaySeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ScaleDownDelaySeconds))
+	}
+	if m.ScaleDownDelayRevisionLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.ScaleDownDelayRevisionLimit))
+	}
+	if m.PrePromotionAnalysis != nil {
+		l = m.PrePromotionAnalysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AntiAffinity != nil {
+		l = m.AntiAffinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PostPromotionAnalysis != nil {
+		l = m.PostPromotionAnalysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PreviewMetadata != nil {
+		l = m.PreviewMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ActiveMetadata != nil {
+		l = m.ActiveMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AbortScaleDownDelaySeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.AbortScaleDownDelaySeconds))
+	}
+	if len(m.AdditionalActiveServices) > 0 {
+		for _, s := range m.AdditionalActiveServices {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *KayentaScope) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.AdditionalPreviewServices) > 0 {
+		for _, s := range m.AdditionalPreviewServices {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ControlScope.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ExperimentScope.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *KayentaThreshold) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ManagedServices != nil {
+		l = m.ManagedServices.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	var l int
-	_ = l
-	n += 1 + sovGenerated(uint64(m.Pass))
-	n += 1 + sovGenerated(uint64(m.Marginal))
 	return n
 }
 
-func (m *Measurement) Size() (n int) {
+func (m *CanaryStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.StartedAt != nil {
-		l = m.StartedAt.Size()
+	if m.CurrentStepAnalysisRunStatus != nil {
+		l = m.CurrentStepAnalysisRunStatus.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
+	if m.CurrentBackgroundAnalysisRunStatus != nil {
+		l = m.CurrentBackgroundAnalysisRunStatus.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Value)
+	l = len(m.CurrentExperiment)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.SkippedSteps) > 0 {
+		for _, e := range m.SkippedSteps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.ResumeAt != nil {
-		l = m.ResumeAt.Size()
+	if m.Approval != nil {
+		l = m.Approval.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *Metric) Size() (n int) {
+func (m *CanaryStep) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Interval)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.InitialDelay)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Count != nil {
-		l = m.Count.Size()
+	if m.SetWeight != nil {
+		n += 1 + sovGenerated(uint64(*m.SetWeight))
+	}
+	if m.Pause != nil {
+		l = m.Pause.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.SuccessCondition)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FailureCondition)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.FailureLimit != nil {
-		l = m.FailureLimit.Size()
+	if m.Experiment != nil {
+		l = m.Experiment.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.InconclusiveLimit != nil {
-		l = m.InconclusiveLimit.Size()
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.ConsecutiveErrorLimit != nil {
-		l = m.ConsecutiveErrorLimit.Size()
+	if m.SetCanaryScale != nil {
+		l = m.SetCanaryScale.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.Provider.Size()
+	if m.TimeWindow != nil {
+		l = m.TimeWindow.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.When)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Approval != nil {
+		l = m.Approval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *MetricProvider) Size() (n int) {
+func (m *CanaryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Prometheus != nil {
-		l = m.Prometheus.Size()
+	l = len(m.CanaryService)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StableService)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TrafficRouting != nil {
+		l = m.TrafficRouting.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Kayenta != nil {
-		l = m.Kayenta.Size()
+	if m.MaxUnavailable != nil {
+		l = m.MaxUnavailable.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Web != nil {
-		l = m.Web.Size()
+	if m.MaxSurge != nil {
+		l = m.MaxSurge.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Datadog != nil {
-		l = m.Datadog.Size()
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Wavefront != nil {
-		l = m.Wavefront.Size()
+	if m.AntiAffinity != nil {
+		l = m.AntiAffinity.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.NewRelic != nil {
-		l = m.NewRelic.Size()
+	if m.CanaryMetadata != nil {
+		l = m.CanaryMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Job != nil {
-		l = m.Job.Size()
+	if m.StableMetadata != nil {
+		l = m.StableMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ScaleDownDelaySeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ScaleDownDelaySeconds))
+	}
+	if m.ScaleDownDelayRevisionLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.ScaleDownDelayRevisionLimit))
+	}
+	if m.AbortScaleDownDelaySeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.AbortScaleDownDelaySeconds))
+	}
+	if m.ManagedServices != nil {
+		l = m.ManagedServices.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *MetricResult) Size() (n int) {
+func (m *ClusterAnalysisTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Phase)
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Measurements) > 0 {
-		for _, e := range m.Measurements {
+	return n
+}
+
+func (m *ClusterAnalysisTemplateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Count))
-	n += 1 + sovGenerated(uint64(m.Successful))
-	n += 1 + sovGenerated(uint64(m.Failed))
-	n += 1 + sovGenerated(uint64(m.Inconclusive))
-	n += 1 + sovGenerated(uint64(m.Error))
-	n += 1 + sovGenerated(uint64(m.ConsecutiveError))
 	return n
 }
 
-func (m *NewRelicMetric) Size() (n int) {
+func (m *ClusterRolloutFreeze) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Profile)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Query)
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *NginxTrafficRouting) Size() (n int) {
+func (m *ClusterRolloutFreezeList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AnnotationPrefix)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.StableIngress)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.AdditionalIngressAnnotations) > 0 {
-		for k, v := range m.AdditionalIngressAnnotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *ObjectRef) Size() (n int) {
+func (m *ConfigMapKeyRef) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.APIVersion)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PauseCondition) Size() (n int) {
+func (m *DatadogMetric) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Reason)
+	l = len(m.Interval)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.StartTime.Size()
+	l = len(m.Query)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PodTemplateMetadata) Size() (n int) {
+func (m *Experiment) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PreferredDuringSchedulingIgnoredDuringExecution) Size() (n int) {
+func (m *ExperimentAnalysisRunStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.Weight))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AnalysisRun)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PrometheusMetric) Size() (n int) {
+func (m *ExperimentAnalysisTemplateRef) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Query)
+	l = len(m.TemplateName)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
-func (m *RequiredDuringSchedulingIgnoredDuringExecution) Size() (n int) {
+func (m *ExperimentCondition) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastUpdateTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Rollout) Size() (n int) {
+func (m *ExperimentList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *RolloutAnalysis) Size() (n int) {
+func (m *ExperimentSpec) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7646,8 +9677,14 @@ func (m *RolloutAnalysis) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Args) > 0 {
-		for _, e := range m.Args {
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProgressDeadlineSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ProgressDeadlineSeconds))
+	}
+	n += 2
+	if len(m.Analyses) > 0 {
+		for _, e := range m.Analyses {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7655,92 +9692,98 @@ func (m *RolloutAnalysis) Size() (n int) {
 	return n
 }
 
-func (m *RolloutAnalysisBackground) Size() (n int) {
+func (m *ExperimentStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RolloutAnalysis.Size()
+	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.StartingStep != nil {
-		n += 1 + sovGenerated(uint64(*m.StartingStep))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.TemplateStatuses) > 0 {
+		for _, e := range m.TemplateStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.AvailableAt != nil {
+		l = m.AvailableAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.AnalysisRuns) > 0 {
+		for _, e := range m.AnalysisRuns {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *RolloutAnalysisRunStatus) Size() (n int) {
+func (m *FieldRef) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.FieldPath)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RolloutAnalysisTemplate) Size() (n int) {
+func (m *FreezeWindow) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.TemplateName)
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimeZone)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
 	return n
 }
 
-func (m *RolloutCondition) Size() (n int) {
+func (m *IstioDestinationRule) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastUpdateTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastTransitionTime.Size()
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
+	l = len(m.CanarySubsetName)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.StableSubsetName)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RolloutExperimentStep) Size() (n int) {
+func (m *IstioTrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Templates) > 0 {
-		for _, e := range m.Templates {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.Duration)
+	l = m.VirtualService.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Analyses) > 0 {
-		for _, e := range m.Analyses {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.DestinationRule != nil {
+		l = m.DestinationRule.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *RolloutExperimentStepAnalysisTemplateRef) Size() (n int) {
+func (m *IstioVirtualService) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7748,51 +9791,50 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Size() (n int) {
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TemplateName)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if len(m.Args) > 0 {
-		for _, e := range m.Args {
-			l = e.Size()
+	if len(m.Routes) > 0 {
+		for _, s := range m.Routes {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	n += 2
 	return n
 }
 
-func (m *RolloutExperimentTemplate) Size() (n int) {
+func (m *JobMetric) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SpecRef)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
-	}
 	l = m.Metadata.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Selector != nil {
-		l = m.Selector.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RolloutList) Size() (n int) {
+func (m *KayentaMetric) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Address)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	l = len(m.Application)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CanaryConfigName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MetricsAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConfigurationAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StorageAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Scopes) > 0 {
+		for _, e := range m.Scopes {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7800,287 +9842,289 @@ func (m *RolloutList) Size() (n int) {
 	return n
 }
 
-func (m *RolloutPause) Size() (n int) {
+func (m *KayentaScope) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Duration != nil {
-		l = m.Duration.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ControlScope.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ExperimentScope.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RolloutSpec) Size() (n int) {
+func (m *KayentaThreshold) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
-	}
-	if m.Selector != nil {
-		l = m.Selector.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = m.Template.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.MinReadySeconds))
-	l = m.Strategy.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.RevisionHistoryLimit != nil {
-		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
-	}
-	n += 2
-	if m.ProgressDeadlineSeconds != nil {
-		n += 1 + sovGenerated(uint64(*m.ProgressDeadlineSeconds))
-	}
-	if m.RestartAt != nil {
-		l = m.RestartAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.WorkloadRef != nil {
-		l = m.WorkloadRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	n += 1 + sovGenerated(uint64(m.Pass))
+	n += 1 + sovGenerated(uint64(m.Marginal))
 	return n
 }
 
-func (m *RolloutStatus) Size() (n int) {
+func (m *ManagedServices) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 2
-	if len(m.PauseConditions) > 0 {
-		for _, e := range m.PauseConditions {
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	n += 2
-	if m.AbortedAt != nil {
-		l = m.AbortedAt.Size()
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.CurrentPodHash)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CurrentStepHash)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Replicas))
-	n += 1 + sovGenerated(uint64(m.UpdatedReplicas))
-	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
-	n += 1 + sovGenerated(uint64(m.AvailableReplicas))
-	if m.CurrentStepIndex != nil {
-		n += 1 + sovGenerated(uint64(*m.CurrentStepIndex))
+	return n
+}
+
+func (m *Measurement) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.CollisionCount != nil {
-		n += 1 + sovGenerated(uint64(*m.CollisionCount))
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.ObservedGeneration)
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	l = m.Canary.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.BlueGreen.Size()
-	n += 2 + l + sovGenerated(uint64(l))
-	n += 2 + sovGenerated(uint64(m.HPAReplicas))
-	l = len(m.Selector)
-	n += 2 + l + sovGenerated(uint64(l))
-	l = len(m.StableRS)
-	n += 2 + l + sovGenerated(uint64(l))
-	if m.RestartedAt != nil {
-		l = m.RestartedAt.Size()
-		n += 2 + l + sovGenerated(uint64(l))
+	if m.ResumeAt != nil {
+		l = m.ResumeAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	n += 3
-	l = len(m.Phase)
-	n += 2 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RolloutStrategy) Size() (n int) {
+func (m *Metric) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BlueGreen != nil {
-		l = m.BlueGreen.Size()
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.InitialDelay)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Count != nil {
+		l = m.Count.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Canary != nil {
-		l = m.Canary.Size()
+	l = len(m.SuccessCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FailureCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FailureLimit != nil {
+		l = m.FailureLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.InconclusiveLimit != nil {
+		l = m.InconclusiveLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ConsecutiveErrorLimit != nil {
+		l = m.ConsecutiveErrorLimit.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = m.Provider.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EvaluationMode)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RolloutTrafficRouting) Size() (n int) {
+func (m *MetricProvider) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Istio != nil {
-		l = m.Istio.Size()
+	if m.Prometheus != nil {
+		l = m.Prometheus.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Nginx != nil {
-		l = m.Nginx.Size()
+	if m.Kayenta != nil {
+		l = m.Kayenta.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.ALB != nil {
-		l = m.ALB.Size()
+	if m.Web != nil {
+		l = m.Web.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.SMI != nil {
-		l = m.SMI.Size()
+	if m.Datadog != nil {
+		l = m.Datadog.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Ambassador != nil {
-		l = m.Ambassador.Size()
+	if m.Wavefront != nil {
+		l = m.Wavefront.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NewRelic != nil {
+		l = m.NewRelic.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *SMITrafficRouting) Size() (n int) {
+func (m *MetricResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RootService)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TrafficSplitName)
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Measurements) > 0 {
+		for _, e := range m.Measurements {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Count))
+	n += 1 + sovGenerated(uint64(m.Successful))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	n += 1 + sovGenerated(uint64(m.Inconclusive))
+	n += 1 + sovGenerated(uint64(m.Error))
+	n += 1 + sovGenerated(uint64(m.ConsecutiveError))
 	return n
 }
 
-func (m *ScopeDetail) Size() (n int) {
+func (m *NewRelicMetric) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Scope)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Region)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Step))
-	l = len(m.Start)
+	l = len(m.Profile)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.End)
+	l = len(m.Query)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *SecretKeyRef) Size() (n int) {
+func (m *NginxTrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.AnnotationPrefix)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Key)
+	l = len(m.StableIngress)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.AdditionalIngressAnnotations) > 0 {
+		for k, v := range m.AdditionalIngressAnnotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
-func (m *SetCanaryScale) Size() (n int) {
+func (m *ObjectRef) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Weight != nil {
-		n += 1 + sovGenerated(uint64(*m.Weight))
-	}
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
-	}
-	n += 2
+	l = len(m.APIVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *TemplateSpec) Size() (n int) {
+func (m *PauseCondition) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Reason)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
-	}
-	n += 1 + sovGenerated(uint64(m.MinReadySeconds))
-	if m.Selector != nil {
-		l = m.Selector.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = m.Template.Size()
+	l = m.StartTime.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *TemplateStatus) Size() (n int) {
+func (m *PodTemplateMetadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Replicas))
-	n += 1 + sovGenerated(uint64(m.UpdatedReplicas))
-	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
-	n += 1 + sovGenerated(uint64(m.AvailableReplicas))
-	if m.CollisionCount != nil {
-		n += 1 + sovGenerated(uint64(*m.CollisionCount))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.LastTransitionTime != nil {
-		l = m.LastTransitionTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *ValueFrom) Size() (n int) {
+func (m *PreferredDuringSchedulingIgnoredDuringExecution) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SecretKeyRef != nil {
-		l = m.SecretKeyRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FieldRef != nil {
-		l = m.FieldRef.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	n += 1 + sovGenerated(uint64(m.Weight))
 	return n
 }
 
-func (m *WavefrontMetric) Size() (n int) {
+func (m *PrometheusMetric) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -8093,1178 +10137,7286 @@ func (m *WavefrontMetric) Size() (n int) {
 	return n
 }
 
-func (m *WebMetric) Size() (n int) {
+func (m *RequiredDuringSchedulingIgnoredDuringExecution) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
-	l = len(m.JSONPath)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
 	return n
 }
 
-func (m *WebMetricHeader) Size() (n int) {
+func (m *RevisionAnalysis) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
+	l = len(m.Type)
 	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	if m.StepIndex != nil {
+		n += 1 + sovGenerated(uint64(*m.StepIndex))
+	}
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ALBTrafficRouting) String() string {
-	if this == nil {
-		return "nil"
+func (m *RevisionImage) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ALBTrafficRouting{`,
-		`Ingress:` + fmt.Sprintf("%v", this.Ingress) + `,`,
-		`ServicePort:` + fmt.Sprintf("%v", this.ServicePort) + `,`,
-		`RootService:` + fmt.Sprintf("%v", this.RootService) + `,`,
-		`AnnotationPrefix:` + fmt.Sprintf("%v", this.AnnotationPrefix) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *AmbassadorTrafficRouting) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RevisionStep) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AmbassadorTrafficRouting{`,
-		`Mappings:` + fmt.Sprintf("%v", this.Mappings) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Index))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *AnalysisRun) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RollbackWindowSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AnalysisRun{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AnalysisRunSpec", "AnalysisRunSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "AnalysisRunStatus", "AnalysisRunStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Revisions))
+	return n
 }
-func (this *AnalysisRunArgument) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Rollout) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AnalysisRunArgument{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ArgumentValueFrom", "ArgumentValueFrom", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *AnalysisRunList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]AnalysisRun{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AnalysisRun", "AnalysisRun", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&AnalysisRunList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AnalysisRunSpec) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForMetrics := "[]Metric{"
-	for _, f := range this.Metrics {
-		repeatedStringForMetrics += strings.Replace(strings.Replace(f.String(), "Metric", "Metric", 1), `&`, ``, 1) + ","
+	return n
+}
+
+func (m *RolloutAnalysisBackground) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForMetrics += "}"
-	repeatedStringForArgs := "[]Argument{"
-	for _, f := range this.Args {
-		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.RolloutAnalysis.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartingStep != nil {
+		n += 1 + sovGenerated(uint64(*m.StartingStep))
 	}
-	repeatedStringForArgs += "}"
-	s := strings.Join([]string{`&AnalysisRunSpec{`,
-		`Metrics:` + repeatedStringForMetrics + `,`,
-		`Args:` + repeatedStringForArgs + `,`,
-		`Terminate:` + fmt.Sprintf("%v", this.Terminate) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *AnalysisRunStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForMetricResults := "[]MetricResult{"
-	for _, f := range this.MetricResults {
-		repeatedStringForMetricResults += strings.Replace(strings.Replace(f.String(), "MetricResult", "MetricResult", 1), `&`, ``, 1) + ","
+
+func (m *RolloutAnalysisRunStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForMetricResults += "}"
-	s := strings.Join([]string{`&AnalysisRunStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`MetricResults:` + repeatedStringForMetricResults + `,`,
-		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *AnalysisTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutAnalysisTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AnalysisTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AnalysisTemplateSpec", "AnalysisTemplateSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.TemplateName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *AnalysisTemplateList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutApprovalHeader) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]AnalysisTemplate{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AnalysisTemplate", "AnalysisTemplate", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&AnalysisTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *AnalysisTemplateSpec) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForMetrics := "[]Metric{"
-	for _, f := range this.Metrics {
-		repeatedStringForMetrics += strings.Replace(strings.Replace(f.String(), "Metric", "Metric", 1), `&`, ``, 1) + ","
+
+func (m *RolloutApprovalHeaderSource) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForMetrics += "}"
-	repeatedStringForArgs := "[]Argument{"
-	for _, f := range this.Args {
-		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.SecretKeyRef != nil {
+		l = m.SecretKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForArgs += "}"
-	s := strings.Join([]string{`&AnalysisTemplateSpec{`,
-		`Metrics:` + repeatedStringForMetrics + `,`,
-		`Args:` + repeatedStringForArgs + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *AntiAffinity) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutApprovalStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AntiAffinity{`,
-		`PreferredDuringSchedulingIgnoredDuringExecution:` + strings.Replace(this.PreferredDuringSchedulingIgnoredDuringExecution.String(), "PreferredDuringSchedulingIgnoredDuringExecution", "PreferredDuringSchedulingIgnoredDuringExecution", 1) + `,`,
-		`RequiredDuringSchedulingIgnoredDuringExecution:` + strings.Replace(this.RequiredDuringSchedulingIgnoredDuringExecution.String(), "RequiredDuringSchedulingIgnoredDuringExecution", "RequiredDuringSchedulingIgnoredDuringExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Argument) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.StepIndex))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RequestedAt != nil {
+		l = m.RequestedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Argument{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + valueToStringGenerated(this.Value) + `,`,
-		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ValueFrom", "ValueFrom", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArgumentValueFrom) String() string {
-	if this == nil {
-		return "nil"
+	if m.LastRequestedAt != nil {
+		l = m.LastRequestedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ArgumentValueFrom{`,
-		`PodTemplateHashValue:` + valueToStringGenerated(this.PodTemplateHashValue) + `,`,
-		`FieldRef:` + strings.Replace(this.FieldRef.String(), "FieldRef", "FieldRef", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Error)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *BlueGreenStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutApprovalStep) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&BlueGreenStatus{`,
-		`PreviewSelector:` + fmt.Sprintf("%v", this.PreviewSelector) + `,`,
-		`ActiveSelector:` + fmt.Sprintf("%v", this.ActiveSelector) + `,`,
-		`ScaleUpPreviewCheckPoint:` + fmt.Sprintf("%v", this.ScaleUpPreviewCheckPoint) + `,`,
-		`PrePromotionAnalysisRunStatus:` + strings.Replace(this.PrePromotionAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
-		`PostPromotionAnalysisRunStatus:` + strings.Replace(this.PostPromotionAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BlueGreenStrategy) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&BlueGreenStrategy{`,
-		`ActiveService:` + fmt.Sprintf("%v", this.ActiveService) + `,`,
-		`PreviewService:` + fmt.Sprintf("%v", this.PreviewService) + `,`,
-		`PreviewReplicaCount:` + valueToStringGenerated(this.PreviewReplicaCount) + `,`,
-		`AutoPromotionEnabled:` + valueToStringGenerated(this.AutoPromotionEnabled) + `,`,
-		`AutoPromotionSeconds:` + fmt.Sprintf("%v", this.AutoPromotionSeconds) + `,`,
-		`MaxUnavailable:` + strings.Replace(fmt.Sprintf("%v", this.MaxUnavailable), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`ScaleDownDelaySeconds:` + valueToStringGenerated(this.ScaleDownDelaySeconds) + `,`,
-		`ScaleDownDelayRevisionLimit:` + valueToStringGenerated(this.ScaleDownDelayRevisionLimit) + `,`,
-		`PrePromotionAnalysis:` + strings.Replace(this.PrePromotionAnalysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
-		`AntiAffinity:` + strings.Replace(this.AntiAffinity.String(), "AntiAffinity", "AntiAffinity", 1) + `,`,
-		`PostPromotionAnalysis:` + strings.Replace(this.PostPromotionAnalysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
-		`PreviewMetadata:` + strings.Replace(this.PreviewMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
-		`ActiveMetadata:` + strings.Replace(this.ActiveMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.PollInterval)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *CanaryStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutCondition) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CanaryStatus{`,
-		`CurrentStepAnalysisRunStatus:` + strings.Replace(this.CurrentStepAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
-		`CurrentBackgroundAnalysisRunStatus:` + strings.Replace(this.CurrentBackgroundAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
-		`CurrentExperiment:` + fmt.Sprintf("%v", this.CurrentExperiment) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastUpdateTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *CanaryStep) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutExperimentStep) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CanaryStep{`,
-		`SetWeight:` + valueToStringGenerated(this.SetWeight) + `,`,
-		`Pause:` + strings.Replace(this.Pause.String(), "RolloutPause", "RolloutPause", 1) + `,`,
-		`Experiment:` + strings.Replace(this.Experiment.String(), "RolloutExperimentStep", "RolloutExperimentStep", 1) + `,`,
-		`Analysis:` + strings.Replace(this.Analysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
-		`SetCanaryScale:` + strings.Replace(this.SetCanaryScale.String(), "SetCanaryScale", "SetCanaryScale", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CanaryStrategy) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForSteps := "[]CanaryStep{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "CanaryStep", "CanaryStep", 1), `&`, ``, 1) + ","
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Analyses) > 0 {
+		for _, e := range m.Analyses {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForSteps += "}"
-	s := strings.Join([]string{`&CanaryStrategy{`,
-		`CanaryService:` + fmt.Sprintf("%v", this.CanaryService) + `,`,
-		`StableService:` + fmt.Sprintf("%v", this.StableService) + `,`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`TrafficRouting:` + strings.Replace(this.TrafficRouting.String(), "RolloutTrafficRouting", "RolloutTrafficRouting", 1) + `,`,
-		`MaxUnavailable:` + strings.Replace(fmt.Sprintf("%v", this.MaxUnavailable), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`MaxSurge:` + strings.Replace(fmt.Sprintf("%v", this.MaxSurge), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`Analysis:` + strings.Replace(this.Analysis.String(), "RolloutAnalysisBackground", "RolloutAnalysisBackground", 1) + `,`,
-		`AntiAffinity:` + strings.Replace(this.AntiAffinity.String(), "AntiAffinity", "AntiAffinity", 1) + `,`,
-		`CanaryMetadata:` + strings.Replace(this.CanaryMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
-		`StableMetadata:` + strings.Replace(this.StableMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
-		`ScaleDownDelaySeconds:` + valueToStringGenerated(this.ScaleDownDelaySeconds) + `,`,
-		`ScaleDownDelayRevisionLimit:` + valueToStringGenerated(this.ScaleDownDelayRevisionLimit) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ClusterAnalysisTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutExperimentStepAnalysisTemplateRef) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ClusterAnalysisTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AnalysisTemplateSpec", "AnalysisTemplateSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TemplateName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	return n
 }
-func (this *ClusterAnalysisTemplateList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutExperimentTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]ClusterAnalysisTemplate{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterAnalysisTemplate", "ClusterAnalysisTemplate", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SpecRef)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ClusterAnalysisTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.Metadata.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *DatadogMetric) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutFreeze) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DatadogMetric{`,
-		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
-		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Experiment) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutFreezeList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Experiment{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ExperimentSpec", "ExperimentSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ExperimentStatus", "ExperimentStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ExperimentAnalysisRunStatus) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ExperimentAnalysisRunStatus{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`AnalysisRun:` + fmt.Sprintf("%v", this.AnalysisRun) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ExperimentAnalysisTemplateRef) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutFreezeSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForArgs := "[]Argument{"
-	for _, f := range this.Args {
-		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForArgs += "}"
-	s := strings.Join([]string{`&ExperimentAnalysisTemplateRef{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
-		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
-		`Args:` + repeatedStringForArgs + `,`,
-		`RequiredForCompletion:` + fmt.Sprintf("%v", this.RequiredForCompletion) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ExperimentCondition) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ExperimentCondition{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ExperimentList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutHook) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Experiment{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Experiment", "Experiment", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Job.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FailurePolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutHookStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ExperimentList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PodTemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JobName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Occurrence))
+	return n
 }
-func (this *ExperimentSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutHooks) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTemplates := "[]TemplateSpec{"
-	for _, f := range this.Templates {
-		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "TemplateSpec", "TemplateSpec", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.PreRollout) > 0 {
+		for _, e := range m.PreRollout {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForTemplates += "}"
-	repeatedStringForAnalyses := "[]ExperimentAnalysisTemplateRef{"
-	for _, f := range this.Analyses {
-		repeatedStringForAnalyses += strings.Replace(strings.Replace(f.String(), "ExperimentAnalysisTemplateRef", "ExperimentAnalysisTemplateRef", 1), `&`, ``, 1) + ","
+	if len(m.PostPromotion) > 0 {
+		for _, e := range m.PostPromotion {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForAnalyses += "}"
-	s := strings.Join([]string{`&ExperimentSpec{`,
-		`Templates:` + repeatedStringForTemplates + `,`,
-		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
-		`ProgressDeadlineSeconds:` + valueToStringGenerated(this.ProgressDeadlineSeconds) + `,`,
-		`Terminate:` + fmt.Sprintf("%v", this.Terminate) + `,`,
-		`Analyses:` + repeatedStringForAnalyses + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.PostAbort) > 0 {
+		for _, e := range m.PostAbort {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *ExperimentStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTemplateStatuses := "[]TemplateStatus{"
-	for _, f := range this.TemplateStatuses {
-		repeatedStringForTemplateStatuses += strings.Replace(strings.Replace(f.String(), "TemplateStatus", "TemplateStatus", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForTemplateStatuses += "}"
-	repeatedStringForConditions := "[]ExperimentCondition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "ExperimentCondition", "ExperimentCondition", 1), `&`, ``, 1) + ","
+	return n
+}
+
+func (m *RolloutPause) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForConditions += "}"
-	repeatedStringForAnalysisRuns := "[]ExperimentAnalysisRunStatus{"
-	for _, f := range this.AnalysisRuns {
-		repeatedStringForAnalysisRuns += strings.Replace(strings.Replace(f.String(), "ExperimentAnalysisRunStatus", "ExperimentAnalysisRunStatus", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Duration != nil {
+		l = m.Duration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForAnalysisRuns += "}"
-	s := strings.Join([]string{`&ExperimentStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`TemplateStatuses:` + repeatedStringForTemplateStatuses + `,`,
-		`AvailableAt:` + strings.Replace(fmt.Sprintf("%v", this.AvailableAt), "Time", "v1.Time", 1) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`AnalysisRuns:` + repeatedStringForAnalysisRuns + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FieldRef) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutRevision) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&FieldRef{`,
-		`FieldPath:` + fmt.Sprintf("%v", this.FieldPath) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *IstioDestinationRule) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutRevisionList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&IstioDestinationRule{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`CanarySubsetName:` + fmt.Sprintf("%v", this.CanarySubsetName) + `,`,
-		`StableSubsetName:` + fmt.Sprintf("%v", this.StableSubsetName) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *IstioTrafficRouting) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&IstioTrafficRouting{`,
-		`VirtualService:` + strings.Replace(strings.Replace(this.VirtualService.String(), "IstioVirtualService", "IstioVirtualService", 1), `&`, ``, 1) + `,`,
-		`DestinationRule:` + strings.Replace(this.DestinationRule.String(), "IstioDestinationRule", "IstioDestinationRule", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *IstioVirtualService) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutRevisionSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&IstioVirtualService{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Routes:` + fmt.Sprintf("%v", this.Routes) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobMetric) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.RolloutName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Revision))
+	l = len(m.PodTemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&JobMetric{`,
-		`Metadata:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Metadata), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Spec), "JobSpec", "v11.JobSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KayentaMetric) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Result)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PromotedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AbortReason)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForScopes := "[]KayentaScope{"
-	for _, f := range this.Scopes {
-		repeatedStringForScopes += strings.Replace(strings.Replace(f.String(), "KayentaScope", "KayentaScope", 1), `&`, ``, 1) + ","
+	l = m.FinishedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForScopes += "}"
-	s := strings.Join([]string{`&KayentaMetric{`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`Application:` + fmt.Sprintf("%v", this.Application) + `,`,
-		`CanaryConfigName:` + fmt.Sprintf("%v", this.CanaryConfigName) + `,`,
-		`MetricsAccountName:` + fmt.Sprintf("%v", this.MetricsAccountName) + `,`,
-		`ConfigurationAccountName:` + fmt.Sprintf("%v", this.ConfigurationAccountName) + `,`,
-		`StorageAccountName:` + fmt.Sprintf("%v", this.StorageAccountName) + `,`,
-		`Threshold:` + strings.Replace(strings.Replace(this.Threshold.String(), "KayentaThreshold", "KayentaThreshold", 1), `&`, ``, 1) + `,`,
-		`Scopes:` + repeatedStringForScopes + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KayentaScope) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Analyses) > 0 {
+		for _, e := range m.Analyses {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&KayentaScope{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ControlScope:` + strings.Replace(strings.Replace(this.ControlScope.String(), "ScopeDetail", "ScopeDetail", 1), `&`, ``, 1) + `,`,
-		`ExperimentScope:` + strings.Replace(strings.Replace(this.ExperimentScope.String(), "ScopeDetail", "ScopeDetail", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *KayentaThreshold) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&KayentaThreshold{`,
-		`Pass:` + fmt.Sprintf("%v", this.Pass) + `,`,
-		`Marginal:` + fmt.Sprintf("%v", this.Marginal) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Measurement) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
 	}
-	keysForMetadata := make([]string, 0, len(this.Metadata))
-	for k := range this.Metadata {
-		keysForMetadata = append(keysForMetadata, k)
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
-	mapStringForMetadata := "map[string]string{"
-	for _, k := range keysForMetadata {
-		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MinReadySeconds))
+	l = m.Strategy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RevisionHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
 	}
-	mapStringForMetadata += "}"
-	s := strings.Join([]string{`&Measurement{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Metadata:` + mapStringForMetadata + `,`,
-		`ResumeAt:` + strings.Replace(fmt.Sprintf("%v", this.ResumeAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Metric) String() string {
-	if this == nil {
-		return "nil"
+	n += 2
+	if m.ProgressDeadlineSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ProgressDeadlineSeconds))
 	}
-	s := strings.Join([]string{`&Metric{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
-		`InitialDelay:` + fmt.Sprintf("%v", this.InitialDelay) + `,`,
-		`Count:` + strings.Replace(fmt.Sprintf("%v", this.Count), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
-		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
-		`FailureLimit:` + strings.Replace(fmt.Sprintf("%v", this.FailureLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`InconclusiveLimit:` + strings.Replace(fmt.Sprintf("%v", this.InconclusiveLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`ConsecutiveErrorLimit:` + strings.Replace(fmt.Sprintf("%v", this.ConsecutiveErrorLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
-		`Provider:` + strings.Replace(strings.Replace(this.Provider.String(), "MetricProvider", "MetricProvider", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MetricProvider) String() string {
-	if this == nil {
-		return "nil"
+	if m.RestartAt != nil {
+		l = m.RestartAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&MetricProvider{`,
-		`Prometheus:` + strings.Replace(this.Prometheus.String(), "PrometheusMetric", "PrometheusMetric", 1) + `,`,
-		`Kayenta:` + strings.Replace(this.Kayenta.String(), "KayentaMetric", "KayentaMetric", 1) + `,`,
-		`Web:` + strings.Replace(this.Web.String(), "WebMetric", "WebMetric", 1) + `,`,
-		`Datadog:` + strings.Replace(this.Datadog.String(), "DatadogMetric", "DatadogMetric", 1) + `,`,
-		`Wavefront:` + strings.Replace(this.Wavefront.String(), "WavefrontMetric", "WavefrontMetric", 1) + `,`,
-		`NewRelic:` + strings.Replace(this.NewRelic.String(), "NewRelicMetric", "NewRelicMetric", 1) + `,`,
-		`Job:` + strings.Replace(this.Job.String(), "JobMetric", "JobMetric", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MetricResult) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkloadRef != nil {
+		l = m.WorkloadRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForMeasurements := "[]Measurement{"
-	for _, f := range this.Measurements {
-		repeatedStringForMeasurements += strings.Replace(strings.Replace(f.String(), "Measurement", "Measurement", 1), `&`, ``, 1) + ","
+	n += 2
+	if m.RollbackWindow != nil {
+		l = m.RollbackWindow.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForMeasurements += "}"
-	s := strings.Join([]string{`&MetricResult{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Measurements:` + repeatedStringForMeasurements + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
-		`Successful:` + fmt.Sprintf("%v", this.Successful) + `,`,
-		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
-		`Inconclusive:` + fmt.Sprintf("%v", this.Inconclusive) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`ConsecutiveError:` + fmt.Sprintf("%v", this.ConsecutiveError) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NewRelicMetric) String() string {
-	if this == nil {
-		return "nil"
+	if m.Hooks != nil {
+		l = m.Hooks.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&NewRelicMetric{`,
-		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
-		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.RolloutRevisionLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.RolloutRevisionLimit))
+	}
+	return n
 }
-func (this *NginxTrafficRouting) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForAdditionalIngressAnnotations := make([]string, 0, len(this.AdditionalIngressAnnotations))
-	for k := range this.AdditionalIngressAnnotations {
-		keysForAdditionalIngressAnnotations = append(keysForAdditionalIngressAnnotations, k)
+	var l int
+	_ = l
+	n += 2
+	if len(m.PauseConditions) > 0 {
+		for _, e := range m.PauseConditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAdditionalIngressAnnotations)
-	mapStringForAdditionalIngressAnnotations := "map[string]string{"
-	for _, k := range keysForAdditionalIngressAnnotations {
-		mapStringForAdditionalIngressAnnotations += fmt.Sprintf("%v: %v,", k, this.AdditionalIngressAnnotations[k])
+	n += 2
+	if m.AbortedAt != nil {
+		l = m.AbortedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForAdditionalIngressAnnotations += "}"
-	s := strings.Join([]string{`&NginxTrafficRouting{`,
-		`AnnotationPrefix:` + fmt.Sprintf("%v", this.AnnotationPrefix) + `,`,
-		`StableIngress:` + fmt.Sprintf("%v", this.StableIngress) + `,`,
-		`AdditionalIngressAnnotations:` + mapStringForAdditionalIngressAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ObjectRef) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.CurrentPodHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CurrentStepHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	n += 1 + sovGenerated(uint64(m.UpdatedReplicas))
+	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
+	n += 1 + sovGenerated(uint64(m.AvailableReplicas))
+	if m.CurrentStepIndex != nil {
+		n += 1 + sovGenerated(uint64(*m.CurrentStepIndex))
 	}
-	s := strings.Join([]string{`&ObjectRef{`,
-		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PauseCondition) String() string {
-	if this == nil {
-		return "nil"
+	if m.CollisionCount != nil {
+		n += 1 + sovGenerated(uint64(*m.CollisionCount))
 	}
-	s := strings.Join([]string{`&PauseCondition{`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`StartTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PodTemplateMetadata) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.ObservedGeneration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	l = m.Canary.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.BlueGreen.Size()
+	n += 2 + l + sovGenerated(uint64(l))
+	n += 2 + sovGenerated(uint64(m.HPAReplicas))
+	l = len(m.Selector)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.StableRS)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.RestartedAt != nil {
+		l = m.RestartedAt.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	n += 3
+	l = len(m.Phase)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.WorkloadObservedGeneration)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	return n
+}
+
+func (m *RolloutStrategy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	var l int
+	_ = l
+	if m.BlueGreen != nil {
+		l = m.BlueGreen.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&PodTemplateMetadata{`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PreferredDuringSchedulingIgnoredDuringExecution) String() string {
-	if this == nil {
-		return "nil"
+	if m.Canary != nil {
+		l = m.Canary.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&PreferredDuringSchedulingIgnoredDuringExecution{`,
-		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PrometheusMetric) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutTimeWindow) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PrometheusMetric{`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RequiredDuringSchedulingIgnoredDuringExecution) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.StartTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EndTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DaysOfWeek) > 0 {
+		for _, s := range m.DaysOfWeek {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&RequiredDuringSchedulingIgnoredDuringExecution{`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Rollout) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RolloutTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Rollout{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutSpec", "RolloutSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "RolloutStatus", "RolloutStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RolloutAnalysis) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Istio != nil {
+		l = m.Istio.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForTemplates := "[]RolloutAnalysisTemplate{"
-	for _, f := range this.Templates {
-		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "RolloutAnalysisTemplate", "RolloutAnalysisTemplate", 1), `&`, ``, 1) + ","
+	if m.Nginx != nil {
+		l = m.Nginx.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForTemplates += "}"
-	repeatedStringForArgs := "[]AnalysisRunArgument{"
-	for _, f := range this.Args {
-		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	if m.ALB != nil {
+		l = m.ALB.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForArgs += "}"
-	s := strings.Join([]string{`&RolloutAnalysis{`,
-		`Templates:` + repeatedStringForTemplates + `,`,
-		`Args:` + repeatedStringForArgs + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RolloutAnalysisBackground) String() string {
-	if this == nil {
-		return "nil"
+	if m.SMI != nil {
+		l = m.SMI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&RolloutAnalysisBackground{`,
-		`RolloutAnalysis:` + strings.Replace(strings.Replace(this.RolloutAnalysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1), `&`, ``, 1) + `,`,
-		`StartingStep:` + valueToStringGenerated(this.StartingStep) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Ambassador != nil {
+		l = m.Ambassador.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *RolloutAnalysisRunStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SMITrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RolloutAnalysisRunStatus{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.RootService)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TrafficSplitName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *RolloutAnalysisTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ScopeDetail) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RolloutAnalysisTemplate{`,
-		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
-		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Scope)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Step))
+	l = len(m.Start)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.End)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *RolloutCondition) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SecretKeyRef) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RolloutCondition{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *RolloutExperimentStep) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SetCanaryScale) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTemplates := "[]RolloutExperimentTemplate{"
-	for _, f := range this.Templates {
-		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "RolloutExperimentTemplate", "RolloutExperimentTemplate", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Weight != nil {
+		n += 1 + sovGenerated(uint64(*m.Weight))
 	}
-	repeatedStringForTemplates += "}"
-	repeatedStringForAnalyses := "[]RolloutExperimentStepAnalysisTemplateRef{"
-	for _, f := range this.Analyses {
-		repeatedStringForAnalyses += strings.Replace(strings.Replace(f.String(), "RolloutExperimentStepAnalysisTemplateRef", "RolloutExperimentStepAnalysisTemplateRef", 1), `&`, ``, 1) + ","
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
 	}
-	repeatedStringForAnalyses += "}"
-	s := strings.Join([]string{`&RolloutExperimentStep{`,
-		`Templates:` + repeatedStringForTemplates + `,`,
-		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
-		`Analyses:` + repeatedStringForAnalyses + `,`,
+	n += 2
+	return n
+}
+
+func (m *SkippedCanaryStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Index))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
+	}
+	n += 1 + sovGenerated(uint64(m.MinReadySeconds))
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TemplateStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	n += 1 + sovGenerated(uint64(m.UpdatedReplicas))
+	n += 1 + sovGenerated(uint64(m.ReadyReplicas))
+	n += 1 + sovGenerated(uint64(m.AvailableReplicas))
+	if m.CollisionCount != nil {
+		n += 1 + sovGenerated(uint64(*m.CollisionCount))
+	}
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ValueFrom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecretKeyRef != nil {
+		l = m.SecretKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FieldRef != nil {
+		l = m.FieldRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WavefrontMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WebMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	l = len(m.JSONPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *WebMetricHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ALBTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ALBTrafficRouting{`,
+		`Ingress:` + fmt.Sprintf("%v", this.Ingress) + `,`,
+		`ServicePort:` + fmt.Sprintf("%v", this.ServicePort) + `,`,
+		`RootService:` + fmt.Sprintf("%v", this.RootService) + `,`,
+		`AnnotationPrefix:` + fmt.Sprintf("%v", this.AnnotationPrefix) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RolloutExperimentStepAnalysisTemplateRef) String() string {
+func (this *AmbassadorTrafficRouting) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForArgs := "[]AnalysisRunArgument{"
-	for _, f := range this.Args {
-		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	s := strings.Join([]string{`&AmbassadorTrafficRouting{`,
+		`Mappings:` + fmt.Sprintf("%v", this.Mappings) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalysisRun) String() string {
+	if this == nil {
+		return "nil"
 	}
-	repeatedStringForArgs += "}"
-	s := strings.Join([]string{`&RolloutExperimentStepAnalysisTemplateRef{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
-		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
-		`Args:` + repeatedStringForArgs + `,`,
-		`RequiredForCompletion:` + fmt.Sprintf("%v", this.RequiredForCompletion) + `,`,
+	s := strings.Join([]string{`&AnalysisRun{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AnalysisRunSpec", "AnalysisRunSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "AnalysisRunStatus", "AnalysisRunStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RolloutExperimentTemplate) String() string {
+func (this *AnalysisRunArgument) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RolloutExperimentTemplate{`,
+	s := strings.Join([]string{`&AnalysisRunArgument{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`SpecRef:` + fmt.Sprintf("%v", this.SpecRef) + `,`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1), `&`, ``, 1) + `,`,
-		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ArgumentValueFrom", "ArgumentValueFrom", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RolloutList) String() string {
+func (this *AnalysisRunJudgment) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]Rollout{"
+	s := strings.Join([]string{`&AnalysisRunJudgment{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`User:` + fmt.Sprintf("%v", this.User) + `,`,
+		`JudgedAt:` + strings.Replace(fmt.Sprintf("%v", this.JudgedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalysisRunList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]AnalysisRun{"
 	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Rollout", "Rollout", 1), `&`, ``, 1) + ","
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AnalysisRun", "AnalysisRun", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&RolloutList{`,
+	s := strings.Join([]string{`&AnalysisRunList{`,
 		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RolloutPause) String() string {
+func (this *AnalysisRunSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RolloutPause{`,
-		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "IntOrString", "intstr.IntOrString", 1) + `,`,
+	repeatedStringForMetrics := "[]Metric{"
+	for _, f := range this.Metrics {
+		repeatedStringForMetrics += strings.Replace(strings.Replace(f.String(), "Metric", "Metric", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetrics += "}"
+	repeatedStringForArgs := "[]Argument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&AnalysisRunSpec{`,
+		`Metrics:` + repeatedStringForMetrics + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`Terminate:` + fmt.Sprintf("%v", this.Terminate) + `,`,
+		`Judgment:` + strings.Replace(this.Judgment.String(), "AnalysisRunJudgment", "AnalysisRunJudgment", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RolloutSpec) String() string {
+func (this *AnalysisRunStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RolloutSpec{`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
-		`Template:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Template), "PodTemplateSpec", "v12.PodTemplateSpec", 1), `&`, ``, 1) + `,`,
-		`MinReadySeconds:` + fmt.Sprintf("%v", this.MinReadySeconds) + `,`,
-		`Strategy:` + strings.Replace(strings.Replace(this.Strategy.String(), "RolloutStrategy", "RolloutStrategy", 1), `&`, ``, 1) + `,`,
-		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
-		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
-		`ProgressDeadlineSeconds:` + valueToStringGenerated(this.ProgressDeadlineSeconds) + `,`,
-		`RestartAt:` + strings.Replace(fmt.Sprintf("%v", this.RestartAt), "Time", "v1.Time", 1) + `,`,
-		`WorkloadRef:` + strings.Replace(this.WorkloadRef.String(), "ObjectRef", "ObjectRef", 1) + `,`,
+	repeatedStringForMetricResults := "[]MetricResult{"
+	for _, f := range this.MetricResults {
+		repeatedStringForMetricResults += strings.Replace(strings.Replace(f.String(), "MetricResult", "MetricResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetricResults += "}"
+	s := strings.Join([]string{`&AnalysisRunStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`MetricResults:` + repeatedStringForMetricResults + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RolloutStatus) String() string {
+func (this *AnalysisTemplate) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForPauseConditions := "[]PauseCondition{"
-	for _, f := range this.PauseConditions {
-		repeatedStringForPauseConditions += strings.Replace(strings.Replace(f.String(), "PauseCondition", "PauseCondition", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForPauseConditions += "}"
-	repeatedStringForConditions := "[]RolloutCondition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "RolloutCondition", "RolloutCondition", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&RolloutStatus{`,
-		`Abort:` + fmt.Sprintf("%v", this.Abort) + `,`,
-		`PauseConditions:` + repeatedStringForPauseConditions + `,`,
-		`ControllerPause:` + fmt.Sprintf("%v", this.ControllerPause) + `,`,
-		`AbortedAt:` + strings.Replace(fmt.Sprintf("%v", this.AbortedAt), "Time", "v1.Time", 1) + `,`,
-		`CurrentPodHash:` + fmt.Sprintf("%v", this.CurrentPodHash) + `,`,
-		`CurrentStepHash:` + fmt.Sprintf("%v", this.CurrentStepHash) + `,`,
-		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
-		`UpdatedReplicas:` + fmt.Sprintf("%v", this.UpdatedReplicas) + `,`,
-		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
-		`AvailableReplicas:` + fmt.Sprintf("%v", this.AvailableReplicas) + `,`,
-		`CurrentStepIndex:` + valueToStringGenerated(this.CurrentStepIndex) + `,`,
-		`CollisionCount:` + valueToStringGenerated(this.CollisionCount) + `,`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`Canary:` + strings.Replace(strings.Replace(this.Canary.String(), "CanaryStatus", "CanaryStatus", 1), `&`, ``, 1) + `,`,
-		`BlueGreen:` + strings.Replace(strings.Replace(this.BlueGreen.String(), "BlueGreenStatus", "BlueGreenStatus", 1), `&`, ``, 1) + `,`,
-		`HPAReplicas:` + fmt.Sprintf("%v", this.HPAReplicas) + `,`,
-		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
-		`StableRS:` + fmt.Sprintf("%v", this.StableRS) + `,`,
-		`RestartedAt:` + strings.Replace(fmt.Sprintf("%v", this.RestartedAt), "Time", "v1.Time", 1) + `,`,
-		`PromoteFull:` + fmt.Sprintf("%v", this.PromoteFull) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+	s := strings.Join([]string{`&AnalysisTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AnalysisTemplateSpec", "AnalysisTemplateSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RolloutStrategy) String() string {
+func (this *AnalysisTemplateInclude) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RolloutStrategy{`,
-		`BlueGreen:` + strings.Replace(this.BlueGreen.String(), "BlueGreenStrategy", "BlueGreenStrategy", 1) + `,`,
-		`Canary:` + strings.Replace(this.Canary.String(), "CanaryStrategy", "CanaryStrategy", 1) + `,`,
+	repeatedStringForArgs := "[]Argument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&AnalysisTemplateInclude{`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *RolloutTrafficRouting) String() string {
+func (this *AnalysisTemplateList) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RolloutTrafficRouting{`,
-		`Istio:` + strings.Replace(this.Istio.String(), "IstioTrafficRouting", "IstioTrafficRouting", 1) + `,`,
-		`Nginx:` + strings.Replace(this.Nginx.String(), "NginxTrafficRouting", "NginxTrafficRouting", 1) + `,`,
-		`ALB:` + strings.Replace(this.ALB.String(), "ALBTrafficRouting", "ALBTrafficRouting", 1) + `,`,
-		`SMI:` + strings.Replace(this.SMI.String(), "SMITrafficRouting", "SMITrafficRouting", 1) + `,`,
-		`Ambassador:` + strings.Replace(this.Ambassador.String(), "AmbassadorTrafficRouting", "AmbassadorTrafficRouting", 1) + `,`,
+	repeatedStringForItems := "[]AnalysisTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AnalysisTemplate", "AnalysisTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&AnalysisTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *SMITrafficRouting) String() string {
+func (this *AnalysisTemplateSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&SMITrafficRouting{`,
-		`RootService:` + fmt.Sprintf("%v", this.RootService) + `,`,
-		`TrafficSplitName:` + fmt.Sprintf("%v", this.TrafficSplitName) + `,`,
+	repeatedStringForMetrics := "[]Metric{"
+	for _, f := range this.Metrics {
+		repeatedStringForMetrics += strings.Replace(strings.Replace(f.String(), "Metric", "Metric", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetrics += "}"
+	repeatedStringForArgs := "[]Argument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	repeatedStringForIncludes := "[]AnalysisTemplateInclude{"
+	for _, f := range this.Includes {
+		repeatedStringForIncludes += strings.Replace(strings.Replace(f.String(), "AnalysisTemplateInclude", "AnalysisTemplateInclude", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForIncludes += "}"
+	s := strings.Join([]string{`&AnalysisTemplateSpec{`,
+		`Metrics:` + repeatedStringForMetrics + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`Includes:` + repeatedStringForIncludes + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ScopeDetail) String() string {
+func (this *AntiAffinity) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ScopeDetail{`,
-		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
-		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
-		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
-		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
-		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+	s := strings.Join([]string{`&AntiAffinity{`,
+		`PreferredDuringSchedulingIgnoredDuringExecution:` + strings.Replace(this.PreferredDuringSchedulingIgnoredDuringExecution.String(), "PreferredDuringSchedulingIgnoredDuringExecution", "PreferredDuringSchedulingIgnoredDuringExecution", 1) + `,`,
+		`RequiredDuringSchedulingIgnoredDuringExecution:` + strings.Replace(this.RequiredDuringSchedulingIgnoredDuringExecution.String(), "RequiredDuringSchedulingIgnoredDuringExecution", "RequiredDuringSchedulingIgnoredDuringExecution", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *SecretKeyRef) String() string {
+func (this *Argument) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&SecretKeyRef{`,
+	s := strings.Join([]string{`&Argument{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + valueToStringGenerated(this.Value) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ValueFrom", "ValueFrom", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *SetCanaryScale) String() string {
+func (this *ArgumentValueFrom) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&SetCanaryScale{`,
-		`Weight:` + valueToStringGenerated(this.Weight) + `,`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`MatchTrafficWeight:` + fmt.Sprintf("%v", this.MatchTrafficWeight) + `,`,
+	s := strings.Join([]string{`&ArgumentValueFrom{`,
+		`PodTemplateHashValue:` + valueToStringGenerated(this.PodTemplateHashValue) + `,`,
+		`FieldRef:` + strings.Replace(this.FieldRef.String(), "FieldRef", "FieldRef", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(this.ConfigMapKeyRef.String(), "ConfigMapKeyRef", "ConfigMapKeyRef", 1) + `,`,
+		`RolloutStatusValue:` + valueToStringGenerated(this.RolloutStatusValue) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *TemplateSpec) String() string {
+func (this *BlueGreenStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TemplateSpec{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`MinReadySeconds:` + fmt.Sprintf("%v", this.MinReadySeconds) + `,`,
-		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
-		`Template:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Template), "PodTemplateSpec", "v12.PodTemplateSpec", 1), `&`, ``, 1) + `,`,
+	s := strings.Join([]string{`&BlueGreenStatus{`,
+		`PreviewSelector:` + fmt.Sprintf("%v", this.PreviewSelector) + `,`,
+		`ActiveSelector:` + fmt.Sprintf("%v", this.ActiveSelector) + `,`,
+		`ScaleUpPreviewCheckPoint:` + fmt.Sprintf("%v", this.ScaleUpPreviewCheckPoint) + `,`,
+		`PrePromotionAnalysisRunStatus:` + strings.Replace(this.PrePromotionAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
+		`PostPromotionAnalysisRunStatus:` + strings.Replace(this.PostPromotionAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *TemplateStatus) String() string {
+func (this *BlueGreenStrategy) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TemplateStatus{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
-		`UpdatedReplicas:` + fmt.Sprintf("%v", this.UpdatedReplicas) + `,`,
-		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
-		`AvailableReplicas:` + fmt.Sprintf("%v", this.AvailableReplicas) + `,`,
-		`CollisionCount:` + valueToStringGenerated(this.CollisionCount) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
+	s := strings.Join([]string{`&BlueGreenStrategy{`,
+		`ActiveService:` + fmt.Sprintf("%v", this.ActiveService) + `,`,
+		`PreviewService:` + fmt.Sprintf("%v", this.PreviewService) + `,`,
+		`PreviewReplicaCount:` + valueToStringGenerated(this.PreviewReplicaCount) + `,`,
+		`AutoPromotionEnabled:` + valueToStringGenerated(this.AutoPromotionEnabled) + `,`,
+		`AutoPromotionSeconds:` + fmt.Sprintf("%v", this.AutoPromotionSeconds) + `,`,
+		`MaxUnavailable:` + strings.Replace(fmt.Sprintf("%v", this.MaxUnavailable), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`ScaleDownDelaySeconds:` + valueToStringGenerated(this.ScaleDownDelaySeconds) + `,`,
+		`ScaleDownDelayRevisionLimit:` + valueToStringGenerated(this.ScaleDownDelayRevisionLimit) + `,`,
+		`PrePromotionAnalysis:` + strings.Replace(this.PrePromotionAnalysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
+		`AntiAffinity:` + strings.Replace(this.AntiAffinity.String(), "AntiAffinity", "AntiAffinity", 1) + `,`,
+		`PostPromotionAnalysis:` + strings.Replace(this.PostPromotionAnalysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
+		`PreviewMetadata:` + strings.Replace(this.PreviewMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
+		`ActiveMetadata:` + strings.Replace(this.ActiveMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
+		`AbortScaleDownDelaySeconds:` + valueToStringGenerated(this.AbortScaleDownDelaySeconds) + `,`,
+		`AdditionalActiveServices:` + fmt.Sprintf("%v", this.AdditionalActiveServices) + `,`,
+		`AdditionalPreviewServices:` + fmt.Sprintf("%v", this.AdditionalPreviewServices) + `,`,
+		`ManagedServices:` + strings.Replace(this.ManagedServices.String(), "ManagedServices", "ManagedServices", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ValueFrom) String() string {
+func (this *CanaryStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ValueFrom{`,
-		`SecretKeyRef:` + strings.Replace(this.SecretKeyRef.String(), "SecretKeyRef", "SecretKeyRef", 1) + `,`,
-		`FieldRef:` + strings.Replace(this.FieldRef.String(), "FieldRef", "FieldRef", 1) + `,`,
+	repeatedStringForSkippedSteps := "[]SkippedCanaryStep{"
+	for _, f := range this.SkippedSteps {
+		repeatedStringForSkippedSteps += strings.Replace(strings.Replace(f.String(), "SkippedCanaryStep", "SkippedCanaryStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSkippedSteps += "}"
+	s := strings.Join([]string{`&CanaryStatus{`,
+		`CurrentStepAnalysisRunStatus:` + strings.Replace(this.CurrentStepAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
+		`CurrentBackgroundAnalysisRunStatus:` + strings.Replace(this.CurrentBackgroundAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
+		`CurrentExperiment:` + fmt.Sprintf("%v", this.CurrentExperiment) + `,`,
+		`SkippedSteps:` + repeatedStringForSkippedSteps + `,`,
+		`Approval:` + strings.Replace(this.Approval.String(), "RolloutApprovalStatus", "RolloutApprovalStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WavefrontMetric) String() string {
+func (this *CanaryStep) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&WavefrontMetric{`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+	s := strings.Join([]string{`&CanaryStep{`,
+		`SetWeight:` + valueToStringGenerated(this.SetWeight) + `,`,
+		`Pause:` + strings.Replace(this.Pause.String(), "RolloutPause", "RolloutPause", 1) + `,`,
+		`Experiment:` + strings.Replace(this.Experiment.String(), "RolloutExperimentStep", "RolloutExperimentStep", 1) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
+		`SetCanaryScale:` + strings.Replace(this.SetCanaryScale.String(), "SetCanaryScale", "SetCanaryScale", 1) + `,`,
+		`TimeWindow:` + strings.Replace(this.TimeWindow.String(), "RolloutTimeWindow", "RolloutTimeWindow", 1) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`Approval:` + strings.Replace(this.Approval.String(), "RolloutApprovalStep", "RolloutApprovalStep", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WebMetric) String() string {
+func (this *CanaryStrategy) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForHeaders := "[]WebMetricHeader{"
-	for _, f := range this.Headers {
-		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	repeatedStringForSteps := "[]CanaryStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "CanaryStep", "CanaryStep", 1), `&`, ``, 1) + ","
 	}
-	repeatedStringForHeaders += "}"
-	s := strings.Join([]string{`&WebMetric{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Headers:` + repeatedStringForHeaders + `,`,
-		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
-		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
-		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+	repeatedStringForSteps += "}"
+	s := strings.Join([]string{`&CanaryStrategy{`,
+		`CanaryService:` + fmt.Sprintf("%v", this.CanaryService) + `,`,
+		`StableService:` + fmt.Sprintf("%v", this.StableService) + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`TrafficRouting:` + strings.Replace(this.TrafficRouting.String(), "RolloutTrafficRouting", "RolloutTrafficRouting", 1) + `,`,
+		`MaxUnavailable:` + strings.Replace(fmt.Sprintf("%v", this.MaxUnavailable), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`MaxSurge:` + strings.Replace(fmt.Sprintf("%v", this.MaxSurge), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "RolloutAnalysisBackground", "RolloutAnalysisBackground", 1) + `,`,
+		`AntiAffinity:` + strings.Replace(this.AntiAffinity.String(), "AntiAffinity", "AntiAffinity", 1) + `,`,
+		`CanaryMetadata:` + strings.Replace(this.CanaryMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
+		`StableMetadata:` + strings.Replace(this.StableMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
+		`ScaleDownDelaySeconds:` + valueToStringGenerated(this.ScaleDownDelaySeconds) + `,`,
+		`ScaleDownDelayRevisionLimit:` + valueToStringGenerated(this.ScaleDownDelayRevisionLimit) + `,`,
+		`AbortScaleDownDelaySeconds:` + valueToStringGenerated(this.AbortScaleDownDelaySeconds) + `,`,
+		`ManagedServices:` + strings.Replace(this.ManagedServices.String(), "ManagedServices", "ManagedServices", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WebMetricHeader) String() string {
+func (this *ClusterAnalysisTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterAnalysisTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AnalysisTemplateSpec", "AnalysisTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterAnalysisTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterAnalysisTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterAnalysisTemplate", "ClusterAnalysisTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterAnalysisTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterRolloutFreeze) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterRolloutFreeze{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutFreezeSpec", "RolloutFreezeSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterRolloutFreezeList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterRolloutFreeze{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterRolloutFreeze", "ClusterRolloutFreeze", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterRolloutFreezeList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigMapKeyRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfigMapKeyRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DatadogMetric) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&WebMetricHeader{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&DatadogMetric{`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Experiment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Experiment{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ExperimentSpec", "ExperimentSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ExperimentStatus", "ExperimentStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExperimentAnalysisRunStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExperimentAnalysisRunStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`AnalysisRun:` + fmt.Sprintf("%v", this.AnalysisRun) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExperimentAnalysisTemplateRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArgs := "[]Argument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&ExperimentAnalysisTemplateRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`RequiredForCompletion:` + fmt.Sprintf("%v", this.RequiredForCompletion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExperimentCondition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExperimentCondition{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExperimentList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Experiment{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Experiment", "Experiment", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ExperimentList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExperimentSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTemplates := "[]TemplateSpec{"
+	for _, f := range this.Templates {
+		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "TemplateSpec", "TemplateSpec", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTemplates += "}"
+	repeatedStringForAnalyses := "[]ExperimentAnalysisTemplateRef{"
+	for _, f := range this.Analyses {
+		repeatedStringForAnalyses += strings.Replace(strings.Replace(f.String(), "ExperimentAnalysisTemplateRef", "ExperimentAnalysisTemplateRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAnalyses += "}"
+	s := strings.Join([]string{`&ExperimentSpec{`,
+		`Templates:` + repeatedStringForTemplates + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`ProgressDeadlineSeconds:` + valueToStringGenerated(this.ProgressDeadlineSeconds) + `,`,
+		`Terminate:` + fmt.Sprintf("%v", this.Terminate) + `,`,
+		`Analyses:` + repeatedStringForAnalyses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExperimentStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTemplateStatuses := "[]TemplateStatus{"
+	for _, f := range this.TemplateStatuses {
+		repeatedStringForTemplateStatuses += strings.Replace(strings.Replace(f.String(), "TemplateStatus", "TemplateStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTemplateStatuses += "}"
+	repeatedStringForConditions := "[]ExperimentCondition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "ExperimentCondition", "ExperimentCondition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	repeatedStringForAnalysisRuns := "[]ExperimentAnalysisRunStatus{"
+	for _, f := range this.AnalysisRuns {
+		repeatedStringForAnalysisRuns += strings.Replace(strings.Replace(f.String(), "ExperimentAnalysisRunStatus", "ExperimentAnalysisRunStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAnalysisRuns += "}"
+	s := strings.Join([]string{`&ExperimentStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`TemplateStatuses:` + repeatedStringForTemplateStatuses + `,`,
+		`AvailableAt:` + strings.Replace(fmt.Sprintf("%v", this.AvailableAt), "Time", "v1.Time", 1) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`AnalysisRuns:` + repeatedStringForAnalysisRuns + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FieldRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FieldRef{`,
+		`FieldPath:` + fmt.Sprintf("%v", this.FieldPath) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreezeWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreezeWindow{`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IstioDestinationRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IstioDestinationRule{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CanarySubsetName:` + fmt.Sprintf("%v", this.CanarySubsetName) + `,`,
+		`StableSubsetName:` + fmt.Sprintf("%v", this.StableSubsetName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IstioTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IstioTrafficRouting{`,
+		`VirtualService:` + strings.Replace(strings.Replace(this.VirtualService.String(), "IstioVirtualService", "IstioVirtualService", 1), `&`, ``, 1) + `,`,
+		`DestinationRule:` + strings.Replace(this.DestinationRule.String(), "IstioDestinationRule", "IstioDestinationRule", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IstioVirtualService) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IstioVirtualService{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Routes:` + fmt.Sprintf("%v", this.Routes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobMetric{`,
+		`Metadata:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Metadata), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Spec), "JobSpec", "v11.JobSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KayentaMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForScopes := "[]KayentaScope{"
+	for _, f := range this.Scopes {
+		repeatedStringForScopes += strings.Replace(strings.Replace(f.String(), "KayentaScope", "KayentaScope", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForScopes += "}"
+	s := strings.Join([]string{`&KayentaMetric{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Application:` + fmt.Sprintf("%v", this.Application) + `,`,
+		`CanaryConfigName:` + fmt.Sprintf("%v", this.CanaryConfigName) + `,`,
+		`MetricsAccountName:` + fmt.Sprintf("%v", this.MetricsAccountName) + `,`,
+		`ConfigurationAccountName:` + fmt.Sprintf("%v", this.ConfigurationAccountName) + `,`,
+		`StorageAccountName:` + fmt.Sprintf("%v", this.StorageAccountName) + `,`,
+		`Threshold:` + strings.Replace(strings.Replace(this.Threshold.String(), "KayentaThreshold", "KayentaThreshold", 1), `&`, ``, 1) + `,`,
+		`Scopes:` + repeatedStringForScopes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KayentaScope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KayentaScope{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ControlScope:` + strings.Replace(strings.Replace(this.ControlScope.String(), "ScopeDetail", "ScopeDetail", 1), `&`, ``, 1) + `,`,
+		`ExperimentScope:` + strings.Replace(strings.Replace(this.ExperimentScope.String(), "ScopeDetail", "ScopeDetail", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KayentaThreshold) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KayentaThreshold{`,
+		`Pass:` + fmt.Sprintf("%v", this.Pass) + `,`,
+		`Marginal:` + fmt.Sprintf("%v", this.Marginal) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ManagedServices) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPorts := "[]ServicePort{"
+	for _, f := range this.Ports {
+		repeatedStringForPorts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForPorts += "}"
+	s := strings.Join([]string{`&ManagedServices{`,
+		`Ports:` + repeatedStringForPorts + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Metadata:` + strings.Replace(this.Metadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Measurement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&Measurement{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`ResumeAt:` + strings.Replace(fmt.Sprintf("%v", this.ResumeAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Metric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Metric{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`InitialDelay:` + fmt.Sprintf("%v", this.InitialDelay) + `,`,
+		`Count:` + strings.Replace(fmt.Sprintf("%v", this.Count), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
+		`FailureLimit:` + strings.Replace(fmt.Sprintf("%v", this.FailureLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`InconclusiveLimit:` + strings.Replace(fmt.Sprintf("%v", this.InconclusiveLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`ConsecutiveErrorLimit:` + strings.Replace(fmt.Sprintf("%v", this.ConsecutiveErrorLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Provider:` + strings.Replace(strings.Replace(this.Provider.String(), "MetricProvider", "MetricProvider", 1), `&`, ``, 1) + `,`,
+		`EvaluationMode:` + fmt.Sprintf("%v", this.EvaluationMode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetricProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetricProvider{`,
+		`Prometheus:` + strings.Replace(this.Prometheus.String(), "PrometheusMetric", "PrometheusMetric", 1) + `,`,
+		`Kayenta:` + strings.Replace(this.Kayenta.String(), "KayentaMetric", "KayentaMetric", 1) + `,`,
+		`Web:` + strings.Replace(this.Web.String(), "WebMetric", "WebMetric", 1) + `,`,
+		`Datadog:` + strings.Replace(this.Datadog.String(), "DatadogMetric", "DatadogMetric", 1) + `,`,
+		`Wavefront:` + strings.Replace(this.Wavefront.String(), "WavefrontMetric", "WavefrontMetric", 1) + `,`,
+		`NewRelic:` + strings.Replace(this.NewRelic.String(), "NewRelicMetric", "NewRelicMetric", 1) + `,`,
+		`Job:` + strings.Replace(this.Job.String(), "JobMetric", "JobMetric", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetricResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMeasurements := "[]Measurement{"
+	for _, f := range this.Measurements {
+		repeatedStringForMeasurements += strings.Replace(strings.Replace(f.String(), "Measurement", "Measurement", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMeasurements += "}"
+	s := strings.Join([]string{`&MetricResult{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Measurements:` + repeatedStringForMeasurements + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Successful:` + fmt.Sprintf("%v", this.Successful) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`Inconclusive:` + fmt.Sprintf("%v", this.Inconclusive) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`ConsecutiveError:` + fmt.Sprintf("%v", this.ConsecutiveError) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NewRelicMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NewRelicMetric{`,
+		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NginxTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAdditionalIngressAnnotations := make([]string, 0, len(this.AdditionalIngressAnnotations))
+	for k := range this.AdditionalIngressAnnotations {
+		keysForAdditionalIngressAnnotations = append(keysForAdditionalIngressAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAdditionalIngressAnnotations)
+	mapStringForAdditionalIngressAnnotations := "map[string]string{"
+	for _, k := range keysForAdditionalIngressAnnotations {
+		mapStringForAdditionalIngressAnnotations += fmt.Sprintf("%v: %v,", k, this.AdditionalIngressAnnotations[k])
+	}
+	mapStringForAdditionalIngressAnnotations += "}"
+	s := strings.Join([]string{`&NginxTrafficRouting{`,
+		`AnnotationPrefix:` + fmt.Sprintf("%v", this.AnnotationPrefix) + `,`,
+		`StableIngress:` + fmt.Sprintf("%v", this.StableIngress) + `,`,
+		`AdditionalIngressAnnotations:` + mapStringForAdditionalIngressAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ObjectRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ObjectRef{`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseCondition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseCondition{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`StartTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodTemplateMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&PodTemplateMetadata{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PreferredDuringSchedulingIgnoredDuringExecution) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PreferredDuringSchedulingIgnoredDuringExecution{`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrometheusMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrometheusMetric{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequiredDuringSchedulingIgnoredDuringExecution) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequiredDuringSchedulingIgnoredDuringExecution{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevisionAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevisionAnalysis{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`StepIndex:` + valueToStringGenerated(this.StepIndex) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevisionImage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevisionImage{`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RevisionStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RevisionStep{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RollbackWindowSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollbackWindowSpec{`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Rollout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Rollout{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutSpec", "RolloutSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "RolloutStatus", "RolloutStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTemplates := "[]RolloutAnalysisTemplate{"
+	for _, f := range this.Templates {
+		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "RolloutAnalysisTemplate", "RolloutAnalysisTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTemplates += "}"
+	repeatedStringForArgs := "[]AnalysisRunArgument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&RolloutAnalysis{`,
+		`Templates:` + repeatedStringForTemplates + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutAnalysisBackground) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutAnalysisBackground{`,
+		`RolloutAnalysis:` + strings.Replace(strings.Replace(this.RolloutAnalysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1), `&`, ``, 1) + `,`,
+		`StartingStep:` + valueToStringGenerated(this.StartingStep) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutAnalysisRunStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutAnalysisRunStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutAnalysisTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutAnalysisTemplate{`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutApprovalHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutApprovalHeader{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "RolloutApprovalHeaderSource", "RolloutApprovalHeaderSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutApprovalHeaderSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutApprovalHeaderSource{`,
+		`SecretKeyRef:` + strings.Replace(this.SecretKeyRef.String(), "SecretKeyRef", "SecretKeyRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutApprovalStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutApprovalStatus{`,
+		`StepIndex:` + fmt.Sprintf("%v", this.StepIndex) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`RequestedAt:` + strings.Replace(fmt.Sprintf("%v", this.RequestedAt), "Time", "v1.Time", 1) + `,`,
+		`LastRequestedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastRequestedAt), "Time", "v1.Time", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutApprovalStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]RolloutApprovalHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "RolloutApprovalHeader", "RolloutApprovalHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&RolloutApprovalStep{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`PollInterval:` + fmt.Sprintf("%v", this.PollInterval) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutCondition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutCondition{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutExperimentStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTemplates := "[]RolloutExperimentTemplate{"
+	for _, f := range this.Templates {
+		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "RolloutExperimentTemplate", "RolloutExperimentTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTemplates += "}"
+	repeatedStringForAnalyses := "[]RolloutExperimentStepAnalysisTemplateRef{"
+	for _, f := range this.Analyses {
+		repeatedStringForAnalyses += strings.Replace(strings.Replace(f.String(), "RolloutExperimentStepAnalysisTemplateRef", "RolloutExperimentStepAnalysisTemplateRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAnalyses += "}"
+	s := strings.Join([]string{`&RolloutExperimentStep{`,
+		`Templates:` + repeatedStringForTemplates + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Analyses:` + repeatedStringForAnalyses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutExperimentStepAnalysisTemplateRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArgs := "[]AnalysisRunArgument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&RolloutExperimentStepAnalysisTemplateRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`RequiredForCompletion:` + fmt.Sprintf("%v", this.RequiredForCompletion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutExperimentTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutExperimentTemplate{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`SpecRef:` + fmt.Sprintf("%v", this.SpecRef) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1), `&`, ``, 1) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutFreeze) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutFreeze{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutFreezeSpec", "RolloutFreezeSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutFreezeList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]RolloutFreeze{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "RolloutFreeze", "RolloutFreeze", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&RolloutFreezeList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutFreezeSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWindows := "[]FreezeWindow{"
+	for _, f := range this.Windows {
+		repeatedStringForWindows += strings.Replace(strings.Replace(f.String(), "FreezeWindow", "FreezeWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWindows += "}"
+	s := strings.Join([]string{`&RolloutFreezeSpec{`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Windows:` + repeatedStringForWindows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutHook{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Job:` + strings.Replace(strings.Replace(this.Job.String(), "JobMetric", "JobMetric", 1), `&`, ``, 1) + `,`,
+		`FailurePolicy:` + fmt.Sprintf("%v", this.FailurePolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutHookStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutHookStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`JobName:` + fmt.Sprintf("%v", this.JobName) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Occurrence:` + fmt.Sprintf("%v", this.Occurrence) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutHooks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPreRollout := "[]RolloutHook{"
+	for _, f := range this.PreRollout {
+		repeatedStringForPreRollout += strings.Replace(strings.Replace(f.String(), "RolloutHook", "RolloutHook", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPreRollout += "}"
+	repeatedStringForPostPromotion := "[]RolloutHook{"
+	for _, f := range this.PostPromotion {
+		repeatedStringForPostPromotion += strings.Replace(strings.Replace(f.String(), "RolloutHook", "RolloutHook", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPostPromotion += "}"
+	repeatedStringForPostAbort := "[]RolloutHook{"
+	for _, f := range this.PostAbort {
+		repeatedStringForPostAbort += strings.Replace(strings.Replace(f.String(), "RolloutHook", "RolloutHook", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPostAbort += "}"
+	s := strings.Join([]string{`&RolloutHooks{`,
+		`PreRollout:` + repeatedStringForPreRollout + `,`,
+		`PostPromotion:` + repeatedStringForPostPromotion + `,`,
+		`PostAbort:` + repeatedStringForPostAbort + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Rollout{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Rollout", "Rollout", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&RolloutList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutPause) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutPause{`,
+		`Duration:` + strings.Replace(fmt.Sprintf("%v", this.Duration), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutRevision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutRevision{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutRevisionSpec", "RolloutRevisionSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutRevisionList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]RolloutRevision{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "RolloutRevision", "RolloutRevision", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&RolloutRevisionList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutRevisionSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImages := "[]RevisionImage{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "RevisionImage", "RevisionImage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForSteps := "[]RevisionStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "RevisionStep", "RevisionStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	repeatedStringForAnalyses := "[]RevisionAnalysis{"
+	for _, f := range this.Analyses {
+		repeatedStringForAnalyses += strings.Replace(strings.Replace(f.String(), "RevisionAnalysis", "RevisionAnalysis", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAnalyses += "}"
+	s := strings.Join([]string{`&RolloutRevisionSpec{`,
+		`RolloutName:` + fmt.Sprintf("%v", this.RolloutName) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`PromotedBy:` + fmt.Sprintf("%v", this.PromotedBy) + `,`,
+		`AbortReason:` + fmt.Sprintf("%v", this.AbortReason) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`Analyses:` + repeatedStringForAnalyses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutSpec{`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Template:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Template), "PodTemplateSpec", "v12.PodTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`MinReadySeconds:` + fmt.Sprintf("%v", this.MinReadySeconds) + `,`,
+		`Strategy:` + strings.Replace(strings.Replace(this.Strategy.String(), "RolloutStrategy", "RolloutStrategy", 1), `&`, ``, 1) + `,`,
+		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`ProgressDeadlineSeconds:` + valueToStringGenerated(this.ProgressDeadlineSeconds) + `,`,
+		`RestartAt:` + strings.Replace(fmt.Sprintf("%v", this.RestartAt), "Time", "v1.Time", 1) + `,`,
+		`WorkloadRef:` + strings.Replace(this.WorkloadRef.String(), "ObjectRef", "ObjectRef", 1) + `,`,
+		`ProgressDeadlineAbort:` + fmt.Sprintf("%v", this.ProgressDeadlineAbort) + `,`,
+		`RollbackWindow:` + strings.Replace(this.RollbackWindow.String(), "RollbackWindowSpec", "RollbackWindowSpec", 1) + `,`,
+		`Hooks:` + strings.Replace(this.Hooks.String(), "RolloutHooks", "RolloutHooks", 1) + `,`,
+		`RolloutRevisionLimit:` + valueToStringGenerated(this.RolloutRevisionLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPauseConditions := "[]PauseCondition{"
+	for _, f := range this.PauseConditions {
+		repeatedStringForPauseConditions += strings.Replace(strings.Replace(f.String(), "PauseCondition", "PauseCondition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPauseConditions += "}"
+	repeatedStringForConditions := "[]RolloutCondition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "RolloutCondition", "RolloutCondition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
+	repeatedStringForHooks := "[]RolloutHookStatus{"
+	for _, f := range this.Hooks {
+		repeatedStringForHooks += strings.Replace(strings.Replace(f.String(), "RolloutHookStatus", "RolloutHookStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHooks += "}"
+	s := strings.Join([]string{`&RolloutStatus{`,
+		`Abort:` + fmt.Sprintf("%v", this.Abort) + `,`,
+		`PauseConditions:` + repeatedStringForPauseConditions + `,`,
+		`ControllerPause:` + fmt.Sprintf("%v", this.ControllerPause) + `,`,
+		`AbortedAt:` + strings.Replace(fmt.Sprintf("%v", this.AbortedAt), "Time", "v1.Time", 1) + `,`,
+		`CurrentPodHash:` + fmt.Sprintf("%v", this.CurrentPodHash) + `,`,
+		`CurrentStepHash:` + fmt.Sprintf("%v", this.CurrentStepHash) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`UpdatedReplicas:` + fmt.Sprintf("%v", this.UpdatedReplicas) + `,`,
+		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
+		`AvailableReplicas:` + fmt.Sprintf("%v", this.AvailableReplicas) + `,`,
+		`CurrentStepIndex:` + valueToStringGenerated(this.CurrentStepIndex) + `,`,
+		`CollisionCount:` + valueToStringGenerated(this.CollisionCount) + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`Canary:` + strings.Replace(strings.Replace(this.Canary.String(), "CanaryStatus", "CanaryStatus", 1), `&`, ``, 1) + `,`,
+		`BlueGreen:` + strings.Replace(strings.Replace(this.BlueGreen.String(), "BlueGreenStatus", "BlueGreenStatus", 1), `&`, ``, 1) + `,`,
+		`HPAReplicas:` + fmt.Sprintf("%v", this.HPAReplicas) + `,`,
+		`Selector:` + fmt.Sprintf("%v", this.Selector) + `,`,
+		`StableRS:` + fmt.Sprintf("%v", this.StableRS) + `,`,
+		`RestartedAt:` + strings.Replace(fmt.Sprintf("%v", this.RestartedAt), "Time", "v1.Time", 1) + `,`,
+		`PromoteFull:` + fmt.Sprintf("%v", this.PromoteFull) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`WorkloadObservedGeneration:` + fmt.Sprintf("%v", this.WorkloadObservedGeneration) + `,`,
+		`Hooks:` + repeatedStringForHooks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutStrategy{`,
+		`BlueGreen:` + strings.Replace(this.BlueGreen.String(), "BlueGreenStrategy", "BlueGreenStrategy", 1) + `,`,
+		`Canary:` + strings.Replace(this.Canary.String(), "CanaryStrategy", "CanaryStrategy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutTimeWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutTimeWindow{`,
+		`StartTime:` + fmt.Sprintf("%v", this.StartTime) + `,`,
+		`EndTime:` + fmt.Sprintf("%v", this.EndTime) + `,`,
+		`DaysOfWeek:` + fmt.Sprintf("%v", this.DaysOfWeek) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutTrafficRouting{`,
+		`Istio:` + strings.Replace(this.Istio.String(), "IstioTrafficRouting", "IstioTrafficRouting", 1) + `,`,
+		`Nginx:` + strings.Replace(this.Nginx.String(), "NginxTrafficRouting", "NginxTrafficRouting", 1) + `,`,
+		`ALB:` + strings.Replace(this.ALB.String(), "ALBTrafficRouting", "ALBTrafficRouting", 1) + `,`,
+		`SMI:` + strings.Replace(this.SMI.String(), "SMITrafficRouting", "SMITrafficRouting", 1) + `,`,
+		`Ambassador:` + strings.Replace(this.Ambassador.String(), "AmbassadorTrafficRouting", "AmbassadorTrafficRouting", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SMITrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SMITrafficRouting{`,
+		`RootService:` + fmt.Sprintf("%v", this.RootService) + `,`,
+		`TrafficSplitName:` + fmt.Sprintf("%v", this.TrafficSplitName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScopeDetail) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScopeDetail{`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SecretKeyRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SecretKeyRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetCanaryScale) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetCanaryScale{`,
+		`Weight:` + valueToStringGenerated(this.Weight) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`MatchTrafficWeight:` + fmt.Sprintf("%v", this.MatchTrafficWeight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SkippedCanaryStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SkippedCanaryStep{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateSpec{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`MinReadySeconds:` + fmt.Sprintf("%v", this.MinReadySeconds) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Template:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Template), "PodTemplateSpec", "v12.PodTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`UpdatedReplicas:` + fmt.Sprintf("%v", this.UpdatedReplicas) + `,`,
+		`ReadyReplicas:` + fmt.Sprintf("%v", this.ReadyReplicas) + `,`,
+		`AvailableReplicas:` + fmt.Sprintf("%v", this.AvailableReplicas) + `,`,
+		`CollisionCount:` + valueToStringGenerated(this.CollisionCount) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValueFrom) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValueFrom{`,
+		`SecretKeyRef:` + strings.Replace(this.SecretKeyRef.String(), "SecretKeyRef", "SecretKeyRef", 1) + `,`,
+		`FieldRef:` + strings.Replace(this.FieldRef.String(), "FieldRef", "FieldRef", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(this.ConfigMapKeyRef.String(), "ConfigMapKeyRef", "ConfigMapKeyRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WavefrontMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WavefrontMetric{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]WebMetricHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&WebMetric{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebMetricHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebMetricHeader{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ALBTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ALBTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServicePort", wireType)
+			}
+			m.ServicePort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServicePort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmbassadorTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmbassadorTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRun: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRun: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ArgumentValueFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunJudgment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunJudgment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunJudgment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JudgedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JudgedAt == nil {
+				m.JudgedAt = &v1.Time{}
+			}
+			if err := m.JudgedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AnalysisRun{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metrics = append(m.Metrics, Metric{})
+			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminate = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Judgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Judgment == nil {
+				m.Judgment = &AnalysisRunJudgment{}
+			}
+			if err := m.Judgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricResults = append(m.MetricResults, MetricResult{})
+			if err := m.MetricResults[len(m.MetricResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisTemplateInclude) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisTemplateInclude: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisTemplateInclude: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisTemplateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisTemplateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AnalysisTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisTemplateSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisTemplateSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metrics = append(m.Metrics, Metric{})
+			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Includes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Includes = append(m.Includes, AnalysisTemplateInclude{})
+			if err := m.Includes[len(m.Includes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AntiAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntiAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredDuringSchedulingIgnoredDuringExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreferredDuringSchedulingIgnoredDuringExecution == nil {
+				m.PreferredDuringSchedulingIgnoredDuringExecution = &PreferredDuringSchedulingIgnoredDuringExecution{}
+			}
+			if err := m.PreferredDuringSchedulingIgnoredDuringExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredDuringSchedulingIgnoredDuringExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+				m.RequiredDuringSchedulingIgnoredDuringExecution = &RequiredDuringSchedulingIgnoredDuringExecution{}
+			}
+			if err := m.RequiredDuringSchedulingIgnoredDuringExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Argument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Argument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Argument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgumentValueFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgumentValueFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgumentValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHashValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ValueFromPodTemplateHash(dAtA[iNdEx:postIndex])
+			m.PodTemplateHashValue = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FieldRef == nil {
+				m.FieldRef = &FieldRef{}
+			}
+			if err := m.FieldRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &ConfigMapKeyRef{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutStatusValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ValueFromRolloutStatus(dAtA[iNdEx:postIndex])
+			m.RolloutStatusValue = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlueGreenStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlueGreenStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpPreviewCheckPoint", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleUpPreviewCheckPoint = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrePromotionAnalysisRunStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrePromotionAnalysisRunStatus == nil {
+				m.PrePromotionAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			}
+			if err := m.PrePromotionAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotionAnalysisRunStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostPromotionAnalysisRunStatus == nil {
+				m.PostPromotionAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			}
+			if err := m.PostPromotionAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlueGreenStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlueGreenStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviewService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewReplicaCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreviewReplicaCount = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.AutoPromotionEnabled = &b
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionSeconds", wireType)
+			}
+			m.AutoPromotionSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoPromotionSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxUnavailable == nil {
+				m.MaxUnavailable = &intstr.IntOrString{}
+			}
+			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleDownDelaySeconds = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelayRevisionLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleDownDelayRevisionLimit = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrePromotionAnalysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrePromotionAnalysis == nil {
+				m.PrePromotionAnalysis = &RolloutAnalysis{}
+			}
+			if err := m.PrePromotionAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AntiAffinity == nil {
+				m.AntiAffinity = &AntiAffinity{}
+			}
+			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotionAnalysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostPromotionAnalysis == nil {
+				m.PostPromotionAnalysis = &RolloutAnalysis{}
+			}
+			if err := m.PostPromotionAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviewMetadata == nil {
+				m.PreviewMetadata = &PodTemplateMetadata{}
+			}
+			if err := m.PreviewMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActiveMetadata == nil {
+				m.ActiveMetadata = &PodTemplateMetadata{}
+			}
+			if err := m.ActiveMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortScaleDownDelaySeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AbortScaleDownDelaySeconds = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalActiveServices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalActiveServices = append(m.AdditionalActiveServices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalPreviewServices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalPreviewServices = append(m.AdditionalPreviewServices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedServices == nil {
+				m.ManagedServices = &ManagedServices{}
+			}
+			if err := m.ManagedServices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanaryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanaryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepAnalysisRunStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentStepAnalysisRunStatus == nil {
+				m.CurrentStepAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			}
+			if err := m.CurrentStepAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBackgroundAnalysisRunStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentBackgroundAnalysisRunStatus == nil {
+				m.CurrentBackgroundAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			}
+			if err := m.CurrentBackgroundAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentExperiment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentExperiment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedSteps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkippedSteps = append(m.SkippedSteps, SkippedCanaryStep{})
+			if err := m.SkippedSteps[len(m.SkippedSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &RolloutApprovalStatus{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanaryStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanaryStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanaryStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetWeight = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pause == nil {
+				m.Pause = &RolloutPause{}
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Experiment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Experiment == nil {
+				m.Experiment = &RolloutExperimentStep{}
+			}
+			if err := m.Experiment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysis{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetCanaryScale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetCanaryScale == nil {
+				m.SetCanaryScale = &SetCanaryScale{}
+			}
+			if err := m.SetCanaryScale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeWindow == nil {
+				m.TimeWindow = &RolloutTimeWindow{}
+			}
+			if err := m.TimeWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Approval == nil {
+				m.Approval = &RolloutApprovalStep{}
+			}
+			if err := m.Approval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanaryService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, CanaryStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrafficRouting == nil {
+				m.TrafficRouting = &RolloutTrafficRouting{}
+			}
+			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxUnavailable == nil {
+				m.MaxUnavailable = &intstr.IntOrString{}
+			}
+			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSurge == nil {
+				m.MaxSurge = &intstr.IntOrString{}
+			}
+			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysisBackground{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AntiAffinity == nil {
+				m.AntiAffinity = &AntiAffinity{}
+			}
+			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanaryMetadata == nil {
+				m.CanaryMetadata = &PodTemplateMetadata{}
+			}
+			if err := m.CanaryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StableMetadata == nil {
+				m.StableMetadata = &PodTemplateMetadata{}
+			}
+			if err := m.StableMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleDownDelaySeconds = &v
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelayRevisionLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleDownDelayRevisionLimit = &v
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortScaleDownDelaySeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AbortScaleDownDelaySeconds = &v
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedServices == nil {
+				m.ManagedServices = &ManagedServices{}
+			}
+			if err := m.ManagedServices.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterAnalysisTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterAnalysisTemplateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterAnalysisTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterAnalysisTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterRolloutFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterRolloutFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterRolloutFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterRolloutFreezeList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterRolloutFreezeList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterRolloutFreezeList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterRolloutFreeze{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMapKeyRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMapKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatadogMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatadogMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Experiment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9287,15 +17439,15 @@ func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ALBTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ALBTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9323,13 +17475,13 @@ func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServicePort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
 			}
-			m.ServicePort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9339,14 +17491,27 @@ func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ServicePort |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9374,11 +17539,11 @@ func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootService = string(dAtA[iNdEx:postIndex])
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9406,7 +17571,7 @@ func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9429,7 +17594,7 @@ func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9452,15 +17617,15 @@ func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AmbassadorTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AmbassadorTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9488,8 +17653,114 @@ func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mappings = append(m.Mappings, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredForCompletion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9511,7 +17782,7 @@ func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
+func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9534,17 +17805,17 @@ func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRun: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRun: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9554,30 +17825,29 @@ func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = ExperimentConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9587,28 +17857,27 @@ func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9626,74 +17895,24 @@ func (m *AnalysisRun) Unmarshal(dAtA []byte) error {
 				}
 			}
 			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9703,27 +17922,28 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9751,13 +17971,13 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9767,27 +17987,23 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ArgumentValueFrom{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9810,7 +18026,7 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunList) Unmarshal(dAtA []byte) error {
+func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9833,10 +18049,10 @@ func (m *AnalysisRunList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9901,7 +18117,7 @@ func (m *AnalysisRunList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AnalysisRun{})
+			m.Items = append(m.Items, Experiment{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -9927,7 +18143,7 @@ func (m *AnalysisRunList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunSpec) Unmarshal(dAtA []byte) error {
+func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9950,15 +18166,15 @@ func (m *AnalysisRunSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9985,16 +18201,16 @@ func (m *AnalysisRunSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metrics = append(m.Metrics, Metric{})
-			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Templates = append(m.Templates, TemplateSpec{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10004,27 +18220,45 @@ func (m *AnalysisRunSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, Argument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineSeconds = &v
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
 			}
@@ -10044,6 +18278,40 @@ func (m *AnalysisRunSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Terminate = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyses = append(m.Analyses, ExperimentAnalysisTemplateRef{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10065,7 +18333,7 @@ func (m *AnalysisRunSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunStatus) Unmarshal(dAtA []byte) error {
+func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10088,10 +18356,10 @@ func (m *AnalysisRunStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10147,140 +18415,20 @@ func (m *AnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			}
 			intStringLen := int(stringLen)
 			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricResults", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetricResults = append(m.MetricResults, MetricResult{})
-			if err := m.MetricResults[len(m.MetricResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AnalysisTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10307,13 +18455,14 @@ func (m *AnalysisTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TemplateStatuses = append(m.TemplateStatuses, TemplateStatus{})
+			if err := m.TemplateStatuses[len(m.TemplateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10340,63 +18489,16 @@ func (m *AnalysisTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.AvailableAt == nil {
+				m.AvailableAt = &v1.Time{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.AvailableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AnalysisTemplateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisTemplateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10423,13 +18525,14 @@ func (m *AnalysisTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, ExperimentCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10456,8 +18559,8 @@ func (m *AnalysisTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AnalysisTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AnalysisRuns = append(m.AnalysisRuns, ExperimentAnalysisRunStatus{})
+			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10482,7 +18585,7 @@ func (m *AnalysisTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisTemplateSpec) Unmarshal(dAtA []byte) error {
+func (m *FieldRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10505,51 +18608,17 @@ func (m *AnalysisTemplateSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisTemplateSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metrics = append(m.Metrics, Metric{})
-			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10559,25 +18628,23 @@ func (m *AnalysisTemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, Argument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FieldPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10600,7 +18667,7 @@ func (m *AnalysisTemplateSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntiAffinity) Unmarshal(dAtA []byte) error {
+func (m *FreezeWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10623,17 +18690,17 @@ func (m *AntiAffinity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntiAffinity: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreezeWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreezeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreferredDuringSchedulingIgnoredDuringExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10643,33 +18710,29 @@ func (m *AntiAffinity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreferredDuringSchedulingIgnoredDuringExecution == nil {
-				m.PreferredDuringSchedulingIgnoredDuringExecution = &PreferredDuringSchedulingIgnoredDuringExecution{}
-			}
-			if err := m.PreferredDuringSchedulingIgnoredDuringExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredDuringSchedulingIgnoredDuringExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10679,27 +18742,55 @@ func (m *AntiAffinity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredDuringSchedulingIgnoredDuringExecution == nil {
-				m.RequiredDuringSchedulingIgnoredDuringExecution = &RequiredDuringSchedulingIgnoredDuringExecution{}
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
-			if err := m.RequiredDuringSchedulingIgnoredDuringExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10722,7 +18813,7 @@ func (m *AntiAffinity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Argument) Unmarshal(dAtA []byte) error {
+func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10745,10 +18836,10 @@ func (m *Argument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Argument: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Argument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10785,7 +18876,7 @@ func (m *Argument) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10813,14 +18904,13 @@ func (m *Argument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10830,27 +18920,23 @@ func (m *Argument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
-			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10873,7 +18959,7 @@ func (m *Argument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgumentValueFrom) Unmarshal(dAtA []byte) error {
+func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10896,17 +18982,17 @@ func (m *ArgumentValueFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgumentValueFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgumentValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHashValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10916,28 +19002,28 @@ func (m *ArgumentValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := ValueFromPodTemplateHash(dAtA[iNdEx:postIndex])
-			m.PodTemplateHashValue = &s
+			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10964,10 +19050,10 @@ func (m *ArgumentValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FieldRef == nil {
-				m.FieldRef = &FieldRef{}
+			if m.DestinationRule == nil {
+				m.DestinationRule = &IstioDestinationRule{}
 			}
-			if err := m.FieldRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10992,7 +19078,7 @@ func (m *ArgumentValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
+func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11015,15 +19101,15 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlueGreenStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlueGreenStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11051,11 +19137,11 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviewSelector = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11083,31 +19169,61 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveSelector = string(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleUpPreviewCheckPoint", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ScaleUpPreviewCheckPoint = bool(v != 0)
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrePromotionAnalysisRunStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11134,16 +19250,13 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrePromotionAnalysisRunStatus == nil {
-				m.PrePromotionAnalysisRunStatus = &RolloutAnalysisRunStatus{}
-			}
-			if err := m.PrePromotionAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotionAnalysisRunStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11170,10 +19283,7 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PostPromotionAnalysisRunStatus == nil {
-				m.PostPromotionAnalysisRunStatus = &RolloutAnalysisRunStatus{}
-			}
-			if err := m.PostPromotionAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11198,7 +19308,7 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
+func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11221,47 +19331,15 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlueGreenStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlueGreenStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveService", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActiveService = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11273,89 +19351,29 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviewService = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewReplicaCount", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PreviewReplicaCount = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.AutoPromotionEnabled = &b
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionSeconds", wireType)
-			}
-			m.AutoPromotionSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AutoPromotionSeconds |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11365,33 +19383,29 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxUnavailable == nil {
-				m.MaxUnavailable = &intstr.IntOrString{}
-			}
-			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11401,37 +19415,29 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ScaleDownDelaySeconds = &v
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelayRevisionLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ScaleDownDelayRevisionLimit = &v
-		case 9:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrePromotionAnalysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11441,33 +19447,29 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrePromotionAnalysis == nil {
-				m.PrePromotionAnalysis = &RolloutAnalysis{}
-			}
-			if err := m.PrePromotionAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11477,33 +19479,29 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AntiAffinity == nil {
-				m.AntiAffinity = &AntiAffinity{}
-			}
-			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotionAnalysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11513,31 +19511,27 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PostPromotionAnalysis == nil {
-				m.PostPromotionAnalysis = &RolloutAnalysis{}
-			}
-			if err := m.PostPromotionAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviewMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11564,16 +19558,13 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviewMetadata == nil {
-				m.PreviewMetadata = &PodTemplateMetadata{}
-			}
-			if err := m.PreviewMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11600,10 +19591,8 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActiveMetadata == nil {
-				m.ActiveMetadata = &PodTemplateMetadata{}
-			}
-			if err := m.ActiveMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Scopes = append(m.Scopes, KayentaScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11628,7 +19617,7 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
+func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11651,17 +19640,17 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaScope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaScope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepAnalysisRunStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11671,31 +19660,27 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentStepAnalysisRunStatus == nil {
-				m.CurrentStepAnalysisRunStatus = &RolloutAnalysisRunStatus{}
-			}
-			if err := m.CurrentStepAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBackgroundAnalysisRunStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlScope", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11722,18 +19707,15 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentBackgroundAnalysisRunStatus == nil {
-				m.CurrentBackgroundAnalysisRunStatus = &RolloutAnalysisRunStatus{}
-			}
-			if err := m.CurrentBackgroundAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ControlScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentExperiment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExperimentScope", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11743,23 +19725,24 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentExperiment = string(dAtA[iNdEx:postIndex])
+			if err := m.ExperimentScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11782,7 +19765,7 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CanaryStep) Unmarshal(dAtA []byte) error {
+func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11805,17 +19788,17 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
 			}
-			var v int32
+			m.Pass = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11825,17 +19808,16 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.Pass |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SetWeight = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marginal", wireType)
 			}
-			var msglen int
+			m.Marginal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11845,31 +19827,64 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Marginal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pause == nil {
-				m.Pause = &RolloutPause{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedServices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedServices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedServices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Experiment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11896,18 +19911,16 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Experiment == nil {
-				m.Experiment = &RolloutExperimentStep{}
-			}
-			if err := m.Experiment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ports = append(m.Ports, v12.ServicePort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11917,31 +19930,27 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &RolloutAnalysis{}
-			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = k8s_io_api_core_v1.ServiceType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetCanaryScale", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11968,10 +19977,10 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetCanaryScale == nil {
-				m.SetCanaryScale = &SetCanaryScale{}
+			if m.Metadata == nil {
+				m.Metadata = &PodTemplateMetadata{}
 			}
-			if err := m.SetCanaryScale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11996,7 +20005,7 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
+func (m *Measurement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12019,15 +20028,15 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12055,11 +20064,11 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanaryService = string(dAtA[iNdEx:postIndex])
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12087,11 +20096,11 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableService = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12118,14 +20127,211 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, CanaryStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12152,90 +20358,68 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TrafficRouting == nil {
-				m.TrafficRouting = &RolloutTrafficRouting{}
+			if m.ResumeAt == nil {
+				m.ResumeAt = &v1.Time{}
 			}
-			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResumeAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxUnavailable == nil {
-				m.MaxUnavailable = &intstr.IntOrString{}
-			}
-			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxSurge == nil {
-				m.MaxSurge = &intstr.IntOrString{}
-			}
-			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12245,33 +20429,29 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &RolloutAnalysisBackground{}
-			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12281,33 +20461,29 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AntiAffinity == nil {
-				m.AntiAffinity = &AntiAffinity{}
-			}
-			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12317,31 +20493,27 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CanaryMetadata == nil {
-				m.CanaryMetadata = &PodTemplateMetadata{}
-			}
-			if err := m.CanaryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InitialDelay = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12368,38 +20540,18 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StableMetadata == nil {
-				m.StableMetadata = &PodTemplateMetadata{}
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
 			}
-			if err := m.StableMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ScaleDownDelaySeconds = &v
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelayRevisionLimit", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12409,67 +20561,29 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ScaleDownDelayRevisionLimit = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12479,28 +20593,27 @@ func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12527,63 +20640,16 @@ func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.FailureLimit == nil {
+				m.FailureLimit = &intstr.IntOrString{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.FailureLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12610,13 +20676,16 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InconclusiveLimit == nil {
+				m.InconclusiveLimit = &intstr.IntOrString{}
+			}
+			if err := m.InconclusiveLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveErrorLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12643,66 +20712,18 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterAnalysisTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.ConsecutiveErrorLimit == nil {
+				m.ConsecutiveErrorLimit = &intstr.IntOrString{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ConsecutiveErrorLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DatadogMetric: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatadogMetric: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12712,27 +20733,28 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationMode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12760,7 +20782,7 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.EvaluationMode = MetricEvaluationMode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12783,7 +20805,7 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Experiment) Unmarshal(dAtA []byte) error {
+func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12806,15 +20828,15 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12841,13 +20863,16 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Prometheus == nil {
+				m.Prometheus = &PrometheusMetric{}
+			}
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kayenta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12874,13 +20899,16 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Kayenta == nil {
+				m.Kayenta = &KayentaMetric{}
+			}
+			if err := m.Kayenta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Web", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12907,65 +20935,18 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Web == nil {
+				m.Web = &WebMetric{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Web.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12975,29 +20956,33 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Datadog == nil {
+				m.Datadog = &DatadogMetric{}
+			}
+			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13007,29 +20992,33 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
+			if m.Wavefront == nil {
+				m.Wavefront = &WavefrontMetric{}
+			}
+			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13039,29 +21028,33 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			if m.NewRelic == nil {
+				m.NewRelic = &NewRelicMetric{}
+			}
+			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13071,23 +21064,27 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &JobMetric{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13110,7 +21107,7 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+func (m *MetricResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13133,10 +21130,10 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13173,7 +21170,7 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13201,13 +21198,13 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13217,17 +21214,31 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterScope = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Measurements = append(m.Measurements, Measurement{})
+			if err := m.Measurements[len(m.Measurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13237,31 +21248,29 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, Argument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13271,67 +21280,16 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Count |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiredForCompletion = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentCondition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentCondition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
 			}
-			var stringLen uint64
+			m.Successful = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13341,29 +21299,16 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Successful |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = ExperimentConditionType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var stringLen uint64
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13373,29 +21318,35 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Failed |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Inconclusive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inconclusive |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			m.Error = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13405,30 +21356,16 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Error |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
 			}
-			var msglen int
+			m.ConsecutiveError = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13438,28 +21375,64 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConsecutiveError |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13487,11 +21460,11 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Profile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13519,7 +21492,7 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13542,7 +21515,7 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentList) Unmarshal(dAtA []byte) error {
+func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13565,17 +21538,17 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentList: wiretype end group for non-group")
+			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13585,28 +21558,59 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableIngress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13633,10 +21637,103 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Experiment{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.AdditionalIngressAnnotations == nil {
+				m.AdditionalIngressAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.AdditionalIngressAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13659,7 +21756,7 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
+func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13682,17 +21779,17 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13702,29 +21799,27 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, TemplateSpec{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13752,53 +21847,13 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ProgressDeadlineSeconds = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Terminate = bool(v != 0)
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13808,25 +21863,23 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Analyses = append(m.Analyses, ExperimentAnalysisTemplateRef{})
-			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13849,7 +21902,7 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
+func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13872,15 +21925,15 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13908,13 +21961,13 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13924,27 +21977,78 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateStatuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13971,50 +22075,107 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateStatuses = append(m.TemplateStatuses, TemplateStatus{})
-			if err := m.TemplateStatuses[len(m.TemplateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableAt", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AvailableAt == nil {
-				m.AvailableAt = &v1.Time{}
-			}
-			if err := m.AvailableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14041,44 +22202,103 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ExperimentCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AnalysisRuns = append(m.AnalysisRuns, ExperimentAnalysisRunStatus{})
-			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14101,7 +22321,7 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FieldRef) Unmarshal(dAtA []byte) error {
+func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14124,17 +22344,17 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FieldRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FieldRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLen uint64
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14144,24 +22364,11 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Weight |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FieldPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14183,7 +22390,7 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
+func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14206,15 +22413,15 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14242,11 +22449,11 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14274,40 +22481,58 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequiredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14329,7 +22554,7 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *RevisionAnalysis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14352,17 +22577,17 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionAnalysis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14372,30 +22597,29 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14405,81 +22629,47 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DestinationRule == nil {
-				m.DestinationRule = &IstioDestinationRule{}
-			}
-			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepIndex", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.StepIndex = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14507,11 +22697,11 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14539,7 +22729,7 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14562,7 +22752,7 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobMetric) Unmarshal(dAtA []byte) error {
+func (m *RevisionImage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14585,17 +22775,17 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionImage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionImage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14605,30 +22795,29 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Container = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14638,24 +22827,23 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14678,7 +22866,7 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
+func (m *RevisionStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14701,17 +22889,17 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14721,27 +22909,14 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14769,13 +22944,13 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Application = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14785,29 +22960,30 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14817,29 +22993,80 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackWindowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackWindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
-			var stringLen uint64
+			m.Revisions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14849,29 +23076,66 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Revisions |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Rollout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14881,27 +23145,28 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14928,13 +23193,13 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14961,8 +23226,7 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, KayentaScope{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14987,7 +23251,7 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaScope) Unmarshal(dAtA []byte) error {
+func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15010,47 +23274,15 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaScope: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaScope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15077,13 +23309,14 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ControlScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Templates = append(m.Templates, RolloutAnalysisTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExperimentScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15110,7 +23343,8 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExperimentScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15135,7 +23369,7 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
+func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15158,17 +23392,17 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaThreshold: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
 			}
-			m.Pass = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15178,16 +23412,30 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Pass |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marginal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
 			}
-			m.Marginal = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15197,11 +23445,12 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Marginal |= int64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.StartingStep = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15223,7 +23472,7 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Measurement) Unmarshal(dAtA []byte) error {
+func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15246,15 +23495,15 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15282,11 +23531,11 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15314,13 +23563,13 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15330,67 +23579,77 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysisTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15418,140 +23677,13 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15561,28 +23693,12 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResumeAt == nil {
-				m.ResumeAt = &v1.Time{}
-			}
-			if err := m.ResumeAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ClusterScope = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15604,7 +23720,7 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metric) Unmarshal(dAtA []byte) error {
+func (m *RolloutApprovalHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15627,15 +23743,15 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutApprovalHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutApprovalHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15663,11 +23779,11 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15695,13 +23811,13 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15711,27 +23827,81 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialDelay = DurationString(dAtA[iNdEx:postIndex])
+			if m.ValueFrom == nil {
+				m.ValueFrom = &RolloutApprovalHeaderSource{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutApprovalHeaderSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutApprovalHeaderSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutApprovalHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15758,18 +23928,68 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &SecretKeyRef{}
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutApprovalStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutApprovalStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutApprovalStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepIndex", wireType)
+			}
+			m.StepIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15779,27 +23999,14 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StepIndex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15827,13 +24034,13 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			m.Phase = ApprovalPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15843,31 +24050,27 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailureLimit == nil {
-				m.FailureLimit = &intstr.IntOrString{}
-			}
-			if err := m.FailureLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15894,16 +24097,16 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InconclusiveLimit == nil {
-				m.InconclusiveLimit = &intstr.IntOrString{}
+			if m.RequestedAt == nil {
+				m.RequestedAt = &v1.Time{}
 			}
-			if err := m.InconclusiveLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveErrorLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRequestedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15930,18 +24133,18 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsecutiveErrorLimit == nil {
-				m.ConsecutiveErrorLimit = &intstr.IntOrString{}
+			if m.LastRequestedAt == nil {
+				m.LastRequestedAt = &v1.Time{}
 			}
-			if err := m.ConsecutiveErrorLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastRequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15951,24 +24154,23 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15991,7 +24193,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricProvider) Unmarshal(dAtA []byte) error {
+func (m *RolloutApprovalStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16014,125 +24216,17 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutApprovalStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutApprovalStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Prometheus == nil {
-				m.Prometheus = &PrometheusMetric{}
-			}
-			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kayenta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Kayenta == nil {
-				m.Kayenta = &KayentaMetric{}
-			}
-			if err := m.Kayenta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Web", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Web == nil {
-				m.Web = &WebMetric{}
-			}
-			if err := m.Web.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16142,31 +24236,27 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datadog == nil {
-				m.Datadog = &DatadogMetric{}
-			}
-			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16193,18 +24283,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Wavefront == nil {
-				m.Wavefront = &WavefrontMetric{}
-			}
-			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, RolloutApprovalHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollInterval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16214,33 +24302,29 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRelic == nil {
-				m.NewRelic = &NewRelicMetric{}
-			}
-			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PollInterval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16250,27 +24334,23 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &JobMetric{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Timeout = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16293,7 +24373,7 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricResult) Unmarshal(dAtA []byte) error {
+func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16316,15 +24396,15 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16352,11 +24432,11 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = RolloutConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16384,11 +24464,11 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16415,16 +24495,15 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Measurements = append(m.Measurements, Measurement{})
-			if err := m.Measurements[len(m.Measurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16434,29 +24513,30 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16466,54 +24546,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
-			}
-			m.Successful = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Failed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			m.Inconclusive = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16523,49 +24578,24 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Inconclusive |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
-			}
-			m.Error = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Error |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ConsecutiveError = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConsecutiveError |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16587,7 +24617,7 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16610,17 +24640,17 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutExperimentStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutExperimentStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16630,27 +24660,29 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			m.Templates = append(m.Templates, RolloutExperimentTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16678,7 +24710,41 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyses = append(m.Analyses, RolloutExperimentStepAnalysisTemplateRef{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16701,7 +24767,7 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16724,15 +24790,15 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16760,11 +24826,11 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16792,11 +24858,31 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableIngress = string(dAtA[iNdEx:postIndex])
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16823,104 +24909,31 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdditionalIngressAnnotations == nil {
-				m.AdditionalIngressAnnotations = make(map[string]string)
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AdditionalIngressAnnotations[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.RequiredForCompletion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16942,7 +24955,7 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectRef) Unmarshal(dAtA []byte) error {
+func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16965,15 +24978,15 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutExperimentTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutExperimentTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17001,11 +25014,11 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecRef", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17033,13 +25046,13 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.SpecRef = ReplicaSetSpecRef(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17049,79 +25062,17 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PauseCondition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Replicas = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17131,27 +25082,28 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17178,7 +25130,10 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17203,7 +25158,7 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
+func (m *RolloutFreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17226,15 +25181,15 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutFreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17261,107 +25216,13 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17388,103 +25249,9 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17507,7 +25274,7 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
+func (m *RolloutFreezeList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17530,17 +25297,17 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutFreezeList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutFreezeList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17550,11 +25317,59 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, RolloutFreeze{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17576,7 +25391,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
+func (m *RolloutFreezeSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17599,17 +25414,17 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutFreezeSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutFreezeSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17619,29 +25434,33 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17651,74 +25470,26 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Windows = append(m.Windows, FreezeWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequiredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17740,7 +25511,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *Rollout) Unmarshal(dAtA []byte) error {
+func (m *RolloutHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17763,17 +25534,17 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17783,28 +25554,27 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17831,15 +25601,15 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17849,24 +25619,23 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FailurePolicy = HookFailurePolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17889,7 +25658,7 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
+func (m *RolloutHookStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17912,17 +25681,17 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutHookStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutHookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17932,31 +25701,29 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, RolloutAnalysisTemplate{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17966,81 +25733,125 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Type = HookType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.JobName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18050,30 +25861,29 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Occurrence", wireType)
 			}
-			var v int32
+			m.Occurrence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18083,12 +25893,11 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				m.Occurrence |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StartingStep = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18110,7 +25919,7 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+func (m *RolloutHooks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18133,17 +25942,17 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutHooks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutHooks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreRollout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18153,29 +25962,31 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PreRollout = append(m.PreRollout, RolloutHook{})
+			if err := m.PreRollout[len(m.PreRollout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotion", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18185,29 +25996,31 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.PostPromotion = append(m.PostPromotion, RolloutHook{})
+			if err := m.PostPromotion[len(m.PostPromotion)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostAbort", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18217,23 +26030,25 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.PostAbort = append(m.PostAbort, RolloutHook{})
+			if err := m.PostAbort[len(m.PostAbort)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18256,7 +26071,7 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
+func (m *RolloutList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18279,17 +26094,17 @@ func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18299,29 +26114,30 @@ func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18331,12 +26147,26 @@ func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterScope = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Rollout{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18358,7 +26188,7 @@ func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
+func (m *RolloutPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18381,17 +26211,17 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18401,92 +26231,81 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = RolloutConditionType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if m.Duration == nil {
+				m.Duration = &intstr.IntOrString{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18513,15 +26332,15 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18531,55 +26350,24 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18602,7 +26390,7 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
+func (m *RolloutRevisionList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18625,15 +26413,15 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutRevisionList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutRevisionList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18660,46 +26448,13 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, RolloutExperimentTemplate{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Duration = DurationString(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18726,8 +26481,8 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Analyses = append(m.Analyses, RolloutExperimentStepAnalysisTemplateRef{})
-			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, RolloutRevision{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18752,7 +26507,7 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+func (m *RolloutRevisionSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18775,15 +26530,15 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutRevisionSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutRevisionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18811,13 +26566,13 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RolloutName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var stringLen uint64
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18827,29 +26582,16 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18859,15 +26601,27 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterScope = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18894,16 +26648,16 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Images = append(m.Images, RevisionImage{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18913,65 +26667,27 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiredForCompletion = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Result = RevisionResult(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18999,11 +26715,11 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19031,33 +26747,13 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecRef = ReplicaSetSpecRef(dAtA[iNdEx:postIndex])
+			m.PromotedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19067,28 +26763,27 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AbortReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19115,66 +26810,16 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19201,13 +26846,13 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19234,64 +26879,14 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Rollout{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, RevisionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutPause) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19318,10 +26913,8 @@ func (m *RolloutPause) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &intstr.IntOrString{}
-			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Analyses = append(m.Analyses, RevisionAnalysis{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19483,9 +27076,138 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineSeconds = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartAt == nil {
+				m.RestartAt = &v1.Time{}
+			}
+			if err := m.RestartAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19512,33 +27234,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WorkloadRef == nil {
+				m.WorkloadRef = &ObjectRef{}
+			}
+			if err := m.WorkloadRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RevisionHistoryLimit = &v
-		case 7:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineAbort", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -19555,30 +27260,10 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ProgressDeadlineSeconds = &v
-		case 9:
+			m.ProgressDeadlineAbort = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19605,16 +27290,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RestartAt == nil {
-				m.RestartAt = &v1.Time{}
+			if m.RollbackWindow == nil {
+				m.RollbackWindow = &RollbackWindowSpec{}
 			}
-			if err := m.RestartAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RollbackWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19641,13 +27326,33 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkloadRef == nil {
-				m.WorkloadRef = &ObjectRef{}
+			if m.Hooks == nil {
+				m.Hooks = &RolloutHooks{}
 			}
-			if err := m.WorkloadRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Hooks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutRevisionLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RolloutRevisionLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20261,9 +27966,189 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			m.PromoteFull = bool(v != 0)
 		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadObservedGeneration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkloadObservedGeneration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, RolloutHookStatus{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20273,29 +28158,33 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
+			if m.BlueGreen == nil {
+				m.BlueGreen = &BlueGreenStrategy{}
+			}
+			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20305,23 +28194,27 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Canary == nil {
+				m.Canary = &CanaryStrategy{}
+			}
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20344,7 +28237,7 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
+func (m *RolloutTimeWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20367,17 +28260,17 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutTimeWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20387,33 +28280,61 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlueGreen == nil {
-				m.BlueGreen = &BlueGreenStrategy{}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysOfWeek", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20423,27 +28344,55 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Canary == nil {
-				m.Canary = &CanaryStrategy{}
+			m.DaysOfWeek = append(m.DaysOfWeek, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
-			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21231,6 +29180,107 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SkippedCanaryStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkippedCanaryStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkippedCanaryStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21800,6 +29850,42 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &ConfigMapKeyRef{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
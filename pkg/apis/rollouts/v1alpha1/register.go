@@ -18,6 +18,9 @@ var (
 	AnalysisTemplateGVR        = SchemeGroupVersion.WithResource("analysistemplates")
 	ClusterAnalysisTemplateGVR = SchemeGroupVersion.WithResource("clusteranalysistemplates")
 	ExperimentGVR              = SchemeGroupVersion.WithResource("experiments")
+	RolloutFreezeGVR           = SchemeGroupVersion.WithResource("rolloutfreezes")
+	ClusterRolloutFreezeGVR    = SchemeGroupVersion.WithResource("clusterrolloutfreezes")
+	RolloutRevisionGVR         = SchemeGroupVersion.WithResource("rolloutrevisions")
 )
 
 // Kind takes an unqualified kind and returns back a Group qualified GroupKind
@@ -48,6 +51,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterAnalysisTemplateList{},
 		&AnalysisRun{},
 		&AnalysisRunList{},
+		&RolloutFreeze{},
+		&RolloutFreezeList{},
+		&ClusterRolloutFreeze{},
+		&ClusterRolloutFreezeList{},
+		&RolloutRevision{},
+		&RolloutRevisionList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,116 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RolloutRevision is the record of a revision of a rollout which completed or was aborted. It is
+// kept after the ReplicaSet of the revision is garbage collected
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=rolloutrevisions,shortName=rorev
+// +kubebuilder:printcolumn:name="Rollout",type="string",JSONPath=".spec.rolloutName",description="Name of the rollout"
+// +kubebuilder:printcolumn:name="Revision",type="integer",JSONPath=".spec.revision",description="Revision of the rollout"
+// +kubebuilder:printcolumn:name="Result",type="string",JSONPath=".spec.result",description="Result of the revision"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type RolloutRevision struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutRevisionSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// RolloutRevisionList is a list of RolloutRevision resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RolloutRevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RolloutRevision `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RolloutRevisionSpec is the record of a revision of a rollout
+type RolloutRevisionSpec struct {
+	// RolloutName is the name of the rollout of the revision
+	RolloutName string `json:"rolloutName" protobuf:"bytes,1,opt,name=rolloutName"`
+	// Revision is the revision number of the rollout
+	Revision int64 `json:"revision" protobuf:"varint,2,opt,name=revision"`
+	// PodTemplateHash is the hash of the pod template of the revision
+	PodTemplateHash string `json:"podTemplateHash" protobuf:"bytes,3,opt,name=podTemplateHash"`
+	// Images are the images of the containers of the revision
+	// +optional
+	Images []RevisionImage `json:"images,omitempty" protobuf:"bytes,4,rep,name=images"`
+	// Result is the final result of the revision
+	Result RevisionResult `json:"result" protobuf:"bytes,5,opt,name=result,casttype=RevisionResult"`
+	// Message explains the result of the revision, e.g. why it was aborted
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+	// PromotedBy is who promoted the revision to stable: the user who last promoted the update according to the audit log of the rollout, user or controller
+	// +optional
+	PromotedBy string `json:"promotedBy,omitempty" protobuf:"bytes,7,opt,name=promotedBy"`
+	// AbortReason is the reason of the abort of the revision. The reason of an abort by a user is the reason recorded in the audit log of the rollout, or User
+	// +optional
+	AbortReason string `json:"abortReason,omitempty" protobuf:"bytes,8,opt,name=abortReason"`
+	// StartedAt is the time at which the update to the revision started, if known
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,9,opt,name=startedAt"`
+	// FinishedAt is the time at which the revision completed or was aborted
+	FinishedAt metav1.Time `json:"finishedAt" protobuf:"bytes,10,opt,name=finishedAt"`
+	// Steps are the timings of the canary steps which the revision went through
+	// +optional
+	Steps []RevisionStep `json:"steps,omitempty" protobuf:"bytes,11,rep,name=steps"`
+	// Analyses are the outcomes of the analysis runs of the revision
+	// +optional
+	Analyses []RevisionAnalysis `json:"analyses,omitempty" protobuf:"bytes,12,rep,name=analyses"`
+}
+
+const (
+	// RolloutRevisionRolloutLabel is the label of a RolloutRevision which holds the name of its rollout
+	RolloutRevisionRolloutLabel = "argo-rollouts.argoproj.io/rollout"
+)
+
+// RevisionResult is the final result of a revision
+type RevisionResult string
+
+const (
+	// RevisionResultCompleted means the revision was promoted to stable
+	RevisionResultCompleted RevisionResult = "Completed"
+	// RevisionResultAborted means the update to the revision was aborted
+	RevisionResultAborted RevisionResult = "Aborted"
+)
+
+// RevisionImage is the image of a container of a revision
+type RevisionImage struct {
+	// Container is the name of the container
+	Container string `json:"container" protobuf:"bytes,1,opt,name=container"`
+	// Image is the image of the container
+	Image string `json:"image" protobuf:"bytes,2,opt,name=image"`
+}
+
+// RevisionStep is the timing of a canary step of a revision
+type RevisionStep struct {
+	// Index is the index of the step in the canary steps
+	Index int32 `json:"index" protobuf:"varint,1,opt,name=index"`
+	// Type is the type of the step, e.g. setWeight or pause
+	Type string `json:"type" protobuf:"bytes,2,opt,name=type"`
+	// StartedAt is the time at which the step started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,3,opt,name=startedAt"`
+	// FinishedAt is the time at which the rollout moved past the step
+	FinishedAt metav1.Time `json:"finishedAt" protobuf:"bytes,4,opt,name=finishedAt"`
+}
+
+// RevisionAnalysis is the outcome of an analysis run of a revision
+type RevisionAnalysis struct {
+	// Name is the name of the analysis run
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Type is the type of the analysis run: Step, Background, PrePromotion or PostPromotion
+	// +optional
+	Type string `json:"type,omitempty" protobuf:"bytes,2,opt,name=type"`
+	// StepIndex is the index of the canary step which ran the analysis
+	// +optional
+	StepIndex *int32 `json:"stepIndex,omitempty" protobuf:"varint,3,opt,name=stepIndex"`
+	// Phase is the phase of the analysis run
+	Phase AnalysisPhase `json:"phase" protobuf:"bytes,4,opt,name=phase,casttype=AnalysisPhase"`
+	// Message is the message of the analysis run
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
@@ -71,8 +71,80 @@ type RolloutSpec struct {
 	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty" protobuf:"varint,8,opt,name=progressDeadlineSeconds"`
 	// RestartAt indicates when all the pods of a Rollout should be restarted
 	RestartAt *metav1.Time `json:"restartAt,omitempty" protobuf:"bytes,9,opt,name=restartAt"`
+	// ProgressDeadlineAbort aborts the update when the rollout exceeds ProgressDeadlineSeconds,
+	// which shifts traffic back to the stable version and scales down the new ReplicaSet.
+	// Defaults to false, which only surfaces a ProgressDeadlineExceeded condition.
+	// +optional
+	ProgressDeadlineAbort bool `json:"progressDeadlineAbort,omitempty" protobuf:"varint,11,opt,name=progressDeadlineAbort"`
+	// RollbackWindow fast-tracks updates back to recently stable revisions, skipping the steps
+	// and analysis of the strategy
+	// +optional
+	RollbackWindow *RollbackWindowSpec `json:"rollbackWindow,omitempty" protobuf:"bytes,12,opt,name=rollbackWindow"`
+	// Hooks are Jobs run at defined points of the lifecycle of an update
+	// +optional
+	Hooks *RolloutHooks `json:"hooks,omitempty" protobuf:"bytes,13,opt,name=hooks"`
+	// The number of RolloutRevision records of completed or aborted revisions to retain. If
+	// unspecified, will retain 10 records. Records are not written when set to 0
+	// +optional
+	RolloutRevisionLimit *int32 `json:"rolloutRevisionLimit,omitempty" protobuf:"varint,14,opt,name=rolloutRevisionLimit"`
+}
+
+// RollbackWindowSpec defines which updates are fast-tracked as rollbacks
+type RollbackWindowSpec struct {
+	// Revisions is the maximum number of revisions rolled out since the target revision was last
+	// stable for the update to be fast-tracked
+	Revisions int32 `json:"revisions,omitempty" protobuf:"varint,1,opt,name=revisions"`
+}
+
+// RolloutHooks defines the Jobs run at defined points of the lifecycle of an update
+type RolloutHooks struct {
+	// PreRollout hooks run before the ReplicaSet of a new pod template is created. The new
+	// ReplicaSet is only created once all of them completed
+	// +optional
+	PreRollout []RolloutHook `json:"preRollout,omitempty" protobuf:"bytes,1,rep,name=preRollout"`
+	// PostPromotion hooks run after a revision is fully promoted
+	// +optional
+	PostPromotion []RolloutHook `json:"postPromotion,omitempty" protobuf:"bytes,2,rep,name=postPromotion"`
+	// PostAbort hooks run after an update is aborted
+	// +optional
+	PostAbort []RolloutHook `json:"postAbort,omitempty" protobuf:"bytes,3,rep,name=postAbort"`
+}
+
+// RolloutHook is a Job run at a defined point of the lifecycle of an update
+type RolloutHook struct {
+	// Name of the hook, unique among all the hooks of the rollout
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Job is the Job run by the hook
+	Job JobMetric `json:"job" protobuf:"bytes,2,opt,name=job"`
+	// FailurePolicy decides what happens when the Job of the hook fails. Abort aborts the update,
+	// Ignore only records a warning event. Failed PostPromotion and PostAbort hooks never abort the
+	// rollout. Defaults to Abort
+	// +optional
+	FailurePolicy HookFailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,3,opt,name=failurePolicy,casttype=HookFailurePolicy"`
 }
 
+// HookFailurePolicy decides what happens when the Job of a hook fails
+type HookFailurePolicy string
+
+const (
+	// HookFailurePolicyAbort aborts the update when the Job of the hook fails
+	HookFailurePolicyAbort HookFailurePolicy = "Abort"
+	// HookFailurePolicyIgnore only records a warning event when the Job of the hook fails
+	HookFailurePolicyIgnore HookFailurePolicy = "Ignore"
+)
+
+// HookType is the point of the lifecycle of an update a hook runs at
+type HookType string
+
+const (
+	// HookTypePreRollout hooks run before the ReplicaSet of a new pod template is created
+	HookTypePreRollout HookType = "PreRollout"
+	// HookTypePostPromotion hooks run after a revision is fully promoted
+	HookTypePostPromotion HookType = "PostPromotion"
+	// HookTypePostAbort hooks run after an update is aborted
+	HookTypePostAbort HookType = "PostAbort"
+)
+
 func (s *RolloutSpec) SetResolvedSelector(selector *metav1.LabelSelector) {
 	s.SelectorResolvedFromRef = true
 	s.Selector = selector
@@ -202,6 +274,23 @@ type BlueGreenStrategy struct {
 	// ActiveMetadata specify labels and annotations which will be attached to the active pods for
 	// the duration which they act as a active pod, and will be removed after
 	ActiveMetadata *PodTemplateMetadata `json:"activeMetadata,omitempty" protobuf:"bytes,13,opt,name=activeMetadata"`
+	// AbortScaleDownDelaySeconds adds a delay before scaling down the preview ReplicaSet when the
+	// update is aborted, so that the aborted pods can be inspected. 0 means the preview ReplicaSet
+	// is not scaled down until the update is retried or replaced. If unset, the preview ReplicaSet
+	// is left running.
+	// +optional
+	AbortScaleDownDelaySeconds *int32 `json:"abortScaleDownDelaySeconds,omitempty" protobuf:"varint,14,opt,name=abortScaleDownDelaySeconds"`
+	// AdditionalActiveServices are the names of additional services which the rollout modifies
+	// together with the active service, e.g. services exposing other ports of the same pods
+	// +optional
+	AdditionalActiveServices []string `json:"additionalActiveServices,omitempty" protobuf:"bytes,15,rep,name=additionalActiveServices"`
+	// AdditionalPreviewServices are the names of additional services which the rollout modifies
+	// together with the preview service
+	// +optional
+	AdditionalPreviewServices []string `json:"additionalPreviewServices,omitempty" protobuf:"bytes,16,rep,name=additionalPreviewServices"`
+	// ManagedServices makes the controller create and own the active and preview services
+	// +optional
+	ManagedServices *ManagedServices `json:"managedServices,omitempty" protobuf:"bytes,17,opt,name=managedServices"`
 }
 
 // AntiAffinity defines which inter-pod scheduling rule to use for anti-affinity injection
@@ -282,6 +371,29 @@ type CanaryStrategy struct {
 	// ScaleDownDelayRevisionLimit limits the number of old RS that can run at one time before getting scaled down
 	// +optional
 	ScaleDownDelayRevisionLimit *int32 `json:"scaleDownDelayRevisionLimit,omitempty" protobuf:"varint,12,opt,name=scaleDownDelayRevisionLimit"`
+	// AbortScaleDownDelaySeconds adds a delay before scaling down the canary ReplicaSet when the
+	// update is aborted, so that the aborted pods can be inspected while receiving no traffic.
+	// 0 means the canary ReplicaSet is not scaled down until the update is retried or replaced.
+	// If unset, the canary ReplicaSet is scaled down immediately. Requires traffic routing.
+	// +optional
+	AbortScaleDownDelaySeconds *int32 `json:"abortScaleDownDelaySeconds,omitempty" protobuf:"varint,13,opt,name=abortScaleDownDelaySeconds"`
+	// ManagedServices makes the controller create and own the canary and stable services
+	// +optional
+	ManagedServices *ManagedServices `json:"managedServices,omitempty" protobuf:"bytes,14,opt,name=managedServices"`
+}
+
+// ManagedServices defines the Services which the controller creates for a rollout. The Services
+// select the pods of the rollout and are owned by it, so they are deleted together with the rollout.
+// Existing Services which are not owned by the rollout are left untouched.
+type ManagedServices struct {
+	// Ports are the ports exposed by the Services
+	Ports []corev1.ServicePort `json:"ports" protobuf:"bytes,1,rep,name=ports"`
+	// Type of the Services. Defaults to ClusterIP
+	// +optional
+	Type corev1.ServiceType `json:"type,omitempty" protobuf:"bytes,2,opt,name=type,casttype=k8s.io/api/core/v1.ServiceType"`
+	// Metadata holds the labels and annotations which are added to the Services
+	// +optional
+	Metadata *PodTemplateMetadata `json:"metadata,omitempty" protobuf:"bytes,3,opt,name=metadata"`
 }
 
 // ALBTrafficRouting configuration for ALB ingress controller to control traffic routing
@@ -450,6 +562,71 @@ type CanaryStep struct {
 	// SetCanaryScale defines how to scale the newRS without changing traffic weight
 	// +optional
 	SetCanaryScale *SetCanaryScale `json:"setCanaryScale,omitempty" protobuf:"bytes,5,opt,name=setCanaryScale"`
+	// TimeWindow pauses the rollout until the current time is within the window
+	// +optional
+	TimeWindow *RolloutTimeWindow `json:"timeWindow,omitempty" protobuf:"bytes,6,opt,name=timeWindow"`
+	// When is an expression evaluated against the rollout metadata, the analysis args and the results
+	// of previous analysis runs when the step is reached. The step is skipped if it evaluates to false
+	// +optional
+	When string `json:"when,omitempty" protobuf:"bytes,7,opt,name=when"`
+	// Approval pauses the rollout until an external approval endpoint approves or denies the step.
+	// A denied step aborts the rollout
+	// +optional
+	Approval *RolloutApprovalStep `json:"approval,omitempty" protobuf:"bytes,8,opt,name=approval"`
+}
+
+// RolloutApprovalStep defines an external approval gate
+type RolloutApprovalStep struct {
+	// URL is the address of the approval endpoint. The controller POSTs an approval request to the URL
+	// when the step is reached
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Headers are added to the approval requests
+	// +optional
+	Headers []RolloutApprovalHeader `json:"headers,omitempty" protobuf:"bytes,2,rep,name=headers"`
+	// PollInterval is how often the approval request is resent while the answer is pending. If omitted,
+	// the request is sent once and the answer is expected to be recorded on the rollout status. A failed
+	// request is retried after the poll interval, or after 30s if it is omitted
+	// +optional
+	PollInterval DurationString `json:"pollInterval,omitempty" protobuf:"bytes,3,opt,name=pollInterval,casttype=DurationString"`
+	// Timeout denies the step if no answer was received within the duration. Defaults to waiting indefinitely
+	// +optional
+	Timeout DurationString `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout,casttype=DurationString"`
+}
+
+// RolloutApprovalHeader is a header of approval requests. Its value is either set inline or read from
+// a Secret in the namespace of the rollout
+type RolloutApprovalHeader struct {
+	// Key is the name of the header
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// Value is the value of the header
+	// +optional
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// ValueFrom is the source of the value of the header
+	// +optional
+	ValueFrom *RolloutApprovalHeaderSource `json:"valueFrom,omitempty" protobuf:"bytes,3,opt,name=valueFrom"`
+}
+
+// RolloutApprovalHeaderSource is the source of the value of an approval request header
+type RolloutApprovalHeaderSource struct {
+	// SecretKeyRef selects a key of a Secret in the namespace of the rollout
+	SecretKeyRef *SecretKeyRef `json:"secretKeyRef,omitempty" protobuf:"bytes,1,opt,name=secretKeyRef"`
+}
+
+// RolloutTimeWindow defines a recurring time of day during which a canary step is allowed to complete
+type RolloutTimeWindow struct {
+	// StartTime is the time of day (HH:MM) at which the window opens. Defaults to 00:00
+	// +optional
+	StartTime string `json:"startTime,omitempty" protobuf:"bytes,1,opt,name=startTime"`
+	// EndTime is the time of day (HH:MM) at which the window closes. An EndTime earlier than the
+	// StartTime closes the window on the following day. Defaults to the end of the day
+	// +optional
+	EndTime string `json:"endTime,omitempty" protobuf:"bytes,2,opt,name=endTime"`
+	// DaysOfWeek restricts the days on which the window opens (e.g. Mon, Tue). Defaults to every day
+	// +optional
+	DaysOfWeek []string `json:"daysOfWeek,omitempty" protobuf:"bytes,3,rep,name=daysOfWeek"`
+	// TimeZone is the IANA time zone in which the window is evaluated (e.g. Europe/Berlin). Defaults to UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,4,opt,name=timeZone"`
 }
 
 // SetCanaryScale defines how to scale the newRS without changing traffic weight
@@ -508,6 +685,12 @@ type ArgumentValueFrom struct {
 	PodTemplateHashValue *ValueFromPodTemplateHash `json:"podTemplateHashValue,omitempty" protobuf:"bytes,1,opt,name=podTemplateHashValue,casttype=ValueFromPodTemplateHash"`
 	//FieldRef
 	FieldRef *FieldRef `json:"fieldRef,omitempty" protobuf:"bytes,2,opt,name=fieldRef"`
+	// ConfigMapKeyRef gets the value from a key of a ConfigMap in the namespace of the Rollout
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeyRef `json:"configMapKeyRef,omitempty" protobuf:"bytes,3,opt,name=configMapKeyRef"`
+	// RolloutStatusValue gets the value from the progress of the Rollout at the time the AnalysisRun is created
+	// +optional
+	RolloutStatusValue *ValueFromRolloutStatus `json:"rolloutStatusValue,omitempty" protobuf:"bytes,4,opt,name=rolloutStatusValue,casttype=ValueFromRolloutStatus"`
 }
 
 type FieldRef struct {
@@ -525,6 +708,16 @@ const (
 	Latest ValueFromPodTemplateHash = "Latest"
 )
 
+// ValueFromRolloutStatus indicates which value of the progress of the Rollout to use
+type ValueFromRolloutStatus string
+
+const (
+	// StepIndex tells the Rollout to use the index of the current canary step
+	StepIndex ValueFromRolloutStatus = "StepIndex"
+	// CanaryWeight tells the Rollout to use the weight of the current canary step
+	CanaryWeight ValueFromRolloutStatus = "CanaryWeight"
+)
+
 const (
 	// RolloutTypeLabel indicates how the rollout created the analysisRun
 	RolloutTypeLabel = "rollout-type"
@@ -592,8 +785,12 @@ const (
 	PauseReasonInconclusiveExperiment PauseReason = "InconclusiveExperiment"
 	// PauseReasonCanaryPauseStep pause rollout for canary pause step
 	PauseReasonCanaryPauseStep PauseReason = "CanaryPauseStep"
+	// PauseReasonCanaryApprovalStep pause rollout until the approval of a canary approval step
+	PauseReasonCanaryApprovalStep PauseReason = "CanaryApprovalStep"
 	// PauseReasonBlueGreenPause pause rollout before promoting rollout
 	PauseReasonBlueGreenPause PauseReason = "BlueGreenPause"
+	// PauseReasonRolloutFreeze pauses rollout during a window of a RolloutFreeze or ClusterRolloutFreeze
+	PauseReasonRolloutFreeze PauseReason = "RolloutFreeze"
 )
 
 // PauseCondition the reason for a pause and when it started
@@ -693,6 +890,31 @@ type RolloutStatus struct {
 	Phase RolloutPhase `json:"phase,omitempty" protobuf:"bytes,22,opt,name=phase,casttype=RolloutPhase"`
 	// Message provides details on why the rollout is in its current phase
 	Message string `json:"message,omitempty" protobuf:"bytes,23,opt,name=message"`
+	// Hooks indicates the state of the hook Jobs run for the current revision
+	// +optional
+	Hooks []RolloutHookStatus `json:"hooks,omitempty" protobuf:"bytes,25,rep,name=hooks"`
+}
+
+// RolloutHookStatus is the state of a hook Job
+type RolloutHookStatus struct {
+	// Name is the name of the hook
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Type is the point of the lifecycle of the update the hook runs at
+	Type HookType `json:"type" protobuf:"bytes,2,opt,name=type,casttype=HookType"`
+	// PodTemplateHash is the pod template hash of the revision the hook runs for
+	PodTemplateHash string `json:"podTemplateHash" protobuf:"bytes,3,opt,name=podTemplateHash"`
+	// JobName is the name of the Job of the hook
+	// +optional
+	JobName string `json:"jobName,omitempty" protobuf:"bytes,4,opt,name=jobName"`
+	// Phase is the phase of the Job of the hook
+	Phase AnalysisPhase `json:"phase" protobuf:"bytes,5,opt,name=phase,casttype=AnalysisPhase"`
+	// Message provides details on the phase of the Job of the hook
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+	// Occurrence counts the aborts of the revision a PostAbort hook runs for, since an update which
+	// is retried can be aborted again. It is not set for the other hooks
+	// +optional
+	Occurrence int32 `json:"occurrence,omitempty" protobuf:"varint,7,opt,name=occurrence"`
 }
 
 // BlueGreenStatus status fields that only pertain to the blueGreen rollout
@@ -720,6 +942,53 @@ type CanaryStatus struct {
 	CurrentBackgroundAnalysisRunStatus *RolloutAnalysisRunStatus `json:"currentBackgroundAnalysisRunStatus,omitempty" protobuf:"bytes,2,opt,name=currentBackgroundAnalysisRunStatus"`
 	// CurrentExperiment indicates the running experiment
 	CurrentExperiment string `json:"currentExperiment,omitempty" protobuf:"bytes,3,opt,name=currentExperiment"`
+	// SkippedSteps lists the steps of the current revision which were skipped by their when expression
+	// +optional
+	SkippedSteps []SkippedCanaryStep `json:"skippedSteps,omitempty" protobuf:"bytes,4,rep,name=skippedSteps"`
+	// Approval indicates the state of the current approval step
+	// +optional
+	Approval *RolloutApprovalStatus `json:"approval,omitempty" protobuf:"bytes,5,opt,name=approval"`
+}
+
+// SkippedCanaryStep describes a canary step which was skipped
+type SkippedCanaryStep struct {
+	// Index is the index of the skipped step
+	Index int32 `json:"index" protobuf:"varint,1,opt,name=index"`
+	// Reason describes why the step was skipped
+	Reason string `json:"reason" protobuf:"bytes,2,opt,name=reason"`
+}
+
+// ApprovalPhase is the state of an approval step
+type ApprovalPhase string
+
+const (
+	// ApprovalPhasePending means the approval step has not been answered yet
+	ApprovalPhasePending ApprovalPhase = "Pending"
+	// ApprovalPhaseApproved means the approval step was approved
+	ApprovalPhaseApproved ApprovalPhase = "Approved"
+	// ApprovalPhaseDenied means the approval step was denied
+	ApprovalPhaseDenied ApprovalPhase = "Denied"
+)
+
+// RolloutApprovalStatus describes the state of an approval step
+type RolloutApprovalStatus struct {
+	// StepIndex is the index of the approval step
+	StepIndex int32 `json:"stepIndex" protobuf:"varint,1,opt,name=stepIndex"`
+	// Phase is the answer to the approval request. An external system may record its answer by
+	// setting the phase to Approved or Denied
+	Phase ApprovalPhase `json:"phase" protobuf:"bytes,2,opt,name=phase,casttype=ApprovalPhase"`
+	// Message is the message accompanying the answer
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// RequestedAt is the time the approval was first requested
+	// +optional
+	RequestedAt *metav1.Time `json:"requestedAt,omitempty" protobuf:"bytes,4,opt,name=requestedAt"`
+	// LastRequestedAt is the time the approval request was last sent
+	// +optional
+	LastRequestedAt *metav1.Time `json:"lastRequestedAt,omitempty" protobuf:"bytes,5,opt,name=lastRequestedAt"`
+	// Error is the error of the last approval request, if it failed
+	// +optional
+	Error string `json:"error,omitempty" protobuf:"bytes,6,opt,name=error"`
 }
 
 type RolloutAnalysisRunStatus struct {
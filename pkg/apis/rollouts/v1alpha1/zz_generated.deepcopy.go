@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
@@ -112,6 +113,26 @@ func (in *AnalysisRunArgument) DeepCopy() *AnalysisRunArgument {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalysisRunJudgment) DeepCopyInto(out *AnalysisRunJudgment) {
+	*out = *in
+	if in.JudgedAt != nil {
+		in, out := &in.JudgedAt, &out.JudgedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisRunJudgment.
+func (in *AnalysisRunJudgment) DeepCopy() *AnalysisRunJudgment {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisRunJudgment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AnalysisRunList) DeepCopyInto(out *AnalysisRunList) {
 	*out = *in
@@ -162,6 +183,11 @@ func (in *AnalysisRunSpec) DeepCopyInto(out *AnalysisRunSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Judgment != nil {
+		in, out := &in.Judgment, &out.Judgment
+		*out = new(AnalysisRunJudgment)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -229,6 +255,29 @@ func (in *AnalysisTemplate) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalysisTemplateInclude) DeepCopyInto(out *AnalysisTemplateInclude) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]Argument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalysisTemplateInclude.
+func (in *AnalysisTemplateInclude) DeepCopy() *AnalysisTemplateInclude {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalysisTemplateInclude)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AnalysisTemplateList) DeepCopyInto(out *AnalysisTemplateList) {
 	*out = *in
@@ -279,6 +328,13 @@ func (in *AnalysisTemplateSpec) DeepCopyInto(out *AnalysisTemplateSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Includes != nil {
+		in, out := &in.Includes, &out.Includes
+		*out = make([]AnalysisTemplateInclude, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -357,6 +413,16 @@ func (in *ArgumentValueFrom) DeepCopyInto(out *ArgumentValueFrom) {
 		*out = new(FieldRef)
 		**out = **in
 	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
+	if in.RolloutStatusValue != nil {
+		in, out := &in.RolloutStatusValue, &out.RolloutStatusValue
+		*out = new(ValueFromRolloutStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -449,6 +515,26 @@ func (in *BlueGreenStrategy) DeepCopyInto(out *BlueGreenStrategy) {
 		*out = new(PodTemplateMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AbortScaleDownDelaySeconds != nil {
+		in, out := &in.AbortScaleDownDelaySeconds, &out.AbortScaleDownDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AdditionalActiveServices != nil {
+		in, out := &in.AdditionalActiveServices, &out.AdditionalActiveServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalPreviewServices != nil {
+		in, out := &in.AdditionalPreviewServices, &out.AdditionalPreviewServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedServices != nil {
+		in, out := &in.ManagedServices, &out.ManagedServices
+		*out = new(ManagedServices)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -475,6 +561,16 @@ func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 		*out = new(RolloutAnalysisRunStatus)
 		**out = **in
 	}
+	if in.SkippedSteps != nil {
+		in, out := &in.SkippedSteps, &out.SkippedSteps
+		*out = make([]SkippedCanaryStep, len(*in))
+		copy(*out, *in)
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(RolloutApprovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -516,6 +612,16 @@ func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 		*out = new(SetCanaryScale)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TimeWindow != nil {
+		in, out := &in.TimeWindow, &out.TimeWindow
+		*out = new(RolloutTimeWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(RolloutApprovalStep)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -584,6 +690,16 @@ func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.AbortScaleDownDelaySeconds != nil {
+		in, out := &in.AbortScaleDownDelaySeconds, &out.AbortScaleDownDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ManagedServices != nil {
+		in, out := &in.ManagedServices, &out.ManagedServices
+		*out = new(ManagedServices)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -657,6 +773,82 @@ func (in *ClusterAnalysisTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutFreeze) DeepCopyInto(out *ClusterRolloutFreeze) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutFreeze.
+func (in *ClusterRolloutFreeze) DeepCopy() *ClusterRolloutFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutFreeze) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutFreezeList) DeepCopyInto(out *ClusterRolloutFreezeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRolloutFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutFreezeList.
+func (in *ClusterRolloutFreezeList) DeepCopy() *ClusterRolloutFreezeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutFreezeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutFreezeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyRef) DeepCopyInto(out *ConfigMapKeyRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyRef.
+func (in *ConfigMapKeyRef) DeepCopy() *ConfigMapKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMetric) DeepCopyInto(out *DatadogMetric) {
 	*out = *in
@@ -881,6 +1073,22 @@ func (in *FieldRef) DeepCopy() *FieldRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeWindow) DeepCopyInto(out *FreezeWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeWindow.
+func (in *FreezeWindow) DeepCopy() *FreezeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioDestinationRule) DeepCopyInto(out *IstioDestinationRule) {
 	*out = *in
@@ -1014,6 +1222,34 @@ func (in *KayentaThreshold) DeepCopy() *KayentaThreshold {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedServices) DeepCopyInto(out *ManagedServices) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]corev1.ServicePort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PodTemplateMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedServices.
+func (in *ManagedServices) DeepCopy() *ManagedServices {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedServices)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Measurement) DeepCopyInto(out *Measurement) {
 	*out = *in
@@ -1310,6 +1546,77 @@ func (in *RequiredDuringSchedulingIgnoredDuringExecution) DeepCopy() *RequiredDu
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionAnalysis) DeepCopyInto(out *RevisionAnalysis) {
+	*out = *in
+	if in.StepIndex != nil {
+		in, out := &in.StepIndex, &out.StepIndex
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionAnalysis.
+func (in *RevisionAnalysis) DeepCopy() *RevisionAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionImage) DeepCopyInto(out *RevisionImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionImage.
+func (in *RevisionImage) DeepCopy() *RevisionImage {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionStep) DeepCopyInto(out *RevisionStep) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionStep.
+func (in *RevisionStep) DeepCopy() *RevisionStep {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackWindowSpec) DeepCopyInto(out *RollbackWindowSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackWindowSpec.
+func (in *RollbackWindowSpec) DeepCopy() *RollbackWindowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackWindowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rollout) DeepCopyInto(out *Rollout) {
 	*out = *in
@@ -1420,6 +1727,95 @@ func (in *RolloutAnalysisTemplate) DeepCopy() *RolloutAnalysisTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutApprovalHeader) DeepCopyInto(out *RolloutApprovalHeader) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(RolloutApprovalHeaderSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutApprovalHeader.
+func (in *RolloutApprovalHeader) DeepCopy() *RolloutApprovalHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutApprovalHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutApprovalHeaderSource) DeepCopyInto(out *RolloutApprovalHeaderSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutApprovalHeaderSource.
+func (in *RolloutApprovalHeaderSource) DeepCopy() *RolloutApprovalHeaderSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutApprovalHeaderSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutApprovalStatus) DeepCopyInto(out *RolloutApprovalStatus) {
+	*out = *in
+	if in.RequestedAt != nil {
+		in, out := &in.RequestedAt, &out.RequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRequestedAt != nil {
+		in, out := &in.LastRequestedAt, &out.LastRequestedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutApprovalStatus.
+func (in *RolloutApprovalStatus) DeepCopy() *RolloutApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutApprovalStep) DeepCopyInto(out *RolloutApprovalStep) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]RolloutApprovalHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutApprovalStep.
+func (in *RolloutApprovalStep) DeepCopy() *RolloutApprovalStep {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutApprovalStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutCondition) DeepCopyInto(out *RolloutCondition) {
 	*out = *in
@@ -1518,6 +1914,162 @@ func (in *RolloutExperimentTemplate) DeepCopy() *RolloutExperimentTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutFreeze) DeepCopyInto(out *RolloutFreeze) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutFreeze.
+func (in *RolloutFreeze) DeepCopy() *RolloutFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutFreeze) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutFreezeList) DeepCopyInto(out *RolloutFreezeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolloutFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutFreezeList.
+func (in *RolloutFreezeList) DeepCopy() *RolloutFreezeList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutFreezeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutFreezeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutFreezeSpec) DeepCopyInto(out *RolloutFreezeSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]FreezeWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutFreezeSpec.
+func (in *RolloutFreezeSpec) DeepCopy() *RolloutFreezeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutFreezeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutHook) DeepCopyInto(out *RolloutHook) {
+	*out = *in
+	in.Job.DeepCopyInto(&out.Job)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutHook.
+func (in *RolloutHook) DeepCopy() *RolloutHook {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutHookStatus) DeepCopyInto(out *RolloutHookStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutHookStatus.
+func (in *RolloutHookStatus) DeepCopy() *RolloutHookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutHookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutHooks) DeepCopyInto(out *RolloutHooks) {
+	*out = *in
+	if in.PreRollout != nil {
+		in, out := &in.PreRollout, &out.PreRollout
+		*out = make([]RolloutHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostPromotion != nil {
+		in, out := &in.PostPromotion, &out.PostPromotion
+		*out = make([]RolloutHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostAbort != nil {
+		in, out := &in.PostAbort, &out.PostAbort
+		*out = make([]RolloutHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutHooks.
+func (in *RolloutHooks) DeepCopy() *RolloutHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutList) DeepCopyInto(out *RolloutList) {
 	*out = *in
@@ -1572,6 +2124,106 @@ func (in *RolloutPause) DeepCopy() *RolloutPause {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutRevision) DeepCopyInto(out *RolloutRevision) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutRevision.
+func (in *RolloutRevision) DeepCopy() *RolloutRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutRevision) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutRevisionList) DeepCopyInto(out *RolloutRevisionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolloutRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutRevisionList.
+func (in *RolloutRevisionList) DeepCopy() *RolloutRevisionList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutRevisionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutRevisionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutRevisionSpec) DeepCopyInto(out *RolloutRevisionSpec) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]RevisionImage, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]RevisionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Analyses != nil {
+		in, out := &in.Analyses, &out.Analyses
+		*out = make([]RevisionAnalysis, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutRevisionSpec.
+func (in *RolloutRevisionSpec) DeepCopy() *RolloutRevisionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutRevisionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 	*out = *in
@@ -1606,6 +2258,21 @@ func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 		in, out := &in.RestartAt, &out.RestartAt
 		*out = (*in).DeepCopy()
 	}
+	if in.RollbackWindow != nil {
+		in, out := &in.RollbackWindow, &out.RollbackWindow
+		*out = new(RollbackWindowSpec)
+		**out = **in
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(RolloutHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolloutRevisionLimit != nil {
+		in, out := &in.RolloutRevisionLimit, &out.RolloutRevisionLimit
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1656,6 +2323,11 @@ func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
 		in, out := &in.RestartedAt, &out.RestartedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]RolloutHookStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1695,6 +2367,27 @@ func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutTimeWindow) DeepCopyInto(out *RolloutTimeWindow) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutTimeWindow.
+func (in *RolloutTimeWindow) DeepCopy() *RolloutTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutTrafficRouting) DeepCopyInto(out *RolloutTrafficRouting) {
 	*out = *in
@@ -1810,6 +2503,22 @@ func (in *SetCanaryScale) DeepCopy() *SetCanaryScale {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkippedCanaryStep) DeepCopyInto(out *SkippedCanaryStep) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkippedCanaryStep.
+func (in *SkippedCanaryStep) DeepCopy() *SkippedCanaryStep {
+	if in == nil {
+		return nil
+	}
+	out := new(SkippedCanaryStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateSpec) DeepCopyInto(out *TemplateSpec) {
 	*out = *in
@@ -1875,6 +2584,11 @@ func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
 		*out = new(FieldRef)
 		**out = **in
 	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
 	return
 }
 
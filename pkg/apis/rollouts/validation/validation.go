@@ -3,6 +3,7 @@ package validation
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
@@ -19,6 +20,8 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	"github.com/argoproj/argo-rollouts/utils/timewindow"
 )
 
 const (
@@ -35,13 +38,19 @@ const (
 	// InvalidMaxSurgeMaxUnavailable indicates both maxSurge and MaxUnavailable can not be set to zero
 	InvalidMaxSurgeMaxUnavailable = "MaxSurge and MaxUnavailable both can not be zero"
 	// InvalidStepMessage indicates that a step must have either setWeight or pause set
-	InvalidStepMessage = "Step must have one of the following set: experiment, setWeight, setCanaryScale or pause"
+	InvalidStepMessage = "Step must have one of the following set: experiment, setWeight, setCanaryScale, timeWindow, approval or pause"
 	// InvalidStrategyMessage indicates that multiple strategies can not be listed
 	InvalidStrategyMessage = "Multiple Strategies can not be listed"
 	// DuplicatedServicesBlueGreenMessage the message to indicate that the rollout uses the same service for the active and preview services
 	DuplicatedServicesBlueGreenMessage = "This rollout uses the same service for the active and preview services, but two different services are required."
 	// DuplicatedServicesMessage the message to indicate that the rollout uses the same service for the active and preview services
 	DuplicatedServicesCanaryMessage = "This rollout uses the same service for the stable and canary services, but two different services are required."
+	// DuplicatedAdditionalServicesBlueGreenMessage indicates that a service is referenced more than once by a blue-green rollout
+	DuplicatedAdditionalServicesBlueGreenMessage = "Each service can only be referenced once across the active, preview and additional services"
+	// MissingPreviewServiceMessage indicates that additional preview services are set without a preview service
+	MissingPreviewServiceMessage = "additionalPreviewServices can only be used with previewService"
+	// AdditionalServiceSelectorMessage indicates that an additional service does not select the pods of the rollout
+	AdditionalServiceSelectorMessage = "Service %q selector does not match the labels of the pod template"
 	// InvalidAntiAffinityStrategyMessage indicates that Anti-Affinity can only have one strategy listed
 	InvalidAntiAffinityStrategyMessage = "AntiAffinity must have exactly one strategy listed"
 	// InvalidAntiAffinityWeightMessage indicates that Anti-Affinity must have weight between 1-100
@@ -55,6 +64,18 @@ const (
 	InvalidAnalysisArgsMessage = "Analyses arguments must refer to valid object metadata supported by downwardAPI"
 	// InvalidCanaryScaleDownDelay indicates that canary.scaleDownDelaySeconds cannot be used
 	InvalidCanaryScaleDownDelay = "Canary scaleDownDelaySeconds can only be used with traffic routing"
+	// InvalidCanaryAbortScaleDownDelay indicates that canary.abortScaleDownDelaySeconds cannot be used
+	InvalidCanaryAbortScaleDownDelay = "Canary abortScaleDownDelaySeconds can only be used with traffic routing"
+	// InvalidAbortScaleDownDelay indicates that abortScaleDownDelaySeconds is negative
+	InvalidAbortScaleDownDelay = "abortScaleDownDelaySeconds must be >= 0"
+	// InvalidApprovalURLMessage indicates that the url of an approval step is not an absolute http(s) url
+	InvalidApprovalURLMessage = "Approval url must be an absolute http or https url"
+	// InvalidApprovalHeaderMessage indicates that an approval header does not set exactly one of value and valueFrom.secretKeyRef
+	InvalidApprovalHeaderMessage = "Approval header must set exactly one of value and valueFrom.secretKeyRef"
+	// MissingManagedServicesPortsMessage indicates that managedServices does not define any port
+	MissingManagedServicesPortsMessage = "managedServices must define at least one port"
+	// MissingManagedServicesSelectorMessage indicates that managed services cannot select the pods of the rollout
+	MissingManagedServicesSelectorMessage = "managedServices requires the rollout selector to define matchLabels"
 )
 
 func ValidateRollout(rollout *v1alpha1.Rollout) field.ErrorList {
@@ -138,11 +159,48 @@ func ValidateRolloutSpec(rollout *v1alpha1.Rollout, fldPath *field.Path) field.E
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("progressDeadlineSeconds"), progressDeadlineSeconds, "must be greater than minReadySeconds"))
 	}
 
+	if spec.RollbackWindow != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(spec.RollbackWindow.Revisions), fldPath.Child("rollbackWindow", "revisions"))...)
+	}
+
+	if spec.Hooks != nil {
+		allErrs = append(allErrs, validateHooks(spec.Hooks, fldPath.Child("hooks"))...)
+	}
+
 	allErrs = append(allErrs, ValidateRolloutStrategy(rollout, fldPath.Child("strategy"))...)
 
 	return allErrs
 }
 
+func validateHooks(hooks *v1alpha1.RolloutHooks, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := map[string]bool{}
+	validate := func(hooks []v1alpha1.RolloutHook, fldPath *field.Path) {
+		for i, hook := range hooks {
+			hookPath := fldPath.Index(i)
+			for _, msg := range validationutil.IsDNS1123Label(hook.Name) {
+				allErrs = append(allErrs, field.Invalid(hookPath.Child("name"), hook.Name, msg))
+			}
+			if names[hook.Name] {
+				allErrs = append(allErrs, field.Duplicate(hookPath.Child("name"), hook.Name))
+			}
+			names[hook.Name] = true
+			switch hook.FailurePolicy {
+			case "", v1alpha1.HookFailurePolicyAbort, v1alpha1.HookFailurePolicyIgnore:
+			default:
+				allErrs = append(allErrs, field.NotSupported(hookPath.Child("failurePolicy"), hook.FailurePolicy, []string{string(v1alpha1.HookFailurePolicyAbort), string(v1alpha1.HookFailurePolicyIgnore)}))
+			}
+			if len(hook.Job.Spec.Template.Spec.Containers) == 0 {
+				allErrs = append(allErrs, field.Required(hookPath.Child("job", "spec", "template", "spec", "containers"), "hook Job must have at least one container"))
+			}
+		}
+	}
+	validate(hooks.PreRollout, fldPath.Child("preRollout"))
+	validate(hooks.PostPromotion, fldPath.Child("postPromotion"))
+	validate(hooks.PostAbort, fldPath.Child("postAbort"))
+	return allErrs
+}
+
 // removeSecurityContextPrivileged removes the privileged value on containers for the purposes of
 // validation. This is necessary because the k8s ValidateSecurityContext library which we reuse,
 // calls k8s.io/kubernetes/pkg/capabilities.Get(), which determines the security capabilities at a
@@ -183,11 +241,39 @@ func ValidateRolloutStrategyBlueGreen(rollout *v1alpha1.Rollout, fldPath *field.
 	if blueGreen.ActiveService == blueGreen.PreviewService {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("activeService"), blueGreen.ActiveService, DuplicatedServicesBlueGreenMessage))
 	}
+	if len(blueGreen.AdditionalPreviewServices) > 0 && blueGreen.PreviewService == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("additionalPreviewServices"), blueGreen.AdditionalPreviewServices, MissingPreviewServiceMessage))
+	}
+	services := map[string]bool{blueGreen.ActiveService: true, blueGreen.PreviewService: true}
+	for _, additional := range []struct {
+		name     string
+		services []string
+	}{
+		{"additionalActiveServices", blueGreen.AdditionalActiveServices},
+		{"additionalPreviewServices", blueGreen.AdditionalPreviewServices},
+	} {
+		for i, svc := range additional.services {
+			svcPath := fldPath.Child(additional.name).Index(i)
+			if svc == "" {
+				allErrs = append(allErrs, field.Required(svcPath, "service name must be set"))
+				continue
+			}
+			if services[svc] {
+				allErrs = append(allErrs, field.Invalid(svcPath, svc, DuplicatedAdditionalServicesBlueGreenMessage))
+				continue
+			}
+			services[svc] = true
+		}
+	}
 	revisionHistoryLimit := defaults.GetRevisionHistoryLimitOrDefault(rollout)
 	if blueGreen.ScaleDownDelayRevisionLimit != nil && revisionHistoryLimit < *blueGreen.ScaleDownDelayRevisionLimit {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownDelayRevisionLimit"), *blueGreen.ScaleDownDelayRevisionLimit, ScaleDownLimitLargerThanRevisionLimit))
 	}
+	if blueGreen.AbortScaleDownDelaySeconds != nil && *blueGreen.AbortScaleDownDelaySeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("abortScaleDownDelaySeconds"), *blueGreen.AbortScaleDownDelaySeconds, InvalidAbortScaleDownDelay))
+	}
 	allErrs = append(allErrs, ValidateRolloutStrategyAntiAffinity(blueGreen.AntiAffinity, fldPath.Child("antiAffinity"))...)
+	allErrs = append(allErrs, validateManagedServices(rollout, blueGreen.ManagedServices, fldPath.Child("managedServices"))...)
 	return allErrs
 }
 
@@ -220,13 +306,20 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 	if canary.ScaleDownDelaySeconds != nil && canary.TrafficRouting == nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownDelaySeconds"), *canary.ScaleDownDelaySeconds, InvalidCanaryScaleDownDelay))
 	}
+	if canary.AbortScaleDownDelaySeconds != nil {
+		if canary.TrafficRouting == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("abortScaleDownDelaySeconds"), *canary.AbortScaleDownDelaySeconds, InvalidCanaryAbortScaleDownDelay))
+		} else if *canary.AbortScaleDownDelaySeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("abortScaleDownDelaySeconds"), *canary.AbortScaleDownDelaySeconds, InvalidAbortScaleDownDelay))
+		}
+	}
 
 	for i, step := range canary.Steps {
 		stepFldPath := fldPath.Child("steps").Index(i)
 		allErrs = append(allErrs, hasMultipleStepsType(step, stepFldPath)...)
-		if step.Experiment == nil && step.Pause == nil && step.SetWeight == nil && step.Analysis == nil && step.SetCanaryScale == nil {
-			errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.SetCanaryScale %t",
-				step.Experiment == nil, step.Pause == nil, step.SetWeight == nil, step.Analysis == nil, step.SetCanaryScale == nil)
+		if step.Experiment == nil && step.Pause == nil && step.SetWeight == nil && step.Analysis == nil && step.SetCanaryScale == nil && step.TimeWindow == nil && step.Approval == nil {
+			errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.SetCanaryScale %t step.TimeWindow %t step.Approval %t",
+				step.Experiment == nil, step.Pause == nil, step.SetWeight == nil, step.Analysis == nil, step.SetCanaryScale == nil, step.TimeWindow == nil, step.Approval == nil)
 			allErrs = append(allErrs, field.Invalid(stepFldPath, errVal, InvalidStepMessage))
 		}
 		if step.SetWeight != nil && (*step.SetWeight < 0 || *step.SetWeight > 100) {
@@ -235,6 +328,19 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 		if step.Pause != nil && step.Pause.DurationSeconds() < 0 {
 			allErrs = append(allErrs, field.Invalid(stepFldPath.Child("pause").Child("duration"), step.Pause.DurationSeconds(), InvalidDurationMessage))
 		}
+		if step.TimeWindow != nil {
+			if err := timewindow.Validate(*step.TimeWindow); err != nil {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("timeWindow"), *step.TimeWindow, err.Error()))
+			}
+		}
+		if step.Approval != nil {
+			allErrs = append(allErrs, validateApprovalStep(*step.Approval, stepFldPath.Child("approval"))...)
+		}
+		if step.When != "" {
+			if err := evaluate.ValidateStepCondition(step.When); err != nil {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("when"), step.When, err.Error()))
+			}
+		}
 		if rollout.Spec.Strategy.Canary != nil && rollout.Spec.Strategy.Canary.TrafficRouting == nil && step.SetCanaryScale != nil {
 			allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setCanaryScale"), step.SetCanaryScale, InvalidSetCanaryScaleTrafficPolicy))
 		}
@@ -260,11 +366,33 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 						allErrs = append(allErrs, field.Invalid(stepFldPath.Child("analyses"), analysisRunArgs, InvalidAnalysisArgsMessage))
 					}
 				}
+				if arg.ValueFrom.RolloutStatusValue != nil {
+					switch *arg.ValueFrom.RolloutStatusValue {
+					case v1alpha1.StepIndex, v1alpha1.CanaryWeight:
+					default:
+						allErrs = append(allErrs, field.Invalid(stepFldPath.Child("analyses"), analysisRunArgs, InvalidAnalysisArgsMessage))
+					}
+				}
 			}
 		}
 
 	}
 	allErrs = append(allErrs, ValidateRolloutStrategyAntiAffinity(canary.AntiAffinity, fldPath.Child("antiAffinity"))...)
+	allErrs = append(allErrs, validateManagedServices(rollout, canary.ManagedServices, fldPath.Child("managedServices"))...)
+	return allErrs
+}
+
+func validateManagedServices(rollout *v1alpha1.Rollout, managed *v1alpha1.ManagedServices, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if managed == nil {
+		return allErrs
+	}
+	if len(managed.Ports) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ports"), MissingManagedServicesPortsMessage))
+	}
+	if rollout.Spec.Selector == nil || len(rollout.Spec.Selector.MatchLabels) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, managed, MissingManagedServicesSelectorMessage))
+	}
 	return allErrs
 }
 
@@ -314,6 +442,38 @@ func getIntOrPercentValue(intOrStringValue intstr.IntOrString) int {
 	return intOrStringValue.IntValue()
 }
 
+func validateApprovalStep(approval v1alpha1.RolloutApprovalStep, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if u, err := url.Parse(approval.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), approval.URL, InvalidApprovalURLMessage))
+	}
+	for i, header := range approval.Headers {
+		headerFldPath := fldPath.Child("headers").Index(i)
+		if header.Key == "" {
+			allErrs = append(allErrs, field.Required(headerFldPath.Child("key"), fmt.Sprintf(MissingFieldMessage, "key")))
+		}
+		if header.ValueFrom != nil {
+			ref := header.ValueFrom.SecretKeyRef
+			if ref == nil || header.Value != "" {
+				allErrs = append(allErrs, field.Invalid(headerFldPath, header.Key, InvalidApprovalHeaderMessage))
+			} else if ref.Name == "" || ref.Key == "" {
+				allErrs = append(allErrs, field.Required(headerFldPath.Child("valueFrom", "secretKeyRef"), fmt.Sprintf(MissingFieldMessage, "name and key")))
+			}
+		}
+	}
+	if approval.PollInterval != "" {
+		if _, err := approval.PollInterval.Duration(); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("pollInterval"), approval.PollInterval, err.Error()))
+		}
+	}
+	if approval.Timeout != "" {
+		if _, err := approval.Timeout.Duration(); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), approval.Timeout, err.Error()))
+		}
+	}
+	return allErrs
+}
+
 func hasMultipleStepsType(s v1alpha1.CanaryStep, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	oneOf := make([]bool, 3)
@@ -321,11 +481,13 @@ func hasMultipleStepsType(s v1alpha1.CanaryStep, fldPath *field.Path) field.Erro
 	oneOf = append(oneOf, s.Pause != nil)
 	oneOf = append(oneOf, s.Experiment != nil)
 	oneOf = append(oneOf, s.Analysis != nil)
+	oneOf = append(oneOf, s.TimeWindow != nil)
+	oneOf = append(oneOf, s.Approval != nil)
 	hasMultipleStepTypes := false
 	for i := range oneOf {
 		if oneOf[i] {
 			if hasMultipleStepTypes {
-				errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t", s.Experiment != nil, s.Pause != nil, s.SetWeight != nil, s.Analysis != nil)
+				errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.TimeWindow: %t step.Approval: %t", s.Experiment != nil, s.Pause != nil, s.SetWeight != nil, s.Analysis != nil, s.TimeWindow != nil, s.Approval != nil)
 				allErrs = append(allErrs, field.Invalid(fldPath, errVal, InvalidStepMessage))
 				break
 			}
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -40,6 +41,8 @@ type AnalysisTemplatesWithType struct {
 	// CanaryStepIndex only used for InlineAnalysis
 	CanaryStepIndex int
 	Args            []v1alpha1.AnalysisRunArgument
+	// TemplateGetter resolves the templates included by the referenced templates
+	TemplateGetter analysisutil.TemplateGetter
 }
 
 type ServiceType string
@@ -49,11 +52,16 @@ const (
 	CanaryService  ServiceType = "CanaryService"
 	ActiveService  ServiceType = "ActiveService"
 	PreviewService ServiceType = "PreviewService"
+
+	AdditionalActiveService  ServiceType = "AdditionalActiveService"
+	AdditionalPreviewService ServiceType = "AdditionalPreviewService"
 )
 
 type ServiceWithType struct {
 	Service *corev1.Service
 	Type    ServiceType
+	// Index only used for AdditionalActiveService and AdditionalPreviewService
+	Index int
 }
 
 type ReferencedResources struct {
@@ -90,6 +98,10 @@ func ValidateService(svc ServiceWithType, rollout *v1alpha1.Rollout) field.Error
 	if fldPath == nil {
 		return allErrs
 	}
+	additional := svc.Type == AdditionalActiveService || svc.Type == AdditionalPreviewService
+	if additional {
+		fldPath = fldPath.Index(svc.Index)
+	}
 
 	service := svc.Service
 	rolloutManagingService, exists := serviceutil.HasManagedByAnnotation(service)
@@ -97,6 +109,20 @@ func ValidateService(svc ServiceWithType, rollout *v1alpha1.Rollout) field.Error
 		msg := fmt.Sprintf(conditions.ServiceReferencingManagedService, service.Name)
 		allErrs = append(allErrs, field.Invalid(fldPath, service.Name, msg))
 	}
+	if additional {
+		// additional services are switched to the same ReplicaSet as their primary service, so they
+		// need to select the pods of the rollout
+		selector := map[string]string{}
+		for k, v := range service.Spec.Selector {
+			if k != v1alpha1.DefaultRolloutUniqueLabelKey {
+				selector[k] = v
+			}
+		}
+		if len(selector) == 0 || !labels.SelectorFromSet(selector).Matches(labels.Set(rollout.Spec.Template.Labels)) {
+			msg := fmt.Sprintf(AdditionalServiceSelectorMessage, service.Name)
+			allErrs = append(allErrs, field.Invalid(fldPath, service.Name, msg))
+		}
+	}
 	return allErrs
 }
 
@@ -109,16 +135,31 @@ func ValidateAnalysisTemplatesWithType(rollout *v1alpha1.Rollout, templates Anal
 
 	templateNames := GetAnalysisTemplateNames(templates)
 	value := fmt.Sprintf("templateNames: %s", templateNames)
-	_, err := analysisutil.NewAnalysisRunFromTemplates(templates.AnalysisTemplates, templates.ClusterAnalysisTemplates, buildAnalysisArgs(templates.Args, rollout), "", "", "")
+	_, err := analysisutil.NewAnalysisRunFromTemplates(templates.AnalysisTemplates, templates.ClusterAnalysisTemplates, templates.TemplateGetter, buildAnalysisArgs(templates.Args, rollout), "", "", "")
 	if err != nil {
 		allErrs = append(allErrs, field.Invalid(fldPath, value, err.Error()))
 		return allErrs
 	}
 
+	// the metrics of the included templates are validated like the template's own metrics
 	for _, template := range templates.AnalysisTemplates {
+		flattened, err := analysisutil.FlattenTemplates([]*v1alpha1.AnalysisTemplate{template}, nil, templates.TemplateGetter)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, err.Error()))
+			continue
+		}
+		template = template.DeepCopy()
+		template.Spec = flattened.Spec
 		allErrs = append(allErrs, ValidateAnalysisTemplateWithType(rollout, template, nil, templates.TemplateType, fldPath)...)
 	}
 	for _, clusterTemplate := range templates.ClusterAnalysisTemplates {
+		flattened, err := analysisutil.FlattenTemplates(nil, []*v1alpha1.ClusterAnalysisTemplate{clusterTemplate}, templates.TemplateGetter)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, err.Error()))
+			continue
+		}
+		clusterTemplate = clusterTemplate.DeepCopy()
+		clusterTemplate.Spec = flattened.Spec
 		allErrs = append(allErrs, ValidateAnalysisTemplateWithType(rollout, nil, clusterTemplate, templates.TemplateType, fldPath)...)
 	}
 	return allErrs
@@ -254,6 +295,10 @@ func GetServiceWithTypeFieldPath(serviceType ServiceType) *field.Path {
 		fldPath = fldPath.Child("blueGreen", "activeService")
 	case PreviewService:
 		fldPath = fldPath.Child("blueGreen", "previewService")
+	case AdditionalActiveService:
+		fldPath = fldPath.Child("blueGreen", "additionalActiveServices")
+	case AdditionalPreviewService:
+		fldPath = fldPath.Child("blueGreen", "additionalPreviewServices")
 	case CanaryService:
 		fldPath = fldPath.Child("canary", "canaryService")
 	case StableService:
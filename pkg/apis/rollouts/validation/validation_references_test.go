@@ -106,6 +106,26 @@ func getAnalysisTemplatesWithType() AnalysisTemplatesWithType {
 	}
 }
 
+// mapTemplateGetter resolves the templates included by analysis templates from maps
+type mapTemplateGetter struct {
+	templates        map[string]*v1alpha1.AnalysisTemplate
+	clusterTemplates map[string]*v1alpha1.ClusterAnalysisTemplate
+}
+
+func (g mapTemplateGetter) GetAnalysisTemplate(namespace, name string) (*v1alpha1.AnalysisTemplate, error) {
+	if template, ok := g.templates[name]; ok {
+		return template, nil
+	}
+	return nil, fmt.Errorf("AnalysisTemplate '%s' not found", name)
+}
+
+func (g mapTemplateGetter) GetClusterAnalysisTemplate(name string) (*v1alpha1.ClusterAnalysisTemplate, error) {
+	if template, ok := g.clusterTemplates[name]; ok {
+		return template, nil
+	}
+	return nil, fmt.Errorf("ClusterAnalysisTemplate '%s' not found", name)
+}
+
 func getRollout() *v1alpha1.Rollout {
 	return &v1alpha1.Rollout{
 		Spec: v1alpha1.RolloutSpec{
@@ -189,6 +209,26 @@ func TestValidateAnalysisTemplatesWithType(t *testing.T) {
 		assert.Empty(t, allErrs)
 	})
 
+	t.Run("failure - included metric runs indefinitely", func(t *testing.T) {
+		rollout := getRollout()
+		templates := getAnalysisTemplatesWithType()
+		templates.ClusterAnalysisTemplates = nil
+		templates.AnalysisTemplates[0].Spec.Includes = []v1alpha1.AnalysisTemplateInclude{{TemplateName: "included"}}
+		templates.TemplateGetter = mapTemplateGetter{
+			templates: map[string]*v1alpha1.AnalysisTemplate{
+				"included": {
+					ObjectMeta: metav1.ObjectMeta{Name: "included"},
+					Spec: v1alpha1.AnalysisTemplateSpec{
+						Metrics: []v1alpha1.Metric{{Name: "included-metric", Interval: "1m"}},
+					},
+				},
+			},
+		}
+		allErrs := ValidateAnalysisTemplatesWithType(rollout, templates)
+		assert.Len(t, allErrs, 1)
+		assert.Contains(t, allErrs[0].Error(), "AnalysisTemplate analysis-template-name has metric included-metric which runs indefinitely")
+	})
+
 	t.Run("failure - duplicate metrics", func(t *testing.T) {
 		rollout := getRollout()
 		templates := getAnalysisTemplatesWithType()
@@ -344,6 +384,53 @@ func TestValidateService(t *testing.T) {
 		expectedErr := field.Invalid(GetServiceWithTypeFieldPath(svc.Type), svc.Service.Name, "Service \"stable-service-name\" is managed by another Rollout")
 		assert.Equal(t, expectedErr.Error(), allErrs[0].Error())
 	})
+
+	t.Run("validate additional service - success", func(t *testing.T) {
+		ro := getRollout()
+		ro.Spec.Template.Labels = map[string]string{"app": "guestbook", "team": "payments"}
+		svc := ServiceWithType{
+			Service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "active-grpc"},
+				Spec: corev1.ServiceSpec{
+					Selector: map[string]string{"app": "guestbook", v1alpha1.DefaultRolloutUniqueLabelKey: "abc123"},
+				},
+			},
+			Type:  AdditionalActiveService,
+			Index: 1,
+		}
+		allErrs := ValidateService(svc, ro)
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("validate additional service - selector mismatch", func(t *testing.T) {
+		ro := getRollout()
+		ro.Spec.Template.Labels = map[string]string{"app": "guestbook"}
+		svc := ServiceWithType{
+			Service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "preview-grpc"},
+				Spec: corev1.ServiceSpec{
+					Selector: map[string]string{"app": "other"},
+				},
+			},
+			Type:  AdditionalPreviewService,
+			Index: 1,
+		}
+		allErrs := ValidateService(svc, ro)
+		assert.Len(t, allErrs, 1)
+		expectedErr := field.Invalid(field.NewPath("spec", "strategy", "blueGreen", "additionalPreviewServices").Index(1), "preview-grpc", "Service \"preview-grpc\" selector does not match the labels of the pod template")
+		assert.Equal(t, expectedErr.Error(), allErrs[0].Error())
+	})
+
+	t.Run("validate additional service - empty selector", func(t *testing.T) {
+		ro := getRollout()
+		ro.Spec.Template.Labels = map[string]string{"app": "guestbook"}
+		svc := ServiceWithType{
+			Service: &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "active-grpc"}},
+			Type:    AdditionalActiveService,
+		}
+		allErrs := ValidateService(svc, ro)
+		assert.Len(t, allErrs, 1)
+	})
 }
 
 func TestValidateVirtualService(t *testing.T) {
@@ -423,6 +510,18 @@ func TestGetServiceWithTypeFieldPath(t *testing.T) {
 		assert.Equal(t, expectedFldPath.String(), fldPath.String())
 	})
 
+	t.Run("get additionalActiveServices fieldPath", func(t *testing.T) {
+		fldPath := GetServiceWithTypeFieldPath(AdditionalActiveService)
+		expectedFldPath := field.NewPath("spec", "strategy", "blueGreen", "additionalActiveServices")
+		assert.Equal(t, expectedFldPath.String(), fldPath.String())
+	})
+
+	t.Run("get additionalPreviewServices fieldPath", func(t *testing.T) {
+		fldPath := GetServiceWithTypeFieldPath(AdditionalPreviewService)
+		expectedFldPath := field.NewPath("spec", "strategy", "blueGreen", "additionalPreviewServices")
+		assert.Equal(t, expectedFldPath.String(), fldPath.String())
+	})
+
 	t.Run("get canaryService fieldPath", func(t *testing.T) {
 		fldPath := GetServiceWithTypeFieldPath(CanaryService)
 		expectedFldPath := field.NewPath("spec", "strategy", "canary", "canaryService")
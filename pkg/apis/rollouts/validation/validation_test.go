@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -63,6 +64,30 @@ func TestValidateRollout(t *testing.T) {
 
 	})
 
+	t.Run("invalid rollbackWindow", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: -1}
+		allErrs := ValidateRollout(invalidRo)
+		assert.Equal(t, "spec.rollbackWindow.revisions", allErrs[0].Field)
+	})
+
+	t.Run("invalid hooks", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		job := v1alpha1.JobMetric{Spec: batchv1.JobSpec{Template: ro.Spec.Template}}
+		invalidRo.Spec.Hooks = &v1alpha1.RolloutHooks{
+			PreRollout:    []v1alpha1.RolloutHook{{Name: "migrate", Job: job}},
+			PostPromotion: []v1alpha1.RolloutHook{{Name: "migrate", Job: job, FailurePolicy: "Retry"}},
+			PostAbort:     []v1alpha1.RolloutHook{{Name: "Notify", Job: v1alpha1.JobMetric{}}},
+		}
+		allErrs := ValidateRollout(invalidRo)
+		assert.Len(t, allErrs, 4)
+		assert.Equal(t, "spec.hooks.postPromotion[0].name", allErrs[0].Field)
+		assert.Equal(t, field.ErrorTypeDuplicate, allErrs[0].Type)
+		assert.Equal(t, "spec.hooks.postPromotion[0].failurePolicy", allErrs[1].Field)
+		assert.Equal(t, "spec.hooks.postAbort[0].name", allErrs[2].Field)
+		assert.Equal(t, "spec.hooks.postAbort[0].job.spec.template.spec.containers", allErrs[3].Field)
+	})
+
 	t.Run("successful run", func(t *testing.T) {
 		invalidRo := ro.DeepCopy()
 		invalidRo.Spec.Strategy.Canary = nil
@@ -124,6 +149,60 @@ func TestValidateRolloutStrategyBlueGreen(t *testing.T) {
 	assert.Equal(t, ScaleDownLimitLargerThanRevisionLimit, allErrs[1].Detail)
 }
 
+func TestValidateRolloutStrategyBlueGreenAdditionalServices(t *testing.T) {
+	rollout := v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				BlueGreen: &v1alpha1.BlueGreenStrategy{
+					ActiveService:            "active",
+					AdditionalActiveServices: []string{"active-grpc", "active-admin"},
+				},
+			},
+		},
+	}
+	fldPath := field.NewPath("spec", "strategy", "blueGreen")
+	assert.Empty(t, ValidateRolloutStrategyBlueGreen(&rollout, fldPath))
+
+	rollout.Spec.Strategy.BlueGreen.AdditionalActiveServices = []string{"active-grpc", "active", ""}
+	rollout.Spec.Strategy.BlueGreen.AdditionalPreviewServices = []string{"active-grpc"}
+	allErrs := ValidateRolloutStrategyBlueGreen(&rollout, fldPath)
+	assert.Len(t, allErrs, 4)
+	assert.Equal(t, MissingPreviewServiceMessage, allErrs[0].Detail)
+	assert.Equal(t, "spec.strategy.blueGreen.additionalActiveServices[1]", allErrs[1].Field)
+	assert.Equal(t, DuplicatedAdditionalServicesBlueGreenMessage, allErrs[1].Detail)
+	assert.Equal(t, "spec.strategy.blueGreen.additionalActiveServices[2]", allErrs[2].Field)
+	assert.Equal(t, field.ErrorTypeRequired, allErrs[2].Type)
+	assert.Equal(t, "spec.strategy.blueGreen.additionalPreviewServices[0]", allErrs[3].Field)
+	assert.Equal(t, DuplicatedAdditionalServicesBlueGreenMessage, allErrs[3].Detail)
+}
+
+func TestValidateRolloutStrategyManagedServices(t *testing.T) {
+	rollout := v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					CanaryService: "canary",
+					StableService: "stable",
+					ManagedServices: &v1alpha1.ManagedServices{
+						Ports: []corev1.ServicePort{{Name: "http", Port: 80}},
+					},
+				},
+			},
+		},
+	}
+	fldPath := field.NewPath("spec", "strategy", "canary")
+	assert.Empty(t, ValidateRolloutStrategyCanary(&rollout, fldPath))
+
+	rollout.Spec.Strategy.Canary.ManagedServices.Ports = nil
+	rollout.Spec.Selector = &metav1.LabelSelector{}
+	allErrs := ValidateRolloutStrategyCanary(&rollout, fldPath)
+	assert.Len(t, allErrs, 2)
+	assert.Equal(t, "spec.strategy.canary.managedServices.ports", allErrs[0].Field)
+	assert.Equal(t, MissingManagedServicesPortsMessage, allErrs[0].Detail)
+	assert.Equal(t, MissingManagedServicesSelectorMessage, allErrs[1].Detail)
+}
+
 func TestValidateRolloutStrategyCanary(t *testing.T) {
 	canaryStrategy := &v1alpha1.CanaryStrategy{
 		CanaryService: "canary",
@@ -205,6 +284,50 @@ func TestValidateRolloutStrategyCanary(t *testing.T) {
 		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
 		assert.Equal(t, InvalidDurationMessage, allErrs[0].Detail)
 	})
+	t.Run("invalid time window step", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].TimeWindow = &v1alpha1.RolloutTimeWindow{
+			StartTime: "9am",
+		}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "invalid time of day '9am': must be in the HH:MM format", allErrs[0].Detail)
+	})
+	t.Run("invalid approval step", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].Approval = &v1alpha1.RolloutApprovalStep{
+			URL:          "change-management/approve",
+			PollInterval: "1 minute",
+		}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, InvalidApprovalURLMessage, allErrs[0].Detail)
+		assert.Equal(t, "[].steps[0].approval.pollInterval", allErrs[1].Field)
+	})
+	t.Run("invalid approval header", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].Approval = &v1alpha1.RolloutApprovalStep{
+			URL: "https://change-management.example.com/approve",
+			Headers: []v1alpha1.RolloutApprovalHeader{
+				{Key: "Authorization", ValueFrom: &v1alpha1.RolloutApprovalHeaderSource{SecretKeyRef: &v1alpha1.SecretKeyRef{Name: "approval", Key: "token"}}},
+				{Key: "X-Token", Value: "token", ValueFrom: &v1alpha1.RolloutApprovalHeaderSource{SecretKeyRef: &v1alpha1.SecretKeyRef{Name: "approval", Key: "token"}}},
+				{Key: "X-Other", ValueFrom: &v1alpha1.RolloutApprovalHeaderSource{SecretKeyRef: &v1alpha1.SecretKeyRef{Name: "approval"}}},
+			},
+		}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, InvalidApprovalHeaderMessage, allErrs[0].Detail)
+		assert.Equal(t, "[].steps[0].approval.headers[1]", allErrs[0].Field)
+		assert.Equal(t, "[].steps[0].approval.headers[2].valueFrom.secretKeyRef", allErrs[1].Field)
+	})
+	t.Run("invalid when expression", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32Ptr(10)
+		invalidRo.Spec.Strategy.Canary.Steps[0].When = `metadata.labels.env ==`
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "[].steps[0].when", allErrs[0].Field)
+	})
 	t.Run("invalid metadata references in analysis step", func(t *testing.T) {
 		invalidRo := ro.DeepCopy()
 		invalidRo.Spec.Strategy.Canary.Steps[0].Analysis = rolloutAnalysisStep
@@ -217,6 +340,18 @@ func TestValidateRolloutStrategyCanary(t *testing.T) {
 		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
 		assert.Equal(t, InvalidAnalysisArgsMessage, allErrs[0].Detail)
 	})
+	t.Run("invalid rollout status value in analysis step", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		statusValue := v1alpha1.ValueFromRolloutStatus("CurrentWeight")
+		invalidRo.Spec.Strategy.Canary.Steps[0].Analysis = &v1alpha1.RolloutAnalysis{
+			Args: []v1alpha1.AnalysisRunArgument{{
+				Name:      "weight",
+				ValueFrom: &v1alpha1.ArgumentValueFrom{RolloutStatusValue: &statusValue},
+			}},
+		}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Equal(t, InvalidAnalysisArgsMessage, allErrs[0].Detail)
+	})
 }
 
 func TestValidateRolloutStrategyAntiAffinity(t *testing.T) {
@@ -317,6 +452,22 @@ func TestCanaryScaleDownDelaySeconds(t *testing.T) {
 		allErrs := ValidateRollout(ro)
 		assert.Empty(t, allErrs)
 	})
+	t.Run("abortScaleDownDelaySeconds with basic canary", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.ScaleDownDelaySeconds = nil
+		ro.Spec.Strategy.Canary.AbortScaleDownDelaySeconds = pointer.Int32Ptr(600)
+		allErrs := ValidateRollout(ro)
+		assert.EqualError(t, allErrs[0], fmt.Sprintf("spec.strategy.abortScaleDownDelaySeconds: Invalid value: 600: %s", InvalidCanaryAbortScaleDownDelay))
+	})
+	t.Run("negative abortScaleDownDelaySeconds with traffic weight canary", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+			SMI: &v1alpha1.SMITrafficRouting{},
+		}
+		ro.Spec.Strategy.Canary.AbortScaleDownDelaySeconds = pointer.Int32Ptr(-1)
+		allErrs := ValidateRollout(ro)
+		assert.EqualError(t, allErrs[0], fmt.Sprintf("spec.strategy.abortScaleDownDelaySeconds: Invalid value: -1: %s", InvalidAbortScaleDownDelay))
+	})
 
 }
 
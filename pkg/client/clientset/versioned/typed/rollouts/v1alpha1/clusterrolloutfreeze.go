@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	scheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterRolloutFreezesGetter has a method to return a ClusterRolloutFreezeInterface.
+// A group's client should implement this interface.
+type ClusterRolloutFreezesGetter interface {
+	ClusterRolloutFreezes() ClusterRolloutFreezeInterface
+}
+
+// ClusterRolloutFreezeInterface has methods to work with ClusterRolloutFreeze resources.
+type ClusterRolloutFreezeInterface interface {
+	Create(ctx context.Context, clusterRolloutFreeze *v1alpha1.ClusterRolloutFreeze, opts v1.CreateOptions) (*v1alpha1.ClusterRolloutFreeze, error)
+	Update(ctx context.Context, clusterRolloutFreeze *v1alpha1.ClusterRolloutFreeze, opts v1.UpdateOptions) (*v1alpha1.ClusterRolloutFreeze, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ClusterRolloutFreeze, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ClusterRolloutFreezeList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRolloutFreeze, err error)
+	ClusterRolloutFreezeExpansion
+}
+
+// clusterRolloutFreezes implements ClusterRolloutFreezeInterface
+type clusterRolloutFreezes struct {
+	client rest.Interface
+}
+
+// newClusterRolloutFreezes returns a ClusterRolloutFreezes
+func newClusterRolloutFreezes(c *ArgoprojV1alpha1Client) *clusterRolloutFreezes {
+	return &clusterRolloutFreezes{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterRolloutFreeze, and returns the corresponding clusterRolloutFreeze object, and an error if there is any.
+func (c *clusterRolloutFreezes) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterRolloutFreeze, err error) {
+	result = &v1alpha1.ClusterRolloutFreeze{}
+	err = c.client.Get().
+		Resource("clusterrolloutfreezes").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterRolloutFreezes that match those selectors.
+func (c *clusterRolloutFreezes) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterRolloutFreezeList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ClusterRolloutFreezeList{}
+	err = c.client.Get().
+		Resource("clusterrolloutfreezes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterRolloutFreezes.
+func (c *clusterRolloutFreezes) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clusterrolloutfreezes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a clusterRolloutFreeze and creates it.  Returns the server's representation of the clusterRolloutFreeze, and an error, if there is any.
+func (c *clusterRolloutFreezes) Create(ctx context.Context, clusterRolloutFreeze *v1alpha1.ClusterRolloutFreeze, opts v1.CreateOptions) (result *v1alpha1.ClusterRolloutFreeze, err error) {
+	result = &v1alpha1.ClusterRolloutFreeze{}
+	err = c.client.Post().
+		Resource("clusterrolloutfreezes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterRolloutFreeze).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterRolloutFreeze and updates it. Returns the server's representation of the clusterRolloutFreeze, and an error, if there is any.
+func (c *clusterRolloutFreezes) Update(ctx context.Context, clusterRolloutFreeze *v1alpha1.ClusterRolloutFreeze, opts v1.UpdateOptions) (result *v1alpha1.ClusterRolloutFreeze, err error) {
+	result = &v1alpha1.ClusterRolloutFreeze{}
+	err = c.client.Put().
+		Resource("clusterrolloutfreezes").
+		Name(clusterRolloutFreeze.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterRolloutFreeze).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterRolloutFreeze and deletes it. Returns an error if one occurs.
+func (c *clusterRolloutFreezes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clusterrolloutfreezes").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterRolloutFreezes) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clusterrolloutfreezes").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterRolloutFreeze.
+func (c *clusterRolloutFreezes) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRolloutFreeze, err error) {
+	result = &v1alpha1.ClusterRolloutFreeze{}
+	err = c.client.Patch(pt).
+		Resource("clusterrolloutfreezes").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
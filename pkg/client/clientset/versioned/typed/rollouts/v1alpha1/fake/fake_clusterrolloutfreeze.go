@@ -0,0 +1,122 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterRolloutFreezes implements ClusterRolloutFreezeInterface
+type FakeClusterRolloutFreezes struct {
+	Fake *FakeArgoprojV1alpha1
+}
+
+var clusterrolloutfreezesResource = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "clusterrolloutfreezes"}
+
+var clusterrolloutfreezesKind = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "ClusterRolloutFreeze"}
+
+// Get takes name of the clusterRolloutFreeze, and returns the corresponding clusterRolloutFreeze object, and an error if there is any.
+func (c *FakeClusterRolloutFreezes) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterRolloutFreeze, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clusterrolloutfreezesResource, name), &v1alpha1.ClusterRolloutFreeze{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutFreeze), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterRolloutFreezes that match those selectors.
+func (c *FakeClusterRolloutFreezes) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterRolloutFreezeList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clusterrolloutfreezesResource, clusterrolloutfreezesKind, opts), &v1alpha1.ClusterRolloutFreezeList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ClusterRolloutFreezeList{ListMeta: obj.(*v1alpha1.ClusterRolloutFreezeList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ClusterRolloutFreezeList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterRolloutFreezes.
+func (c *FakeClusterRolloutFreezes) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clusterrolloutfreezesResource, opts))
+}
+
+// Create takes the representation of a clusterRolloutFreeze and creates it.  Returns the server's representation of the clusterRolloutFreeze, and an error, if there is any.
+func (c *FakeClusterRolloutFreezes) Create(ctx context.Context, clusterRolloutFreeze *v1alpha1.ClusterRolloutFreeze, opts v1.CreateOptions) (result *v1alpha1.ClusterRolloutFreeze, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clusterrolloutfreezesResource, clusterRolloutFreeze), &v1alpha1.ClusterRolloutFreeze{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutFreeze), err
+}
+
+// Update takes the representation of a clusterRolloutFreeze and updates it. Returns the server's representation of the clusterRolloutFreeze, and an error, if there is any.
+func (c *FakeClusterRolloutFreezes) Update(ctx context.Context, clusterRolloutFreeze *v1alpha1.ClusterRolloutFreeze, opts v1.UpdateOptions) (result *v1alpha1.ClusterRolloutFreeze, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clusterrolloutfreezesResource, clusterRolloutFreeze), &v1alpha1.ClusterRolloutFreeze{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutFreeze), err
+}
+
+// Delete takes name of the clusterRolloutFreeze and deletes it. Returns an error if one occurs.
+func (c *FakeClusterRolloutFreezes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(clusterrolloutfreezesResource, name), &v1alpha1.ClusterRolloutFreeze{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterRolloutFreezes) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clusterrolloutfreezesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ClusterRolloutFreezeList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterRolloutFreeze.
+func (c *FakeClusterRolloutFreezes) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRolloutFreeze, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clusterrolloutfreezesResource, name, pt, data, subresources...), &v1alpha1.ClusterRolloutFreeze{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutFreeze), err
+}
@@ -0,0 +1,130 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRolloutFreezes implements RolloutFreezeInterface
+type FakeRolloutFreezes struct {
+	Fake *FakeArgoprojV1alpha1
+	ns   string
+}
+
+var rolloutfreezesResource = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rolloutfreezes"}
+
+var rolloutfreezesKind = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "RolloutFreeze"}
+
+// Get takes name of the rolloutFreeze, and returns the corresponding rolloutFreeze object, and an error if there is any.
+func (c *FakeRolloutFreezes) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RolloutFreeze, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(rolloutfreezesResource, c.ns, name), &v1alpha1.RolloutFreeze{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutFreeze), err
+}
+
+// List takes label and field selectors, and returns the list of RolloutFreezes that match those selectors.
+func (c *FakeRolloutFreezes) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RolloutFreezeList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(rolloutfreezesResource, rolloutfreezesKind, c.ns, opts), &v1alpha1.RolloutFreezeList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.RolloutFreezeList{ListMeta: obj.(*v1alpha1.RolloutFreezeList).ListMeta}
+	for _, item := range obj.(*v1alpha1.RolloutFreezeList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested rolloutFreezes.
+func (c *FakeRolloutFreezes) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(rolloutfreezesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a rolloutFreeze and creates it.  Returns the server's representation of the rolloutFreeze, and an error, if there is any.
+func (c *FakeRolloutFreezes) Create(ctx context.Context, rolloutFreeze *v1alpha1.RolloutFreeze, opts v1.CreateOptions) (result *v1alpha1.RolloutFreeze, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(rolloutfreezesResource, c.ns, rolloutFreeze), &v1alpha1.RolloutFreeze{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutFreeze), err
+}
+
+// Update takes the representation of a rolloutFreeze and updates it. Returns the server's representation of the rolloutFreeze, and an error, if there is any.
+func (c *FakeRolloutFreezes) Update(ctx context.Context, rolloutFreeze *v1alpha1.RolloutFreeze, opts v1.UpdateOptions) (result *v1alpha1.RolloutFreeze, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(rolloutfreezesResource, c.ns, rolloutFreeze), &v1alpha1.RolloutFreeze{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutFreeze), err
+}
+
+// Delete takes name of the rolloutFreeze and deletes it. Returns an error if one occurs.
+func (c *FakeRolloutFreezes) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(rolloutfreezesResource, c.ns, name), &v1alpha1.RolloutFreeze{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRolloutFreezes) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(rolloutfreezesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.RolloutFreezeList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched rolloutFreeze.
+func (c *FakeRolloutFreezes) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RolloutFreeze, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rolloutfreezesResource, c.ns, name, pt, data, subresources...), &v1alpha1.RolloutFreeze{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutFreeze), err
+}
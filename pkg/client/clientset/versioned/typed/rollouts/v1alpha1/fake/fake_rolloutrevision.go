@@ -0,0 +1,130 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRolloutRevisions implements RolloutRevisionInterface
+type FakeRolloutRevisions struct {
+	Fake *FakeArgoprojV1alpha1
+	ns   string
+}
+
+var rolloutrevisionsResource = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rolloutrevisions"}
+
+var rolloutrevisionsKind = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "RolloutRevision"}
+
+// Get takes name of the rolloutRevision, and returns the corresponding rolloutRevision object, and an error if there is any.
+func (c *FakeRolloutRevisions) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RolloutRevision, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(rolloutrevisionsResource, c.ns, name), &v1alpha1.RolloutRevision{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutRevision), err
+}
+
+// List takes label and field selectors, and returns the list of RolloutRevisions that match those selectors.
+func (c *FakeRolloutRevisions) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RolloutRevisionList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(rolloutrevisionsResource, rolloutrevisionsKind, c.ns, opts), &v1alpha1.RolloutRevisionList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.RolloutRevisionList{ListMeta: obj.(*v1alpha1.RolloutRevisionList).ListMeta}
+	for _, item := range obj.(*v1alpha1.RolloutRevisionList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested rolloutRevisions.
+func (c *FakeRolloutRevisions) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(rolloutrevisionsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a rolloutRevision and creates it.  Returns the server's representation of the rolloutRevision, and an error, if there is any.
+func (c *FakeRolloutRevisions) Create(ctx context.Context, rolloutRevision *v1alpha1.RolloutRevision, opts v1.CreateOptions) (result *v1alpha1.RolloutRevision, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(rolloutrevisionsResource, c.ns, rolloutRevision), &v1alpha1.RolloutRevision{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutRevision), err
+}
+
+// Update takes the representation of a rolloutRevision and updates it. Returns the server's representation of the rolloutRevision, and an error, if there is any.
+func (c *FakeRolloutRevisions) Update(ctx context.Context, rolloutRevision *v1alpha1.RolloutRevision, opts v1.UpdateOptions) (result *v1alpha1.RolloutRevision, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(rolloutrevisionsResource, c.ns, rolloutRevision), &v1alpha1.RolloutRevision{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutRevision), err
+}
+
+// Delete takes name of the rolloutRevision and deletes it. Returns an error if one occurs.
+func (c *FakeRolloutRevisions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(rolloutrevisionsResource, c.ns, name), &v1alpha1.RolloutRevision{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRolloutRevisions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(rolloutrevisionsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.RolloutRevisionList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched rolloutRevision.
+func (c *FakeRolloutRevisions) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RolloutRevision, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rolloutrevisionsResource, c.ns, name, pt, data, subresources...), &v1alpha1.RolloutRevision{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutRevision), err
+}
@@ -40,6 +40,10 @@ func (c *FakeArgoprojV1alpha1) ClusterAnalysisTemplates() v1alpha1.ClusterAnalys
 	return &FakeClusterAnalysisTemplates{c}
 }
 
+func (c *FakeArgoprojV1alpha1) ClusterRolloutFreezes() v1alpha1.ClusterRolloutFreezeInterface {
+	return &FakeClusterRolloutFreezes{c}
+}
+
 func (c *FakeArgoprojV1alpha1) Experiments(namespace string) v1alpha1.ExperimentInterface {
 	return &FakeExperiments{c, namespace}
 }
@@ -48,6 +52,14 @@ func (c *FakeArgoprojV1alpha1) Rollouts(namespace string) v1alpha1.RolloutInterf
 	return &FakeRollouts{c, namespace}
 }
 
+func (c *FakeArgoprojV1alpha1) RolloutFreezes(namespace string) v1alpha1.RolloutFreezeInterface {
+	return &FakeRolloutFreezes{c, namespace}
+}
+
+func (c *FakeArgoprojV1alpha1) RolloutRevisions(namespace string) v1alpha1.RolloutRevisionInterface {
+	return &FakeRolloutRevisions{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeArgoprojV1alpha1) RESTClient() rest.Interface {
@@ -24,6 +24,12 @@ type AnalysisTemplateExpansion interface{}
 
 type ClusterAnalysisTemplateExpansion interface{}
 
+type ClusterRolloutFreezeExpansion interface{}
+
 type ExperimentExpansion interface{}
 
 type RolloutExpansion interface{}
+
+type RolloutFreezeExpansion interface{}
+
+type RolloutRevisionExpansion interface{}
@@ -29,8 +29,11 @@ type ArgoprojV1alpha1Interface interface {
 	AnalysisRunsGetter
 	AnalysisTemplatesGetter
 	ClusterAnalysisTemplatesGetter
+	ClusterRolloutFreezesGetter
 	ExperimentsGetter
 	RolloutsGetter
+	RolloutFreezesGetter
+	RolloutRevisionsGetter
 }
 
 // ArgoprojV1alpha1Client is used to interact with features provided by the argoproj.io group.
@@ -50,6 +53,10 @@ func (c *ArgoprojV1alpha1Client) ClusterAnalysisTemplates() ClusterAnalysisTempl
 	return newClusterAnalysisTemplates(c)
 }
 
+func (c *ArgoprojV1alpha1Client) ClusterRolloutFreezes() ClusterRolloutFreezeInterface {
+	return newClusterRolloutFreezes(c)
+}
+
 func (c *ArgoprojV1alpha1Client) Experiments(namespace string) ExperimentInterface {
 	return newExperiments(c, namespace)
 }
@@ -58,6 +65,14 @@ func (c *ArgoprojV1alpha1Client) Rollouts(namespace string) RolloutInterface {
 	return newRollouts(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) RolloutFreezes(namespace string) RolloutFreezeInterface {
+	return newRolloutFreezes(c, namespace)
+}
+
+func (c *ArgoprojV1alpha1Client) RolloutRevisions(namespace string) RolloutRevisionInterface {
+	return newRolloutRevisions(c, namespace)
+}
+
 // NewForConfig creates a new ArgoprojV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*ArgoprojV1alpha1Client, error) {
 	config := *c
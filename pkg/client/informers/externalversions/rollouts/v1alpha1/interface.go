@@ -30,10 +30,16 @@ type Interface interface {
 	AnalysisTemplates() AnalysisTemplateInformer
 	// ClusterAnalysisTemplates returns a ClusterAnalysisTemplateInformer.
 	ClusterAnalysisTemplates() ClusterAnalysisTemplateInformer
+	// ClusterRolloutFreezes returns a ClusterRolloutFreezeInformer.
+	ClusterRolloutFreezes() ClusterRolloutFreezeInformer
 	// Experiments returns a ExperimentInformer.
 	Experiments() ExperimentInformer
 	// Rollouts returns a RolloutInformer.
 	Rollouts() RolloutInformer
+	// RolloutFreezes returns a RolloutFreezeInformer.
+	RolloutFreezes() RolloutFreezeInformer
+	// RolloutRevisions returns a RolloutRevisionInformer.
+	RolloutRevisions() RolloutRevisionInformer
 }
 
 type version struct {
@@ -62,6 +68,11 @@ func (v *version) ClusterAnalysisTemplates() ClusterAnalysisTemplateInformer {
 	return &clusterAnalysisTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterRolloutFreezes returns a ClusterRolloutFreezeInformer.
+func (v *version) ClusterRolloutFreezes() ClusterRolloutFreezeInformer {
+	return &clusterRolloutFreezeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Experiments returns a ExperimentInformer.
 func (v *version) Experiments() ExperimentInformer {
 	return &experimentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -71,3 +82,13 @@ func (v *version) Experiments() ExperimentInformer {
 func (v *version) Rollouts() RolloutInformer {
 	return &rolloutInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// RolloutFreezes returns a RolloutFreezeInformer.
+func (v *version) RolloutFreezes() RolloutFreezeInformer {
+	return &rolloutFreezeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RolloutRevisions returns a RolloutRevisionInformer.
+func (v *version) RolloutRevisions() RolloutRevisionInformer {
+	return &rolloutRevisionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -38,6 +38,10 @@ type AnalysisTemplateNamespaceListerExpansion interface{}
 // ClusterAnalysisTemplateLister.
 type ClusterAnalysisTemplateListerExpansion interface{}
 
+// ClusterRolloutFreezeListerExpansion allows custom methods to be added to
+// ClusterRolloutFreezeLister.
+type ClusterRolloutFreezeListerExpansion interface{}
+
 // ExperimentListerExpansion allows custom methods to be added to
 // ExperimentLister.
 type ExperimentListerExpansion interface{}
@@ -53,3 +57,19 @@ type RolloutListerExpansion interface{}
 // RolloutNamespaceListerExpansion allows custom methods to be added to
 // RolloutNamespaceLister.
 type RolloutNamespaceListerExpansion interface{}
+
+// RolloutFreezeListerExpansion allows custom methods to be added to
+// RolloutFreezeLister.
+type RolloutFreezeListerExpansion interface{}
+
+// RolloutFreezeNamespaceListerExpansion allows custom methods to be added to
+// RolloutFreezeNamespaceLister.
+type RolloutFreezeNamespaceListerExpansion interface{}
+
+// RolloutRevisionListerExpansion allows custom methods to be added to
+// RolloutRevisionLister.
+type RolloutRevisionListerExpansion interface{}
+
+// RolloutRevisionNamespaceListerExpansion allows custom methods to be added to
+// RolloutRevisionNamespaceLister.
+type RolloutRevisionNamespaceListerExpansion interface{}
@@ -0,0 +1,99 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RolloutFreezeLister helps list RolloutFreezes.
+// All objects returned here must be treated as read-only.
+type RolloutFreezeLister interface {
+	// List lists all RolloutFreezes in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RolloutFreeze, err error)
+	// RolloutFreezes returns an object that can list and get RolloutFreezes.
+	RolloutFreezes(namespace string) RolloutFreezeNamespaceLister
+	RolloutFreezeListerExpansion
+}
+
+// rolloutFreezeLister implements the RolloutFreezeLister interface.
+type rolloutFreezeLister struct {
+	indexer cache.Indexer
+}
+
+// NewRolloutFreezeLister returns a new RolloutFreezeLister.
+func NewRolloutFreezeLister(indexer cache.Indexer) RolloutFreezeLister {
+	return &rolloutFreezeLister{indexer: indexer}
+}
+
+// List lists all RolloutFreezes in the indexer.
+func (s *rolloutFreezeLister) List(selector labels.Selector) (ret []*v1alpha1.RolloutFreeze, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RolloutFreeze))
+	})
+	return ret, err
+}
+
+// RolloutFreezes returns an object that can list and get RolloutFreezes.
+func (s *rolloutFreezeLister) RolloutFreezes(namespace string) RolloutFreezeNamespaceLister {
+	return rolloutFreezeNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RolloutFreezeNamespaceLister helps list and get RolloutFreezes.
+// All objects returned here must be treated as read-only.
+type RolloutFreezeNamespaceLister interface {
+	// List lists all RolloutFreezes in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RolloutFreeze, err error)
+	// Get retrieves the RolloutFreeze from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.RolloutFreeze, error)
+	RolloutFreezeNamespaceListerExpansion
+}
+
+// rolloutFreezeNamespaceLister implements the RolloutFreezeNamespaceLister
+// interface.
+type rolloutFreezeNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RolloutFreezes in the indexer for a given namespace.
+func (s rolloutFreezeNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.RolloutFreeze, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RolloutFreeze))
+	})
+	return ret, err
+}
+
+// Get retrieves the RolloutFreeze from the indexer for a given namespace and name.
+func (s rolloutFreezeNamespaceLister) Get(name string) (*v1alpha1.RolloutFreeze, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("rolloutfreeze"), name)
+	}
+	return obj.(*v1alpha1.RolloutFreeze), nil
+}
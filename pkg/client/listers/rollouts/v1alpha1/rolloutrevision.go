@@ -0,0 +1,99 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RolloutRevisionLister helps list RolloutRevisions.
+// All objects returned here must be treated as read-only.
+type RolloutRevisionLister interface {
+	// List lists all RolloutRevisions in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RolloutRevision, err error)
+	// RolloutRevisions returns an object that can list and get RolloutRevisions.
+	RolloutRevisions(namespace string) RolloutRevisionNamespaceLister
+	RolloutRevisionListerExpansion
+}
+
+// rolloutRevisionLister implements the RolloutRevisionLister interface.
+type rolloutRevisionLister struct {
+	indexer cache.Indexer
+}
+
+// NewRolloutRevisionLister returns a new RolloutRevisionLister.
+func NewRolloutRevisionLister(indexer cache.Indexer) RolloutRevisionLister {
+	return &rolloutRevisionLister{indexer: indexer}
+}
+
+// List lists all RolloutRevisions in the indexer.
+func (s *rolloutRevisionLister) List(selector labels.Selector) (ret []*v1alpha1.RolloutRevision, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RolloutRevision))
+	})
+	return ret, err
+}
+
+// RolloutRevisions returns an object that can list and get RolloutRevisions.
+func (s *rolloutRevisionLister) RolloutRevisions(namespace string) RolloutRevisionNamespaceLister {
+	return rolloutRevisionNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RolloutRevisionNamespaceLister helps list and get RolloutRevisions.
+// All objects returned here must be treated as read-only.
+type RolloutRevisionNamespaceLister interface {
+	// List lists all RolloutRevisions in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RolloutRevision, err error)
+	// Get retrieves the RolloutRevision from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.RolloutRevision, error)
+	RolloutRevisionNamespaceListerExpansion
+}
+
+// rolloutRevisionNamespaceLister implements the RolloutRevisionNamespaceLister
+// interface.
+type rolloutRevisionNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RolloutRevisions in the indexer for a given namespace.
+func (s rolloutRevisionNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.RolloutRevision, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RolloutRevision))
+	})
+	return ret, err
+}
+
+// Get retrieves the RolloutRevision from the indexer for a given namespace and name.
+func (s rolloutRevisionNamespaceLister) Get(name string) (*v1alpha1.RolloutRevision, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("rolloutrevision"), name)
+	}
+	return obj.(*v1alpha1.RolloutRevision), nil
+}
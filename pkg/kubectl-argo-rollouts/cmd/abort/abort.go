@@ -12,12 +12,16 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
 	abortExample = `
   # Abort a rollout
-  %[1]s abort guestbook`
+  %[1]s abort guestbook
+
+  # Abort a rollout and record why
+  %[1]s abort guestbook --reason "error rate too high"`
 
 	abortUsage = `This command stops progressing the current rollout and reverts all steps. The previous ReplicaSet will be active.
 
@@ -31,6 +35,7 @@ const (
 
 // NewCmdAbort returns a new instance of an `rollouts abort` command
 func NewCmdAbort(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var reason string
 	var cmd = &cobra.Command{
 		Use:          "abort ROLLOUT_NAME",
 		Short:        "Abort a rollout",
@@ -44,7 +49,12 @@ func NewCmdAbort(o *options.ArgoRolloutsOptions) *cobra.Command {
 			ns := o.Namespace()
 			rolloutIf := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(ns)
 			for _, name := range args {
-				ro, err := AbortRollout(rolloutIf, name)
+				var ro *v1alpha1.Rollout
+				err := o.RecordAndPerformAction(rolloutIf, name, audit.ActionAbort, reason, func() error {
+					var err error
+					ro, err = AbortRollout(rolloutIf, name)
+					return err
+				})
 				if err != nil {
 					return err
 				}
@@ -53,6 +63,7 @@ func NewCmdAbort(o *options.ArgoRolloutsOptions) *cobra.Command {
 			return nil
 		},
 	}
+	o.AddReasonFlag(cmd, &reason)
 	return cmd
 }
 
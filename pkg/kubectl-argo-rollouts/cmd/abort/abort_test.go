@@ -2,6 +2,7 @@ package abort
 
 import (
 	"bytes"
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	fakeroclient "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 func TestAbortCmdUsage(t *testing.T) {
@@ -64,6 +66,46 @@ func TestAbortCmd(t *testing.T) {
 	assert.Empty(t, stderr)
 }
 
+func TestAbortCmdReason(t *testing.T) {
+	ro := v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(&ro)
+	defer tf.Cleanup()
+
+	cmd := NewCmdAbort(o)
+	o.AddKubectlFlags(cmd)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--user", "alice", "--reason", "error rate too high"})
+	err := cmd.Execute()
+	assert.Nil(t, err)
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stderr)
+
+	ctx := context.TODO()
+	updated, err := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(metav1.NamespaceDefault).Get(ctx, "guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, updated.Status.Abort)
+	entries := audit.GetLog(updated)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, audit.ActionAbort, entries[0].Action)
+		assert.Equal(t, "alice", entries[0].User)
+		assert.Equal(t, "error rate too high", entries[0].Reason)
+	}
+
+	events, err := o.KubeClientset().CoreV1().Events(metav1.NamespaceDefault).List(ctx, metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, events.Items, 1) {
+		assert.Equal(t, "AbortRequested", events.Items[0].Reason)
+		assert.Equal(t, "Abort requested by alice: error rate too high", events.Items[0].Message)
+		assert.Equal(t, "guestbook", events.Items[0].InvolvedObject.Name)
+	}
+}
+
 func TestAbortCmdError(t *testing.T) {
 	tf, o := options.NewFakeArgoRolloutsOptions(&v1alpha1.Rollout{})
 	defer tf.Cleanup()
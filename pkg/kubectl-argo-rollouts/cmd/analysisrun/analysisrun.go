@@ -0,0 +1,119 @@
+package analysisrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+)
+
+const (
+	analysisRunExample = `
+	# Mark an inconclusive AnalysisRun as successful
+	%[1]s analysisrun pass guestbook-877894d5b-4-success-rate.1 --reason "error rate spike caused by unrelated outage"
+
+	# Mark an inconclusive AnalysisRun as failed
+	%[1]s analysisrun fail guestbook-877894d5b-4-success-rate.1 --reason "latency regression confirmed"`
+
+	analysisRunPassExample = `
+	# Mark an inconclusive AnalysisRun as successful
+	%[1]s analysisrun pass guestbook-877894d5b-4-success-rate.1 --reason "error rate spike caused by unrelated outage"`
+
+	analysisRunFailExample = `
+	# Mark an inconclusive AnalysisRun as failed
+	%[1]s analysisrun fail guestbook-877894d5b-4-success-rate.1 --reason "latency regression confirmed"`
+)
+
+// NewCmdAnalysisRun returns a new instance of an `argo rollouts analysisrun` command
+func NewCmdAnalysisRun(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:          "analysisrun <pass|fail> ANALYSISRUN_NAME",
+		Aliases:      []string{"ar", "analysisruns"},
+		Short:        "Manually judge an inconclusive AnalysisRun",
+		Long:         "This command consists of multiple subcommands which can be used to manually mark an Inconclusive AnalysisRun as Successful or Failed.",
+		Example:      o.Example(analysisRunExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			return o.UsageErr(c)
+		},
+	}
+	cmd.AddCommand(NewCmdAnalysisRunPass(o))
+	cmd.AddCommand(NewCmdAnalysisRunFail(o))
+	return cmd
+}
+
+// NewCmdAnalysisRunPass returns a new instance of an `argo rollouts analysisrun pass` command
+func NewCmdAnalysisRunPass(o *options.ArgoRolloutsOptions) *cobra.Command {
+	return newCmdJudge(o, "pass", "Mark an inconclusive AnalysisRun as successful", analysisRunPassExample, v1alpha1.AnalysisPhaseSuccessful)
+}
+
+// NewCmdAnalysisRunFail returns a new instance of an `argo rollouts analysisrun fail` command
+func NewCmdAnalysisRunFail(o *options.ArgoRolloutsOptions) *cobra.Command {
+	return newCmdJudge(o, "fail", "Mark an inconclusive AnalysisRun as failed", analysisRunFailExample, v1alpha1.AnalysisPhaseFailed)
+}
+
+func newCmdJudge(o *options.ArgoRolloutsOptions, use, short, example string, phase v1alpha1.AnalysisPhase) *cobra.Command {
+	var reason string
+	var cmd = &cobra.Command{
+		Use:          use + " ANALYSISRUN_NAME",
+		Short:        short,
+		Long:         short + ". Only AnalysisRuns which are Inconclusive can be judged.",
+		Example:      o.Example(example),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return o.UsageErr(c)
+			}
+			if reason == "" {
+				return fmt.Errorf("a reason must be given with --reason")
+			}
+			analysisRunIf := o.RolloutsClientset().ArgoprojV1alpha1().AnalysisRuns(o.Namespace())
+			ar, err := JudgeAnalysisRun(analysisRunIf, args[0], phase, reason, o.CurrentUser())
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(o.Out, "analysisRun '%s' judged %s\n", ar.Name, phase)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason for the judgment, which is recorded on the AnalysisRun")
+	return cmd
+}
+
+// JudgeAnalysisRun records a manual judgment on an Inconclusive AnalysisRun, which the analysis
+// controller applies as the final phase of the run.
+func JudgeAnalysisRun(analysisRunIf clientset.AnalysisRunInterface, name string, phase v1alpha1.AnalysisPhase, reason, user string) (*v1alpha1.AnalysisRun, error) {
+	if phase != v1alpha1.AnalysisPhaseSuccessful && phase != v1alpha1.AnalysisPhaseFailed {
+		return nil, fmt.Errorf("invalid judgment '%s': must be %s or %s", phase, v1alpha1.AnalysisPhaseSuccessful, v1alpha1.AnalysisPhaseFailed)
+	}
+	ctx := context.TODO()
+	ar, err := analysisRunIf.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if ar.Status.Phase != v1alpha1.AnalysisPhaseInconclusive {
+		return nil, fmt.Errorf("analysisRun '%s' is not Inconclusive", name)
+	}
+	now := metav1.Now()
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"judgment": v1alpha1.AnalysisRunJudgment{
+				Phase:    phase,
+				Reason:   reason,
+				User:     user,
+				JudgedAt: &now,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return analysisRunIf.Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+}
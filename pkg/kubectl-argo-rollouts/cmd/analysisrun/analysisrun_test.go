@@ -0,0 +1,123 @@
+package analysisrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	fakeroclient "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+)
+
+func newAnalysisRun(phase v1alpha1.AnalysisPhase) *v1alpha1.AnalysisRun {
+	return &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func TestAnalysisRunCmdUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "Usage:\n  analysisrun <pass|fail> ANALYSISRUN_NAME")
+	assert.Contains(t, stderr, "Aliases:\n  analysisrun, ar, analysisruns")
+}
+
+func TestAnalysisRunPassCmdUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdAnalysisRunPass(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Contains(t, stderr, "Usage:\n  pass ANALYSISRUN_NAME")
+}
+
+func TestAnalysisRunPassCmd(t *testing.T) {
+	ar := newAnalysisRun(v1alpha1.AnalysisPhaseInconclusive)
+	tf, o := options.NewFakeArgoRolloutsOptions(ar)
+	defer tf.Cleanup()
+	var judgment *v1alpha1.AnalysisRunJudgment
+	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
+	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			var patched v1alpha1.AnalysisRun
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &patched))
+			judgment = patched.Spec.Judgment
+		}
+		return true, ar, nil
+	})
+
+	cmd := NewCmdAnalysisRunPass(o)
+	o.AddKubectlFlags(cmd)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--reason", "unrelated outage"})
+	err := cmd.Execute()
+	assert.Nil(t, err)
+
+	if assert.NotNil(t, judgment) {
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, judgment.Phase)
+		assert.Equal(t, "unrelated outage", judgment.Reason)
+		assert.NotNil(t, judgment.JudgedAt)
+	}
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Equal(t, "analysisRun 'guestbook' judged Successful\n", stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestAnalysisRunFailCmdRequiresReason(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions(newAnalysisRun(v1alpha1.AnalysisPhaseInconclusive))
+	defer tf.Cleanup()
+	cmd := NewCmdAnalysisRunFail(o)
+	o.AddKubectlFlags(cmd)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Equal(t, "Error: a reason must be given with --reason\n", stderr)
+}
+
+func TestAnalysisRunFailCmdNotInconclusive(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions(newAnalysisRun(v1alpha1.AnalysisPhaseRunning))
+	defer tf.Cleanup()
+	cmd := NewCmdAnalysisRunFail(o)
+	o.AddKubectlFlags(cmd)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--reason", "regression"})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Equal(t, "Error: analysisRun 'guestbook' is not Inconclusive\n", stderr)
+}
+
+func TestJudgeAnalysisRunInvalidPhase(t *testing.T) {
+	client := fakeroclient.NewSimpleClientset(newAnalysisRun(v1alpha1.AnalysisPhaseInconclusive))
+	analysisRunIf := client.ArgoprojV1alpha1().AnalysisRuns(metav1.NamespaceDefault)
+	_, err := JudgeAnalysisRun(analysisRunIf, "guestbook", v1alpha1.AnalysisPhaseRunning, "", "")
+	assert.EqualError(t, err, "invalid judgment 'Running': must be Successful or Failed")
+}
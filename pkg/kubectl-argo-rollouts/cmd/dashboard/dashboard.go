@@ -22,6 +22,7 @@ func NewCmdDashboard(o *options.ArgoRolloutsOptions) *cobra.Command {
 				KubeClientset:     kubeclientset,
 				RolloutsClientset: rolloutclientset,
 				DynamicClientset:  o.DynamicClientset(),
+				User:              o.CurrentUser(),
 			}
 
 			for {
@@ -132,6 +132,9 @@ func (o *GetOptions) PrintRollout(roInfo *rollout.RolloutInfo) {
 	fmt.Fprintf(o.Out, tableFormat, "Strategy:", roInfo.Strategy)
 	if roInfo.Strategy == "Canary" {
 		fmt.Fprintf(o.Out, tableFormat, "  Step:", roInfo.Step)
+		for _, skipped := range roInfo.SkippedSteps {
+			fmt.Fprintf(o.Out, tableFormat, "  SkippedStep:", fmt.Sprintf("%d/%d (%s)", skipped.Index+1, len(roInfo.Steps), skipped.Reason))
+		}
 		fmt.Fprintf(o.Out, tableFormat, "  SetWeight:", roInfo.SetWeight)
 		fmt.Fprintf(o.Out, tableFormat, "  ActualWeight:", roInfo.ActualWeight)
 	}
@@ -305,6 +305,30 @@ NAME                                     KIND        STATUS              AGE  IN
 	assertStdout(t, expectedOut, o.IOStreams)
 }
 
+func TestGetCanaryRolloutSkippedSteps(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	rolloutObjs.Rollouts[0].Status.Canary.SkippedSteps = []v1alpha1.SkippedCanaryStep{{
+		Index:  1,
+		Reason: "when expression 'metadata.labels.env == \"prod\"' evaluated to false",
+	}}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--no-color"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, `
+  Step:          0/8
+  SkippedStep:   2/8 (when expression 'metadata.labels.env == "prod"' evaluated to false)
+  SetWeight:     20
+`)
+}
+
 func TestExperimentRollout(t *testing.T) {
 	rolloutObjs := testdata.NewExperimentAnalysisRollout()
 
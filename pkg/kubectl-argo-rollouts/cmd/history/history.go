@@ -0,0 +1,178 @@
+package history
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+)
+
+const (
+	historyLong = `Show the records of the completed and aborted revisions of a rollout. The records are kept
+after the ReplicaSets of the revisions are garbage collected, up to the rolloutRevisionLimit of the rollout.`
+	historyExample = `
+	# Show the revision history of the guestbook rollout
+	%[1]s history guestbook
+
+	# Show the details of revision 3 of the guestbook rollout
+	%[1]s history guestbook --revision 3`
+)
+
+type HistoryOptions struct {
+	Revision int64
+
+	options.ArgoRolloutsOptions
+}
+
+// NewCmdHistory returns a new instance of a `rollouts history` command
+func NewCmdHistory(o *options.ArgoRolloutsOptions) *cobra.Command {
+	historyOptions := HistoryOptions{
+		ArgoRolloutsOptions: *o,
+	}
+
+	var cmd = &cobra.Command{
+		Use:          "history ROLLOUT_NAME",
+		Short:        "Show the revision history of a rollout",
+		Long:         historyLong,
+		Example:      o.Example(historyExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return o.UsageErr(c)
+			}
+			name := args[0]
+			revisionIf := o.RolloutsClientset().ArgoprojV1alpha1().RolloutRevisions(o.Namespace())
+			selector := labels.SelectorFromSet(labels.Set{v1alpha1.RolloutRevisionRolloutLabel: name})
+			revisionList, err := revisionIf.List(c.Context(), metav1.ListOptions{LabelSelector: selector.String()})
+			if err != nil {
+				return err
+			}
+			revisions := revisionList.Items
+			// a revision which was aborted and retried has a record for each abort
+			sort.Slice(revisions, func(i, j int) bool {
+				if revisions[i].Spec.Revision != revisions[j].Spec.Revision {
+					return revisions[i].Spec.Revision < revisions[j].Spec.Revision
+				}
+				return revisions[i].Spec.FinishedAt.Before(&revisions[j].Spec.FinishedAt)
+			})
+			if historyOptions.Revision > 0 {
+				found := false
+				for i := range revisions {
+					if revisions[i].Spec.Revision == historyOptions.Revision {
+						if found {
+							fmt.Fprintln(o.Out)
+						}
+						historyOptions.PrintRevision(&revisions[i])
+						found = true
+					}
+				}
+				if !found {
+					return fmt.Errorf("revision %d of rollout '%s' not found", historyOptions.Revision, name)
+				}
+				return nil
+			}
+			historyOptions.PrintHistoryTable(revisions)
+			return nil
+		},
+	}
+	cmd.Flags().Int64Var(&historyOptions.Revision, "revision", 0, "Show the details of the revision")
+	return cmd
+}
+
+const (
+	historyHeaderFmtString = "REVISION\tRESULT\tSTARTED\tDURATION\tBY\tIMAGES\n"
+	historyColumnFmtString = "%-8d\t%-9s\t%-7s\t%-8s\t%-2s\t%s\n"
+)
+
+// PrintHistoryTable prints the records of the revisions in table format
+func (o *HistoryOptions) PrintHistoryTable(revisions []v1alpha1.RolloutRevision) {
+	if len(revisions) == 0 {
+		fmt.Fprintln(o.ErrOut, "No resources found.")
+		return
+	}
+	w := tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, historyHeaderFmtString)
+	for _, revision := range revisions {
+		started, dur := revisionTimes(&revision.Spec)
+		fmt.Fprintf(w, historyColumnFmtString, revision.Spec.Revision, revision.Spec.Result, started, dur, revisionActor(&revision.Spec), revisionImages(&revision.Spec))
+	}
+	_ = w.Flush()
+}
+
+// PrintRevision prints the details of the record of a revision
+func (o *HistoryOptions) PrintRevision(revision *v1alpha1.RolloutRevision) {
+	spec := &revision.Spec
+	started, dur := revisionTimes(spec)
+	w := tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Revision:\t%d\n", spec.Revision)
+	fmt.Fprintf(w, "Pod Template Hash:\t%s\n", spec.PodTemplateHash)
+	fmt.Fprintf(w, "Result:\t%s\n", spec.Result)
+	if spec.Message != "" {
+		fmt.Fprintf(w, "Message:\t%s\n", spec.Message)
+	}
+	if spec.PromotedBy != "" {
+		fmt.Fprintf(w, "Promoted By:\t%s\n", spec.PromotedBy)
+	}
+	if spec.AbortReason != "" {
+		fmt.Fprintf(w, "Abort Reason:\t%s\n", spec.AbortReason)
+	}
+	fmt.Fprintf(w, "Started:\t%s\n", started)
+	fmt.Fprintf(w, "Duration:\t%s\n", dur)
+	fmt.Fprintf(w, "Images:\t%s\n", revisionImages(spec))
+	_ = w.Flush()
+
+	if len(spec.Steps) > 0 {
+		fmt.Fprintln(o.Out)
+		w = tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "STEP\tTYPE\tDURATION\n")
+		for _, step := range spec.Steps {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", step.Index, step.Type, duration.HumanDuration(step.FinishedAt.Sub(step.StartedAt.Time)))
+		}
+		_ = w.Flush()
+	}
+	if len(spec.Analyses) > 0 {
+		fmt.Fprintln(o.Out)
+		w = tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "ANALYSIS RUN\tTYPE\tSTEP\tPHASE\tMESSAGE\n")
+		for _, analysis := range spec.Analyses {
+			step := "-"
+			if analysis.StepIndex != nil {
+				step = fmt.Sprint(*analysis.StepIndex)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", analysis.Name, analysis.Type, step, analysis.Phase, analysis.Message)
+		}
+		_ = w.Flush()
+	}
+}
+
+// revisionTimes returns how long ago the update to the revision started and how long it took
+func revisionTimes(spec *v1alpha1.RolloutRevisionSpec) (string, string) {
+	if spec.StartedAt == nil {
+		return "-", "-"
+	}
+	return duration.HumanDuration(metav1.Now().Sub(spec.StartedAt.Time)) + " ago", duration.HumanDuration(spec.FinishedAt.Sub(spec.StartedAt.Time))
+}
+
+// revisionActor returns who promoted the revision or why it was aborted
+func revisionActor(spec *v1alpha1.RolloutRevisionSpec) string {
+	if spec.Result == v1alpha1.RevisionResultAborted {
+		return spec.AbortReason
+	}
+	return spec.PromotedBy
+}
+
+func revisionImages(spec *v1alpha1.RolloutRevisionSpec) string {
+	var images []string
+	for _, image := range spec.Images {
+		images = append(images, image.Image)
+	}
+	return strings.Join(images, ",")
+}
@@ -0,0 +1,186 @@
+package history
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+)
+
+func newRolloutRevision(rollout string, revision int64, spec v1alpha1.RolloutRevisionSpec) *v1alpha1.RolloutRevision {
+	spec.RolloutName = rollout
+	spec.Revision = revision
+	return &v1alpha1.RolloutRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", rollout, revision),
+			Namespace: metav1.NamespaceDefault,
+			Labels:    map[string]string{v1alpha1.RolloutRevisionRolloutLabel: rollout},
+		},
+		Spec: spec,
+	}
+}
+
+func newRevisions() []*v1alpha1.RolloutRevision {
+	finishedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	startedAt := metav1.NewTime(finishedAt.Add(-10 * time.Minute))
+	return []*v1alpha1.RolloutRevision{
+		newRolloutRevision("guestbook", 2, v1alpha1.RolloutRevisionSpec{
+			Result:          v1alpha1.RevisionResultAborted,
+			PodTemplateHash: "abc",
+			Message:         "RolloutAborted: metric failed",
+			AbortReason:     "AnalysisRunFailed",
+			Images:          []v1alpha1.RevisionImage{{Container: "guestbook", Image: "guestbook:v2"}},
+			StartedAt:       &startedAt,
+			FinishedAt:      finishedAt,
+			Steps: []v1alpha1.RevisionStep{
+				{Index: 0, Type: "setWeight", StartedAt: startedAt, FinishedAt: metav1.NewTime(startedAt.Add(time.Minute))},
+			},
+			Analyses: []v1alpha1.RevisionAnalysis{
+				{Name: "guestbook-abc-2-1", Type: v1alpha1.RolloutTypeStepLabel, StepIndex: int32Ptr(1), Phase: v1alpha1.AnalysisPhaseFailed, Message: "metric failed"},
+			},
+		}),
+		newRolloutRevision("guestbook", 1, v1alpha1.RolloutRevisionSpec{
+			Result:     v1alpha1.RevisionResultCompleted,
+			PromotedBy: "controller",
+			Images:     []v1alpha1.RevisionImage{{Container: "guestbook", Image: "guestbook:v1"}},
+			FinishedAt: finishedAt,
+		}),
+		newRolloutRevision("other", 1, v1alpha1.RolloutRevisionSpec{
+			Result: v1alpha1.RevisionResultCompleted,
+		}),
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func TestHistoryCmdUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "Usage:")
+	assert.Contains(t, stderr, "history ROLLOUT_NAME")
+}
+
+func TestHistoryCmd(t *testing.T) {
+	revisions := newRevisions()
+	tf, o := options.NewFakeArgoRolloutsOptions(revisions[0], revisions[1], revisions[2])
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	expectedOut := `REVISION  RESULT     STARTED  DURATION  BY                 IMAGES
+1         Completed  -        -         controller         guestbook:v1
+2         Aborted    70m ago  10m       AnalysisRunFailed  guestbook:v2
+`
+	assert.Equal(t, expectedOut, stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestHistoryCmdRevision(t *testing.T) {
+	revisions := newRevisions()
+	tf, o := options.NewFakeArgoRolloutsOptions(revisions[0], revisions[1], revisions[2])
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--revision", "2"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	expectedOut := `Revision:           2
+Pod Template Hash:  abc
+Result:             Aborted
+Message:            RolloutAborted: metric failed
+Abort Reason:       AnalysisRunFailed
+Started:            70m ago
+Duration:           10m
+Images:             guestbook:v2
+
+STEP  TYPE       DURATION
+0     setWeight  60s
+
+ANALYSIS RUN       TYPE  STEP  PHASE   MESSAGE
+guestbook-abc-2-1  Step  1     Failed  metric failed
+`
+	assert.Equal(t, expectedOut, stdout)
+}
+
+func TestHistoryCmdRetriedRevision(t *testing.T) {
+	revisions := newRevisions()
+	revisions[0].Name = "guestbook-2-aborted-1622541600"
+	completed := newRolloutRevision("guestbook", 2, v1alpha1.RolloutRevisionSpec{
+		Result:     v1alpha1.RevisionResultCompleted,
+		PromotedBy: "alice",
+		Images:     []v1alpha1.RevisionImage{{Container: "guestbook", Image: "guestbook:v2"}},
+		FinishedAt: metav1.NewTime(time.Now().Add(-30 * time.Minute)),
+	})
+	tf, o := options.NewFakeArgoRolloutsOptions(completed, revisions[0], revisions[1])
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	expectedOut := `REVISION  RESULT     STARTED  DURATION  BY                 IMAGES
+1         Completed  -        -         controller         guestbook:v1
+2         Aborted    70m ago  10m       AnalysisRunFailed  guestbook:v2
+2         Completed  -        -         alice              guestbook:v2
+`
+	assert.Equal(t, expectedOut, stdout)
+
+	tf, o = options.NewFakeArgoRolloutsOptions(completed, revisions[0], revisions[1])
+	defer tf.Cleanup()
+	cmd = NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--revision", "2"})
+	err = cmd.Execute()
+	assert.NoError(t, err)
+	stdout = o.Out.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "Result:             Aborted\n")
+	assert.Contains(t, stdout, "guestbook-abc-2-1  Step  1     Failed  metric failed\n\nRevision:           2\n")
+	assert.Contains(t, stdout, "Result:             Completed\nPromoted By:        alice\n")
+}
+
+func TestHistoryCmdRevisionNotFound(t *testing.T) {
+	revisions := newRevisions()
+	tf, o := options.NewFakeArgoRolloutsOptions(revisions[0], revisions[1], revisions[2])
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--revision", "3"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "revision 3 of rollout 'guestbook' not found")
+}
+
+func TestHistoryCmdNoRevisions(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Equal(t, "No resources found.\n", stderr)
+}
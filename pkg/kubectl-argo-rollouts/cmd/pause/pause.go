@@ -9,6 +9,7 @@ import (
 	types "k8s.io/apimachinery/pkg/types"
 
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
@@ -19,6 +20,7 @@ const (
 
 // NewCmdPause returns a new instance of an `rollouts pause` command
 func NewCmdPause(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var reason string
 	var cmd = &cobra.Command{
 		Use:          "pause ROLLOUT_NAME",
 		Short:        "Pause a rollout",
@@ -37,9 +39,11 @@ func NewCmdPause(o *options.ArgoRolloutsOptions) *cobra.Command {
 					return err
 				}
 				fmt.Fprintf(o.Out, "rollout '%s' paused\n", ro.Name)
+				o.RecordAction(rolloutIf, name, audit.ActionPause, reason)
 			}
 			return nil
 		},
 	}
+	o.AddReasonFlag(cmd, &reason)
 	return cmd
 }
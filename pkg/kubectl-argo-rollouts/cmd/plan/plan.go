@@ -0,0 +1,132 @@
+package plan
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/rollout"
+)
+
+const (
+	planLong = `Show what the controller would do next for a rollout, e.g. which ReplicaSets it would scale,
+which canary weight it would set and which AnalysisRuns it would create. The reconciliation of the
+controller is run against a copy of the live objects of the rollout, so nothing is changed in the cluster.`
+	planExample = `
+	# Show the next actions of the controller for the guestbook rollout
+	%[1]s plan guestbook
+
+	# Show the next actions along with the logs of the reconciliation
+	%[1]s plan guestbook --verbose`
+)
+
+// detailLimit is the length which the details of the actions are truncated to, unless --full is set
+const detailLimit = 80
+
+type PlanOptions struct {
+	Verbose bool
+	Full    bool
+
+	options.ArgoRolloutsOptions
+}
+
+// NewCmdPlan returns a new instance of a `rollouts plan` command
+func NewCmdPlan(o *options.ArgoRolloutsOptions) *cobra.Command {
+	planOptions := PlanOptions{
+		ArgoRolloutsOptions: *o,
+	}
+
+	var cmd = &cobra.Command{
+		Use:          "plan ROLLOUT_NAME",
+		Short:        "Show the next actions of the controller for a rollout",
+		Long:         planLong,
+		Example:      o.Example(planExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return o.UsageErr(c)
+			}
+			name := args[0]
+			// the reconciliation logs to the standard logger, as the controller does
+			logOut := log.StandardLogger().Out
+			if planOptions.Verbose {
+				log.SetOutput(o.ErrOut)
+			} else {
+				log.SetOutput(ioutil.Discard)
+			}
+			defer log.SetOutput(logOut)
+
+			cfg := rollout.PlanConfig{
+				KubeClientSet:     o.KubeClientset(),
+				ArgoProjClientset: o.RolloutsClientset(),
+				DynamicClientSet:  o.DynamicClientset(),
+				SmiClientSet:      o.SmiClientset(),
+			}
+			plan, err := rollout.PlanReconciliation(c.Context(), cfg, o.Namespace(), name)
+			if err != nil {
+				return err
+			}
+			planOptions.PrintPlan(plan)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&planOptions.Verbose, "verbose", false, "Show the logs of the reconciliation")
+	cmd.Flags().BoolVar(&planOptions.Full, "full", false, "Show the details of the actions without truncating them")
+	return cmd
+}
+
+// PrintPlan prints the actions, events and requeue of a planned reconciliation
+func (o *PlanOptions) PrintPlan(plan *rollout.Plan) {
+	if len(plan.Actions) == 0 {
+		fmt.Fprintln(o.Out, "No actions.")
+	} else {
+		w := tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "ACTION\tKIND\tNAME\tDETAIL\n")
+		for _, action := range plan.Actions {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", action.Verb, action.Kind, dashIfEmpty(action.Name), o.detail(action.Detail))
+		}
+		_ = w.Flush()
+	}
+	if len(plan.Events) > 0 {
+		fmt.Fprintln(o.Out)
+		fmt.Fprintln(o.Out, "Events:")
+		for _, event := range plan.Events {
+			fmt.Fprintf(o.Out, "  %s\n", event)
+		}
+	}
+	if plan.Requeue {
+		fmt.Fprintln(o.Out)
+		if plan.RequeueAfter > 0 {
+			fmt.Fprintf(o.Out, "Requeue: after %s\n", duration.HumanDuration(plan.RequeueAfter))
+		} else {
+			fmt.Fprintln(o.Out, "Requeue: immediately")
+		}
+	}
+	if plan.Error != nil {
+		fmt.Fprintln(o.Out)
+		fmt.Fprintf(o.Out, "Error: %v\n", plan.Error)
+	}
+}
+
+func (o *PlanOptions) detail(detail string) string {
+	if detail == "" {
+		return "-"
+	}
+	if !o.Full && len(detail) > detailLimit {
+		return strings.TrimSpace(detail[:detailLimit]) + "..."
+	}
+	return detail
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
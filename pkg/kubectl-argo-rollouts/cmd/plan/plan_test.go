@@ -0,0 +1,116 @@
+package plan
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	"github.com/argoproj/argo-rollouts/rollout"
+)
+
+func newRollout() *v1alpha1.Rollout {
+	selector := map[string]string{"app": "guestbook"}
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: pointer.Int32Ptr(2),
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: selector},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "guestbook", Image: "guestbook:v1"}},
+				},
+			},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{},
+			},
+		},
+	}
+}
+
+func TestPlanCmdUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdPlan(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "Usage:")
+	assert.Contains(t, stderr, "plan ROLLOUT_NAME")
+}
+
+func TestPlanCmd(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions(newRollout())
+	defer tf.Cleanup()
+	cmd := NewCmdPlan(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	// the first reconciliation of a rollout initializes its status
+	assert.Regexp(t, `^ACTION\s+KIND\s+NAME\s+DETAIL\n`, stdout)
+	assert.Regexp(t, `(?m)^patch status\s+Rollout\s+guestbook\s+\{"status":.+\.\.\.$`, stdout)
+	assert.Empty(t, stderr)
+
+	// the plan is not applied to the cluster
+	ro, err := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(metav1.NamespaceDefault).Get(cmd.Context(), "guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, ro.Status.Phase)
+}
+
+func TestPlanCmdNotFound(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdPlan(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, `rollouts.argoproj.io "guestbook" not found`)
+}
+
+func TestPrintPlan(t *testing.T) {
+	_, o := options.NewFakeArgoRolloutsOptions()
+	planOptions := PlanOptions{ArgoRolloutsOptions: *o}
+	planOptions.PrintPlan(&rollout.Plan{
+		Actions: []rollout.PlannedAction{
+			{Verb: "scale", Kind: "ReplicaSet", Name: "guestbook-abc", Detail: "from 3 to 2"},
+			{Verb: "setWeight", Kind: "Nginx", Detail: "20"},
+		},
+		Requeue:      true,
+		RequeueAfter: 5 * time.Minute,
+		Error:        errors.New("failed to reconcile"),
+	})
+	expectedOut := `ACTION     KIND        NAME           DETAIL
+scale      ReplicaSet  guestbook-abc  from 3 to 2
+setWeight  Nginx       -              20
+
+Requeue: after 5m
+
+Error: failed to reconcile
+`
+	assert.Equal(t, expectedOut, o.Out.(*bytes.Buffer).String())
+}
+
+func TestPrintPlanNoActions(t *testing.T) {
+	_, o := options.NewFakeArgoRolloutsOptions()
+	planOptions := PlanOptions{ArgoRolloutsOptions: *o}
+	planOptions.PrintPlan(&rollout.Plan{})
+	assert.Equal(t, "No actions.\n", o.Out.(*bytes.Buffer).String())
+}
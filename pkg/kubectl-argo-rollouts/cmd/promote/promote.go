@@ -12,6 +12,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 )
 
@@ -49,6 +50,7 @@ func NewCmdPromote(o *options.ArgoRolloutsOptions) *cobra.Command {
 		skipCurrentStep = false
 		skipAllSteps    = false
 		full            = false
+		reason          string
 	)
 	var cmd = &cobra.Command{
 		Use:          "promote ROLLOUT_NAME",
@@ -65,7 +67,16 @@ func NewCmdPromote(o *options.ArgoRolloutsOptions) *cobra.Command {
 			}
 			name := args[0]
 			rolloutIf := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(o.Namespace())
-			ro, err := PromoteRollout(rolloutIf, name, skipCurrentStep, skipAllSteps, full)
+			action := audit.ActionPromote
+			if full {
+				action = audit.ActionPromoteFull
+			}
+			var ro *v1alpha1.Rollout
+			err := o.RecordAndPerformAction(rolloutIf, name, action, reason, func() error {
+				var err error
+				ro, err = PromoteRollout(rolloutIf, name, skipCurrentStep, skipAllSteps, full)
+				return err
+			})
 			if err != nil {
 				return err
 			}
@@ -85,6 +96,7 @@ func NewCmdPromote(o *options.ArgoRolloutsOptions) *cobra.Command {
 	cmd.Flags().MarkDeprecated("skip-all-steps", "use --full instead")
 	cmd.Flags().MarkShorthandDeprecated("a", "use --full instead")
 	cmd.Flags().BoolVar(&full, "full", false, "Perform a full promotion, skipping analysis, pauses, and steps")
+	o.AddReasonFlag(cmd, &reason)
 	return cmd
 }
 
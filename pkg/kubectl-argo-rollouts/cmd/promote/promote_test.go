@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,8 +16,14 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	fakeroclient "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
 )
 
+// isAuditLogPatch returns whether the patch only records the action in the audit log
+func isAuditLogPatch(action kubetesting.PatchAction) bool {
+	return strings.Contains(string(action.GetPatch()), annotations.AuditLogAnnotation)
+}
+
 func TestPromoteCmdUsage(t *testing.T) {
 	tf, o := options.NewFakeArgoRolloutsOptions()
 	defer tf.Cleanup()
@@ -120,7 +127,7 @@ func TestPromoteCmdSuccesSkipAllSteps(t *testing.T) {
 	defer tf.Cleanup()
 	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
 	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
-		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok && !isAuditLogPatch(patchAction) {
 			patchRo := v1alpha1.Rollout{}
 			err := json.Unmarshal(patchAction.GetPatch(), &patchRo)
 			if err != nil {
@@ -170,7 +177,7 @@ func TestPromoteCmdSuccesFirstStep(t *testing.T) {
 	defer tf.Cleanup()
 	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
 	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
-		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok && !isAuditLogPatch(patchAction) {
 			patchRo := v1alpha1.Rollout{}
 			err := json.Unmarshal(patchAction.GetPatch(), &patchRo)
 			if err != nil {
@@ -223,7 +230,7 @@ func TestPromoteCmdSuccessDoNotGoPastLastStep(t *testing.T) {
 	defer tf.Cleanup()
 	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
 	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
-		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok && !isAuditLogPatch(patchAction) {
 			patchRo := v1alpha1.Rollout{}
 			err := json.Unmarshal(patchAction.GetPatch(), &patchRo)
 			if err != nil {
@@ -396,7 +403,7 @@ func TestPromoteCmdAlreadyFullyPromoted(t *testing.T) {
 	defer tf.Cleanup()
 	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
 	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
-		if _, ok := action.(kubetesting.PatchAction); ok {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok && !isAuditLogPatch(patchAction) {
 			// should not be called if we are already fully promoted
 			t.FailNow()
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
@@ -31,7 +32,8 @@ const (
 
 func NewCmdRestart(o *options.ArgoRolloutsOptions) *cobra.Command {
 	var (
-		in string
+		in     string
+		reason string
 	)
 	var cmd = &cobra.Command{
 		Use:          "restart ROLLOUT",
@@ -59,10 +61,12 @@ func NewCmdRestart(o *options.ArgoRolloutsOptions) *cobra.Command {
 				return err
 			}
 			fmt.Fprintf(o.Out, "rollout '%s' restarts in %s\n", ro.Name, in)
+			o.RecordAction(rolloutIf, name, audit.ActionRestart, reason)
 			return nil
 		},
 	}
 	cmd.Flags().StringVarP(&in, "in", "i", "", "Amount of time before a restart. (e.g. 30s, 5m, 1h)")
+	o.AddReasonFlag(cmd, &reason)
 	return cmd
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
@@ -55,6 +56,7 @@ func NewCmdRetry(o *options.ArgoRolloutsOptions) *cobra.Command {
 
 // NewCmdRetryRollout returns a new instance of an `argo rollouts retry rollout` command
 func NewCmdRetryRollout(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var reason string
 	var cmd = &cobra.Command{
 		Use:          "rollout ROLLOUT_NAME",
 		Aliases:      []string{"ro", "rollouts"},
@@ -73,10 +75,12 @@ func NewCmdRetryRollout(o *options.ArgoRolloutsOptions) *cobra.Command {
 					return err
 				}
 				fmt.Fprintf(o.Out, "rollout '%s' retried\n", ro.Name)
+				o.RecordAction(rolloutIf, name, audit.ActionRetry, reason)
 			}
 			return nil
 		},
 	}
+	o.AddReasonFlag(cmd, &reason)
 	return cmd
 }
 
@@ -62,7 +62,7 @@ func TestRetryRolloutCmd(t *testing.T) {
 	ro := v1alpha1.Rollout{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "guestbook",
-			Namespace: "test",
+			Namespace: metav1.NamespaceDefault,
 		},
 	}
 
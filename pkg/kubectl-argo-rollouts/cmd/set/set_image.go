@@ -13,6 +13,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
@@ -27,6 +28,7 @@ const (
 
 // NewCmdSetImage returns a new instance of an `rollouts set image` command
 func NewCmdSetImage(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var reason string
 	var cmd = &cobra.Command{
 		Use:          "image ROLLOUT_NAME CONTAINER=IMAGE",
 		Short:        "Update the image of a rollout",
@@ -55,9 +57,11 @@ func NewCmdSetImage(o *options.ArgoRolloutsOptions) *cobra.Command {
 				break
 			}
 			fmt.Fprintf(o.Out, "rollout \"%s\" image updated\n", rollout)
+			o.RecordAction(o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(o.Namespace()), rollout, audit.ActionSetImage, reason)
 			return nil
 		},
 	}
+	o.AddReasonFlag(cmd, &reason)
 	return cmd
 }
 
@@ -17,6 +17,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 	routils "github.com/argoproj/argo-rollouts/utils/unstructured"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -39,6 +40,7 @@ const (
 func NewCmdUndo(o *options.ArgoRolloutsOptions) *cobra.Command {
 	var (
 		toRevision = int64(0)
+		reason     string
 	)
 	var cmd = &cobra.Command{
 		Use:          "undo ROLLOUT_NAME",
@@ -58,10 +60,12 @@ func NewCmdUndo(o *options.ArgoRolloutsOptions) *cobra.Command {
 				return err
 			}
 			fmt.Fprintf(o.Out, result)
+			o.RecordAction(o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(o.Namespace()), name, audit.ActionUndo, reason)
 			return nil
 		},
 	}
 	cmd.Flags().Int64Var(&toRevision, "to-revision", toRevision, "The revision to rollback to. Default to 0 (last revision).")
+	o.AddReasonFlag(cmd, &reason)
 	return cmd
 }
 
@@ -47,6 +47,9 @@ func NewRolloutInfo(
 				steps = append(steps, &ro.Spec.Strategy.Canary.Steps[i])
 			}
 			roInfo.Steps = steps
+			for i := range ro.Status.Canary.SkippedSteps {
+				roInfo.SkippedSteps = append(roInfo.SkippedSteps, &ro.Status.Canary.SkippedSteps[i])
+			}
 		}
 		// NOTE that this is desired weight, not the actual current weight
 		roInfo.SetWeight = strconv.Itoa(int(replicasetutil.GetCurrentSetWeight(ro)))
@@ -1,6 +1,7 @@
 package options
 
 import (
+	smifake "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -59,6 +60,12 @@ func NewFakeArgoRolloutsOptions(obj ...runtime.Object) (*cmdtesting.TestFactory,
 				APIVersion: "argoproj.io/v1alpha1",
 			}
 			rolloutObjs = append(rolloutObjs, o)
+		case *v1alpha1.RolloutRevision:
+			typedO.TypeMeta = metav1.TypeMeta{
+				Kind:       "RolloutRevision",
+				APIVersion: "argoproj.io/v1alpha1",
+			}
+			rolloutObjs = append(rolloutObjs, o)
 		default:
 			kubeObjs = append(kubeObjs, o)
 		}
@@ -72,5 +79,6 @@ func NewFakeArgoRolloutsOptions(obj ...runtime.Object) (*cmdtesting.TestFactory,
 		panic(err)
 	}
 	o.DynamicClient = dynamicfake.NewSimpleDynamicClient(scheme.Scheme, allObjs...)
+	o.SmiClient = smifake.NewSimpleClientset()
 	return tf, o
 }
@@ -1,12 +1,14 @@
 package options
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"strconv"
 	"strings"
 
+	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,6 +18,8 @@ import (
 	"k8s.io/klog/v2"
 
 	roclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 )
 
 const (
@@ -33,6 +37,7 @@ type ArgoRolloutsOptions struct {
 	RolloutsClient   roclientset.Interface
 	KubeClient       kubernetes.Interface
 	DynamicClient    dynamic.Interface
+	SmiClient        smiclientset.Interface
 
 	Log *log.Logger
 	genericclioptions.IOStreams
@@ -150,6 +155,23 @@ func (o *ArgoRolloutsOptions) DynamicClientset() dynamic.Interface {
 	return o.DynamicClient
 }
 
+// SmiClientset returns a SMI client interface based on client flags
+func (o *ArgoRolloutsOptions) SmiClientset() smiclientset.Interface {
+	if o.SmiClient != nil {
+		return o.SmiClient
+	}
+	config, err := o.RESTClientGetter.ToRESTConfig()
+	if err != nil {
+		panic(err)
+	}
+	smiClient, err := smiclientset.NewForConfig(config)
+	if err != nil {
+		panic(err)
+	}
+	o.SmiClient = smiClient
+	return o.SmiClient
+}
+
 // Namespace returns the namespace based on client flags or kube context
 func (o *ArgoRolloutsOptions) Namespace() string {
 	namespace, _, err := o.RESTClientGetter.ToRawKubeConfigLoader().Namespace()
@@ -158,3 +180,52 @@ func (o *ArgoRolloutsOptions) Namespace() string {
 	}
 	return namespace
 }
+
+// CurrentUser returns the user of the kube context based on client flags, which is recorded when
+// manual actions are performed. Returns an empty string if the user cannot be determined.
+func (o *ArgoRolloutsOptions) CurrentUser() string {
+	if o.ConfigFlags != nil && o.ConfigFlags.AuthInfoName != nil && *o.ConfigFlags.AuthInfoName != "" {
+		return *o.ConfigFlags.AuthInfoName
+	}
+	rawConfig, err := o.RESTClientGetter.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return ""
+	}
+	contextName := rawConfig.CurrentContext
+	if o.ConfigFlags != nil && o.ConfigFlags.Context != nil && *o.ConfigFlags.Context != "" {
+		contextName = *o.ConfigFlags.Context
+	}
+	if kubeContext, ok := rawConfig.Contexts[contextName]; ok {
+		return kubeContext.AuthInfo
+	}
+	return ""
+}
+
+// AddReasonFlag adds the flag of the reason for a manual action, which is recorded in the audit log
+// of the rollout
+func (o *ArgoRolloutsOptions) AddReasonFlag(cmd *cobra.Command, reason *string) {
+	cmd.Flags().StringVar(reason, "reason", "", "Reason for the action, which is recorded in the audit log of the rollout")
+}
+
+// RecordAction records a manual action on a rollout, along with the current user, in the audit log
+// of the rollout. Failures are only reported as warnings since the action was already performed.
+func (o *ArgoRolloutsOptions) RecordAction(rolloutIf clientset.RolloutInterface, name string, action audit.Action, reason string) {
+	err := audit.RecordAction(context.TODO(), rolloutIf, o.KubeClientset(), name, action, o.CurrentUser(), reason)
+	if err != nil {
+		fmt.Fprintf(o.ErrOut, "Warning: failed to record the action in the audit log of rollout '%s': %v\n", name, err)
+	}
+}
+
+// RecordAndPerformAction records a manual action on a rollout in its audit log before performing it,
+// so that the controller knows who requested the action once it acts on it. A failure to record the
+// action is only reported as a warning if the action succeeds.
+func (o *ArgoRolloutsOptions) RecordAndPerformAction(rolloutIf clientset.RolloutInterface, name string, action audit.Action, reason string, perform func() error) error {
+	recordErr := audit.RecordAction(context.TODO(), rolloutIf, o.KubeClientset(), name, action, o.CurrentUser(), reason)
+	if err := perform(); err != nil {
+		return err
+	}
+	if recordErr != nil {
+		fmt.Fprintf(o.ErrOut, "Warning: failed to record the action in the audit log of rollout '%s': %v\n", name, recordErr)
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
@@ -109,6 +110,11 @@ func (c *rolloutContext) reconcileAnalysisRuns() error {
 		c.setPauseOrAbort(postPromotionAr)
 		newCurrentAnalysisRuns.BlueGreenPostPromotion = postPromotionAr
 	}
+	if getPauseCondition(c.rollout, v1alpha1.PauseReasonInconclusiveAnalysis) != nil && judgedInconclusiveAnalysis(newCurrentAnalysisRuns) {
+		// the rollout continues or aborts based on the judged runs in the next reconciliation
+		c.log.Info("Inconclusive analysis has been judged, removing pause")
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
+	}
 	c.SetCurrentAnalysisRuns(newCurrentAnalysisRuns)
 
 	// Due to the possibility that we are operating on stale/inconsistent data in the informer, it's
@@ -149,8 +155,23 @@ func (c *rolloutContext) setPauseOrAbort(ar *v1alpha1.AnalysisRun) {
 	case v1alpha1.AnalysisPhaseInconclusive:
 		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
 	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
-		c.pauseContext.AddAbort(ar.Status.Message)
+		c.pauseContext.AddAbort(conditions.RolloutAnalysisRunFailedReason, ar.Status.Message)
+	}
+}
+
+// judgedInconclusiveAnalysis returns true if none of the current analysis runs are inconclusive
+// and at least one of them concluded from a manual judgment
+func judgedInconclusiveAnalysis(currentArs analysisutil.CurrentAnalysisRuns) bool {
+	judged := false
+	for _, ar := range currentArs.ToArray() {
+		if ar.Status.Phase == v1alpha1.AnalysisPhaseInconclusive {
+			return false
+		}
+		if ar.Spec.Judgment != nil && ar.Status.Phase.Completed() {
+			judged = true
+		}
 	}
+	return judged
 }
 
 func needsNewAnalysisRun(currentAr *v1alpha1.AnalysisRun, rollout *v1alpha1.Rollout) bool {
@@ -336,7 +357,7 @@ func (c *rolloutContext) reconcileBackgroundAnalysisRun() (*v1alpha1.AnalysisRun
 	case v1alpha1.AnalysisPhaseInconclusive:
 		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
 	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
-		c.pauseContext.AddAbort(currentAr.Status.Message)
+		c.pauseContext.AddAbort(conditions.RolloutAnalysisRunFailedReason, currentAr.Status.Message)
 	}
 	return currentAr, nil
 }
@@ -383,14 +404,14 @@ func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun,
 	case v1alpha1.AnalysisPhaseInconclusive:
 		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
 	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
-		c.pauseContext.AddAbort(currentAr.Status.Message)
+		c.pauseContext.AddAbort(conditions.RolloutAnalysisRunFailedReason, currentAr.Status.Message)
 	}
 
 	return currentAr, nil
 }
 
 func (c *rolloutContext) cancelAnalysisRuns(analysisRuns []*v1alpha1.AnalysisRun) error {
-	ctx := context.TODO()
+	ctx := c.ctx
 	for i := range analysisRuns {
 		ar := analysisRuns[i]
 		isNotCompleted := ar == nil || !ar.Status.Phase.Completed()
@@ -443,7 +464,7 @@ func (c *rolloutContext) newAnalysisRunFromRollout(rolloutAnalysis *v1alpha1.Rol
 		}
 
 	}
-	run, err = analysisutil.NewAnalysisRunFromTemplates(templates, clusterTemplates, args, name, "", c.rollout.Namespace)
+	run, err = analysisutil.NewAnalysisRunFromTemplates(templates, clusterTemplates, analysisutil.NewTemplateGetter(c.analysisTemplateLister, c.clusterAnalysisTemplateLister), args, name, "", c.rollout.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -456,7 +477,7 @@ func (c *rolloutContext) newAnalysisRunFromRollout(rolloutAnalysis *v1alpha1.Rol
 }
 
 func (c *rolloutContext) deleteAnalysisRuns(ars []*v1alpha1.AnalysisRun) error {
-	ctx := context.TODO()
+	ctx := c.ctx
 	for i := range ars {
 		ar := ars[i]
 		if ar.DeletionTimestamp != nil {
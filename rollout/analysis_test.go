@@ -2282,3 +2282,150 @@ func TestAbortRolloutOnErrorPostPromotionAnalysis(t *testing.T) {
 	newConditions := updateConditionsPatch(*r2, progressingFalseAborted)
 	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, now, newConditions, conditions.RolloutAbortedReason, progressingFalseAborted.Message)), patch)
 }
+
+func TestJudgedInconclusiveAnalysis(t *testing.T) {
+	judged := &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Judgment: &v1alpha1.AnalysisRunJudgment{Phase: v1alpha1.AnalysisPhaseSuccessful},
+		},
+		Status: v1alpha1.AnalysisRunStatus{Phase: v1alpha1.AnalysisPhaseSuccessful},
+	}
+	pendingJudgment := judged.DeepCopy()
+	pendingJudgment.Status.Phase = v1alpha1.AnalysisPhaseInconclusive
+	inconclusive := &v1alpha1.AnalysisRun{
+		Status: v1alpha1.AnalysisRunStatus{Phase: v1alpha1.AnalysisPhaseInconclusive},
+	}
+
+	assert.True(t, judgedInconclusiveAnalysis(analysisutil.CurrentAnalysisRuns{CanaryStep: judged}))
+	assert.False(t, judgedInconclusiveAnalysis(analysisutil.CurrentAnalysisRuns{CanaryStep: pendingJudgment}))
+	assert.False(t, judgedInconclusiveAnalysis(analysisutil.CurrentAnalysisRuns{CanaryStep: judged, CanaryBackground: inconclusive}))
+	assert.False(t, judgedInconclusiveAnalysis(analysisutil.CurrentAnalysisRuns{}))
+}
+
+// newJudgedStepAnalysisRollout returns a rollout with a step analysis run which was judged with the
+// given phase. If paused, the rollout is still paused on the run, which was inconclusive before the
+// judgment. Otherwise the pause was removed in a previous reconciliation.
+func newJudgedStepAnalysisRollout(f *fixture, phase v1alpha1.AnalysisPhase, paused bool) *v1alpha1.Rollout {
+	at := analysisTemplate("bar")
+	steps := []v1alpha1.CanaryStep{{
+		Analysis: &v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.RolloutAnalysisTemplate{
+				{
+					TemplateName: at.Name,
+				},
+			},
+		},
+	}}
+
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(0), intstr.FromInt(1))
+	r2 := bumpVersion(r1)
+	ar := analysisRun(at, v1alpha1.RolloutTypeStepLabel, r2)
+	ar.Spec.Judgment = &v1alpha1.AnalysisRunJudgment{
+		Phase: phase,
+		User:  "jane",
+	}
+	ar.Status = v1alpha1.AnalysisRunStatus{
+		Phase:   phase,
+		Message: fmt.Sprintf("analysis judged %s by jane", phase),
+	}
+
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs2 := newReplicaSetWithStatus(r2, 0, 0)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 1, 0, 1, paused)
+	r2.Status.Canary.CurrentStepAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   ar.Name,
+		Status: phase,
+	}
+	if paused {
+		r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+			Reason:    v1alpha1.PauseReasonInconclusiveAnalysis,
+			StartTime: metav1.Now(),
+		}}
+		r2.Status.ControllerPause = true
+		r2.Status.Canary.CurrentStepAnalysisRunStatus.Status = v1alpha1.AnalysisPhaseInconclusive
+		r2.Status.ObservedGeneration = strconv.Itoa(int(r2.Generation))
+		progressingCondition, _ := newProgressingCondition(conditions.RolloutPausedReason, r2, "")
+		conditions.SetRolloutCondition(&r2.Status, progressingCondition)
+		pausedCondition, _ := newPausedCondition(true)
+		conditions.SetRolloutCondition(&r2.Status, pausedCondition)
+		availableCondition, _ := newAvailableCondition(true)
+		conditions.SetRolloutCondition(&r2.Status, availableCondition)
+	}
+
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+	f.objects = append(f.objects, r2, at, ar)
+	return r2
+}
+
+// patchedStatus returns the status fields of a rollout patch
+func patchedStatus(t *testing.T, patch string) map[string]interface{} {
+	var obj struct {
+		Status map[string]interface{} `json:"status"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(patch), &obj))
+	return obj.Status
+}
+
+func TestPromoteAfterSuccessfulJudgment(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r2 := newJudgedStepAnalysisRollout(f, v1alpha1.AnalysisPhaseSuccessful, true)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+	status := patchedStatus(t, f.getPatchedRollout(patchIndex))
+	// the pause is removed and the rollout continues with the next step
+	assert.Contains(t, status, "pauseConditions")
+	assert.Nil(t, status["pauseConditions"])
+	assert.Nil(t, status["controllerPause"])
+	assert.Equal(t, float64(1), status["currentStepIndex"])
+	assert.Equal(t, map[string]interface{}{"currentStepAnalysisRunStatus": nil}, status["canary"])
+	assert.Equal(t, string(v1alpha1.RolloutPhaseProgressing), status["phase"])
+	assert.NotContains(t, status, "abort")
+}
+
+func TestAbortAfterFailedJudgment(t *testing.T) {
+	t.Run("RemovePause", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+
+		r2 := newJudgedStepAnalysisRollout(f, v1alpha1.AnalysisPhaseFailed, true)
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+		status := patchedStatus(t, f.getPatchedRollout(patchIndex))
+		// the pause is removed and the failed run is recorded, which aborts the rollout in the
+		// next reconciliation
+		assert.Contains(t, status, "pauseConditions")
+		assert.Nil(t, status["pauseConditions"])
+		assert.Nil(t, status["controllerPause"])
+		assert.Equal(t, map[string]interface{}{
+			"currentStepAnalysisRunStatus": map[string]interface{}{
+				"status":  string(v1alpha1.AnalysisPhaseFailed),
+				"message": "analysis judged Failed by jane",
+			},
+		}, status["canary"])
+		assert.NotContains(t, status, "currentStepIndex")
+	})
+	t.Run("Abort", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+
+		r2 := newJudgedStepAnalysisRollout(f, v1alpha1.AnalysisPhaseFailed, false)
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+		status := patchedStatus(t, f.getPatchedRollout(patchIndex))
+		assert.Equal(t, true, status["abort"])
+		assert.Equal(t, string(v1alpha1.RolloutPhaseDegraded), status["phase"])
+		assert.Equal(t, "RolloutAborted: Rollout aborted update to revision 2: analysis judged Failed by jane", status["message"])
+		assert.NotContains(t, status, "currentStepIndex")
+	})
+}
@@ -0,0 +1,211 @@
+package rollout
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/approval"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+// defaultApprovalRetryInterval is how long a failed approval request is retried after if the approval
+// step does not set a poll interval
+const defaultApprovalRetryInterval = 30 * time.Second
+
+// reconcileCanaryApproval requests the approval of the current approval step from its endpoint and
+// pauses the rollout until the step is answered, either by the endpoint or by an answer recorded on
+// the rollout status. A denied step aborts the rollout. Returns true while the step is not approved.
+func (c *rolloutContext) reconcileCanaryApproval(step v1alpha1.RolloutApprovalStep, stepIndex int32) bool {
+	if c.pauseContext.IsAborted() {
+		return false
+	}
+	cond := getPauseCondition(c.rollout, v1alpha1.PauseReasonCanaryApprovalStep)
+	if c.pauseContext.IsControllerPaused() && cond == nil {
+		// the rollout was promoted while waiting for the answer
+		return false
+	}
+
+	status := c.currentApprovalStatus(stepIndex)
+	now := metav1.Now()
+	if status.Phase == v1alpha1.ApprovalPhasePending {
+		c.requestApproval(step, status, now)
+	}
+	if status.Phase == v1alpha1.ApprovalPhasePending && step.Timeout != "" && status.RequestedAt != nil {
+		timeout, err := step.Timeout.Duration()
+		if err == nil && now.Sub(status.RequestedAt.Time) >= timeout {
+			status.Phase = v1alpha1.ApprovalPhaseDenied
+			status.Message = fmt.Sprintf("no answer received within %s", step.Timeout)
+		}
+	}
+	c.newStatus.Canary.Approval = status
+
+	stepCount := len(c.rollout.Spec.Strategy.Canary.Steps)
+	switch status.Phase {
+	case v1alpha1.ApprovalPhaseApproved:
+		c.log.Infof("Step %d was approved", stepIndex)
+		return false
+	case v1alpha1.ApprovalPhaseDenied:
+		c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutApprovalDeniedReason}, conditions.RolloutApprovalDeniedMessage, stepIndex+1, stepCount, status.Message)
+		c.pauseContext.AddAbort(conditions.RolloutApprovalDeniedReason, fmt.Sprintf(conditions.RolloutApprovalDeniedMessage, stepIndex+1, stepCount, status.Message))
+		return true
+	}
+
+	if cond == nil {
+		if !c.pauseContext.IsControllerPaused() {
+			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonCanaryApprovalStep)
+		}
+		return true
+	}
+	if interval, ok := approvalRequestInterval(step, status); ok && status.LastRequestedAt != nil {
+		c.checkEnqueueRolloutDuringWait(*status.LastRequestedAt, durationSeconds(interval))
+	}
+	if timeout, err := step.Timeout.Duration(); err == nil && status.RequestedAt != nil {
+		c.checkEnqueueRolloutDuringWait(*status.RequestedAt, durationSeconds(timeout))
+	}
+	return true
+}
+
+// currentApprovalStatus returns a copy of the approval status of the step, or a pending status if
+// the approval of the step was not requested yet
+func (c *rolloutContext) currentApprovalStatus(stepIndex int32) *v1alpha1.RolloutApprovalStatus {
+	status := c.rollout.Status.Canary.Approval
+	if status == nil || status.StepIndex != stepIndex {
+		return &v1alpha1.RolloutApprovalStatus{
+			StepIndex: stepIndex,
+			Phase:     v1alpha1.ApprovalPhasePending,
+		}
+	}
+	status = status.DeepCopy()
+	if status.Phase == "" {
+		status.Phase = v1alpha1.ApprovalPhasePending
+	}
+	return status
+}
+
+// requestApproval sends the approval request of the step in the background if it was not sent yet or
+// if it is due again, and records the answer in the status once it was received. Failed requests leave
+// the step pending and are retried.
+func (c *rolloutContext) requestApproval(step v1alpha1.RolloutApprovalStep, status *v1alpha1.RolloutApprovalStatus, now metav1.Time) {
+	req := approval.Request{
+		Rollout:   c.rollout.Name,
+		Namespace: c.rollout.Namespace,
+		UID:       string(c.rollout.UID),
+		Revision:  c.rollout.Annotations[annotations.RevisionAnnotation],
+		StepIndex: status.StepIndex,
+	}
+	if c.newRS != nil {
+		req.PodTemplateHash = c.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	}
+	// the answers are keyed by the revision and step, so that an answer is never applied to another step
+	key := fmt.Sprintf("%s/%s/%s/%d", req.Namespace, req.Rollout, req.PodTemplateHash, req.StepIndex)
+
+	if approvalRequestDue(step, status, now) {
+		if status.RequestedAt == nil {
+			status.RequestedAt = &now
+			stepCount := len(c.rollout.Spec.Strategy.Canary.Steps)
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutApprovalRequestedReason}, conditions.RolloutApprovalRequestedMessage, status.StepIndex+1, stepCount, step.URL)
+		}
+		status.LastRequestedAt = &now
+		headers, err := c.approvalHeaders(step)
+		if err != nil {
+			c.log.Warnf("Unable to request approval of step %d: %v", status.StepIndex, err)
+			status.Error = err.Error()
+			return
+		}
+		ro := c.rollout
+		c.approvalSender.Send(key, step.URL, headers, req, func() {
+			c.enqueueRollout(ro)
+		})
+	}
+
+	answer, ok := c.approvalSender.Answer(key)
+	if !ok {
+		return
+	}
+	if answer.Err != nil {
+		c.log.Warnf("Unable to request approval of step %d: %v", status.StepIndex, answer.Err)
+		status.Error = answer.Err.Error()
+		return
+	}
+	status.Error = ""
+	status.Phase = answer.Phase
+	status.Message = answer.Message
+}
+
+// approvalRequestDue returns whether the approval request of the step should be sent
+func approvalRequestDue(step v1alpha1.RolloutApprovalStep, status *v1alpha1.RolloutApprovalStatus, now metav1.Time) bool {
+	if status.LastRequestedAt == nil {
+		return true
+	}
+	interval, ok := approvalRequestInterval(step, status)
+	return ok && now.Sub(status.LastRequestedAt.Time) >= interval
+}
+
+// approvalRequestInterval returns the interval after which the approval request is sent again. The
+// request is resent every poll interval, and a failed request is retried even without a poll interval.
+func approvalRequestInterval(step v1alpha1.RolloutApprovalStep, status *v1alpha1.RolloutApprovalStatus) (time.Duration, bool) {
+	if step.PollInterval != "" {
+		pollInterval, err := step.PollInterval.Duration()
+		return pollInterval, err == nil
+	}
+	if status.Error != "" {
+		return defaultApprovalRetryInterval, true
+	}
+	return 0, false
+}
+
+// approvalHeaders returns the headers of the approval requests of the step, with the values of the
+// headers which reference a Secret read from the namespace of the rollout. Secrets are only read for
+// urls which match the allowed url prefixes.
+func (c *rolloutContext) approvalHeaders(step v1alpha1.RolloutApprovalStep) (map[string]string, error) {
+	headers := map[string]string{}
+	for _, header := range step.Headers {
+		if header.ValueFrom == nil || header.ValueFrom.SecretKeyRef == nil {
+			headers[header.Key] = header.Value
+			continue
+		}
+		if err := approval.CheckSecretHeaders(); err != nil {
+			return nil, err
+		}
+		if err := approval.CheckURL(step.URL); err != nil {
+			return nil, err
+		}
+		ref := header.ValueFrom.SecretKeyRef
+		secret, err := c.secretsLister.Secrets(c.rollout.Namespace).Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("key '%s' does not exist in secret '%s'", ref.Key, ref.Name)
+		}
+		headers[header.Key] = string(value)
+	}
+	return headers, nil
+}
+
+// completedCanaryApprovalStep returns true if the current approval step was approved or if the
+// rollout was promoted while waiting for the answer
+func (c *rolloutContext) completedCanaryApprovalStep() bool {
+	if c.pauseContext.IsControllerPaused() && getPauseCondition(c.rollout, v1alpha1.PauseReasonCanaryApprovalStep) == nil {
+		c.log.Info("Rollout has been unpaused")
+		return true
+	}
+	_, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	status := c.newStatus.Canary.Approval
+	if status == nil {
+		status = c.rollout.Status.Canary.Approval
+	}
+	return status != nil && status.StepIndex == *currentStepIndex && status.Phase == v1alpha1.ApprovalPhaseApproved
+}
+
+func durationSeconds(d time.Duration) int32 {
+	return int32(math.Ceil(d.Seconds()))
+}
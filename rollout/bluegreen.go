@@ -163,7 +163,7 @@ func (c *rolloutContext) reconcileBlueGreenPause(activeSvc, previewSvc *corev1.S
 		return
 	}
 	pauseCond := getPauseCondition(c.rollout, v1alpha1.PauseReasonBlueGreenPause)
-	if pauseCond == nil && !c.rollout.Status.ControllerPause {
+	if pauseCond == nil && !c.pauseContext.IsControllerPaused() {
 		if pauseCond == nil {
 			c.log.Info("pausing")
 		}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	core "k8s.io/client-go/testing"
@@ -1325,6 +1326,77 @@ func TestBlueGreenAbort(t *testing.T) {
 	assert.Equal(t, calculatePatch(r2, expectedPatch), patch)
 }
 
+// TestBlueGreenAbortScaleDownDelay verifies the preview ReplicaSet is scaled down after abortScaleDownDelaySeconds
+func TestBlueGreenAbortScaleDownDelay(t *testing.T) {
+	newAbortedFixture := func(t *testing.T, scaleDownAt *metav1.Time) (*fixture, *v1alpha1.Rollout, *appsv1.ReplicaSet, *corev1.Service) {
+		f := newFixture(t)
+		r1 := newBlueGreenRollout("foo", 1, nil, "bar", "")
+		r1.Spec.Strategy.BlueGreen.AbortScaleDownDelaySeconds = pointer.Int32Ptr(30)
+		r2 := bumpVersion(r1)
+		r2.Status.Abort = true
+		now := metav1.Now()
+		r2.Status.AbortedAt = &now
+
+		rs1 := newReplicaSetWithStatus(r1, 1, 1)
+		rs2 := newReplicaSetWithStatus(r2, 1, 1)
+		if scaleDownAt != nil {
+			rs2.Annotations[v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey] = scaleDownAt.UTC().Format(time.RFC3339)
+		}
+		rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+		rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+		serviceSelector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash}
+		s := newService("bar", 80, serviceSelector, r2)
+		f.kubeobjects = append(f.kubeobjects, s, rs1, rs2)
+		f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+		r2 = updateBlueGreenRolloutStatus(r2, "", rs2PodHash, rs1PodHash, 1, 1, 2, 1, false, true)
+		f.rolloutLister = append(f.rolloutLister, r2)
+		f.objects = append(f.objects, r2)
+		f.serviceLister = append(f.serviceLister, s)
+		return f, r2, rs2, s
+	}
+
+	t.Run("Add scale-down deadline to preview ReplicaSet", func(t *testing.T) {
+		f, r2, rs2, s := newAbortedFixture(t, nil)
+		defer f.Close()
+		rs1PodHash := r2.Status.StableRS
+
+		rsPatchIndex := f.expectPatchReplicaSetAction(rs2)
+		f.expectPatchServiceAction(s, rs1PodHash)
+		f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		f.verifyPatchedReplicaSet(rsPatchIndex, 30)
+	})
+
+	t.Run("Keep preview ReplicaSet before scale-down deadline", func(t *testing.T) {
+		scaleDownAt := metav1.NewTime(metav1.Now().Add(time.Minute))
+		f, r2, _, s := newAbortedFixture(t, &scaleDownAt)
+		defer f.Close()
+		rs1PodHash := r2.Status.StableRS
+
+		f.expectPatchServiceAction(s, rs1PodHash)
+		f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+	})
+
+	t.Run("Scale down preview ReplicaSet after scale-down deadline", func(t *testing.T) {
+		scaleDownAt := metav1.NewTime(metav1.Now().Add(-time.Minute))
+		f, r2, rs2, s := newAbortedFixture(t, &scaleDownAt)
+		defer f.Close()
+		rs1PodHash := r2.Status.StableRS
+
+		rsIndex := f.expectUpdateReplicaSetAction(rs2)
+		f.expectPatchServiceAction(s, rs1PodHash)
+		f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		updatedRS := f.getUpdatedReplicaSet(rsIndex)
+		assert.Equal(t, int32(0), *updatedRS.Spec.Replicas)
+	})
+}
+
 func TestBlueGreenHandlePauseAutoPromoteWithConditions(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
@@ -1395,3 +1467,93 @@ func TestBlueGreenHandlePauseAutoPromoteWithConditions(t *testing.T) {
 	rolloutPatch := f.getPatchedRollout(patchRolloutIndex)
 	assert.Equal(t, expectedPatch, rolloutPatch)
 }
+
+func TestBlueGreenAdditionalServices(t *testing.T) {
+	t.Run("SwitchAdditionalPreviewServices", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+
+		r := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+		r.Spec.Strategy.BlueGreen.AdditionalPreviewServices = []string{"preview-grpc"}
+		f.rolloutLister = append(f.rolloutLister, r)
+		f.objects = append(f.objects, r)
+
+		rs := newReplicaSetWithStatus(r, 1, 1)
+		rsPodHash := rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+		f.kubeobjects = append(f.kubeobjects, rs)
+		f.replicaSetLister = append(f.replicaSetLister, rs)
+
+		previewSvc := newService("preview", 80, nil, r)
+		previewGrpcSvc := newService("preview-grpc", 9090, map[string]string{"foo": "bar"}, r)
+		selector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rsPodHash}
+		activeSvc := newService("active", 80, selector, r)
+		f.kubeobjects = append(f.kubeobjects, previewSvc, previewGrpcSvc, activeSvc)
+		f.serviceLister = append(f.serviceLister, previewSvc, previewGrpcSvc, activeSvc)
+
+		servicePatch := f.expectPatchServiceAction(previewSvc, rsPodHash)
+		additionalServicePatch := f.expectPatchServiceAction(previewGrpcSvc, rsPodHash)
+		f.expectPatchRolloutAction(r)
+		f.run(getKey(r, t))
+
+		f.verifyPatchedService(servicePatch, rsPodHash, "")
+		f.verifyPatchedService(additionalServicePatch, rsPodHash, "")
+	})
+
+	t.Run("SwitchAdditionalActiveServices", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+
+		r1 := newBlueGreenRollout("foo", 1, nil, "active", "")
+		r1.Spec.Strategy.BlueGreen.AdditionalActiveServices = []string{"active-grpc"}
+		r2 := bumpVersion(r1)
+
+		rs1 := newReplicaSetWithStatus(r1, 1, 1)
+		rs2 := newReplicaSetWithStatus(r2, 1, 1)
+		rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+		rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+		r2 = updateBlueGreenRolloutStatus(r2, "", rs1PodHash, rs1PodHash, 1, 1, 2, 1, false, true)
+		progressingCondition, _ := newProgressingCondition(conditions.NewReplicaSetReason, rs2, "")
+		conditions.SetRolloutCondition(&r2.Status, progressingCondition)
+		activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}, r2)
+		activeGrpcSvc := newService("active-grpc", 9090, map[string]string{"foo": "bar", v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}, r2)
+
+		f.objects = append(f.objects, r2)
+		f.kubeobjects = append(f.kubeobjects, activeSvc, activeGrpcSvc, rs1, rs2)
+		f.rolloutLister = append(f.rolloutLister, r2)
+		f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+		f.serviceLister = append(f.serviceLister, activeSvc, activeGrpcSvc)
+
+		servicePatchIndex := f.expectPatchServiceAction(activeSvc, rs2PodHash)
+		additionalServicePatchIndex := f.expectPatchServiceAction(activeGrpcSvc, rs2PodHash)
+		f.expectPatchReplicaSetAction(rs1)
+		f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		f.verifyPatchedService(servicePatchIndex, rs2PodHash, "")
+		f.verifyPatchedService(additionalServicePatchIndex, rs2PodHash, "")
+	})
+
+	t.Run("AdditionalServiceWithMismatchingSelector", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+
+		r := newBlueGreenRollout("foo", 1, nil, "active", "")
+		r.Spec.Strategy.BlueGreen.AdditionalActiveServices = []string{"active-grpc"}
+		f.rolloutLister = append(f.rolloutLister, r)
+		f.objects = append(f.objects, r)
+
+		activeSvc := newService("active", 80, nil, r)
+		activeGrpcSvc := newService("active-grpc", 9090, map[string]string{"app": "other"}, r)
+		f.kubeobjects = append(f.kubeobjects, activeSvc, activeGrpcSvc)
+		f.serviceLister = append(f.serviceLister, activeSvc, activeGrpcSvc)
+
+		patchIndex := f.expectPatchRolloutAction(r)
+		f.run(getKey(r, t))
+
+		patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+		condition := conditions.GetRolloutCondition(patchedRollout.Status, v1alpha1.InvalidSpec)
+		assert.NotNil(t, condition)
+		assert.Contains(t, condition.Message, `Service "active-grpc" selector does not match the labels of the pod template`)
+	})
+}
@@ -1,7 +1,10 @@
 package rollout
 
 import (
+	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -11,9 +14,11 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	"github.com/argoproj/argo-rollouts/utils/timewindow"
 )
 
 func (c *rolloutContext) rolloutCanary() error {
@@ -116,6 +121,14 @@ func (c *rolloutContext) reconcileCanaryPause() bool {
 		return false
 	}
 
+	if currentStep.TimeWindow != nil {
+		c.log.Infof("Reconciling canary time window step (stepIndex: %d/%d)", *currentStepIndex, totalSteps)
+		return c.reconcileCanaryTimeWindow(*currentStep.TimeWindow)
+	}
+	if currentStep.Approval != nil {
+		c.log.Infof("Reconciling canary approval step (stepIndex: %d/%d)", *currentStepIndex, totalSteps)
+		return c.reconcileCanaryApproval(*currentStep.Approval, *currentStepIndex)
+	}
 	if currentStep.Pause == nil {
 		return false
 	}
@@ -127,7 +140,7 @@ func (c *rolloutContext) reconcileCanaryPause() bool {
 		// rollout needs to be paused for the first time. If the ControllerPause is false,
 		// the controller has not paused the rollout yet and needs to do so before it
 		// can proceed.
-		if !c.rollout.Status.ControllerPause {
+		if !c.pauseContext.IsControllerPaused() {
 			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonCanaryPauseStep)
 		}
 		return true
@@ -139,6 +152,107 @@ func (c *rolloutContext) reconcileCanaryPause() bool {
 	return true
 }
 
+// reconcileCanaryTimeWindow pauses the rollout while the time window of the current step is closed
+// and requeues the rollout for when the window opens
+func (c *rolloutContext) reconcileCanaryTimeWindow(window v1alpha1.RolloutTimeWindow) bool {
+	now := time.Now()
+	opensAt, err := timewindow.NextOpen(window, now)
+	if err != nil {
+		c.log.Warnf("Unable to evaluate time window: %v", err)
+		return true
+	}
+	if !opensAt.After(now) {
+		return false
+	}
+	cond := getPauseCondition(c.rollout, v1alpha1.PauseReasonCanaryPauseStep)
+	if cond == nil {
+		if !c.pauseContext.IsControllerPaused() {
+			c.log.Infof("Pausing until the time window opens at %s", opensAt.UTC().Format(time.RFC3339))
+			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonCanaryPauseStep)
+		}
+		return true
+	}
+	waitSeconds := int32(math.Ceil(opensAt.Sub(now).Seconds()))
+	c.checkEnqueueRolloutDuringWait(metav1.NewTime(now), waitSeconds)
+	return true
+}
+
+// skipCanarySteps advances the step index past the steps, starting at the step which was just
+// reached, whose when expression evaluates to false. The skipped steps are recorded in the status.
+func (c *rolloutContext) skipCanarySteps(newStatus *v1alpha1.RolloutStatus, currentStepIndex *int32) {
+	if currentStepIndex == nil {
+		return
+	}
+	steps := c.rollout.Spec.Strategy.Canary.Steps
+	var vars map[string]interface{}
+	for int(*currentStepIndex) < len(steps) {
+		step := steps[*currentStepIndex]
+		if step.When == "" {
+			return
+		}
+		if vars == nil {
+			vars = c.stepConditionVars(newStatus.CurrentPodHash)
+		}
+		run, err := evaluate.EvalStepCondition(step.When, vars)
+		if err != nil {
+			c.log.Warnf("Unable to evaluate when expression of step %d, running the step: %v", *currentStepIndex, err)
+			return
+		}
+		if run {
+			return
+		}
+		reason := fmt.Sprintf("when expression '%s' evaluated to false", step.When)
+		newStatus.Canary.SkippedSteps = append(newStatus.Canary.SkippedSteps, v1alpha1.SkippedCanaryStep{
+			Index:  *currentStepIndex,
+			Reason: reason,
+		})
+		*currentStepIndex++
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepSkippedReason}, conditions.RolloutStepSkippedMessage, int(*currentStepIndex), len(steps), reason)
+	}
+}
+
+// stepConditionVars returns the variables available to the when expressions of canary steps: the
+// metadata of the rollout, the literal args of the background analysis and the phases of the
+// background analysis run and of the step analysis runs of the current revision
+func (c *rolloutContext) stepConditionVars(podHash string) map[string]interface{} {
+	args := map[string]string{}
+	if c.rollout.Spec.Strategy.Canary.Analysis != nil {
+		for _, arg := range c.rollout.Spec.Strategy.Canary.Analysis.Args {
+			if arg.ValueFrom == nil {
+				args[arg.Name] = arg.Value
+			}
+		}
+	}
+	background := ""
+	if c.currentArs.CanaryBackground != nil {
+		background = string(c.currentArs.CanaryBackground.Status.Phase)
+	}
+	stepArs := map[int]string{}
+	for _, ar := range append(c.otherArs, c.currentArs.CanaryStep) {
+		if ar == nil || ar.Labels[v1alpha1.RolloutTypeLabel] != v1alpha1.RolloutTypeStepLabel || ar.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] != podHash {
+			continue
+		}
+		index, err := strconv.Atoi(ar.Labels[v1alpha1.RolloutCanaryStepIndexLabel])
+		if err != nil {
+			continue
+		}
+		stepArs[index] = string(ar.Status.Phase)
+	}
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":        c.rollout.Name,
+			"namespace":   c.rollout.Namespace,
+			"labels":      c.rollout.Labels,
+			"annotations": c.rollout.Annotations,
+		},
+		"args": args,
+		"analysis": map[string]interface{}{
+			"background": background,
+			"steps":      stepArs,
+		},
+	}
+}
+
 // scaleDownOldReplicaSetsForCanary scales down old replica sets when rollout strategy is "canary".
 func (c *rolloutContext) scaleDownOldReplicaSetsForCanary(oldRSs []*appsv1.ReplicaSet) (int32, error) {
 	// Clean up unhealthy replicas first, otherwise unhealthy replicas will block rollout
@@ -224,7 +338,7 @@ func (c *rolloutContext) scaleDownOldReplicaSetsForCanary(oldRSs []*appsv1.Repli
 }
 
 func (c *rolloutContext) completedCurrentCanaryStep() bool {
-	if c.rollout.Spec.Paused {
+	if c.rollout.Spec.Paused || c.pauseContext.IsFrozen() {
 		return false
 	}
 	currentStep, _ := replicasetutil.GetCurrentCanaryStep(c.rollout)
@@ -234,6 +348,10 @@ func (c *rolloutContext) completedCurrentCanaryStep() bool {
 	switch {
 	case currentStep.Pause != nil:
 		return c.pauseContext.CompletedCanaryPauseStep(*currentStep.Pause)
+	case currentStep.TimeWindow != nil:
+		return c.pauseContext.CompletedCanaryTimeWindowStep(*currentStep.TimeWindow)
+	case currentStep.Approval != nil:
+		return c.completedCanaryApprovalStep()
 	case currentStep.SetCanaryScale != nil:
 		return replicasetutil.AtDesiredReplicaCountsForCanary(c.rollout, c.newRS, c.stableRS, c.otherRSs)
 	case currentStep.SetWeight != nil:
@@ -275,6 +393,9 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 				newStatus.CurrentStepIndex = &stepCount
 			}
 		}
+		if !newStatus.PromoteFull {
+			c.skipCanarySteps(&newStatus, newStatus.CurrentStepIndex)
+		}
 		newStatus = c.calculateRolloutConditions(newStatus)
 		return c.persistRolloutStatus(&newStatus)
 	}
@@ -282,6 +403,7 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 	if c.rollout.Status.PromoteFull {
 		c.pauseContext.ClearPauseConditions()
 		c.pauseContext.RemoveAbort()
+		newStatus.Canary.Approval = nil
 		if stepCount > 0 {
 			currentStepIndex = &stepCount
 		}
@@ -297,6 +419,7 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 	}
 
 	if c.pauseContext.IsAborted() {
+		newStatus.Canary.Approval = nil
 		if stepCount > int32(0) {
 			if newStatus.StableRS == newStatus.CurrentPodHash {
 				newStatus.CurrentStepIndex = &stepCount
@@ -312,9 +435,12 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 		stepStr := rolloututil.CanaryStepString(*currentStep)
 		*currentStepIndex++
 		newStatus.Canary.CurrentStepAnalysisRunStatus = nil
+		newStatus.Canary.Approval = nil
 
 		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepCompletedReason}, conditions.RolloutStepCompletedMessage, int(*currentStepIndex), stepCount, stepStr)
 		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonCanaryPauseStep)
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonCanaryApprovalStep)
+		c.skipCanarySteps(&newStatus, currentStepIndex)
 	}
 
 	newStatus.CurrentStepIndex = currentStepIndex
@@ -21,6 +21,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/approval"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
@@ -336,6 +337,106 @@ func TestCanaryRolloutIncrementStepAfterUnPaused(t *testing.T) {
 	assert.Equal(t, expectedPatch, patch)
 }
 
+func TestCanaryRolloutSkipStepsWhenExpressionIsFalse(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{
+		{
+			Pause: &v1alpha1.RolloutPause{},
+		},
+		{
+			Pause: &v1alpha1.RolloutPause{},
+			When:  `metadata.labels.env == "prod"`,
+		},
+		{
+			SetWeight: pointer.Int32Ptr(50),
+		},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Labels = map[string]string{"env": "dev"}
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 0, 0)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 0, 10, false)
+	r2.Status.AvailableReplicas = 10
+	r2.Status.ControllerPause = true
+
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+	f.kubeobjects = append(f.kubeobjects, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+	patch := f.getPatchedRollout(patchIndex)
+	expectedPatchTemplate := `{
+	"status":{
+		"controllerPause": null,
+		"conditions" : %s,
+		"currentStepIndex": 2,
+		"canary": {
+			"skippedSteps": [{"index": 1, "reason": "when expression 'metadata.labels.env == \"prod\"' evaluated to false"}]
+		}
+	}
+}`
+	generatedConditions := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, rs2, false, "")
+	expectedPatch := calculatePatch(r2, fmt.Sprintf(expectedPatchTemplate, generatedConditions))
+	assert.Equal(t, expectedPatch, patch)
+}
+
+func TestCanaryRolloutRunStepWhenExpressionIsTrue(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{
+		{
+			Pause: &v1alpha1.RolloutPause{},
+		},
+		{
+			Pause: &v1alpha1.RolloutPause{},
+			When:  `metadata.labels.env == "prod"`,
+		},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Labels = map[string]string{"env": "prod"}
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 0, 0)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 0, 10, false)
+	r2.Status.AvailableReplicas = 10
+	r2.Status.ControllerPause = true
+
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+	f.kubeobjects = append(f.kubeobjects, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+	patch := f.getPatchedRollout(patchIndex)
+	expectedPatchTemplate := `{
+	"status":{
+		"controllerPause": null,
+		"conditions" : %s,
+		"currentStepIndex": 1
+	}
+}`
+	generatedConditions := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, rs2, false, "")
+	expectedPatch := calculatePatch(r2, fmt.Sprintf(expectedPatchTemplate, generatedConditions))
+	assert.Equal(t, expectedPatch, patch)
+}
+
 func TestCanaryRolloutUpdateStatusWhenAtEndOfSteps(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
@@ -743,6 +844,157 @@ func TestRollBackToStable(t *testing.T) {
 	assert.Equal(t, calculatePatch(r2, expectedPatch), patch)
 }
 
+func TestRollBackWithinRollbackWindow(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{{
+		SetWeight: int32Ptr(10),
+	}, {
+		Pause: &v1alpha1.RolloutPause{},
+	}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: 1}
+	r2 := bumpVersion(r1)
+	r3 := bumpVersion(r2)
+	r3.Spec.Template = r1.Spec.Template
+
+	rs1 := newReplicaSetWithStatus(r1, 0, 0)
+	rs1.Annotations[annotations.StableRevisionAnnotation] = "1"
+	rs2 := newReplicaSetWithStatus(r2, 10, 10)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r3 = updateCanaryRolloutStatus(r3, rs2PodHash, 10, 10, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r3)
+	f.objects = append(f.objects, r3)
+
+	updatedRSIndex := f.expectUpdateReplicaSetAction(rs1)
+	f.expectUpdateReplicaSetAction(rs1)
+	patchIndex := f.expectPatchRolloutAction(r3)
+	f.run(getKey(r3, t))
+
+	updatedRS1 := f.getUpdatedReplicaSet(updatedRSIndex)
+	assert.Equal(t, "3", updatedRS1.Annotations[annotations.RevisionAnnotation])
+	assert.Equal(t, "1", updatedRS1.Annotations[annotations.RevisionHistoryAnnotation])
+
+	patch := f.getPatchedRollout(patchIndex)
+	assert.Contains(t, patch, `"promoteFull":true`)
+}
+
+func TestRollBackOutsideRollbackWindow(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{{
+		SetWeight: int32Ptr(10),
+	}, {
+		Pause: &v1alpha1.RolloutPause{},
+	}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: 1}
+	r2 := bumpVersion(r1)
+	r3 := bumpVersion(r2)
+	r4 := bumpVersion(r3)
+	r4.Spec.Template = r1.Spec.Template
+
+	rs1 := newReplicaSetWithStatus(r1, 0, 0)
+	rs1.Annotations[annotations.StableRevisionAnnotation] = "1"
+	rs3 := newReplicaSetWithStatus(r3, 10, 10)
+	rs3.Annotations[annotations.RevisionAnnotation] = "3"
+	rs3PodHash := rs3.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.kubeobjects = append(f.kubeobjects, rs1, rs3)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs3)
+
+	r4 = updateCanaryRolloutStatus(r4, rs3PodHash, 10, 10, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r4)
+	f.objects = append(f.objects, r4)
+
+	updatedRSIndex := f.expectUpdateReplicaSetAction(rs1)
+	f.expectUpdateReplicaSetAction(rs1)
+	patchIndex := f.expectPatchRolloutAction(r4)
+	f.run(getKey(r4, t))
+
+	updatedRS1 := f.getUpdatedReplicaSet(updatedRSIndex)
+	assert.Equal(t, "4", updatedRS1.Annotations[annotations.RevisionAnnotation])
+	assert.Equal(t, "1", updatedRS1.Annotations[annotations.RevisionHistoryAnnotation])
+
+	patch := f.getPatchedRollout(patchIndex)
+	assert.NotContains(t, patch, `"promoteFull"`)
+}
+
+func TestRollBackToAbortedRevisionWithinRollbackWindow(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{{
+		SetWeight: int32Ptr(10),
+	}, {
+		Pause: &v1alpha1.RolloutPause{},
+	}}
+	r2 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r2.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: 3}
+	r3 := bumpVersion(r2)
+	r4 := bumpVersion(r3)
+	r5 := bumpVersion(r4)
+	r5.Spec.Template = r3.Spec.Template
+
+	// revision 2 was stable, revision 3 was aborted and revision 4 is stable
+	rs2 := newReplicaSetWithStatus(r2, 0, 0)
+	rs2.Annotations[annotations.RevisionAnnotation] = "2"
+	rs2.Annotations[annotations.StableRevisionAnnotation] = "2"
+	rs3 := newReplicaSetWithStatus(r3, 0, 0)
+	rs3.Annotations[annotations.RevisionAnnotation] = "3"
+	rs4 := newReplicaSetWithStatus(r4, 10, 10)
+	rs4.Annotations[annotations.RevisionAnnotation] = "4"
+	rs4.Annotations[annotations.StableRevisionAnnotation] = "4"
+	rs4PodHash := rs4.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.kubeobjects = append(f.kubeobjects, rs2, rs3, rs4)
+	f.replicaSetLister = append(f.replicaSetLister, rs2, rs3, rs4)
+
+	r5 = updateCanaryRolloutStatus(r5, rs4PodHash, 10, 10, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r5)
+	f.objects = append(f.objects, r5)
+
+	updatedRSIndex := f.expectUpdateReplicaSetAction(rs3)
+	f.expectUpdateReplicaSetAction(rs3)
+	patchIndex := f.expectPatchRolloutAction(r5)
+	f.run(getKey(r5, t))
+
+	updatedRS3 := f.getUpdatedReplicaSet(updatedRSIndex)
+	assert.Equal(t, "5", updatedRS3.Annotations[annotations.RevisionAnnotation])
+	assert.Equal(t, "3", updatedRS3.Annotations[annotations.RevisionHistoryAnnotation])
+
+	// the aborted revision was never stable, so it goes through the steps again
+	patch := f.getPatchedRollout(patchIndex)
+	assert.NotContains(t, patch, `"promoteFull"`)
+}
+
+func TestRecordStableRevisionWithRollbackWindow(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1 := newCanaryRollout("foo", 10, nil, nil, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: 1}
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+
+	r1 = updateCanaryRolloutStatus(r1, rs1PodHash, 10, 10, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r1)
+	f.objects = append(f.objects, r1)
+
+	updatedRSIndex := f.expectUpdateReplicaSetAction(rs1)
+	f.expectUpdateReplicaSetAction(rs1)
+	f.expectPatchRolloutAction(r1)
+	f.run(getKey(r1, t))
+
+	updatedRS1 := f.getUpdatedReplicaSet(updatedRSIndex)
+	assert.Equal(t, "1", updatedRS1.Annotations[annotations.StableRevisionAnnotation])
+}
+
 func TestGradualShiftToNewStable(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
@@ -1399,6 +1651,393 @@ func TestNoResumeAfterPauseDurationIfUserPaused(t *testing.T) {
 	assert.Equal(t, calculatePatch(r2, expectedPatch), patch)
 }
 
+// timeWindowFromNow returns a time window which opens and closes at the given offsets from now
+func timeWindowFromNow(start, end time.Duration) *v1alpha1.RolloutTimeWindow {
+	now := time.Now().UTC()
+	return &v1alpha1.RolloutTimeWindow{
+		StartTime: now.Add(start).Format("15:04"),
+		EndTime:   now.Add(end).Format("15:04"),
+	}
+}
+
+func TestCanaryRolloutPauseOutsideTimeWindow(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{
+		{
+			SetWeight: pointer.Int32Ptr(10),
+		},
+		{
+			TimeWindow: timeWindowFromNow(2*time.Hour, 3*time.Hour),
+		},
+		{
+			SetWeight: pointer.Int32Ptr(20),
+		},
+	}
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(1))
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 1, 1, 1, false)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	assert.Contains(t, patch, `"pauseConditions":[{"reason":"CanaryPauseStep"`)
+	assert.Contains(t, patch, `"controllerPause":true`)
+	assert.NotContains(t, patch, `"currentStepIndex"`)
+}
+
+func TestCanaryRolloutResumeInsideTimeWindow(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{
+		{
+			SetWeight: pointer.Int32Ptr(10),
+		},
+		{
+			TimeWindow: timeWindowFromNow(-time.Hour, time.Hour),
+		},
+		{
+			SetWeight: pointer.Int32Ptr(20),
+		},
+	}
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(1))
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 1, 1, 1, true)
+	r2.Status.ObservedGeneration = strconv.Itoa(int(r2.Generation))
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason:    v1alpha1.PauseReasonCanaryPauseStep,
+		StartTime: metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+	}}
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	_ = f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	var patchObj map[string]interface{}
+	err := json.Unmarshal([]byte(patch), &patchObj)
+	assert.NoError(t, err)
+
+	status := patchObj["status"].(map[string]interface{})
+	assert.Equal(t, float64(2), status["currentStepIndex"])
+	controllerPause, ok := status["controllerPause"]
+	assert.True(t, ok)
+	assert.Nil(t, controllerPause)
+}
+
+// fakeApprovalSender answers approval requests right away with its answer and records the requests
+type fakeApprovalSender struct {
+	answer   approval.Answer
+	requests []fakeApprovalRequest
+	answers  map[string]approval.Answer
+}
+
+type fakeApprovalRequest struct {
+	url     string
+	headers map[string]string
+	req     approval.Request
+}
+
+func (s *fakeApprovalSender) Send(key string, url string, headers map[string]string, req approval.Request, done func()) {
+	s.requests = append(s.requests, fakeApprovalRequest{url: url, headers: headers, req: req})
+	if s.answers == nil {
+		s.answers = map[string]approval.Answer{}
+	}
+	s.answers[key] = s.answer
+}
+
+func (s *fakeApprovalSender) Answer(key string) (approval.Answer, bool) {
+	answer, ok := s.answers[key]
+	delete(s.answers, key)
+	return answer, ok
+}
+
+func newApprovalStepRollout(url string) *v1alpha1.Rollout {
+	steps := []v1alpha1.CanaryStep{
+		{
+			SetWeight: pointer.Int32Ptr(10),
+		},
+		{
+			Approval: &v1alpha1.RolloutApprovalStep{URL: url},
+		},
+		{
+			SetWeight: pointer.Int32Ptr(20),
+		},
+	}
+	return newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(1))
+}
+
+func TestCanaryRolloutPauseForPendingApproval(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	f.approvalSender.answer = approval.Answer{Phase: v1alpha1.ApprovalPhasePending, Message: "CHG-1 awaiting review"}
+
+	r1 := newApprovalStepRollout("https://change-management.example.com/approve")
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 1, 1, 1, false)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	assert.Contains(t, patch, `"pauseConditions":[{"reason":"CanaryApprovalStep"`)
+	assert.Contains(t, patch, `"controllerPause":true`)
+	assert.NotContains(t, patch, `"currentStepIndex"`)
+
+	var patchObj map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(patch), &patchObj))
+	approval := patchObj["status"].(map[string]interface{})["canary"].(map[string]interface{})["approval"].(map[string]interface{})
+	assert.Equal(t, float64(1), approval["stepIndex"])
+	assert.Equal(t, "Pending", approval["phase"])
+	assert.Equal(t, "CHG-1 awaiting review", approval["message"])
+	assert.NotNil(t, approval["requestedAt"])
+
+	if assert.Len(t, f.approvalSender.requests, 1) {
+		request := f.approvalSender.requests[0]
+		assert.Equal(t, "https://change-management.example.com/approve", request.url)
+		assert.Equal(t, "foo", request.req.Rollout)
+		assert.Equal(t, int32(1), request.req.StepIndex)
+		assert.Equal(t, rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], request.req.PodTemplateHash)
+	}
+}
+
+func TestCanaryRolloutResumeWhenApprovalRecorded(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	// the answer was recorded on the rollout, so the endpoint must not be called again
+	r1 := newApprovalStepRollout("https://change-management.example.com/approve")
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 1, 1, 1, true)
+	r2.Status.ObservedGeneration = strconv.Itoa(int(r2.Generation))
+	requestedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason:    v1alpha1.PauseReasonCanaryApprovalStep,
+		StartTime: requestedAt,
+	}}
+	r2.Status.Canary.Approval = &v1alpha1.RolloutApprovalStatus{
+		StepIndex:       1,
+		Phase:           v1alpha1.ApprovalPhaseApproved,
+		RequestedAt:     &requestedAt,
+		LastRequestedAt: &requestedAt,
+	}
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	_ = f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	var patchObj map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(patch), &patchObj))
+
+	status := patchObj["status"].(map[string]interface{})
+	assert.Equal(t, float64(2), status["currentStepIndex"])
+	assert.Contains(t, status, "pauseConditions")
+	assert.Nil(t, status["pauseConditions"])
+	approvalStatus, ok := status["canary"].(map[string]interface{})["approval"]
+	assert.True(t, ok)
+	assert.Nil(t, approvalStatus)
+	assert.Empty(t, f.approvalSender.requests)
+}
+
+func TestCanaryRolloutAbortWhenApprovalDenied(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	f.approvalSender.answer = approval.Answer{Phase: v1alpha1.ApprovalPhaseDenied, Message: "CHG-1 rejected"}
+
+	r1 := newApprovalStepRollout("https://change-management.example.com/approve")
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 1, 1, 1, false)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	assert.Contains(t, patch, `"abort":true`)
+	assert.Contains(t, patch, `Approval of step 2/3 denied: CHG-1 rejected`)
+	assert.Contains(t, patch, `"currentStepIndex":0`)
+	assert.NotContains(t, patch, `"approval"`)
+	assert.Len(t, f.approvalSender.requests, 1)
+}
+
+// newPausedApprovalStepRollout returns a rollout which is paused on its approval step, whose request was
+// last sent a minute ago and failed with the given error
+func newPausedApprovalStepRollout(step v1alpha1.RolloutApprovalStep, requestErr string) (*v1alpha1.Rollout, *v1.ReplicaSet, *v1.ReplicaSet) {
+	r1 := newApprovalStepRollout(step.URL)
+	r1.Spec.Strategy.Canary.Steps[1].Approval = &step
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 1, 1, 1, true)
+	r2.Status.ObservedGeneration = strconv.Itoa(int(r2.Generation))
+	requestedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason:    v1alpha1.PauseReasonCanaryApprovalStep,
+		StartTime: requestedAt,
+	}}
+	r2.Status.Canary.Approval = &v1alpha1.RolloutApprovalStatus{
+		StepIndex:       1,
+		Phase:           v1alpha1.ApprovalPhasePending,
+		RequestedAt:     &requestedAt,
+		LastRequestedAt: &requestedAt,
+		Error:           requestErr,
+	}
+	return r2, rs1, rs2
+}
+
+func TestCanaryRolloutRetryFailedApprovalRequest(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	// the step does not set a poll interval, but the last request failed and is retried
+	step := v1alpha1.RolloutApprovalStep{URL: "https://change-management.example.com/approve"}
+	r2, rs1, rs2 := newPausedApprovalStepRollout(step, "received non 2xx response code: 503")
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+	f.approvalSender.answer = approval.Answer{Phase: v1alpha1.ApprovalPhasePending, Message: "CHG-1 awaiting review"}
+
+	_ = f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	assert.Len(t, f.approvalSender.requests, 1)
+	patch := f.getPatchedRollout(patchIndex)
+	assert.Contains(t, patch, `"message":"CHG-1 awaiting review"`)
+	assert.Contains(t, patch, `"error":null`)
+}
+
+func TestCanaryRolloutDoNotResendAnsweredApprovalRequest(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	// without a poll interval, a request which was answered is not sent again
+	step := v1alpha1.RolloutApprovalStep{URL: "https://change-management.example.com/approve"}
+	r2, rs1, rs2 := newPausedApprovalStepRollout(step, "")
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	_ = f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	assert.Empty(t, f.approvalSender.requests)
+	assert.NotContains(t, f.getPatchedRollout(patchIndex), `"approval"`)
+}
+
+func TestCanaryRolloutApprovalHeaderFromSecret(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	step := v1alpha1.RolloutApprovalStep{
+		URL:          "https://change-management.example.com/approve",
+		PollInterval: "30s",
+		Headers: []v1alpha1.RolloutApprovalHeader{
+			{Key: "X-Team", Value: "payments"},
+			{Key: "Authorization", ValueFrom: &v1alpha1.RolloutApprovalHeaderSource{
+				SecretKeyRef: &v1alpha1.SecretKeyRef{Name: "change-management", Key: "token"},
+			}},
+		},
+	}
+	r2, rs1, rs2 := newPausedApprovalStepRollout(step, "")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "change-management", Namespace: r2.Namespace},
+		Data:       map[string][]byte{"token": []byte("Bearer token")},
+	}
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2, secret)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.secretLister = append(f.secretLister, secret)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	approval.SetAllowedURLPrefixes([]string{"https://change-management.example.com/"})
+	defer approval.SetAllowedURLPrefixes(nil)
+
+	_ = f.expectPatchRolloutAction(r2)
+	_ = f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	if assert.Len(t, f.approvalSender.requests, 1) {
+		assert.Equal(t, map[string]string{"X-Team": "payments", "Authorization": "Bearer token"}, f.approvalSender.requests[0].headers)
+	}
+}
+
+func TestCanaryRolloutApprovalHeaderFromSecretWithoutURLPrefixes(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	step := v1alpha1.RolloutApprovalStep{
+		URL:          "https://change-management.example.com/approve",
+		PollInterval: "30s",
+		Headers: []v1alpha1.RolloutApprovalHeader{
+			{Key: "Authorization", ValueFrom: &v1alpha1.RolloutApprovalHeaderSource{
+				SecretKeyRef: &v1alpha1.SecretKeyRef{Name: "change-management", Key: "token"},
+			}},
+		},
+	}
+	r2, rs1, rs2 := newPausedApprovalStepRollout(step, "")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "change-management", Namespace: r2.Namespace},
+		Data:       map[string][]byte{"token": []byte("Bearer token")},
+	}
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2, secret)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.secretLister = append(f.secretLister, secret)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	_ = f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	// the secret is not sent to an endpoint which is not restricted by the url prefixes
+	assert.Empty(t, f.approvalSender.requests)
+	assert.Contains(t, f.getPatchedRollout(patchIndex), `"error":"headers can only be read from secrets if the approval url prefixes are configured"`)
+}
+
 func TestHandleNilNewRSOnScaleAndImageChange(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
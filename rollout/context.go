@@ -1,6 +1,8 @@
 package rollout
 
 import (
+	"context"
+
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -8,12 +10,15 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/validation"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 )
 
 type rolloutContext struct {
 	reconcilerBase
 
+	// ctx carries the span of the reconciliation, which is the parent of the spans of API calls
+	ctx context.Context
 	log *log.Entry
 	// rollout is the rollout being reconciled
 	rollout *v1alpha1.Rollout
@@ -49,17 +54,25 @@ type rolloutContext struct {
 }
 
 func (c *rolloutContext) reconcile() error {
-	// Get Rollout Validation errors
-	err := c.getRolloutValidationErrors()
+	// Managed services are only created for a valid spec. They are created before the referenced
+	// resources are validated, which requires the referenced services to exist.
+	if errs := validation.ValidateRollout(c.rollout); len(errs) > 0 {
+		return c.handleValidationError(errs[0])
+	}
+	created, err := c.reconcileManagedServices()
 	if err != nil {
-		if vErr, ok := err.(*field.Error); ok {
-			// We want to frequently requeue rollouts with InvalidSpec errors, because the error
-			// condition might be timing related (e.g. the Rollout was applied before the Service).
-			c.enqueueRolloutAfter(c.rollout, 20*time.Second)
-			return c.createInvalidRolloutCondition(vErr, c.rollout)
-		}
 		return err
 	}
+	if created {
+		c.enqueueRollout(c.rollout)
+		return nil
+	}
+
+	// Get Rollout Validation errors
+	err = c.getRolloutValidationErrors()
+	if err != nil {
+		return c.handleValidationError(err)
+	}
 
 	err = c.checkPausedConditions()
 	if err != nil {
@@ -71,10 +84,28 @@ func (c *rolloutContext) reconcile() error {
 		return err
 	}
 
-	if getPauseCondition(c.rollout, v1alpha1.PauseReasonInconclusiveAnalysis) != nil || c.rollout.Spec.Paused || isScalingEvent {
+	frozen, err := c.reconcileFreeze()
+	if err != nil {
+		return err
+	}
+
+	err = c.reconcileHooks()
+	if err != nil {
+		return err
+	}
+
+	if getPauseCondition(c.rollout, v1alpha1.PauseReasonInconclusiveAnalysis) != nil || c.rollout.Spec.Paused || isScalingEvent || frozen {
 		return c.syncReplicasOnly(isScalingEvent)
 	}
 
+	held, err := c.reconcilePreRolloutHooks()
+	if err != nil {
+		return err
+	}
+	if held {
+		return c.syncReplicasOnly(false)
+	}
+
 	if c.rollout.Spec.Strategy.BlueGreen != nil {
 		return c.rolloutBlueGreen()
 	}
@@ -83,6 +114,17 @@ func (c *rolloutContext) reconcile() error {
 	return c.rolloutCanary()
 }
 
+// handleValidationError reports a validation error of the rollout in the InvalidSpec condition
+func (c *rolloutContext) handleValidationError(err error) error {
+	if vErr, ok := err.(*field.Error); ok {
+		// We want to frequently requeue rollouts with InvalidSpec errors, because the error
+		// condition might be timing related (e.g. the Rollout was applied before the Service).
+		c.enqueueRolloutAfter(c.rollout, 20*time.Second)
+		return c.createInvalidRolloutCondition(vErr, c.rollout)
+	}
+	return err
+}
+
 func (c *rolloutContext) SetRestartedAt() {
 	c.newStatus.RestartedAt = c.rollout.Spec.RestartAt
 }
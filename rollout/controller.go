@@ -11,6 +11,7 @@ import (
 	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/api/extensions/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -21,14 +22,17 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 	appsinformers "k8s.io/client-go/informers/apps/v1"
+	batchinformers "k8s.io/client-go/informers/batch/v1"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	extensionsinformers "k8s.io/client-go/informers/extensions/v1beta1"
 	"k8s.io/client-go/kubernetes"
 	appslisters "k8s.io/client-go/listers/apps/v1"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
 	v1 "k8s.io/client-go/listers/core/v1"
 	extensionslisters "k8s.io/client-go/listers/extensions/v1beta1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/util/slice"
 	"k8s.io/kubernetes/pkg/controller"
 	"k8s.io/utils/pointer"
@@ -43,6 +47,7 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/approval"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
@@ -52,6 +57,8 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
+	"github.com/argoproj/argo-rollouts/utils/shard"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
 )
 
@@ -68,7 +75,8 @@ type Controller struct {
 	// rsControl is used for adopting/releasing replica sets.
 	replicaSetControl controller.RSControlInterface
 
-	metricsServer *metrics.MetricsServer
+	// sharder decides which rollouts are reconciled by this replica of the controller
+	sharder *shard.Sharder
 
 	// workqueue is a rate limited work queue. This is used to queue work to be
 	// processed instead of performing it as soon as a change happens. This
@@ -92,9 +100,13 @@ type ControllerConfig struct {
 	AnalysisRunInformer             informers.AnalysisRunInformer
 	AnalysisTemplateInformer        informers.AnalysisTemplateInformer
 	ClusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer
+	RolloutFreezeInformer           informers.RolloutFreezeInformer
+	ClusterRolloutFreezeInformer    informers.ClusterRolloutFreezeInformer
 	ReplicaSetInformer              appsinformers.ReplicaSetInformer
 	ServicesInformer                coreinformers.ServiceInformer
+	SecretsInformer                 coreinformers.SecretInformer
 	IngressInformer                 extensionsinformers.IngressInformer
+	HookJobInformer                 batchinformers.JobInformer
 	RolloutsInformer                informers.RolloutInformer
 	IstioPrimaryDynamicClient       dynamic.Interface
 	IstioVirtualServiceInformer     cache.SharedIndexInformer
@@ -105,6 +117,7 @@ type ControllerConfig struct {
 	IngressWorkQueue                workqueue.RateLimitingInterface
 	MetricsServer                   *metrics.MetricsServer
 	Recorder                        record.EventRecorder
+	Sharder                         *shard.Sharder
 }
 
 // reconcilerBase is a shared datastructure containing all clients and configuration necessary to
@@ -128,15 +141,29 @@ type reconcilerBase struct {
 	rolloutsSynced                cache.InformerSynced
 	rolloutsIndexer               cache.Indexer
 	servicesLister                v1.ServiceLister
+	servicesIndexer               cache.Indexer
+	secretsLister                 v1.SecretLister
 	ingressesLister               extensionslisters.IngressLister
+	hookJobLister                 batchlisters.JobLister
 	experimentsLister             listers.ExperimentLister
 	analysisRunLister             listers.AnalysisRunLister
 	analysisTemplateLister        listers.AnalysisTemplateLister
 	clusterAnalysisTemplateLister listers.ClusterAnalysisTemplateLister
+	rolloutFreezeLister           listers.RolloutFreezeLister
+	clusterRolloutFreezeLister    listers.ClusterRolloutFreezeLister
 	IstioController               *istio.IstioController
 
 	podRestarter RolloutPodRestarter
 
+	// approvalSender sends the requests of approval steps in the background
+	approvalSender approval.Sender
+
+	metricsServer *metrics.MetricsServer
+	// progressTracker measures the progress of the rollouts for the metrics server
+	progressTracker *progressTracker
+	// invalidFreezes remembers the invalid freezes which were already reported
+	invalidFreezes *invalidFreezeTracker
+
 	// used for unit testing
 	enqueueRollout              func(obj interface{})                                         //nolint:structcheck
 	enqueueRolloutAfter         func(obj interface{}, duration time.Duration)                 //nolint:structcheck
@@ -163,6 +190,10 @@ func NewController(cfg ControllerConfig) *Controller {
 		},
 	}
 
+	util.CheckErr(cfg.ServicesInformer.Informer().AddIndexers(cache.Indexers{
+		serviceOwnerIndexName: serviceOwnerIndexFunc,
+	}))
+
 	base := reconcilerBase{
 		kubeclientset:                 cfg.KubeClientSet,
 		argoprojclientset:             cfg.ArgoProjClientset,
@@ -175,15 +206,24 @@ func NewController(cfg ControllerConfig) *Controller {
 		rolloutsLister:                cfg.RolloutsInformer.Lister(),
 		rolloutsSynced:                cfg.RolloutsInformer.Informer().HasSynced,
 		servicesLister:                cfg.ServicesInformer.Lister(),
+		servicesIndexer:               cfg.ServicesInformer.Informer().GetIndexer(),
+		secretsLister:                 cfg.SecretsInformer.Lister(),
 		ingressesLister:               cfg.IngressInformer.Lister(),
+		hookJobLister:                 cfg.HookJobInformer.Lister(),
 		experimentsLister:             cfg.ExperimentInformer.Lister(),
 		analysisRunLister:             cfg.AnalysisRunInformer.Lister(),
 		analysisTemplateLister:        cfg.AnalysisTemplateInformer.Lister(),
 		clusterAnalysisTemplateLister: cfg.ClusterAnalysisTemplateInformer.Lister(),
+		rolloutFreezeLister:           cfg.RolloutFreezeInformer.Lister(),
+		clusterRolloutFreezeLister:    cfg.ClusterRolloutFreezeInformer.Lister(),
 		recorder:                      cfg.Recorder,
 		resyncPeriod:                  cfg.ResyncPeriod,
 		podRestarter:                  podRestarter,
+		approvalSender:                approval.NewSender(),
 		refResolver:                   cfg.RefResolver,
+		metricsServer:                 cfg.MetricsServer,
+		progressTracker:               newProgressTracker(),
+		invalidFreezes:                newInvalidFreezeTracker(),
 	}
 
 	controller := &Controller{
@@ -193,7 +233,7 @@ func NewController(cfg ControllerConfig) *Controller {
 		rolloutWorkqueue:  cfg.RolloutWorkQueue,
 		serviceWorkqueue:  cfg.ServiceWorkQueue,
 		ingressWorkqueue:  cfg.IngressWorkQueue,
-		metricsServer:     cfg.MetricsServer,
+		sharder:           cfg.Sharder,
 	}
 	controller.enqueueRollout = func(obj interface{}) {
 		controllerutil.EnqueueRateLimited(obj, cfg.RolloutWorkQueue)
@@ -289,6 +329,23 @@ func NewController(cfg ControllerConfig) *Controller {
 		},
 	})
 
+	cfg.HookJobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			controllerutil.EnqueueParentObject(obj, register.RolloutKind, controller.enqueueRollout)
+		},
+		UpdateFunc: func(old, new interface{}) {
+			oldJob := old.(*batchv1.Job)
+			newJob := new.(*batchv1.Job)
+			if newJob.ResourceVersion == oldJob.ResourceVersion {
+				return
+			}
+			controllerutil.EnqueueParentObject(new, register.RolloutKind, controller.enqueueRollout)
+		},
+		DeleteFunc: func(obj interface{}) {
+			controllerutil.EnqueueParentObject(obj, register.RolloutKind, controller.enqueueRollout)
+		},
+	})
+
 	return controller
 }
 
@@ -333,8 +390,7 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
 // syncHandler compares the actual state with the desired, and attempts to
 // converge the two. It then updates the Phase block of the Rollout resource
 // with the current status of the resource.
-func (c *Controller) syncHandler(key string) error {
-	ctx := context.TODO()
+func (c *Controller) syncHandler(key string) (err error) {
 	startTime := time.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -342,11 +398,17 @@ func (c *Controller) syncHandler(key string) error {
 	}
 	rollout, err := c.rolloutsLister.Rollouts(namespace).Get(name)
 	if k8serrors.IsNotFound(err) {
+		c.forgetRollout(namespace, name)
 		return nil
 	}
 	if err != nil {
 		return err
 	}
+	if !c.sharder.Owns(rollout) {
+		logutil.WithRollout(rollout).Debug("Skipping rollout owned by another shard")
+		c.forgetRollout(namespace, name)
+		return nil
+	}
 
 	// Remarshal the rollout to normalize all fields so that when we calculate hashes against the
 	// rollout spec and pod template spec, the hash will be consistent. See issue #70
@@ -361,10 +423,12 @@ func (c *Controller) syncHandler(key string) error {
 		return nil
 	}
 
+	ctx, span := tracing.StartSpan(context.TODO(), "rollout.reconcile", tracing.RolloutAttributes(r)...)
 	defer func() {
 		duration := time.Since(startTime)
 		c.metricsServer.IncRolloutReconcile(r, duration)
 		logCtx.WithField("time_ms", duration.Seconds()*1e3).Info("Reconciliation completed")
+		tracing.EndSpan(span, err)
 	}()
 
 	resolveErr := c.refResolver.Resolve(r)
@@ -373,6 +437,7 @@ func (c *Controller) syncHandler(key string) error {
 		logCtx.Errorf("newRolloutContext err %v", err)
 		return err
 	}
+	roCtx.ctx = ctx
 	if resolveErr != nil {
 		roCtx.createInvalidRolloutCondition(resolveErr, r)
 		return resolveErr
@@ -416,6 +481,27 @@ func (c *Controller) writeBackToInformer(ro *v1alpha1.Rollout) {
 	logCtx.Info("persisted to informer")
 }
 
+// ValidateRollout validates the spec of a rollout which has not been persisted yet, together with
+// the resources it references in the informer caches. It is used by the validating webhook, and
+// returns the first validation error, which the controller would report in the InvalidSpec condition.
+func (c *Controller) ValidateRollout(rollout *v1alpha1.Rollout) error {
+	r := remarshalRollout(rollout)
+	if err := c.refResolver.Resolve(r); err != nil {
+		return err
+	}
+	roCtx := &rolloutContext{
+		ctx:            context.TODO(),
+		rollout:        r,
+		log:            logutil.WithRollout(r),
+		reconcilerBase: c.reconcilerBase,
+	}
+	if serviceutil.GetManagedServices(r) != nil {
+		// Managed services are created before the rollout is validated by the controller
+		roCtx.servicesLister = managedServiceLister{ServiceLister: c.servicesLister, rollout: r}
+	}
+	return roCtx.getRolloutValidationErrors()
+}
+
 func (c *Controller) newRolloutContext(rollout *v1alpha1.Rollout) (*rolloutContext, error) {
 	rsList, err := c.getReplicaSetsForRollouts(rollout)
 	if err != nil {
@@ -442,6 +528,7 @@ func (c *Controller) newRolloutContext(rollout *v1alpha1.Rollout) (*rolloutConte
 
 	logCtx := logutil.WithRollout(rollout)
 	roCtx := rolloutContext{
+		ctx:        context.TODO(),
 		rollout:    rollout,
 		log:        logCtx,
 		newRS:      newRS,
@@ -599,6 +686,30 @@ func (c *rolloutContext) getReferencedServices() (*[]validation.ServiceWithType,
 				Type:    validation.PreviewService,
 			})
 		}
+		additionalServices := []struct {
+			serviceType validation.ServiceType
+			names       []string
+		}{
+			{validation.AdditionalActiveService, c.rollout.Spec.Strategy.BlueGreen.AdditionalActiveServices},
+			{validation.AdditionalPreviewService, c.rollout.Spec.Strategy.BlueGreen.AdditionalPreviewServices},
+		}
+		for _, additional := range additionalServices {
+			for i, name := range additional.names {
+				svc, err := c.servicesLister.Services(c.rollout.Namespace).Get(name)
+				if k8serrors.IsNotFound(err) {
+					fldPath := validation.GetServiceWithTypeFieldPath(additional.serviceType).Index(i)
+					return nil, field.Invalid(fldPath, name, err.Error())
+				}
+				if err != nil {
+					return nil, err
+				}
+				services = append(services, validation.ServiceWithType{
+					Service: svc,
+					Type:    additional.serviceType,
+					Index:   i,
+				})
+			}
+		}
 	} else if c.rollout.Spec.Strategy.Canary != nil {
 		if c.rollout.Spec.Strategy.Canary.StableService != "" {
 			stableSvc, err := c.servicesLister.Services(c.rollout.Namespace).Get(c.rollout.Spec.Strategy.Canary.StableService)
@@ -711,6 +822,7 @@ func (c *rolloutContext) getReferencedAnalysisTemplates(rollout *v1alpha1.Rollou
 		ClusterAnalysisTemplates: clusterTemplates,
 		TemplateType:             templateType,
 		CanaryStepIndex:          canaryStepIndex,
+		TemplateGetter:           analysisutil.NewTemplateGetter(c.analysisTemplateLister, c.clusterAnalysisTemplateLister),
 	}, nil
 }
 
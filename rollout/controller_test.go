@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/undefinedlabs/go-mpatch"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -47,12 +48,14 @@ import (
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	"github.com/argoproj/argo-rollouts/rollout/mocks"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/approval"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	"github.com/argoproj/argo-rollouts/utils/shard"
 )
 
 var (
@@ -89,9 +92,13 @@ type fixture struct {
 	analysisRunLister             []*v1alpha1.AnalysisRun
 	clusterAnalysisTemplateLister []*v1alpha1.ClusterAnalysisTemplate
 	analysisTemplateLister        []*v1alpha1.AnalysisTemplate
+	rolloutFreezeLister           []*v1alpha1.RolloutFreeze
+	clusterRolloutFreezeLister    []*v1alpha1.ClusterRolloutFreeze
 	replicaSetLister              []*appsv1.ReplicaSet
 	serviceLister                 []*corev1.Service
+	secretLister                  []*corev1.Secret
 	ingressLister                 []*extensionsv1beta1.Ingress
+	jobLister                     []*batchv1.Job
 	// Actions expected to happen on the client.
 	kubeactions []core.Action
 	actions     []core.Action
@@ -102,6 +109,7 @@ type fixture struct {
 	unfreezeTime    func() error
 
 	fakeTrafficRouting *mocks.TrafficRoutingReconciler
+	approvalSender     *fakeApprovalSender
 }
 
 func newFixture(t *testing.T) *fixture {
@@ -115,6 +123,7 @@ func newFixture(t *testing.T) *fixture {
 	assert.NoError(t, err)
 	f.unfreezeTime = patch.Unpatch
 	f.fakeTrafficRouting = newFakeTrafficRoutingReconciler()
+	f.approvalSender = &fakeApprovalSender{answer: approval.Answer{Phase: v1alpha1.ApprovalPhasePending}}
 	return f
 }
 
@@ -507,9 +516,13 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 		AnalysisRunInformer:             i.Argoproj().V1alpha1().AnalysisRuns(),
 		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
 		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		RolloutFreezeInformer:           i.Argoproj().V1alpha1().RolloutFreezes(),
+		ClusterRolloutFreezeInformer:    i.Argoproj().V1alpha1().ClusterRolloutFreezes(),
 		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
 		ServicesInformer:                k8sI.Core().V1().Services(),
+		SecretsInformer:                 k8sI.Core().V1().Secrets(),
 		IngressInformer:                 k8sI.Extensions().V1beta1().Ingresses(),
+		HookJobInformer:                 k8sI.Batch().V1().Jobs(),
 		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
 		IstioPrimaryDynamicClient:       dynamicClient,
 		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
@@ -547,6 +560,7 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	c.newTrafficRoutingReconciler = func(roCtx *rolloutContext) (TrafficRoutingReconciler, error) {
 		return f.fakeTrafficRouting, nil
 	}
+	c.approvalSender = f.approvalSender
 
 	for _, r := range f.rolloutLister {
 		i.Argoproj().V1alpha1().Rollouts().Informer().GetIndexer().Add(r)
@@ -562,9 +576,15 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	for _, s := range f.serviceLister {
 		k8sI.Core().V1().Services().Informer().GetIndexer().Add(s)
 	}
+	for _, s := range f.secretLister {
+		k8sI.Core().V1().Secrets().Informer().GetIndexer().Add(s)
+	}
 	for _, i := range f.ingressLister {
 		k8sI.Extensions().V1beta1().Ingresses().Informer().GetIndexer().Add(i)
 	}
+	for _, j := range f.jobLister {
+		k8sI.Batch().V1().Jobs().Informer().GetIndexer().Add(j)
+	}
 	for _, at := range f.analysisTemplateLister {
 		i.Argoproj().V1alpha1().AnalysisTemplates().Informer().GetIndexer().Add(at)
 	}
@@ -574,6 +594,12 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	for _, ar := range f.analysisRunLister {
 		i.Argoproj().V1alpha1().AnalysisRuns().Informer().GetIndexer().Add(ar)
 	}
+	for _, rf := range f.rolloutFreezeLister {
+		i.Argoproj().V1alpha1().RolloutFreezes().Informer().GetIndexer().Add(rf)
+	}
+	for _, crf := range f.clusterRolloutFreezeLister {
+		i.Argoproj().V1alpha1().ClusterRolloutFreezes().Informer().GetIndexer().Add(crf)
+	}
 
 	return c, i, k8sI
 }
@@ -674,14 +700,24 @@ func filterInformerActions(actions []core.Action) []core.Action {
 			action.Matches("watch", "analysistemplates") ||
 			action.Matches("list", "clusteranalysistemplates") ||
 			action.Matches("watch", "clusteranalysistemplates") ||
+			action.Matches("list", "rolloutfreezes") ||
+			action.Matches("watch", "rolloutfreezes") ||
+			action.Matches("list", "clusterrolloutfreezes") ||
+			action.Matches("watch", "clusterrolloutfreezes") ||
 			action.Matches("list", "rollouts") ||
 			action.Matches("watch", "rollouts") ||
 			action.Matches("list", "replicaSets") ||
 			action.Matches("watch", "replicaSets") ||
 			action.Matches("list", "services") ||
 			action.Matches("watch", "services") ||
+			action.Matches("list", "secrets") ||
+			action.Matches("watch", "secrets") ||
 			action.Matches("list", "ingresses") ||
-			action.Matches("watch", "ingresses") {
+			action.Matches("watch", "ingresses") ||
+			action.Matches("list", "jobs") ||
+			action.Matches("watch", "jobs") ||
+			// the records of the completed and aborted revisions are verified by the revision tests
+			action.GetResource().Resource == "rolloutrevisions" {
 			continue
 		}
 		ret = append(ret, action)
@@ -1020,6 +1056,48 @@ func (f *fixture) expectDeleteReplicaSetAction(rs *appsv1.ReplicaSet) int {
 	return len
 }
 
+func (f *fixture) expectCreateJobAction(job *batchv1.Job) int {
+	len := len(f.kubeactions)
+	f.kubeactions = append(f.kubeactions, core.NewCreateAction(schema.GroupVersionResource{Resource: "jobs"}, job.Namespace, job))
+	return len
+}
+
+func (f *fixture) expectDeleteJobAction(job *batchv1.Job) int {
+	action := core.NewDeleteAction(schema.GroupVersionResource{Resource: "jobs"}, job.Namespace, job.Name)
+	len := len(f.kubeactions)
+	f.kubeactions = append(f.kubeactions, action)
+	return len
+}
+
+func (f *fixture) expectCreateServiceAction(svc *corev1.Service) int {
+	len := len(f.kubeactions)
+	f.kubeactions = append(f.kubeactions, core.NewCreateAction(schema.GroupVersionResource{Resource: "services"}, svc.Namespace, svc))
+	return len
+}
+
+func (f *fixture) getCreatedService(index int) *corev1.Service {
+	action := filterInformerActions(f.kubeclient.Actions())[index]
+	createAction, ok := action.(core.CreateAction)
+	if !ok {
+		assert.Fail(f.t, "Expected Created action, not %s", action.GetVerb())
+	}
+	return createAction.GetObject().(*corev1.Service)
+}
+
+func (f *fixture) getCreatedJob(index int) *batchv1.Job {
+	action := filterInformerActions(f.kubeclient.Actions())[index]
+	createAction, ok := action.(core.CreateAction)
+	if !ok {
+		assert.Fail(f.t, "Expected Created action, not %s", action.GetVerb())
+	}
+	obj := createAction.GetObject()
+	job := &batchv1.Job{}
+	converter := runtime.NewTestUnstructuredConverter(equality.Semantic)
+	objMap, _ := converter.ToUnstructured(obj)
+	runtime.NewTestUnstructuredConverter(equality.Semantic).FromUnstructured(objMap, job)
+	return job
+}
+
 func (f *fixture) getDeletedReplicaSet(index int) string {
 	action := filterInformerActions(f.kubeclient.Actions())[index]
 	deleteAction, ok := action.(core.DeleteAction)
@@ -1677,6 +1755,22 @@ func TestRolloutStrategyNotSet(t *testing.T) {
 	assert.Contains(t, patchedRollout, `Rollout has missing field '.spec.strategy.canary or .spec.strategy.blueGreen'`)
 }
 
+// TestSkipRolloutOwnedByAnotherShard verifies that a rollout which is assigned to another replica
+// of the controller is not reconciled
+func TestSkipRolloutOwnedByAnotherShard(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	c, i, k8sI := f.newController(noResyncPeriodFunc)
+	// the sharder did not join the shard group yet, so it owns no rollouts
+	c.sharder = shard.NewSharder(k8sfake.NewSimpleClientset(), shard.Options{ShardBy: shard.ShardByRollout, Identity: "other"})
+	f.runController(getKey(r, t), true, false, c, i, k8sI)
+}
+
 // TestWriteBackToInformer verifies that after a rollout reconciles, the new version of the rollout
 // is written back to the informer
 func TestWriteBackToInformer(t *testing.T) {
@@ -1706,3 +1800,20 @@ func TestWriteBackToInformer(t *testing.T) {
 	assert.NotEmpty(t, stableRS)
 	assert.Equal(t, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], stableRS)
 }
+
+func TestValidateRollout(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	// the services referenced by the rollout do not exist
+	r := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	assert.Error(t, c.ValidateRollout(r))
+
+	// managed services are created by the controller, so they do not need to exist
+	r = newManagedServicesRollout()
+	assert.NoError(t, c.ValidateRollout(r))
+
+	r.Spec.Selector = nil
+	assert.Error(t, c.ValidateRollout(r))
+}
@@ -12,7 +12,7 @@ import (
 
 // reconcileEphemeralMetadata syncs canary/stable ephemeral metadata to ReplicaSets and pods
 func (c *rolloutContext) reconcileEphemeralMetadata() error {
-	ctx := context.TODO()
+	ctx := c.ctx
 	var newMetadata, stableMetadata *v1alpha1.PodTemplateMetadata
 	if c.rollout.Spec.Strategy.Canary != nil {
 		newMetadata = c.rollout.Spec.Strategy.Canary.CanaryMetadata
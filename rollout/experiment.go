@@ -1,12 +1,12 @@
 package rollout
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
 	experimentutil "github.com/argoproj/argo-rollouts/utils/experiment"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
@@ -171,7 +171,7 @@ func (c *rolloutContext) reconcileExperiments() error {
 		case v1alpha1.AnalysisPhaseInconclusive:
 			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveExperiment)
 		case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
-			c.pauseContext.AddAbort(currentEx.Status.Message)
+			c.pauseContext.AddAbort(conditions.RolloutExperimentFailedReason, currentEx.Status.Message)
 		case v1alpha1.AnalysisPhaseSuccessful:
 			// Do not set current Experiment after successful experiment
 		default:
@@ -200,7 +200,7 @@ func (c *rolloutContext) reconcileExperiments() error {
 // createExperimentWithCollisionHandling creates the given experiment, but with a new name
 // in the event that an experiment with the same name already exists
 func (c *rolloutContext) createExperimentWithCollisionHandling(newEx *v1alpha1.Experiment) (*v1alpha1.Experiment, error) {
-	ctx := context.TODO()
+	ctx := c.ctx
 	collisionCount := 1
 	baseName := newEx.Name
 	for {
@@ -247,7 +247,7 @@ func (c *rolloutContext) cancelExperiments(exs []*v1alpha1.Experiment) error {
 }
 
 func (c *rolloutContext) deleteExperiments(exs []*v1alpha1.Experiment) error {
-	ctx := context.TODO()
+	ctx := c.ctx
 	for i := range exs {
 		ex := exs[i]
 		if ex.DeletionTimestamp != nil {
@@ -0,0 +1,103 @@
+package rollout
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/freeze"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// reconcileFreeze checks whether an update of the rollout is held by the window of a RolloutFreeze
+// or ClusterRolloutFreeze. While frozen, the rollout keeps a RolloutFreeze pause condition and is
+// requeued for the end of the window. Aborted rollouts are never frozen so they can return to the
+// stable version.
+func (c *rolloutContext) reconcileFreeze() (bool, error) {
+	var active *freeze.ActiveFreeze
+	if c.isUpdateInProgress() && !c.rollout.Status.Abort {
+		freezes, err := c.rolloutFreezeLister.RolloutFreezes(c.rollout.Namespace).List(labels.Everything())
+		if err != nil {
+			return false, err
+		}
+		clusterFreezes, err := c.clusterRolloutFreezeLister.List(labels.Everything())
+		if err != nil {
+			return false, err
+		}
+		c.reportInvalidFreezes(freezes, clusterFreezes)
+		active = freeze.GetActiveFreeze(c.rollout, freezes, clusterFreezes, time.Now())
+	}
+
+	frozen := getPauseCondition(c.rollout, v1alpha1.PauseReasonRolloutFreeze) != nil
+	if active == nil {
+		if frozen {
+			c.log.Info("Rollout is no longer frozen")
+			c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonRolloutFreeze)
+		}
+		return false, nil
+	}
+	endStr := active.End.UTC().Format(time.RFC3339)
+	if !frozen {
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutFrozenReason}, conditions.RolloutFrozenMessage, active.Name, endStr)
+	}
+	c.log.Infof("Rollout frozen by %s until %s", active.Name, endStr)
+	c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonRolloutFreeze)
+	c.enqueueRolloutAfter(c.rollout, time.Until(active.End))
+	return true, nil
+}
+
+// invalidFreezeTracker remembers the generation of each invalid freeze which was reported, since a
+// freeze is evaluated on every reconciliation of every rollout it may select
+type invalidFreezeTracker struct {
+	lock        sync.Mutex
+	generations map[string]int64
+}
+
+func newInvalidFreezeTracker() *invalidFreezeTracker {
+	return &invalidFreezeTracker{
+		generations: make(map[string]int64),
+	}
+}
+
+// needsReport returns whether the generation of the freeze identified by key is invalid and was not
+// reported yet. A freeze which became valid is forgotten, so that it is reported again if it becomes
+// invalid again.
+func (t *invalidFreezeTracker) needsReport(key string, generation int64, invalid bool) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if !invalid {
+		delete(t.generations, key)
+		return false
+	}
+	if reported, ok := t.generations[key]; ok && reported == generation {
+		return false
+	}
+	t.generations[key] = generation
+	return true
+}
+
+// reportInvalidFreezes sends a warning event for each freeze which cannot be evaluated, since the
+// freeze resources have no status in which their errors could be reported. Each generation of a
+// freeze is reported once.
+func (c *rolloutContext) reportInvalidFreezes(freezes []*v1alpha1.RolloutFreeze, clusterFreezes []*v1alpha1.ClusterRolloutFreeze) {
+	for _, f := range freezes {
+		err := freeze.ValidateSpec(f.Spec)
+		if c.invalidFreezes.needsReport("RolloutFreeze/"+f.Namespace+"/"+f.Name, f.Generation, err != nil) {
+			c.recorder.Warnf(f, record.EventOptions{EventReason: conditions.InvalidRolloutFreezeReason}, conditions.InvalidRolloutFreezeMessage, err)
+		}
+	}
+	for _, f := range clusterFreezes {
+		err := freeze.ValidateSpec(f.Spec)
+		if c.invalidFreezes.needsReport("ClusterRolloutFreeze/"+f.Name, f.Generation, err != nil) {
+			c.recorder.Warnf(f, record.EventOptions{EventReason: conditions.InvalidRolloutFreezeReason}, conditions.InvalidRolloutFreezeMessage, err)
+		}
+	}
+}
+
+// isUpdateInProgress returns whether the rollout is moving to a revision other than the stable one
+func (c *rolloutContext) isUpdateInProgress() bool {
+	return c.newRS == nil || c.stableRS == nil || c.newRS.Name != c.stableRS.Name
+}
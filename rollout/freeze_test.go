@@ -0,0 +1,262 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/approval"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// alwaysFrozen returns a freeze whose window covers any point in time
+func alwaysFrozen() *v1alpha1.RolloutFreeze {
+	return &v1alpha1.RolloutFreeze{
+		ObjectMeta: metav1.ObjectMeta{Name: "always", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.RolloutFreezeSpec{
+			Windows: []v1alpha1.FreezeWindow{{Schedule: "* * * * *", Duration: "1h"}},
+		},
+	}
+}
+
+func TestCanaryRolloutFrozen(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{{
+		SetWeight: int32Ptr(10),
+	}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	rf := alwaysFrozen()
+	f.rolloutFreezeLister = append(f.rolloutFreezeLister, rf)
+	f.objects = append(f.objects, rf)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 1, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	// the step is complete, but the rollout is held at it
+	patch := f.getPatchedRollout(patchIndex)
+	assert.Contains(t, patch, `"pauseConditions":[{"reason":"RolloutFreeze"`)
+	assert.Contains(t, patch, `"phase":"Paused"`)
+	assert.NotContains(t, patch, `"currentStepIndex"`)
+}
+
+func TestCanaryRolloutFreezeLifted(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{{
+		SetWeight: int32Ptr(10),
+	}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 1, 10, true)
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason:    v1alpha1.PauseReasonRolloutFreeze,
+		StartTime: metav1.Now(),
+	}}
+	r2.Status.ControllerPause = true
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	assert.Contains(t, patch, `"pauseConditions":null`)
+	assert.Contains(t, patch, `"currentStepIndex":1`)
+}
+
+// runFreezeLiftedAtStep reconciles a rollout whose freeze window ended while it was held at the
+// given step and returns the patch of the rollout
+func runFreezeLiftedAtStep(t *testing.T, f *fixture, step v1alpha1.CanaryStep) string {
+	steps := []v1alpha1.CanaryStep{
+		{
+			SetWeight: int32Ptr(10),
+		},
+		step,
+		{
+			SetWeight: int32Ptr(20),
+		},
+	}
+	r1 := newCanaryRollout("foo", 1, nil, steps, int32Ptr(1), intstr.FromInt(1), intstr.FromInt(1))
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 1, 1, 1, true)
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason:    v1alpha1.PauseReasonRolloutFreeze,
+		StartTime: metav1.Now(),
+	}}
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+	return f.getPatchedRollout(patchIndex)
+}
+
+func TestCanaryPauseStepAfterFreezeLifted(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	patch := runFreezeLiftedAtStep(t, f, v1alpha1.CanaryStep{Pause: &v1alpha1.RolloutPause{}})
+	assert.Contains(t, patch, `"pauseConditions":[{"reason":"CanaryPauseStep"`)
+	assert.NotContains(t, patch, `"currentStepIndex"`)
+}
+
+func TestCanaryTimeWindowStepAfterFreezeLifted(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	patch := runFreezeLiftedAtStep(t, f, v1alpha1.CanaryStep{TimeWindow: timeWindowFromNow(2*time.Hour, 3*time.Hour)})
+	assert.Contains(t, patch, `"pauseConditions":[{"reason":"CanaryPauseStep"`)
+	assert.NotContains(t, patch, `"currentStepIndex"`)
+}
+
+func TestCanaryApprovalStepAfterFreezeLifted(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	f.approvalSender.answer = approval.Answer{Phase: v1alpha1.ApprovalPhasePending}
+	patch := runFreezeLiftedAtStep(t, f, v1alpha1.CanaryStep{Approval: &v1alpha1.RolloutApprovalStep{URL: "https://change-management.example.com/approve"}})
+	assert.Contains(t, patch, `"pauseConditions":[{"reason":"CanaryApprovalStep"`)
+	assert.NotContains(t, patch, `"currentStepIndex"`)
+	assert.Len(t, f.approvalSender.requests, 1)
+}
+
+func TestReconcileFreezeNotUpdating(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1 := newCanaryRollout("foo", 10, nil, nil, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	r1.Status.StableRS = rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	f.rolloutFreezeLister = append(f.rolloutFreezeLister, alwaysFrozen())
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+	f.rolloutLister = append(f.rolloutLister, r1)
+	f.objects = append(f.objects, r1)
+
+	c, i, k8sI := f.newController(noResyncPeriodFunc)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	i.Start(stopCh)
+	k8sI.Start(stopCh)
+
+	roCtx, err := c.newRolloutContext(r1)
+	assert.NoError(t, err)
+	frozen, err := roCtx.reconcileFreeze()
+	assert.NoError(t, err)
+	assert.False(t, frozen)
+
+	// an aborted update is not frozen either, so it can return to stable
+	r2 := bumpVersion(r1)
+	r2.Status.Abort = true
+	roCtx, err = c.newRolloutContext(r2)
+	assert.NoError(t, err)
+	frozen, err = roCtx.reconcileFreeze()
+	assert.NoError(t, err)
+	assert.False(t, frozen)
+
+	r2.Status.Abort = false
+	roCtx, err = c.newRolloutContext(r2)
+	assert.NoError(t, err)
+	frozen, err = roCtx.reconcileFreeze()
+	assert.NoError(t, err)
+	assert.True(t, frozen)
+}
+
+func TestReconcileFreezeReportsInvalidFreezes(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1 := newCanaryRollout("foo", 10, nil, nil, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r2 := bumpVersion(r1)
+	invalid := alwaysFrozen()
+	invalid.Spec.Windows = append(invalid.Spec.Windows, v1alpha1.FreezeWindow{Schedule: "0 17 * fri", Duration: "1h"})
+	clusterInvalid := &v1alpha1.ClusterRolloutFreeze{
+		ObjectMeta: metav1.ObjectMeta{Name: "empty"},
+	}
+	f.rolloutFreezeLister = append(f.rolloutFreezeLister, invalid)
+	f.clusterRolloutFreezeLister = append(f.clusterRolloutFreezeLister, clusterInvalid)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	c, i, k8sI := f.newController(noResyncPeriodFunc)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	i.Start(stopCh)
+	k8sI.Start(stopCh)
+
+	roCtx, err := c.newRolloutContext(r2)
+	assert.NoError(t, err)
+	frozen, err := roCtx.reconcileFreeze()
+	assert.NoError(t, err)
+	// the valid window of the invalid freeze still applies
+	assert.True(t, frozen)
+	recorder := c.recorder.(*record.FakeEventRecorder)
+	assert.Equal(t, []string{conditions.InvalidRolloutFreezeReason, conditions.InvalidRolloutFreezeReason, conditions.RolloutFrozenReason}, recorder.Events)
+
+	// the freezes are reported once per generation
+	recorder.Events = nil
+	_, err = roCtx.reconcileFreeze()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{conditions.RolloutFrozenReason}, recorder.Events)
+
+	recorder.Events = nil
+	invalid.Generation++
+	_, err = roCtx.reconcileFreeze()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{conditions.InvalidRolloutFreezeReason, conditions.RolloutFrozenReason}, recorder.Events)
+}
+
+func TestCalculatePauseStatusKeepsFreeze(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	startTime := metav1.NewTime(metav1.Now().Add(-time.Hour))
+	ro.Status.PauseConditions = []v1alpha1.PauseCondition{
+		{Reason: v1alpha1.PauseReasonCanaryPauseStep, StartTime: startTime},
+		{Reason: v1alpha1.PauseReasonRolloutFreeze, StartTime: startTime},
+	}
+	pCtx := &pauseContext{rollout: ro}
+	pCtx.ClearPauseConditions()
+	newStatus := v1alpha1.RolloutStatus{}
+	pCtx.CalculatePauseStatus(&newStatus)
+	assert.True(t, newStatus.ControllerPause)
+	assert.Equal(t, []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonRolloutFreeze, StartTime: startTime}}, newStatus.PauseConditions)
+
+	pCtx = &pauseContext{rollout: ro}
+	pCtx.ClearPauseConditions()
+	pCtx.RemovePauseCondition(v1alpha1.PauseReasonRolloutFreeze)
+	newStatus = v1alpha1.RolloutStatus{}
+	pCtx.CalculatePauseStatus(&newStatus)
+	assert.Nil(t, newStatus.PauseConditions)
+}
@@ -0,0 +1,237 @@
+package rollout
+
+import (
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/controller"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	hookutil "github.com/argoproj/argo-rollouts/utils/hook"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// reconcileHooks tracks the Jobs of the hooks which run for the current revision and creates the
+// Jobs of the hooks which were triggered since the last reconciliation. A PreRollout hook which
+// aborted the update is run again when the update is retried.
+func (c *rolloutContext) reconcileHooks() error {
+	if c.rollout.Spec.Hooks == nil && len(c.rollout.Status.Hooks) == 0 {
+		return nil
+	}
+	podHash := c.currentPodHash()
+	var statuses []v1alpha1.RolloutHookStatus
+	for _, status := range c.rollout.Status.Hooks {
+		// hooks of previous revisions are no longer tracked
+		if status.PodTemplateHash == podHash {
+			statuses = append(statuses, status)
+		}
+	}
+	for i := range statuses {
+		if err := c.reconcileHookJob(&statuses[i]); err != nil {
+			return err
+		}
+	}
+	c.newStatus.Hooks = statuses
+	return nil
+}
+
+// reconcilePreRolloutHooks runs the PreRollout hooks of a new pod template. Returns true while the
+// creation of the new ReplicaSet is held by PreRollout hooks which did not complete successfully.
+func (c *rolloutContext) reconcilePreRolloutHooks() (bool, error) {
+	hooks := hookutil.GetHooks(c.rollout, v1alpha1.HookTypePreRollout)
+	if len(hooks) == 0 || c.newRS != nil {
+		return false, nil
+	}
+	podHash := c.currentPodHash()
+	held := false
+	for _, hook := range hooks {
+		status := hookutil.GetHookStatus(c.newStatus.Hooks, v1alpha1.HookTypePreRollout, hook.Name, podHash)
+		if status == nil {
+			if c.rollout.Status.Abort {
+				held = true
+				continue
+			}
+			c.newStatus.Hooks = append(c.newStatus.Hooks, newHookStatus(v1alpha1.HookTypePreRollout, hook.Name, podHash))
+			status = &c.newStatus.Hooks[len(c.newStatus.Hooks)-1]
+			if err := c.reconcileHookJob(status); err != nil {
+				return false, err
+			}
+		}
+		if status.Phase == v1alpha1.AnalysisPhaseSuccessful {
+			continue
+		}
+		if status.Phase.Completed() && hookutil.FailurePolicy(hook) == v1alpha1.HookFailurePolicyIgnore {
+			continue
+		}
+		held = true
+	}
+	if held {
+		c.log.Info("Waiting for PreRollout hooks to complete")
+	}
+	return held, nil
+}
+
+// triggerHooks records the hooks of the given type as pending for the current revision. Their Jobs
+// are created by the next reconciliation. Hooks which already ran for the revision are not run again,
+// except for PostAbort hooks, which run again when an update which was retried is aborted again.
+func (c *rolloutContext) triggerHooks(newStatus *v1alpha1.RolloutStatus, hookType v1alpha1.HookType) {
+	for _, hook := range hookutil.GetHooks(c.rollout, hookType) {
+		var occurrence int32
+		if status := hookutil.GetHookStatus(newStatus.Hooks, hookType, hook.Name, newStatus.CurrentPodHash); status != nil {
+			// a PostAbort hook already runs for the abort if the rollout was aborted before this
+			// reconciliation
+			if hookType != v1alpha1.HookTypePostAbort || c.rollout.Status.AbortedAt != nil {
+				continue
+			}
+			occurrence = status.Occurrence
+			newStatus.Hooks = removeHookStatus(newStatus.Hooks, *status)
+		}
+		c.log.Infof("Triggering %s hook '%s'", hookType, hook.Name)
+		hookStatus := newHookStatus(hookType, hook.Name, newStatus.CurrentPodHash)
+		if hookType == v1alpha1.HookTypePostAbort {
+			hookStatus.Occurrence = occurrence + 1
+		}
+		newStatus.Hooks = append(newStatus.Hooks, hookStatus)
+	}
+}
+
+// removeHookStatus returns the statuses without the status of the given run of a hook
+func removeHookStatus(statuses []v1alpha1.RolloutHookStatus, status v1alpha1.RolloutHookStatus) []v1alpha1.RolloutHookStatus {
+	var remaining []v1alpha1.RolloutHookStatus
+	for _, s := range statuses {
+		if s.Type != status.Type || s.Name != status.Name || s.PodTemplateHash != status.PodTemplateHash {
+			remaining = append(remaining, s)
+		}
+	}
+	return remaining
+}
+
+func newHookStatus(hookType v1alpha1.HookType, name, podHash string) v1alpha1.RolloutHookStatus {
+	return v1alpha1.RolloutHookStatus{
+		Name:            name,
+		Type:            hookType,
+		PodTemplateHash: podHash,
+		Phase:           v1alpha1.AnalysisPhasePending,
+	}
+}
+
+// reconcileHookJob creates the Job of a pending hook, or updates the status of a running hook from
+// its Job
+func (c *rolloutContext) reconcileHookJob(status *v1alpha1.RolloutHookStatus) error {
+	hook := hookutil.GetHook(c.rollout, status.Type, status.Name)
+	if hook == nil {
+		// the hook was removed from the spec, its Job is left to complete on its own
+		return nil
+	}
+	if status.Phase == v1alpha1.AnalysisPhaseFailed && c.abortsOnFailure(status.Type, *hook) && !c.rollout.Status.Abort {
+		// the update was retried after the hook aborted it
+		c.log.Infof("Retrying %s hook '%s'", status.Type, status.Name)
+		if err := c.deleteHookJob(status.JobName); err != nil {
+			return err
+		}
+		status.Phase = v1alpha1.AnalysisPhasePending
+		status.Message = ""
+	}
+	switch status.Phase {
+	case v1alpha1.AnalysisPhasePending:
+		return c.createHookJob(*hook, status)
+	case v1alpha1.AnalysisPhaseRunning:
+		job, err := c.hookJobLister.Jobs(c.rollout.Namespace).Get(status.JobName)
+		if k8serrors.IsNotFound(err) {
+			// the informer may not have observed the Job yet
+			job, err = c.kubeclientset.BatchV1().Jobs(c.rollout.Namespace).Get(c.ctx, status.JobName, metav1.GetOptions{})
+		}
+		if k8serrors.IsNotFound(err) {
+			c.setHookPhase(*hook, status, v1alpha1.AnalysisPhaseFailed, fmt.Sprintf("Job %s not found", status.JobName))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		phase, message := hookutil.JobPhase(job)
+		c.setHookPhase(*hook, status, phase, message)
+	}
+	return nil
+}
+
+// createHookJob creates the Job of the next attempt of a pending hook
+func (c *rolloutContext) createHookJob(hook v1alpha1.RolloutHook, status *v1alpha1.RolloutHookStatus) error {
+	ctx := c.ctx
+	attempt := 1
+	if status.JobName != "" {
+		attempt = hookutil.JobAttempt(status.JobName) + 1
+	}
+	job := hookutil.NewJob(c.rollout, status.Type, hook, status.PodTemplateHash, status.Occurrence, attempt)
+	jobIf := c.kubeclientset.BatchV1().Jobs(c.rollout.Namespace)
+	createdJob, err := jobIf.Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		if !k8serrors.IsAlreadyExists(err) {
+			return err
+		}
+		existingJob, getErr := jobIf.Get(ctx, job.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		if controllerRef := metav1.GetControllerOf(existingJob); controllerRef == nil || controllerRef.UID != c.rollout.UID {
+			return err
+		}
+		c.log.Infof("Duplicate hook job create detected %s", job.Name)
+		createdJob = existingJob
+	}
+	c.log.Infof("Created Job %s of %s hook '%s'", createdJob.Name, status.Type, status.Name)
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutHookStartedReason}, conditions.RolloutHookStartedMessage, status.Type, status.Name, createdJob.Name)
+	status.JobName = createdJob.Name
+	status.Phase = v1alpha1.AnalysisPhaseRunning
+	status.Message = ""
+	phase, message := hookutil.JobPhase(createdJob)
+	c.setHookPhase(hook, status, phase, message)
+	return nil
+}
+
+// setHookPhase records the phase of the Job of a hook. A failed hook which aborts on failure aborts
+// the update.
+func (c *rolloutContext) setHookPhase(hook v1alpha1.RolloutHook, status *v1alpha1.RolloutHookStatus, phase v1alpha1.AnalysisPhase, message string) {
+	if status.Phase == phase {
+		return
+	}
+	status.Phase = phase
+	status.Message = message
+	switch phase {
+	case v1alpha1.AnalysisPhaseSuccessful:
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutHookCompletedReason}, conditions.RolloutHookCompletedMessage, status.Type, status.Name)
+	case v1alpha1.AnalysisPhaseFailed:
+		c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutHookFailedReason}, conditions.RolloutHookFailedMessage, status.Type, status.Name, message)
+		if c.abortsOnFailure(status.Type, hook) {
+			c.pauseContext.AddAbort(conditions.RolloutHookFailedReason, fmt.Sprintf(conditions.RolloutHookFailedMessage, status.Type, status.Name, message))
+		}
+	}
+}
+
+// abortsOnFailure returns whether a failure of the hook aborts the update. Only PreRollout hooks
+// can abort, since the other hooks run once the update is already complete or aborted.
+func (c *rolloutContext) abortsOnFailure(hookType v1alpha1.HookType, hook v1alpha1.RolloutHook) bool {
+	return hookType == v1alpha1.HookTypePreRollout && hookutil.FailurePolicy(hook) == v1alpha1.HookFailurePolicyAbort
+}
+
+func (c *rolloutContext) deleteHookJob(jobName string) error {
+	if jobName == "" {
+		return nil
+	}
+	foregroundDelete := metav1.DeletePropagationForeground
+	err := c.kubeclientset.BatchV1().Jobs(c.rollout.Namespace).Delete(c.ctx, jobName, metav1.DeleteOptions{PropagationPolicy: &foregroundDelete})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// currentPodHash returns the pod template hash of the current revision, which is computed from the
+// pod template while the ReplicaSet of the revision does not exist yet
+func (c *rolloutContext) currentPodHash() string {
+	if c.newRS != nil {
+		return c.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	}
+	return controller.ComputeHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+}
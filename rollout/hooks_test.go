@@ -0,0 +1,322 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	hookutil "github.com/argoproj/argo-rollouts/utils/hook"
+)
+
+func newPreRolloutHookRollout(failurePolicy v1alpha1.HookFailurePolicy) (*v1alpha1.Rollout, *v1alpha1.Rollout) {
+	steps := []v1alpha1.CanaryStep{{
+		SetWeight: int32Ptr(10),
+	}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.Hooks = &v1alpha1.RolloutHooks{
+		PreRollout: []v1alpha1.RolloutHook{{
+			Name:          "migrate",
+			FailurePolicy: failurePolicy,
+			Job: v1alpha1.JobMetric{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers:    []corev1.Container{{Name: "migrate", Image: "migrate:1.0"}},
+							RestartPolicy: corev1.RestartPolicyNever,
+						},
+					},
+				},
+			},
+		}},
+	}
+	r2 := bumpVersion(r1)
+	return r1, r2
+}
+
+func newHookJob(r *v1alpha1.Rollout, podHash string, conditionType batchv1.JobConditionType) *batchv1.Job {
+	hook := r.Spec.Hooks.PreRollout[0]
+	job := hookutil.NewJob(r, v1alpha1.HookTypePreRollout, hook, podHash, 0, 1)
+	if conditionType != "" {
+		job.Status.Conditions = []batchv1.JobCondition{{
+			Type:    conditionType,
+			Status:  corev1.ConditionTrue,
+			Message: "Job has reached the specified backoff limit",
+		}}
+	}
+	return job
+}
+
+func TestPreRolloutHookCreatesJobAndHoldsUpdate(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2 := newPreRolloutHookRollout("")
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 10, 10, false)
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	rs2PodHash := newReplicaSet(r2, 1).Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	createdJobIndex := f.expectCreateJobAction(newHookJob(r2, rs2PodHash, ""))
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	createdJob := f.getCreatedJob(createdJobIndex)
+	assert.Equal(t, "foo-"+rs2PodHash+"-migrate", createdJob.Name)
+	assert.Equal(t, "migrate", createdJob.Labels[hookutil.HookNameLabelKey])
+
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Len(t, patchedRollout.Status.Hooks, 1)
+	hookStatus := patchedRollout.Status.Hooks[0]
+	assert.Equal(t, v1alpha1.HookTypePreRollout, hookStatus.Type)
+	assert.Equal(t, rs2PodHash, hookStatus.PodTemplateHash)
+	assert.Equal(t, createdJob.Name, hookStatus.JobName)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, hookStatus.Phase)
+}
+
+func TestPreRolloutHookSuccessfulCreatesReplicaSet(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2 := newPreRolloutHookRollout("")
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 0)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	job := newHookJob(r2, rs2PodHash, batchv1.JobComplete)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 10, 10, false)
+	r2.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+		Name:            "migrate",
+		Type:            v1alpha1.HookTypePreRollout,
+		PodTemplateHash: rs2PodHash,
+		JobName:         job.Name,
+		Phase:           v1alpha1.AnalysisPhaseRunning,
+	}}
+	f.kubeobjects = append(f.kubeobjects, rs1, job)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+	f.jobLister = append(f.jobLister, job)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	createdRSIndex := f.expectCreateReplicaSetAction(rs2)
+	f.expectUpdateRolloutStatusAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	createdRS := f.getCreatedReplicaSet(createdRSIndex)
+	assert.Equal(t, rs2PodHash, createdRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, patchedRollout.Status.Hooks[0].Phase)
+}
+
+func TestPreRolloutHookFailedAbortsUpdate(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2 := newPreRolloutHookRollout("")
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := newReplicaSet(r2, 1).Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	job := newHookJob(r2, rs2PodHash, batchv1.JobFailed)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 10, 10, false)
+	r2.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+		Name:            "migrate",
+		Type:            v1alpha1.HookTypePreRollout,
+		PodTemplateHash: rs2PodHash,
+		JobName:         job.Name,
+		Phase:           v1alpha1.AnalysisPhaseRunning,
+	}}
+	f.kubeobjects = append(f.kubeobjects, rs1, job)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+	f.jobLister = append(f.jobLister, job)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patchedRollout.Status.Abort)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, patchedRollout.Status.Hooks[0].Phase)
+	assert.Equal(t, "Job has reached the specified backoff limit", patchedRollout.Status.Hooks[0].Message)
+}
+
+func TestPreRolloutHookFailedIgnored(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2 := newPreRolloutHookRollout(v1alpha1.HookFailurePolicyIgnore)
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 0)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	job := newHookJob(r2, rs2PodHash, batchv1.JobFailed)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 10, 10, false)
+	r2.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+		Name:            "migrate",
+		Type:            v1alpha1.HookTypePreRollout,
+		PodTemplateHash: rs2PodHash,
+		JobName:         job.Name,
+		Phase:           v1alpha1.AnalysisPhaseRunning,
+	}}
+	f.kubeobjects = append(f.kubeobjects, rs1, job)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+	f.jobLister = append(f.jobLister, job)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	f.expectCreateReplicaSetAction(rs2)
+	f.expectUpdateRolloutStatusAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.False(t, patchedRollout.Status.Abort)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, patchedRollout.Status.Hooks[0].Phase)
+}
+
+func TestPreRolloutHookRetriedAfterAbort(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2 := newPreRolloutHookRollout("")
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := newReplicaSet(r2, 1).Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	job := newHookJob(r2, rs2PodHash, batchv1.JobFailed)
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 10, 10, false)
+	r2.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+		Name:            "migrate",
+		Type:            v1alpha1.HookTypePreRollout,
+		PodTemplateHash: rs2PodHash,
+		JobName:         job.Name,
+		Phase:           v1alpha1.AnalysisPhaseFailed,
+	}}
+	f.kubeobjects = append(f.kubeobjects, rs1, job)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+	f.jobLister = append(f.jobLister, job)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	f.expectDeleteJobAction(job)
+	createdJobIndex := f.expectCreateJobAction(job)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	createdJob := f.getCreatedJob(createdJobIndex)
+	assert.Equal(t, job.Name+".2", createdJob.Name)
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, createdJob.Name, patchedRollout.Status.Hooks[0].JobName)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, patchedRollout.Status.Hooks[0].Phase)
+}
+
+func newPostAbortHookRollout() (*v1alpha1.Rollout, *v1alpha1.Rollout) {
+	steps := []v1alpha1.CanaryStep{{
+		SetWeight: int32Ptr(10),
+	}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.Hooks = &v1alpha1.RolloutHooks{
+		PostAbort: []v1alpha1.RolloutHook{{
+			Name: "notify",
+			Job: v1alpha1.JobMetric{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers:    []corev1.Container{{Name: "notify", Image: "notifier:1.0"}},
+							RestartPolicy: corev1.RestartPolicyNever,
+						},
+					},
+				},
+			},
+		}},
+	}
+	r2 := bumpVersion(r1)
+	return r1, r2
+}
+
+func TestPostAbortHookTriggeredForEveryAbort(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2 := newPostAbortHookRollout()
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 1, 10, false)
+	// the hook ran for the first abort of the revision, then the update was retried and is now
+	// aborted again
+	r2.Status.Abort = true
+	r2.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+		Name:            "notify",
+		Type:            v1alpha1.HookTypePostAbort,
+		PodTemplateHash: rs2PodHash,
+		JobName:         hookutil.JobName(r2, r2.Spec.Hooks.PostAbort[0], rs2PodHash, 1, 1),
+		Phase:           v1alpha1.AnalysisPhaseSuccessful,
+		Occurrence:      1,
+	}}
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	f.expectUpdateReplicaSetAction(rs2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, []v1alpha1.RolloutHookStatus{{
+		Name:            "notify",
+		Type:            v1alpha1.HookTypePostAbort,
+		PodTemplateHash: rs2PodHash,
+		Phase:           v1alpha1.AnalysisPhasePending,
+		Occurrence:      2,
+	}}, patchedRollout.Status.Hooks)
+}
+
+func TestPostAbortHookCreatesJobForAbort(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1, r2 := newPostAbortHookRollout()
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 0, 0)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 0, 10, false)
+	r2.Status.Abort = true
+	now := metav1.Now()
+	r2.Status.AbortedAt = &now
+	r2.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+		Name:            "notify",
+		Type:            v1alpha1.HookTypePostAbort,
+		PodTemplateHash: rs2PodHash,
+		Phase:           v1alpha1.AnalysisPhasePending,
+		Occurrence:      2,
+	}}
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	createdJobIndex := f.expectCreateJobAction(hookutil.NewJob(r2, v1alpha1.HookTypePostAbort, r2.Spec.Hooks.PostAbort[0], rs2PodHash, 2, 1))
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	createdJob := f.getCreatedJob(createdJobIndex)
+	assert.Equal(t, "foo-"+rs2PodHash+"-notify.abort-2", createdJob.Name)
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	// the hook is not triggered again while the rollout stays aborted
+	assert.Len(t, patchedRollout.Status.Hooks, 1)
+	assert.Equal(t, createdJob.Name, patchedRollout.Status.Hooks[0].JobName)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, patchedRollout.Status.Hooks[0].Phase)
+	assert.Equal(t, int32(2), patchedRollout.Status.Hooks[0].Occurrence)
+}
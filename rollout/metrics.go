@@ -0,0 +1,202 @@
+package rollout
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const (
+	// promotedByUser is the actor of the promotions which were requested by a user, i.e. the resume
+	// of a paused rollout or a full promotion
+	promotedByUser = "user"
+	// promotedByController is the actor of the promotions of the completed updates to stable
+	promotedByController = "controller"
+	// abortedByUser is the abort reason of the rollouts which were aborted by a user
+	abortedByUser = "User"
+)
+
+// rolloutProgress is the progress of a rollout which was last persisted by the controller
+type rolloutProgress struct {
+	podHash         string
+	stepIndex       *int32
+	stepStartedAt   time.Time
+	updateStartedAt time.Time
+	pauseConditions []v1alpha1.PauseCondition
+	// steps are the timings of the steps of the current revision which the rollout moved past
+	steps []v1alpha1.RevisionStep
+}
+
+// progressTracker remembers the progress of the rollouts which was last persisted by the controller,
+// so that the time spent in the steps and pauses is measured when the rollouts move past them, even
+// when a user resumes the rollout. It is kept in memory, so the steps in progress when the controller
+// starts are not measured.
+type progressTracker struct {
+	lock     sync.Mutex
+	rollouts map[string]rolloutProgress
+}
+
+func newProgressTracker() *progressTracker {
+	return &progressTracker{
+		rollouts: make(map[string]rolloutProgress),
+	}
+}
+
+func (t *progressTracker) get(key string) (rolloutProgress, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	progress, ok := t.rollouts[key]
+	return progress, ok
+}
+
+func (t *progressTracker) set(key string, progress rolloutProgress) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.rollouts[key] = progress
+}
+
+func (t *progressTracker) delete(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.rollouts, key)
+}
+
+// forgetRollout drops the progress of a rollout which was deleted or is reconciled by another shard
+func (c *Controller) forgetRollout(namespace, name string) {
+	c.metricsServer.RemoveRollout(namespace, name)
+	c.progressTracker.delete(namespace + "/" + name)
+}
+
+// recordProgressMetrics observes the durations of the steps, pauses and updates which the rollout
+// moved past, and counts its promotions and aborts, once the new status is persisted
+func (c *rolloutContext) recordProgressMetrics(prevStatus, newStatus *v1alpha1.RolloutStatus) {
+	now := nowFn()
+	key := c.rollout.Namespace + "/" + c.rollout.Name
+	tracked, ok := c.progressTracker.get(key)
+	if !ok {
+		tracked = rolloutProgress{
+			podHash:         prevStatus.CurrentPodHash,
+			stepIndex:       prevStatus.CurrentStepIndex,
+			pauseConditions: prevStatus.PauseConditions,
+		}
+	}
+	progress := rolloutProgress{
+		podHash:         newStatus.CurrentPodHash,
+		stepIndex:       newStatus.CurrentStepIndex,
+		stepStartedAt:   tracked.stepStartedAt,
+		updateStartedAt: tracked.updateStartedAt,
+		pauseConditions: newStatus.PauseConditions,
+		steps:           tracked.steps,
+	}
+
+	if tracked.podHash != newStatus.CurrentPodHash {
+		progress.stepStartedAt = now
+		progress.updateStartedAt = now
+		progress.steps = nil
+	} else if !int32PtrEqual(tracked.stepIndex, newStatus.CurrentStepIndex) {
+		if step, stepType := trackedCanaryStep(c.rollout, tracked.stepIndex); step != nil && !tracked.stepStartedAt.IsZero() &&
+			newStatus.CurrentStepIndex != nil && *newStatus.CurrentStepIndex > *tracked.stepIndex {
+			c.metricsServer.ObserveRolloutStep(c.rollout, *tracked.stepIndex, stepType, now.Sub(tracked.stepStartedAt))
+			progress.steps = append(progress.steps, v1alpha1.RevisionStep{
+				Index:      *tracked.stepIndex,
+				Type:       stepType,
+				StartedAt:  metav1.NewTime(tracked.stepStartedAt),
+				FinishedAt: metav1.NewTime(now),
+			})
+		}
+		progress.stepStartedAt = now
+	}
+
+	// the pause conditions which were cleared by a user are only known by the tracker
+	for _, cond := range tracked.pauseConditions {
+		if !hasPauseCondition(newStatus.PauseConditions, cond) {
+			c.metricsServer.ObserveRolloutPause(c.rollout, cond.Reason, now.Sub(cond.StartTime.Time))
+		}
+	}
+
+	if prevStatus.ControllerPause && len(prevStatus.PauseConditions) == 0 && !prevStatus.Abort {
+		c.metricsServer.IncRolloutPromotion(c.rollout, promotedByUser)
+	}
+	if prevStatus.StableRS != "" && prevStatus.StableRS != newStatus.StableRS && newStatus.StableRS == newStatus.CurrentPodHash {
+		if startedAt := c.updateStartedAt(progress); !startedAt.IsZero() {
+			c.metricsServer.ObserveRolloutUpdate(c.rollout, now.Sub(startedAt))
+		}
+		c.metricsServer.IncRolloutPromotion(c.rollout, promotionActor(prevStatus))
+	}
+
+	if prevStatus.AbortedAt == nil && newStatus.AbortedAt != nil {
+		c.metricsServer.IncRolloutAbort(c.rollout, c.abortReason())
+	}
+
+	c.progressTracker.set(key, progress)
+}
+
+// updateStartedAt returns when the update to the new ReplicaSet started, or the zero time if unknown
+func (c *rolloutContext) updateStartedAt(progress rolloutProgress) time.Time {
+	// a ReplicaSet which is reused by a rollback was created long before the update started
+	startedAt := progress.updateStartedAt
+	if c.newRS != nil && c.newRS.CreationTimestamp.After(startedAt) {
+		startedAt = c.newRS.CreationTimestamp.Time
+	}
+	return startedAt
+}
+
+// promotionActor returns who promoted the new ReplicaSet to stable
+func promotionActor(prevStatus *v1alpha1.RolloutStatus) string {
+	if prevStatus.PromoteFull {
+		return promotedByUser
+	}
+	return promotedByController
+}
+
+// abortReason returns the reason of an abort which happened during this reconciliation
+func (c *rolloutContext) abortReason() string {
+	if c.pauseContext.addAbort {
+		return c.pauseContext.abortReason
+	}
+	return abortedByUser
+}
+
+// trackedCanaryStep returns the canary step at the index and its type, or nil if there is no such step
+func trackedCanaryStep(ro *v1alpha1.Rollout, index *int32) (*v1alpha1.CanaryStep, string) {
+	if ro.Spec.Strategy.Canary == nil || index == nil || int(*index) >= len(ro.Spec.Strategy.Canary.Steps) {
+		return nil, ""
+	}
+	step := &ro.Spec.Strategy.Canary.Steps[*index]
+	switch {
+	case step.SetWeight != nil:
+		return step, "setWeight"
+	case step.Pause != nil:
+		return step, "pause"
+	case step.Experiment != nil:
+		return step, "experiment"
+	case step.Analysis != nil:
+		return step, "analysis"
+	case step.SetCanaryScale != nil:
+		return step, "setCanaryScale"
+	case step.TimeWindow != nil:
+		return step, "timeWindow"
+	case step.Approval != nil:
+		return step, "approval"
+	}
+	return step, "unknown"
+}
+
+func hasPauseCondition(conds []v1alpha1.PauseCondition, cond v1alpha1.PauseCondition) bool {
+	for _, c := range conds {
+		if c.Reason == cond.Reason && c.StartTime.Equal(&cond.StartTime) {
+			return true
+		}
+	}
+	return false
+}
+
+func int32PtrEqual(left, right *int32) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+	return *left == *right
+}
@@ -0,0 +1,118 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/controller/metrics"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+)
+
+func newProgressRolloutContext(r *v1alpha1.Rollout) *rolloutContext {
+	return &rolloutContext{
+		rollout: r,
+		log:     logutil.WithRollout(r),
+		reconcilerBase: reconcilerBase{
+			metricsServer: metrics.NewMetricsServer(metrics.ServerConfig{
+				K8SRequestProvider: &metrics.K8sRequestsCountProvider{},
+			}),
+			progressTracker: newProgressTracker(),
+		},
+		pauseContext: &pauseContext{
+			rollout: r,
+		},
+	}
+}
+
+func histogramSamples(t *testing.T, observer prometheus.Observer) (uint64, float64) {
+	t.Helper()
+	metric := &dto.Metric{}
+	assert.NoError(t, observer.(prometheus.Histogram).Write(metric))
+	return metric.GetHistogram().GetSampleCount(), metric.GetHistogram().GetSampleSum()
+}
+
+func TestRecordProgressMetrics(t *testing.T) {
+	now := time.Now()
+	defer func() { nowFn = time.Now }()
+	nowFn = func() time.Time { return now }
+
+	steps := []v1alpha1.CanaryStep{{SetWeight: int32Ptr(10)}, {Pause: &v1alpha1.RolloutPause{}}, {SetWeight: int32Ptr(50)}}
+	r := newCanaryRollout("progress-metrics", 1, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	roCtx := newProgressRolloutContext(r)
+
+	// a new revision starts the first step
+	prevStatus := v1alpha1.RolloutStatus{CurrentPodHash: "stable", StableRS: "stable"}
+	newStatus := v1alpha1.RolloutStatus{CurrentPodHash: "canary", StableRS: "stable", CurrentStepIndex: int32Ptr(0)}
+	roCtx.recordProgressMetrics(&prevStatus, &newStatus)
+
+	// the rollout completes the first step and pauses at the second one
+	now = now.Add(30 * time.Second)
+	pauseCond := v1alpha1.PauseCondition{Reason: v1alpha1.PauseReasonCanaryPauseStep, StartTime: metav1.NewTime(now)}
+	prevStatus = newStatus
+	newStatus = v1alpha1.RolloutStatus{CurrentPodHash: "canary", StableRS: "stable", CurrentStepIndex: int32Ptr(1), ControllerPause: true, PauseConditions: []v1alpha1.PauseCondition{pauseCond}}
+	roCtx.recordProgressMetrics(&prevStatus, &newStatus)
+	count, sum := histogramSamples(t, metrics.MetricRolloutStepDuration.WithLabelValues("default", r.Name, "0", "setWeight"))
+	assert.Equal(t, uint64(1), count)
+	assert.Equal(t, float64(30), sum)
+
+	// a user resumes the rollout by clearing the pause condition
+	now = now.Add(time.Minute)
+	prevStatus = v1alpha1.RolloutStatus{CurrentPodHash: "canary", StableRS: "stable", CurrentStepIndex: int32Ptr(1), ControllerPause: true}
+	newStatus = v1alpha1.RolloutStatus{CurrentPodHash: "canary", StableRS: "stable", CurrentStepIndex: int32Ptr(2)}
+	roCtx.recordProgressMetrics(&prevStatus, &newStatus)
+	count, sum = histogramSamples(t, metrics.MetricRolloutPauseDuration.WithLabelValues("default", r.Name, string(v1alpha1.PauseReasonCanaryPauseStep)))
+	assert.Equal(t, uint64(1), count)
+	assert.Equal(t, float64(60), sum)
+	count, _ = histogramSamples(t, metrics.MetricRolloutStepDuration.WithLabelValues("default", r.Name, "1", "pause"))
+	assert.Equal(t, uint64(1), count)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutPromotionsTotal.WithLabelValues("default", r.Name, promotedByUser)))
+
+	// the controller promotes the revision once all the steps are completed
+	now = now.Add(time.Minute)
+	prevStatus = newStatus
+	newStatus = v1alpha1.RolloutStatus{CurrentPodHash: "canary", StableRS: "canary", CurrentStepIndex: int32Ptr(3)}
+	roCtx.recordProgressMetrics(&prevStatus, &newStatus)
+	count, sum = histogramSamples(t, metrics.MetricRolloutUpdateDuration.WithLabelValues("default", r.Name, "canary"))
+	assert.Equal(t, uint64(1), count)
+	assert.Equal(t, float64(150), sum)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutPromotionsTotal.WithLabelValues("default", r.Name, promotedByController)))
+}
+
+func TestRecordProgressMetricsUnknownStepStart(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: int32Ptr(10)}, {SetWeight: int32Ptr(50)}}
+	r := newCanaryRollout("progress-metrics-restart", 1, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	roCtx := newProgressRolloutContext(r)
+
+	// the controller did not see the start of the step
+	prevStatus := v1alpha1.RolloutStatus{CurrentPodHash: "canary", StableRS: "stable", CurrentStepIndex: int32Ptr(0)}
+	newStatus := v1alpha1.RolloutStatus{CurrentPodHash: "canary", StableRS: "stable", CurrentStepIndex: int32Ptr(1)}
+	roCtx.recordProgressMetrics(&prevStatus, &newStatus)
+	count, _ := histogramSamples(t, metrics.MetricRolloutStepDuration.WithLabelValues("default", r.Name, "0", "setWeight"))
+	assert.Equal(t, uint64(0), count)
+}
+
+func TestRecordProgressMetricsAbort(t *testing.T) {
+	r := newCanaryRollout("progress-metrics-abort", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	now := metav1.Now()
+
+	roCtx := newProgressRolloutContext(r)
+	roCtx.pauseContext.AddAbort(conditions.RolloutAnalysisRunFailedReason, "metric failed")
+	roCtx.recordProgressMetrics(&v1alpha1.RolloutStatus{}, &v1alpha1.RolloutStatus{Abort: true, AbortedAt: &now})
+	// the rollout stays aborted
+	roCtx.recordProgressMetrics(&v1alpha1.RolloutStatus{Abort: true, AbortedAt: &now}, &v1alpha1.RolloutStatus{Abort: true, AbortedAt: &now})
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutAbortsTotal.WithLabelValues("default", r.Name, conditions.RolloutAnalysisRunFailedReason)))
+
+	// the abort is requested by a user
+	roCtx = newProgressRolloutContext(r)
+	roCtx.recordProgressMetrics(&v1alpha1.RolloutStatus{Abort: true}, &v1alpha1.RolloutStatus{Abort: true, AbortedAt: &now})
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutAbortsTotal.WithLabelValues("default", r.Name, abortedByUser)))
+}
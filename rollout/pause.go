@@ -7,6 +7,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/timewindow"
 )
 
 type pauseContext struct {
@@ -18,6 +19,7 @@ type pauseContext struct {
 	clearPauseConditions bool
 	addAbort             bool
 	removeAbort          bool
+	abortReason          string
 	abortMessage         string
 }
 
@@ -35,8 +37,9 @@ func (pCtx *pauseContext) IsAborted() bool {
 	return false
 }
 
-func (pCtx *pauseContext) AddAbort(message string) {
+func (pCtx *pauseContext) AddAbort(reason, message string) {
 	pCtx.addAbort = true
+	pCtx.abortReason = reason
 	pCtx.abortMessage = message
 }
 
@@ -55,6 +58,39 @@ func (pCtx *pauseContext) ClearPauseConditions() {
 	pCtx.clearPauseConditions = true
 }
 
+// IsFrozen returns whether the rollout is held by a freeze window
+func (pCtx *pauseContext) IsFrozen() bool {
+	for _, reason := range pCtx.removePauseReasons {
+		if reason == v1alpha1.PauseReasonRolloutFreeze {
+			return false
+		}
+	}
+	for _, reason := range pCtx.addPauseReasons {
+		if reason == v1alpha1.PauseReasonRolloutFreeze {
+			return true
+		}
+	}
+	return getPauseCondition(pCtx.rollout, v1alpha1.PauseReasonRolloutFreeze) != nil
+}
+
+// IsControllerPaused returns whether the controller paused the rollout. A rollout which was only
+// paused by a freeze window which is being lifted counts as not paused, so that the step it was held
+// at pauses the rollout itself instead of taking the lifted freeze for a resume by a user.
+func (pCtx *pauseContext) IsControllerPaused() bool {
+	if !pCtx.rollout.Status.ControllerPause {
+		return false
+	}
+	if getPauseCondition(pCtx.rollout, v1alpha1.PauseReasonRolloutFreeze) == nil || pCtx.IsFrozen() {
+		return true
+	}
+	for _, cond := range pCtx.rollout.Status.PauseConditions {
+		if cond.Reason != v1alpha1.PauseReasonRolloutFreeze {
+			return true
+		}
+	}
+	return false
+}
+
 func (pCtx *pauseContext) CalculatePauseStatus(newStatus *v1alpha1.RolloutStatus) {
 	now := metav1.Now()
 	// if we are already aborted, preserve the original timestamp, otherwise we'll cause a
@@ -77,6 +113,16 @@ func (pCtx *pauseContext) CalculatePauseStatus(newStatus *v1alpha1.RolloutStatus
 	newStatus.AbortedAt = nil
 
 	if pCtx.clearPauseConditions {
+		// a freeze window outlasts the pause conditions of the update, e.g. when a new revision is
+		// started while the rollout is frozen
+		if pCtx.IsFrozen() {
+			cond := getPauseCondition(pCtx.rollout, v1alpha1.PauseReasonRolloutFreeze)
+			if cond == nil {
+				cond = &v1alpha1.PauseCondition{Reason: v1alpha1.PauseReasonRolloutFreeze, StartTime: now}
+			}
+			newStatus.ControllerPause = true
+			newStatus.PauseConditions = []v1alpha1.PauseCondition{*cond}
+		}
 		return
 	}
 
@@ -173,7 +219,7 @@ func (pCtx *pauseContext) CompletedBlueGreenPause() bool {
 		return false
 	} else {
 		// autoPromotion is disabled. the presence of a pause condition means human has not resumed it
-		if rollout.Status.ControllerPause {
+		if pCtx.IsControllerPaused() {
 			return pauseCond == nil
 		}
 		// status.controllerPause has not yet been set
@@ -185,7 +231,7 @@ func (pCtx *pauseContext) CompletedCanaryPauseStep(pause v1alpha1.RolloutPause)
 	rollout := pCtx.rollout
 	pauseCondition := getPauseCondition(rollout, v1alpha1.PauseReasonCanaryPauseStep)
 
-	if rollout.Status.ControllerPause && pauseCondition == nil {
+	if pCtx.IsControllerPaused() && pauseCondition == nil {
 		pCtx.log.Info("Rollout has been unpaused")
 		return true
 	} else if pause.Duration != nil {
@@ -201,6 +247,29 @@ func (pCtx *pauseContext) CompletedCanaryPauseStep(pause v1alpha1.RolloutPause)
 	return false
 }
 
+// CompletedCanaryTimeWindowStep returns true if the rollout has been resumed by a human or if the
+// time window of the step is open
+func (pCtx *pauseContext) CompletedCanaryTimeWindowStep(window v1alpha1.RolloutTimeWindow) bool {
+	rollout := pCtx.rollout
+	pauseCondition := getPauseCondition(rollout, v1alpha1.PauseReasonCanaryPauseStep)
+
+	if pCtx.IsControllerPaused() && pauseCondition == nil {
+		pCtx.log.Info("Rollout has been unpaused")
+		return true
+	}
+	now := time.Now()
+	opensAt, err := timewindow.NextOpen(window, now)
+	if err != nil {
+		pCtx.log.Warnf("Unable to evaluate time window: %v", err)
+		return false
+	}
+	if opensAt.After(now) {
+		return false
+	}
+	pCtx.log.Info("Rollout is within the time window of the step")
+	return true
+}
+
 func (c *rolloutContext) checkEnqueueRolloutDuringWait(startTime metav1.Time, durationInSeconds int32) {
 	now := metav1.Now()
 	expiredTime := startTime.Add(time.Duration(durationInSeconds) * time.Second)
@@ -0,0 +1,577 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	smifake "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	kubetesting "k8s.io/client-go/testing"
+	k8srecord "k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-rollouts/controller/metrics"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	rolloutscheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
+	"github.com/argoproj/argo-rollouts/utils/approval"
+	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// planResyncPeriod is the resync period of the controller of a planned reconciliation. The
+// controller only requeues rollouts which wait for less than the resync period, so it is long
+// enough for the plan to report any wait.
+const planResyncPeriod = 365 * 24 * time.Hour
+
+// PlanConfig holds the clients which the live objects of a rollout are read from, in order to plan
+// its reconciliation. The clients are only used for reads.
+type PlanConfig struct {
+	KubeClientSet     kubernetes.Interface
+	ArgoProjClientset clientset.Interface
+	DynamicClientSet  dynamic.Interface
+	SmiClientSet      smiclientset.Interface
+}
+
+// PlannedAction is a change which the controller would make to a resource
+type PlannedAction struct {
+	// Verb is the kind of change, e.g. create, scale, patch, delete, setWeight or requestApproval
+	Verb string
+	// Kind is the kind of the changed resource, or the type of the traffic router for setWeight
+	Kind string
+	// Name is the name of the changed resource
+	Name string
+	// Detail describes the change, e.g. the patch, or the replicas a ReplicaSet is scaled to
+	Detail string
+}
+
+// Plan is the outcome of a single reconciliation of a rollout against a copy of its live objects
+type Plan struct {
+	// Actions are the changes which the controller would make, in order
+	Actions []PlannedAction
+	// Events are the events which the controller would record, e.g. "Normal RolloutPaused Rollout is paused"
+	Events []string
+	// Requeue is whether the controller would reconcile the rollout again after RequeueAfter,
+	// without waiting for a change to the rollout or its resources
+	Requeue      bool
+	RequeueAfter time.Duration
+	// Error is the error the reconciliation would fail with
+	Error error
+}
+
+// PlanReconciliation runs a single reconciliation of a rollout against fake clients seeded with
+// the live objects of the rollout, and reports what the controller would do next without writing
+// anything to the cluster.
+func PlanReconciliation(ctx context.Context, cfg PlanConfig, namespace, name string) (*Plan, error) {
+	objs, err := getPlanObjects(ctx, cfg, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	p, err := newPlanner(cfg, objs)
+	if err != nil {
+		return nil, err
+	}
+	defer p.shutdown()
+	reconcileErr := p.controller.syncHandler(namespace + "/" + name)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.plan.Events = p.recorder.events
+	p.plan.Error = reconcileErr
+	return &p.plan, nil
+}
+
+// planObjects are the live objects of a rollout which a planned reconciliation is run against
+type planObjects struct {
+	rollout         *v1alpha1.Rollout
+	kubeObjects     []runtime.Object
+	argoProjObjects []runtime.Object
+	dynamicObjects  []runtime.Object
+	smiObjects      []runtime.Object
+}
+
+// planList lists live objects of a kind and appends them to objs
+type planList struct {
+	objs *[]runtime.Object
+	list func() (runtime.Object, error)
+}
+
+func getPlanObjects(ctx context.Context, cfg PlanConfig, namespace, name string) (*planObjects, error) {
+	ro, err := cfg.ArgoProjClientset.ArgoprojV1alpha1().Rollouts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	objs := planObjects{
+		rollout:         ro,
+		argoProjObjects: []runtime.Object{ro},
+	}
+	// the ReplicaSets and pods of workload reference rollouts are listed by the selector of the
+	// workload, which is only known once the rollout is resolved
+	resolved := ro.DeepCopy()
+	if err := (&planTemplateResolver{dynamicClient: cfg.DynamicClientSet}).Resolve(resolved); err != nil {
+		return nil, err
+	}
+	if resolved.Spec.Selector == nil {
+		return nil, fmt.Errorf("rollout '%s' has no selector", ro.Name)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(resolved.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	selectorOpts := metav1.ListOptions{LabelSelector: selector.String()}
+	revisionOpts := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{v1alpha1.RolloutRevisionRolloutLabel: ro.Name}).String(),
+	}
+
+	kubeclientset := cfg.KubeClientSet
+	argoprojclientset := cfg.ArgoProjClientset.ArgoprojV1alpha1()
+	lists := []planList{
+		{&objs.kubeObjects, func() (runtime.Object, error) {
+			return kubeclientset.AppsV1().ReplicaSets(namespace).List(ctx, selectorOpts)
+		}},
+		{&objs.kubeObjects, func() (runtime.Object, error) {
+			return kubeclientset.CoreV1().Pods(namespace).List(ctx, selectorOpts)
+		}},
+		{&objs.kubeObjects, func() (runtime.Object, error) {
+			return kubeclientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+		}},
+		{&objs.kubeObjects, func() (runtime.Object, error) {
+			return kubeclientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+		}},
+		{&objs.argoProjObjects, func() (runtime.Object, error) {
+			return argoprojclientset.AnalysisRuns(namespace).List(ctx, metav1.ListOptions{})
+		}},
+		{&objs.argoProjObjects, func() (runtime.Object, error) {
+			return argoprojclientset.Experiments(namespace).List(ctx, metav1.ListOptions{})
+		}},
+		{&objs.argoProjObjects, func() (runtime.Object, error) {
+			return argoprojclientset.AnalysisTemplates(namespace).List(ctx, metav1.ListOptions{})
+		}},
+		{&objs.argoProjObjects, func() (runtime.Object, error) {
+			return argoprojclientset.ClusterAnalysisTemplates().List(ctx, metav1.ListOptions{})
+		}},
+		{&objs.argoProjObjects, func() (runtime.Object, error) {
+			return argoprojclientset.RolloutFreezes(namespace).List(ctx, metav1.ListOptions{})
+		}},
+		{&objs.argoProjObjects, func() (runtime.Object, error) {
+			return argoprojclientset.ClusterRolloutFreezes().List(ctx, metav1.ListOptions{})
+		}},
+		{&objs.argoProjObjects, func() (runtime.Object, error) {
+			return argoprojclientset.RolloutRevisions(namespace).List(ctx, revisionOpts)
+		}},
+	}
+
+	canary := ro.Spec.Strategy.Canary
+	if canary != nil && canary.TrafficRouting != nil {
+		trafficRouting := canary.TrafficRouting
+		if trafficRouting.Nginx != nil || trafficRouting.ALB != nil {
+			// the canary ingresses are managed by the controller, next to the stable ingress
+			lists = append(lists, planList{&objs.kubeObjects, func() (runtime.Object, error) {
+				return kubeclientset.ExtensionsV1beta1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+			}})
+		}
+		if trafficRouting.Ambassador != nil {
+			// the canary mappings are managed by the controller, next to the referenced mappings
+			lists = append(lists, planList{&objs.dynamicObjects, func() (runtime.Object, error) {
+				return cfg.DynamicClientSet.Resource(ambassador.GetMappingGVR()).Namespace(namespace).List(ctx, metav1.ListOptions{})
+			}})
+		}
+		if trafficRouting.Istio != nil {
+			vsvcNamespace, vsvcName := istioutil.GetVirtualServiceNamespaceName(trafficRouting.Istio.VirtualService.Name)
+			if vsvcNamespace == "" {
+				vsvcNamespace = namespace
+			}
+			vsvc, err := cfg.DynamicClientSet.Resource(istioutil.GetIstioVirtualServiceGVR()).Namespace(vsvcNamespace).Get(ctx, vsvcName, metav1.GetOptions{})
+			if err = appendPlanObject(&objs.dynamicObjects, vsvc, err); err != nil {
+				return nil, err
+			}
+			if trafficRouting.Istio.DestinationRule != nil {
+				dRule, err := cfg.DynamicClientSet.Resource(istioutil.GetIstioDestinationRuleGVR()).Namespace(namespace).Get(ctx, trafficRouting.Istio.DestinationRule.Name, metav1.GetOptions{})
+				if err = appendPlanObject(&objs.dynamicObjects, dRule, err); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if trafficRouting.SMI != nil {
+			trafficSplitName := trafficRouting.SMI.TrafficSplitName
+			if trafficSplitName == "" {
+				trafficSplitName = ro.Name
+			}
+			// the plan runs with the default TrafficSplit API version of the controller
+			ts, err := cfg.SmiClientSet.SplitV1alpha1().TrafficSplits(namespace).Get(ctx, trafficSplitName, metav1.GetOptions{})
+			if err = appendPlanObject(&objs.smiObjects, ts, err); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, l := range lists {
+		list, err := l.list()
+		if err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		*l.objs = append(*l.objs, items...)
+	}
+	return &objs, nil
+}
+
+// appendPlanObject appends an object which was read from the cluster to the objects of a plan.
+// Missing objects are left out, so that the reconciliation reports them as the controller would.
+func appendPlanObject(objs *[]runtime.Object, obj runtime.Object, err error) error {
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	*objs = append(*objs, obj)
+	return nil
+}
+
+// planner runs a reconciliation of a rollout with a controller whose clients record the changes
+// in a plan instead of making them
+type planner struct {
+	lock       sync.Mutex
+	plan       Plan
+	controller *Controller
+	recorder   *planEventRecorder
+	queues     []workqueue.Interface
+	// replicas are the replicas of the ReplicaSets, updated as the plan scales them
+	replicas map[string]int32
+}
+
+func newPlanner(cfg PlanConfig, objs *planObjects) (*planner, error) {
+	p := &planner{
+		recorder: &planEventRecorder{k8sRecorder: k8srecord.NewFakeRecorder(100)},
+		replicas: map[string]int32{},
+	}
+	for _, obj := range objs.kubeObjects {
+		if rs, ok := obj.(*appsv1.ReplicaSet); ok && rs.Spec.Replicas != nil {
+			p.replicas[rs.Name] = *rs.Spec.Replicas
+		}
+	}
+
+	kubeclientset := k8sfake.NewSimpleClientset(objs.kubeObjects...)
+	argoprojclientset := fake.NewSimpleClientset(objs.argoProjObjects...)
+	dynamicclientset := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objs.dynamicObjects...)
+	smiclientset := smifake.NewSimpleClientset(objs.smiObjects...)
+	for _, f := range []*kubetesting.Fake{&kubeclientset.Fake, &argoprojclientset.Fake, &dynamicclientset.Fake, &smiclientset.Fake} {
+		f.PrependReactor("*", "*", p.recordAction)
+	}
+
+	i := informers.NewSharedInformerFactory(argoprojclientset, 0)
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeclientset, 0)
+	dynamicInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(dynamicclientset, 0)
+	rolloutWorkqueue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Rollouts")
+	serviceWorkqueue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Services")
+	ingressWorkqueue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "Ingresses")
+	p.queues = []workqueue.Interface{rolloutWorkqueue, serviceWorkqueue, ingressWorkqueue}
+
+	c := NewController(ControllerConfig{
+		Namespace:                       objs.rollout.Namespace,
+		ResyncPeriod:                    planResyncPeriod,
+		KubeClientSet:                   kubeclientset,
+		ArgoProjClientset:               argoprojclientset,
+		DynamicClientSet:                dynamicclientset,
+		RefResolver:                     &planTemplateResolver{dynamicClient: cfg.DynamicClientSet},
+		SmiClientSet:                    smiclientset,
+		ExperimentInformer:              i.Argoproj().V1alpha1().Experiments(),
+		AnalysisRunInformer:             i.Argoproj().V1alpha1().AnalysisRuns(),
+		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
+		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		RolloutFreezeInformer:           i.Argoproj().V1alpha1().RolloutFreezes(),
+		ClusterRolloutFreezeInformer:    i.Argoproj().V1alpha1().ClusterRolloutFreezes(),
+		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
+		ServicesInformer:                k8sI.Core().V1().Services(),
+		SecretsInformer:                 k8sI.Core().V1().Secrets(),
+		IngressInformer:                 k8sI.Extensions().V1beta1().Ingresses(),
+		HookJobInformer:                 k8sI.Batch().V1().Jobs(),
+		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
+		IstioPrimaryDynamicClient:       dynamicclientset,
+		IstioVirtualServiceInformer:     dynamicInformerFactory.ForResource(istioutil.GetIstioVirtualServiceGVR()).Informer(),
+		IstioDestinationRuleInformer:    dynamicInformerFactory.ForResource(istioutil.GetIstioDestinationRuleGVR()).Informer(),
+		RolloutWorkQueue:                rolloutWorkqueue,
+		ServiceWorkQueue:                serviceWorkqueue,
+		IngressWorkQueue:                ingressWorkqueue,
+		MetricsServer: metrics.NewMetricsServer(metrics.ServerConfig{
+			K8SRequestProvider: &metrics.K8sRequestsCountProvider{},
+		}),
+		Recorder: p.recorder,
+	})
+	c.enqueueRollout = func(obj interface{}) {
+		p.requeue(0)
+	}
+	c.enqueueRolloutAfter = func(obj interface{}, duration time.Duration) {
+		p.requeue(duration)
+	}
+	c.podRestarter.enqueueAfter = c.enqueueRolloutAfter
+	c.approvalSender = &planApprovalSender{planner: p}
+	c.newTrafficRoutingReconciler = func(roCtx *rolloutContext) (TrafficRoutingReconciler, error) {
+		reconciler, err := c.NewTrafficRoutingReconciler(roCtx)
+		if err != nil || reconciler == nil {
+			return reconciler, err
+		}
+		return &planTrafficRoutingReconciler{TrafficRoutingReconciler: reconciler, planner: p}, nil
+	}
+	p.controller = c
+
+	// the informers are not started. Their caches are filled with the live objects instead.
+	for _, obj := range append(objs.kubeObjects, objs.argoProjObjects...) {
+		var indexer interface{ Add(obj interface{}) error }
+		switch obj.(type) {
+		case *appsv1.ReplicaSet:
+			indexer = k8sI.Apps().V1().ReplicaSets().Informer().GetIndexer()
+		case *corev1.Service:
+			indexer = k8sI.Core().V1().Services().Informer().GetIndexer()
+		case *v1beta1.Ingress:
+			indexer = k8sI.Extensions().V1beta1().Ingresses().Informer().GetIndexer()
+		case *batchv1.Job:
+			indexer = k8sI.Batch().V1().Jobs().Informer().GetIndexer()
+		case *v1alpha1.Rollout:
+			indexer = i.Argoproj().V1alpha1().Rollouts().Informer().GetIndexer()
+		case *v1alpha1.AnalysisRun:
+			indexer = i.Argoproj().V1alpha1().AnalysisRuns().Informer().GetIndexer()
+		case *v1alpha1.Experiment:
+			indexer = i.Argoproj().V1alpha1().Experiments().Informer().GetIndexer()
+		case *v1alpha1.AnalysisTemplate:
+			indexer = i.Argoproj().V1alpha1().AnalysisTemplates().Informer().GetIndexer()
+		case *v1alpha1.ClusterAnalysisTemplate:
+			indexer = i.Argoproj().V1alpha1().ClusterAnalysisTemplates().Informer().GetIndexer()
+		case *v1alpha1.RolloutFreeze:
+			indexer = i.Argoproj().V1alpha1().RolloutFreezes().Informer().GetIndexer()
+		case *v1alpha1.ClusterRolloutFreeze:
+			indexer = i.Argoproj().V1alpha1().ClusterRolloutFreezes().Informer().GetIndexer()
+		default:
+			continue
+		}
+		if err := indexer.Add(obj); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *planner) shutdown() {
+	for _, queue := range p.queues {
+		queue.ShutDown()
+	}
+}
+
+func (p *planner) addAction(action PlannedAction) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.plan.Actions = append(p.plan.Actions, action)
+}
+
+// requeue records that the controller would reconcile the rollout again after the duration
+func (p *planner) requeue(duration time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if !p.plan.Requeue || duration < p.plan.RequeueAfter {
+		p.plan.Requeue = true
+		p.plan.RequeueAfter = duration
+	}
+}
+
+// recordAction is a reactor of the fake clients, which records the changes the controller makes.
+// The changes are still passed on to the fake clients, since the controller may read them back.
+func (p *planner) recordAction(action kubetesting.Action) (bool, runtime.Object, error) {
+	gvr := action.GetResource()
+	planned := PlannedAction{Verb: action.GetVerb()}
+	// create and update actions have the same methods, so they are told apart by their verbs
+	switch action.GetVerb() {
+	case "create":
+		obj := action.(kubetesting.CreateAction).GetObject()
+		planned.Kind = objectKind(obj, gvr)
+		planned.Name = objectName(obj)
+		switch o := obj.(type) {
+		case *policyv1beta1.Eviction:
+			planned.Verb = "evict"
+			planned.Kind = "Pod"
+		case *appsv1.ReplicaSet:
+			if o.Spec.Replicas != nil {
+				planned.Detail = fmt.Sprintf("%d replicas", *o.Spec.Replicas)
+				p.setReplicas(o.Name, *o.Spec.Replicas)
+			}
+		}
+	case "update":
+		obj := action.(kubetesting.UpdateAction).GetObject()
+		planned.Kind = objectKind(obj, gvr)
+		planned.Name = objectName(obj)
+		if rs, ok := obj.(*appsv1.ReplicaSet); ok && rs.Spec.Replicas != nil {
+			if replicas := p.setReplicas(rs.Name, *rs.Spec.Replicas); replicas != *rs.Spec.Replicas {
+				planned.Verb = "scale"
+				planned.Detail = fmt.Sprintf("from %d to %d", replicas, *rs.Spec.Replicas)
+			}
+		}
+	case "patch":
+		a := action.(kubetesting.PatchAction)
+		planned.Kind = resourceKind(gvr)
+		planned.Name = a.GetName()
+		planned.Detail = string(a.GetPatch())
+	case "delete":
+		planned.Kind = resourceKind(gvr)
+		planned.Name = action.(kubetesting.DeleteAction).GetName()
+	default:
+		// reads are not changes
+		return false, nil, nil
+	}
+	if subresource := action.GetSubresource(); subresource != "" && planned.Verb != "evict" {
+		planned.Verb = planned.Verb + " " + subresource
+	}
+	p.addAction(planned)
+	return false, nil, nil
+}
+
+// setReplicas records the replicas of a ReplicaSet and returns its previous replicas
+func (p *planner) setReplicas(name string, replicas int32) int32 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	prev, ok := p.replicas[name]
+	p.replicas[name] = replicas
+	if !ok {
+		return replicas
+	}
+	return prev
+}
+
+func objectName(obj runtime.Object) string {
+	if objMeta, err := meta.Accessor(obj); err == nil {
+		return objMeta.GetName()
+	}
+	return ""
+}
+
+func objectKind(obj runtime.Object, gvr schema.GroupVersionResource) string {
+	if un, ok := obj.(*unstructured.Unstructured); ok && un.GetKind() != "" {
+		return un.GetKind()
+	}
+	if t := reflect.TypeOf(obj); t != nil && t.Kind() == reflect.Ptr {
+		return t.Elem().Name()
+	}
+	return resourceKind(gvr)
+}
+
+// resourceKind returns the kind of a resource known to the client schemes, or the resource itself
+func resourceKind(gvr schema.GroupVersionResource) string {
+	for _, s := range []*runtime.Scheme{scheme.Scheme, rolloutscheme.Scheme} {
+		for gvk := range s.AllKnownTypes() {
+			if gvk.Group != gvr.Group || gvk.Version != gvr.Version {
+				continue
+			}
+			if plural, _ := meta.UnsafeGuessKindToResource(gvk); plural == gvr {
+				return gvk.Kind
+			}
+		}
+	}
+	return gvr.Resource
+}
+
+// planTrafficRoutingReconciler records the canary weights which are set during a planned reconciliation
+type planTrafficRoutingReconciler struct {
+	TrafficRoutingReconciler
+	planner *planner
+}
+
+func (r *planTrafficRoutingReconciler) SetWeight(desiredWeight int32) error {
+	r.planner.addAction(PlannedAction{
+		Verb:   "setWeight",
+		Kind:   r.Type(),
+		Detail: strconv.Itoa(int(desiredWeight)),
+	})
+	return r.TrafficRoutingReconciler.SetWeight(desiredWeight)
+}
+
+// planApprovalSender records the approval requests of a planned reconciliation instead of sending
+// them. The requests are never answered, so the approval steps stay pending.
+type planApprovalSender struct {
+	planner *planner
+}
+
+func (s *planApprovalSender) Send(key string, url string, headers map[string]string, req approval.Request, done func()) {
+	s.planner.addAction(PlannedAction{
+		Verb:   "requestApproval",
+		Kind:   "Rollout",
+		Name:   req.Rollout,
+		Detail: url,
+	})
+}
+
+func (s *planApprovalSender) Answer(key string) (approval.Answer, bool) {
+	return approval.Answer{}, false
+}
+
+// planEventRecorder records the events of a planned reconciliation instead of sending them, so
+// that neither events nor notifications are sent
+type planEventRecorder struct {
+	lock        sync.Mutex
+	events      []string
+	k8sRecorder k8srecord.EventRecorder
+}
+
+func (r *planEventRecorder) Eventf(object runtime.Object, opts record.EventOptions, messageFmt string, args ...interface{}) {
+	if opts.EventType == "" {
+		opts.EventType = corev1.EventTypeNormal
+	}
+	r.record(opts, messageFmt, args...)
+}
+
+func (r *planEventRecorder) Warnf(object runtime.Object, opts record.EventOptions, messageFmt string, args ...interface{}) {
+	opts.EventType = corev1.EventTypeWarning
+	r.record(opts, messageFmt, args...)
+}
+
+func (r *planEventRecorder) record(opts record.EventOptions, messageFmt string, args ...interface{}) {
+	if opts.EventReason == "" {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, fmt.Sprintf("%s %s %s", opts.EventType, opts.EventReason, fmt.Sprintf(messageFmt, args...)))
+}
+
+func (r *planEventRecorder) K8sRecorder() k8srecord.EventRecorder {
+	return r.k8sRecorder
+}
+
+// planTemplateResolver resolves the workload references of rollouts from the live workloads
+type planTemplateResolver struct {
+	dynamicClient dynamic.Interface
+}
+
+func (r *planTemplateResolver) Resolve(rollout *v1alpha1.Rollout) error {
+	return resolveWorkloadRef(rollout, func(gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		return r.dynamicClient.Resource(gvr).Namespace(rollout.Namespace).Get(context.TODO(), rollout.Spec.WorkloadRef.Name, metav1.GetOptions{})
+	})
+}
@@ -0,0 +1,244 @@
+package rollout
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	smifake "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+type planFixture struct {
+	kubeclientset     *k8sfake.Clientset
+	argoprojclientset *fake.Clientset
+	smiclientset      *smifake.Clientset
+	dynamicclientset  *dynamicfake.FakeDynamicClient
+}
+
+func newPlanFixture(kubeObjects []runtime.Object, argoProjObjects []runtime.Object) *planFixture {
+	return &planFixture{
+		kubeclientset:     k8sfake.NewSimpleClientset(kubeObjects...),
+		argoprojclientset: fake.NewSimpleClientset(argoProjObjects...),
+		smiclientset:      smifake.NewSimpleClientset(),
+		dynamicclientset:  dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+	}
+}
+
+func (f *planFixture) plan(t *testing.T, name string) *Plan {
+	cfg := PlanConfig{
+		KubeClientSet:     f.kubeclientset,
+		ArgoProjClientset: f.argoprojclientset,
+		DynamicClientSet:  f.dynamicclientset,
+		SmiClientSet:      f.smiclientset,
+	}
+	plan, err := PlanReconciliation(context.TODO(), cfg, metav1.NamespaceDefault, name)
+	assert.NoError(t, err)
+	return plan
+}
+
+// verifyReadOnly verifies that the live clients were only read from
+func (f *planFixture) verifyReadOnly(t *testing.T) {
+	var actions []kubetesting.Action
+	actions = append(actions, f.kubeclientset.Actions()...)
+	actions = append(actions, f.argoprojclientset.Actions()...)
+	actions = append(actions, f.smiclientset.Actions()...)
+	actions = append(actions, f.dynamicclientset.Actions()...)
+	for _, action := range actions {
+		assert.Contains(t, []string{"get", "list"}, action.GetVerb(), "unexpected %s of %s", action.GetVerb(), action.GetResource().Resource)
+	}
+}
+
+func findPlannedAction(plan *Plan, verb, kind string) *PlannedAction {
+	for i := range plan.Actions {
+		if plan.Actions[i].Verb == verb && plan.Actions[i].Kind == kind {
+			return &plan.Actions[i]
+		}
+	}
+	return nil
+}
+
+func TestPlanReconciliationCreatesReplicaSet(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}, {Pause: &v1alpha1.RolloutPause{}}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	r1.Status.StableRS = rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 := bumpVersion(r1)
+	r2.Annotations[annotations.RevisionAnnotation] = "1"
+
+	f := newPlanFixture([]runtime.Object{rs1}, []runtime.Object{r2})
+	plan := f.plan(t, "foo")
+	f.verifyReadOnly(t)
+	assert.NoError(t, plan.Error)
+
+	created := findPlannedAction(plan, "create", "ReplicaSet")
+	if assert.NotNil(t, created) {
+		assert.Equal(t, "1 replicas", created.Detail)
+		assert.NotEqual(t, rs1.Name, created.Name)
+	}
+	patched := findPlannedAction(plan, "patch status", "Rollout")
+	if assert.NotNil(t, patched) {
+		assert.Equal(t, "foo", patched.Name)
+	}
+	assert.Contains(t, plan.Events, "Normal RolloutUpdated Rollout updated to revision 2")
+}
+
+func TestPlanReconciliationScalesReplicaSet(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(50)}, {Pause: &v1alpha1.RolloutPause{}}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(0), intstr.FromInt(1))
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 11, 1, 11, false)
+
+	f := newPlanFixture([]runtime.Object{rs1, rs2}, []runtime.Object{r2})
+	plan := f.plan(t, "foo")
+	f.verifyReadOnly(t)
+	assert.NoError(t, plan.Error)
+
+	scaled := findPlannedAction(plan, "scale", "ReplicaSet")
+	if assert.NotNil(t, scaled) {
+		assert.Equal(t, rs1.Name, scaled.Name)
+		assert.Equal(t, "from 10 to 8", scaled.Detail)
+	}
+}
+
+func TestPlanReconciliationSetsWeight(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}, {Pause: &v1alpha1.RolloutPause{}}}
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.Strategy.Canary.CanaryService = "canary"
+	r1.Spec.Strategy.Canary.StableService = "stable"
+	r1.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		SMI: &v1alpha1.SMITrafficRouting{},
+	}
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 2, 1, 2, false)
+	canarySvc := newService("canary", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash}, r2)
+	stableSvc := newService("stable", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}, r2)
+
+	f := newPlanFixture([]runtime.Object{rs1, rs2, canarySvc, stableSvc}, []runtime.Object{r2})
+	plan := f.plan(t, "foo")
+	f.verifyReadOnly(t)
+	assert.NoError(t, plan.Error)
+
+	setWeight := findPlannedAction(plan, "setWeight", smi.Type)
+	if assert.NotNil(t, setWeight) {
+		assert.Equal(t, "10", setWeight.Detail)
+	}
+	assert.NotNil(t, findPlannedAction(plan, "create", "TrafficSplit"))
+}
+
+func TestPlanReconciliationRequeuesTimedPause(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}, {Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromInt(3600)}}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 10, 1, 10, true)
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason:    v1alpha1.PauseReasonCanaryPauseStep,
+		StartTime: metav1.Now(),
+	}}
+
+	f := newPlanFixture([]runtime.Object{rs1, rs2}, []runtime.Object{r2})
+	plan := f.plan(t, "foo")
+	f.verifyReadOnly(t)
+	assert.NoError(t, plan.Error)
+	assert.True(t, plan.Requeue)
+	assert.True(t, plan.RequeueAfter > 59*time.Minute && plan.RequeueAfter <= time.Hour, "unexpected requeue after %s", plan.RequeueAfter)
+	assert.Nil(t, findPlannedAction(plan, "scale", "ReplicaSet"))
+}
+
+func TestPlanReconciliationDoesNotSendApprovalRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("approval request was sent")
+	}))
+	defer server.Close()
+
+	r1 := newApprovalStepRollout(server.URL)
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2 = updateCanaryRolloutStatus(r2, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 1, 1, 1, false)
+
+	f := newPlanFixture([]runtime.Object{rs1, rs2}, []runtime.Object{r2})
+	plan := f.plan(t, "foo")
+	f.verifyReadOnly(t)
+	assert.NoError(t, plan.Error)
+
+	requested := findPlannedAction(plan, "requestApproval", "Rollout")
+	if assert.NotNil(t, requested) {
+		assert.Equal(t, "foo", requested.Name)
+		assert.Equal(t, server.URL, requested.Detail)
+	}
+	patched := findPlannedAction(plan, "patch status", "Rollout")
+	if assert.NotNil(t, patched) {
+		assert.Contains(t, patched.Detail, `"pauseConditions":[{"reason":"CanaryApprovalStep"`)
+		assert.Contains(t, patched.Detail, `"phase":"Pending"`)
+	}
+}
+
+func TestPlanReconciliationListsByWorkloadSelector(t *testing.T) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}, {Pause: &v1alpha1.RolloutPause{}}}
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: r1.Namespace},
+		Spec: appsv1.DeploymentSpec{
+			Selector: r1.Spec.Selector,
+			Template: r1.Spec.Template,
+		},
+	}
+	r1.Spec.Selector = nil
+	r1.Spec.Template = corev1.PodTemplateSpec{}
+	r1.Spec.WorkloadRef = &v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "foo"}
+
+	f := newPlanFixture(nil, []runtime.Object{r1})
+	f.dynamicclientset = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), mustToUnstructured(deployment))
+	plan := f.plan(t, "foo")
+	f.verifyReadOnly(t)
+	assert.NoError(t, plan.Error)
+
+	// the ReplicaSets and pods are listed by the selector of the deployment
+	listed := 0
+	for _, action := range f.kubeclientset.Actions() {
+		if action.GetResource().Resource == "replicasets" || action.GetResource().Resource == "pods" {
+			restrictions := action.(kubetesting.ListAction).GetListRestrictions()
+			assert.Equal(t, metav1.FormatLabelSelector(deployment.Spec.Selector), restrictions.Labels.String(), "unexpected selector of %s", action.GetResource().Resource)
+			listed++
+		}
+	}
+	assert.Equal(t, 2, listed)
+}
+
+func TestPlanReconciliationRolloutNotFound(t *testing.T) {
+	f := newPlanFixture(nil, nil)
+	cfg := PlanConfig{
+		KubeClientSet:     f.kubeclientset,
+		ArgoProjClientset: f.argoprojclientset,
+		DynamicClientSet:  f.dynamicclientset,
+		SmiClientSet:      f.smiclientset,
+	}
+	_, err := PlanReconciliation(context.TODO(), cfg, metav1.NamespaceDefault, "foo")
+	assert.EqualError(t, err, `rollouts.argoproj.io "foo" not found`)
+}
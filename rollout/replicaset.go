@@ -26,7 +26,7 @@ const (
 
 // removeScaleDownDelay removes the `scale-down-deadline` annotation from the ReplicaSet (if it exists)
 func (c *rolloutContext) removeScaleDownDelay(rs *appsv1.ReplicaSet) error {
-	ctx := context.TODO()
+	ctx := c.ctx
 	if !replicasetutil.HasScaleDownDeadline(rs) {
 		return nil
 	}
@@ -40,12 +40,11 @@ func (c *rolloutContext) removeScaleDownDelay(rs *appsv1.ReplicaSet) error {
 
 // addScaleDownDelay injects the `scale-down-deadline` annotation to the ReplicaSet, or if
 // scaleDownDelaySeconds is zero, removes it if it exists
-func (c *rolloutContext) addScaleDownDelay(rs *appsv1.ReplicaSet) error {
+func (c *rolloutContext) addScaleDownDelay(rs *appsv1.ReplicaSet, scaleDownDelaySeconds time.Duration) error {
 	if rs == nil {
 		return nil
 	}
-	ctx := context.TODO()
-	scaleDownDelaySeconds := time.Duration(defaults.GetScaleDownDelaySecondsOrDefault(c.rollout))
+	ctx := c.ctx
 	if scaleDownDelaySeconds == 0 {
 		// If scaledown deadline is zero, it means we need to remove any replicasets with the delay
 		// This might happen if we switch from canary with traffic routing to basic canary
@@ -95,7 +94,8 @@ func (c *Controller) getReplicaSetsForRollouts(r *v1alpha1.Rollout) ([]*appsv1.R
 // in the event that we moved back to an older revision that is still within its scaleDownDelay.
 func (c *rolloutContext) removeScaleDownDeadlines() error {
 	var toRemove []*appsv1.ReplicaSet
-	if c.newRS != nil {
+	// The deadline of an aborted new ReplicaSet is kept to honor the abortScaleDownDelaySeconds
+	if c.newRS != nil && !c.isAbortScaleDownDelayed() {
 		toRemove = append(toRemove, c.newRS)
 	}
 	if c.stableRS != nil {
@@ -120,10 +120,57 @@ func (c *rolloutContext) reconcileNewReplicaSet() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if c.isAbortScaleDownDelayed() {
+		newReplicasCount, err = c.reconcileAbortScaleDownDelay()
+		if err != nil {
+			return false, err
+		}
+	}
 	scaled, _, err := c.scaleReplicaSetAndRecordEvent(c.newRS, newReplicasCount)
 	return scaled, err
 }
 
+// isAbortScaleDownDelayed returns whether the update is aborted and the scale down of the new
+// ReplicaSet is governed by abortScaleDownDelaySeconds
+func (c *rolloutContext) isAbortScaleDownDelayed() bool {
+	if c.newRS == nil || c.stableRS == nil || c.newRS.Name == c.stableRS.Name {
+		return false
+	}
+	return c.pauseContext.IsAborted() && defaults.GetAbortScaleDownDelaySeconds(c.rollout) != nil
+}
+
+// reconcileAbortScaleDownDelay returns the desired replica count of the new ReplicaSet of an
+// aborted update. The ReplicaSet keeps its pods until the `scale-down-deadline` annotation, which
+// is added on the first reconciliation after the abort, has passed. An abortScaleDownDelaySeconds
+// of zero keeps the pods until the update is retried or replaced.
+func (c *rolloutContext) reconcileAbortScaleDownDelay() (int32, error) {
+	currentReplicas := *c.newRS.Spec.Replicas
+	abortScaleDownDelaySeconds := *defaults.GetAbortScaleDownDelaySeconds(c.rollout)
+	if abortScaleDownDelaySeconds == 0 || currentReplicas == 0 {
+		return currentReplicas, nil
+	}
+	scaleDownAtStr, ok := c.newRS.Annotations[v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey]
+	if !ok {
+		return currentReplicas, c.addScaleDownDelay(c.newRS, time.Duration(abortScaleDownDelaySeconds))
+	}
+	scaleDownAtTime, err := time.Parse(time.RFC3339, scaleDownAtStr)
+	if err != nil {
+		c.log.Warnf("Unable to read scaleDownAt label on rs '%s'", c.newRS.Name)
+		return 0, nil
+	}
+	now := metav1.Now()
+	scaleDownAt := metav1.NewTime(scaleDownAtTime)
+	if scaleDownAt.After(now.Time) {
+		c.log.Infof("Aborted RS '%s' has not reached the scaleDownTime", c.newRS.Name)
+		remainingTime := scaleDownAt.Sub(now.Time)
+		if remainingTime < c.resyncPeriod {
+			c.enqueueRolloutAfter(c.rollout, remainingTime)
+		}
+		return currentReplicas, nil
+	}
+	return 0, nil
+}
+
 // reconcileOtherReplicaSets reconciles "other" ReplicaSets.
 // Other ReplicaSets are ReplicaSets are neither the new or stable (allRSs - newRS - stableRS)
 func (c *rolloutContext) reconcileOtherReplicaSets() (bool, error) {
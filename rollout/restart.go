@@ -59,7 +59,7 @@ func (p RolloutPodRestarter) checkEnqueueRollout(roCtx *rolloutContext) {
 // and were created before spec.restartedAt. If the rollout is a canary rollout, it can restart
 // multiple pods, up to maxUnavailable or 1, whichever is greater.
 func (p *RolloutPodRestarter) Reconcile(roCtx *rolloutContext) error {
-	ctx := context.TODO()
+	ctx := roCtx.ctx
 	logCtx := roCtx.log.WithField("Reconciler", "PodRestarter")
 	p.checkEnqueueRollout(roCtx)
 	if !replicaset.NeedsRestart(roCtx.rollout) {
@@ -0,0 +1,233 @@
+package rollout
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/audit"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+// auditLogWindow is the tolerance when matching the entries of the audit log of a rollout to the
+// aborts observed by the controller, since the entries are timestamped by the clients
+const auditLogWindow = time.Minute
+
+// recordRevision writes a RolloutRevision once the current revision of the rollout completes or is
+// aborted, and deletes the records beyond the retention limit. Failures are only logged, since the
+// status of the rollout is already persisted.
+func (c *rolloutContext) recordRevision(prevStatus, newStatus *v1alpha1.RolloutStatus) {
+	var spec v1alpha1.RolloutRevisionSpec
+	switch {
+	case prevStatus.StableRS != newStatus.StableRS && newStatus.StableRS == newStatus.CurrentPodHash:
+		spec.Result = v1alpha1.RevisionResultCompleted
+	case prevStatus.AbortedAt == nil && newStatus.AbortedAt != nil:
+		spec.Result = v1alpha1.RevisionResultAborted
+		spec.Message = newStatus.Message
+	default:
+		return
+	}
+	limit := defaults.GetRolloutRevisionLimitOrDefault(c.rollout)
+	if limit > 0 {
+		revision := c.newRolloutRevision(spec, newStatus)
+		if revision.Spec.Result == v1alpha1.RevisionResultCompleted {
+			revision.Spec.PromotedBy = c.revisionPromotedBy(prevStatus, revision.Spec.StartedAt)
+		} else {
+			c.setRevisionAbortedBy(&revision.Spec, newStatus.AbortedAt)
+		}
+		if err := c.createOrUpdateRevision(revision); err != nil {
+			c.log.Warnf("Failed to record revision: %v", err)
+			return
+		}
+	}
+	if err := c.pruneRevisions(limit); err != nil {
+		c.log.Warnf("Failed to delete old revision records: %v", err)
+	}
+}
+
+// newRolloutRevision completes the record of the current revision with what is known about its update.
+// The records of aborts are named after the time of the abort, so that they are kept when the
+// revision is retried and aborted again or completes.
+func (c *rolloutContext) newRolloutRevision(spec v1alpha1.RolloutRevisionSpec, newStatus *v1alpha1.RolloutStatus) *v1alpha1.RolloutRevision {
+	spec.RolloutName = c.rollout.Name
+	spec.PodTemplateHash = newStatus.CurrentPodHash
+	spec.FinishedAt = metav1.NewTime(nowFn())
+	template := c.rollout.Spec.Template
+	if c.newRS != nil {
+		spec.Revision, _ = replicasetutil.Revision(c.newRS)
+		template = c.newRS.Spec.Template
+	} else {
+		spec.Revision, _ = replicasetutil.Revision(c.rollout)
+	}
+	spec.Images = revisionImages(template.Spec.Containers)
+	if progress, ok := c.progressTracker.get(c.rollout.Namespace + "/" + c.rollout.Name); ok && progress.podHash == newStatus.CurrentPodHash {
+		spec.Steps = progress.steps
+		if startedAt := c.updateStartedAt(progress); !startedAt.IsZero() {
+			spec.StartedAt = &metav1.Time{Time: startedAt}
+		}
+	} else if c.newRS != nil {
+		spec.StartedAt = c.newRS.CreationTimestamp.DeepCopy()
+	}
+	spec.Analyses = c.revisionAnalyses(newStatus.CurrentPodHash)
+
+	name := fmt.Sprintf("%s-%d", c.rollout.Name, spec.Revision)
+	if spec.Result == v1alpha1.RevisionResultAborted {
+		spec.FinishedAt = *newStatus.AbortedAt.DeepCopy()
+		name = fmt.Sprintf("%s-aborted-%d", name, newStatus.AbortedAt.Unix())
+	}
+	// the records are deliberately not owned by the rollout, so that the history outlives a rollout
+	// which is deleted and recreated, e.g. by a migration
+	return &v1alpha1.RolloutRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: c.rollout.Namespace,
+			Labels: map[string]string{
+				v1alpha1.RolloutRevisionRolloutLabel:  c.rollout.Name,
+				v1alpha1.DefaultRolloutUniqueLabelKey: spec.PodTemplateHash,
+			},
+		},
+		Spec: spec,
+	}
+}
+
+// revisionPromotedBy returns the user who last promoted the update according to the audit log of the
+// rollout, or else whether a user or the controller promoted the revision to stable
+func (c *rolloutContext) revisionPromotedBy(prevStatus *v1alpha1.RolloutStatus, startedAt *metav1.Time) string {
+	if startedAt != nil {
+		since := startedAt.Truncate(time.Second)
+		entries := audit.GetLog(c.rollout)
+		for i := len(entries) - 1; i >= 0; i-- {
+			entry := entries[i]
+			if entry.Time.Time.Before(since) {
+				break
+			}
+			if (entry.Action == audit.ActionPromote || entry.Action == audit.ActionPromoteFull) && entry.User != "" {
+				return entry.User
+			}
+		}
+	}
+	return promotionActor(prevStatus)
+}
+
+// setRevisionAbortedBy sets the reason of the abort of the revision. The reason of an abort by a user
+// is the reason given in the audit log of the rollout, and the message tells who aborted it.
+func (c *rolloutContext) setRevisionAbortedBy(spec *v1alpha1.RolloutRevisionSpec, abortedAt *metav1.Time) {
+	spec.AbortReason = c.abortReason()
+	if spec.AbortReason != abortedByUser {
+		return
+	}
+	entries := audit.GetLog(c.rollout)
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if entry.Action != audit.ActionAbort {
+			continue
+		}
+		if diff := entry.Time.Sub(abortedAt.Time); diff < -auditLogWindow || diff > auditLogWindow {
+			continue
+		}
+		if entry.Reason != "" {
+			spec.AbortReason = entry.Reason
+		}
+		spec.Message = entry.Message()
+		return
+	}
+}
+
+func revisionImages(containers []corev1.Container) []v1alpha1.RevisionImage {
+	var images []v1alpha1.RevisionImage
+	for _, container := range containers {
+		images = append(images, v1alpha1.RevisionImage{Container: container.Name, Image: container.Image})
+	}
+	return images
+}
+
+// revisionAnalyses returns the outcomes of the analysis runs of the pod template hash
+func (c *rolloutContext) revisionAnalyses(podHash string) []v1alpha1.RevisionAnalysis {
+	var analyses []v1alpha1.RevisionAnalysis
+	for _, run := range append(c.currentArs.ToArray(), c.otherArs...) {
+		if run.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] != podHash {
+			continue
+		}
+		analysis := v1alpha1.RevisionAnalysis{
+			Name:    run.Name,
+			Type:    run.Labels[v1alpha1.RolloutTypeLabel],
+			Phase:   run.Status.Phase,
+			Message: run.Status.Message,
+		}
+		if stepIndex, err := strconv.ParseInt(run.Labels[v1alpha1.RolloutCanaryStepIndexLabel], 10, 32); err == nil {
+			index := int32(stepIndex)
+			analysis.StepIndex = &index
+		}
+		analyses = append(analyses, analysis)
+	}
+	sort.Slice(analyses, func(i, j int) bool {
+		return analyses[i].Name < analyses[j].Name
+	})
+	return analyses
+}
+
+// createOrUpdateRevision writes the record of the revision, which replaces an existing record with
+// the same name, i.e. a record of the same result which could not be marked as written in the status
+func (c *rolloutContext) createOrUpdateRevision(revision *v1alpha1.RolloutRevision) error {
+	revisionIf := c.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(revision.Namespace)
+	_, err := revisionIf.Create(c.ctx, revision, metav1.CreateOptions{})
+	if !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	existing, err := revisionIf.Get(c.ctx, revision.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	existing.Labels = revision.Labels
+	existing.Spec = revision.Spec
+	_, err = revisionIf.Update(c.ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// pruneRevisions deletes the records of the revisions of the rollout beyond the limit of the most
+// recent revisions
+func (c *rolloutContext) pruneRevisions(limit int32) error {
+	revisionIf := c.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(c.rollout.Namespace)
+	selector := labels.SelectorFromSet(labels.Set{v1alpha1.RolloutRevisionRolloutLabel: c.rollout.Name})
+	revisionList, err := revisionIf.List(c.ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+	var numbers []int64
+	seen := map[int64]bool{}
+	for _, revision := range revisionList.Items {
+		if !seen[revision.Spec.Revision] {
+			seen[revision.Spec.Revision] = true
+			numbers = append(numbers, revision.Spec.Revision)
+		}
+	}
+	if len(numbers) <= int(limit) {
+		return nil
+	}
+	sort.Slice(numbers, func(i, j int) bool {
+		return numbers[i] > numbers[j]
+	})
+	kept := map[int64]bool{}
+	for _, number := range numbers[:limit] {
+		kept[number] = true
+	}
+	for _, revision := range revisionList.Items {
+		if kept[revision.Spec.Revision] {
+			continue
+		}
+		c.log.Infof("Deleting record %s of revision %d", revision.Name, revision.Spec.Revision)
+		err := revisionIf.Delete(c.ctx, revision.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
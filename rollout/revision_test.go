@@ -0,0 +1,261 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/audit"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+)
+
+func newRevisionRolloutContext(r *v1alpha1.Rollout, objs ...runtime.Object) *rolloutContext {
+	roCtx := newProgressRolloutContext(r)
+	roCtx.ctx = context.TODO()
+	roCtx.argoprojclientset = fake.NewSimpleClientset(objs...)
+	roCtx.newRS = newReplicaSet(r, 1)
+	return roCtx
+}
+
+func newRolloutRevision(r *v1alpha1.Rollout, name string, revision int64) *v1alpha1.RolloutRevision {
+	return &v1alpha1.RolloutRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: r.Namespace,
+			Labels:    map[string]string{v1alpha1.RolloutRevisionRolloutLabel: r.Name},
+		},
+		Spec: v1alpha1.RolloutRevisionSpec{
+			RolloutName: r.Name,
+			Revision:    revision,
+			Result:      v1alpha1.RevisionResultCompleted,
+		},
+	}
+}
+
+func TestRecordRevisionCompleted(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	defer func() { nowFn = time.Now }()
+	nowFn = func() time.Time { return now }
+
+	steps := []v1alpha1.CanaryStep{{SetWeight: int32Ptr(10)}, {Analysis: &v1alpha1.RolloutAnalysis{}}}
+	r := newCanaryRollout("foo", 1, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r.Annotations["rollout.argoproj.io/revision"] = "3"
+	podHash := r.Status.CurrentPodHash
+	roCtx := newRevisionRolloutContext(r)
+	roCtx.newRS.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour))
+	roCtx.currentArs = analysisutil.CurrentAnalysisRuns{
+		CanaryStep: &v1alpha1.AnalysisRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo-" + podHash + "-3-1",
+				Labels: map[string]string{
+					v1alpha1.DefaultRolloutUniqueLabelKey: podHash,
+					v1alpha1.RolloutTypeLabel:             v1alpha1.RolloutTypeStepLabel,
+					v1alpha1.RolloutCanaryStepIndexLabel:  "1",
+				},
+			},
+			Status: v1alpha1.AnalysisRunStatus{Phase: v1alpha1.AnalysisPhaseSuccessful},
+		},
+	}
+	roCtx.otherArs = []*v1alpha1.AnalysisRun{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "foo-stable-2-1",
+			Labels: map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "stable"},
+		},
+	}}
+
+	// the update starts and goes through the steps
+	statuses := []v1alpha1.RolloutStatus{
+		{CurrentPodHash: "stable", StableRS: "stable", CurrentStepIndex: int32Ptr(2)},
+		{CurrentPodHash: podHash, StableRS: "stable", CurrentStepIndex: int32Ptr(0)},
+		{CurrentPodHash: podHash, StableRS: "stable", CurrentStepIndex: int32Ptr(1)},
+		{CurrentPodHash: podHash, StableRS: "stable", CurrentStepIndex: int32Ptr(2)},
+		{CurrentPodHash: podHash, StableRS: podHash, CurrentStepIndex: int32Ptr(2)},
+	}
+	for i := 1; i < len(statuses); i++ {
+		now = now.Add(time.Minute)
+		roCtx.recordProgressMetrics(&statuses[i-1], &statuses[i])
+		roCtx.recordRevision(&statuses[i-1], &statuses[i])
+	}
+
+	revision, err := roCtx.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(r.Namespace).Get(context.TODO(), "foo-3", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, r.Name, revision.Labels[v1alpha1.RolloutRevisionRolloutLabel])
+	assert.Empty(t, revision.OwnerReferences)
+	assert.Equal(t, v1alpha1.RolloutRevisionSpec{
+		RolloutName:     r.Name,
+		Revision:        3,
+		PodTemplateHash: podHash,
+		Images:          []v1alpha1.RevisionImage{{Container: "container-name", Image: "foo/bar"}},
+		Result:          v1alpha1.RevisionResultCompleted,
+		PromotedBy:      promotedByController,
+		StartedAt:       &metav1.Time{Time: now.Add(-3 * time.Minute)},
+		FinishedAt:      metav1.NewTime(now),
+		Steps: []v1alpha1.RevisionStep{
+			{Index: 0, Type: "setWeight", StartedAt: metav1.NewTime(now.Add(-3 * time.Minute)), FinishedAt: metav1.NewTime(now.Add(-2 * time.Minute))},
+			{Index: 1, Type: "analysis", StartedAt: metav1.NewTime(now.Add(-2 * time.Minute)), FinishedAt: metav1.NewTime(now.Add(-time.Minute))},
+		},
+		Analyses: []v1alpha1.RevisionAnalysis{{
+			Name:      "foo-" + podHash + "-3-1",
+			Type:      v1alpha1.RolloutTypeStepLabel,
+			StepIndex: int32Ptr(1),
+			Phase:     v1alpha1.AnalysisPhaseSuccessful,
+		}},
+	}, revision.Spec)
+}
+
+func TestRecordRevisionAbortedAndRetried(t *testing.T) {
+	r := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	podHash := r.Status.CurrentPodHash
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	roCtx := newRevisionRolloutContext(r)
+	roCtx.pauseContext.AddAbort(conditions.RolloutAnalysisRunFailedReason, "metric failed")
+	roCtx.recordRevision(
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable"},
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable", Abort: true, AbortedAt: &now, Message: "RolloutAborted: metric failed"},
+	)
+	revisionIf := roCtx.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(r.Namespace)
+	abortedName := fmt.Sprintf("foo-1-aborted-%d", now.Unix())
+	revision, err := revisionIf.Get(context.TODO(), abortedName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.RevisionResultAborted, revision.Spec.Result)
+	assert.Equal(t, conditions.RolloutAnalysisRunFailedReason, revision.Spec.AbortReason)
+	assert.Equal(t, "RolloutAborted: metric failed", revision.Spec.Message)
+	assert.Equal(t, roCtx.newRS.CreationTimestamp, *revision.Spec.StartedAt)
+	assert.Equal(t, now, revision.Spec.FinishedAt)
+
+	// the revision is retried and a user promotes it, which keeps the record of the abort
+	roCtx = newRevisionRolloutContext(r, revision)
+	roCtx.recordRevision(
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable", PromoteFull: true},
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: podHash},
+	)
+	revisionIf = roCtx.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(r.Namespace)
+	revision, err = revisionIf.Get(context.TODO(), "foo-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.RevisionResultCompleted, revision.Spec.Result)
+	assert.Equal(t, promotedByUser, revision.Spec.PromotedBy)
+	assert.Empty(t, revision.Spec.AbortReason)
+	revision, err = revisionIf.Get(context.TODO(), abortedName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.RevisionResultAborted, revision.Spec.Result)
+}
+
+func TestRecordRevisionFirstRevision(t *testing.T) {
+	r := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	podHash := r.Status.CurrentPodHash
+	roCtx := newRevisionRolloutContext(r)
+	roCtx.recordRevision(
+		&v1alpha1.RolloutStatus{},
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: podHash},
+	)
+	revision, err := roCtx.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(r.Namespace).Get(context.TODO(), "foo-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.RevisionResultCompleted, revision.Spec.Result)
+	assert.Equal(t, promotedByController, revision.Spec.PromotedBy)
+}
+
+func setAuditLog(t *testing.T, r *v1alpha1.Rollout, entries ...audit.Entry) {
+	value, err := json.Marshal(entries)
+	assert.NoError(t, err)
+	r.Annotations[annotations.AuditLogAnnotation] = string(value)
+}
+
+func TestRecordRevisionActorsFromAuditLog(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	r := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	podHash := r.Status.CurrentPodHash
+	abortedAt := metav1.NewTime(now)
+	setAuditLog(t, r,
+		audit.Entry{Action: audit.ActionAbort, User: "carol", Time: metav1.NewTime(now.Add(-time.Hour))},
+		audit.Entry{Action: audit.ActionAbort, User: "alice", Reason: "error rate too high", Time: metav1.NewTime(now.Add(-time.Second))},
+		audit.Entry{Action: audit.ActionPause, User: "dave", Time: metav1.NewTime(now)},
+	)
+	roCtx := newRevisionRolloutContext(r)
+	roCtx.recordRevision(
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable"},
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable", Abort: true, AbortedAt: &abortedAt, Message: "RolloutAborted: Rollout aborted update to revision 1"},
+	)
+	revisionIf := roCtx.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(r.Namespace)
+	revision, err := revisionIf.Get(context.TODO(), fmt.Sprintf("foo-1-aborted-%d", now.Unix()), metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "error rate too high", revision.Spec.AbortReason)
+	assert.Equal(t, "Abort requested by alice: error rate too high", revision.Spec.Message)
+
+	// an abort without a matching entry in the audit log is attributed to a user
+	setAuditLog(t, r, audit.Entry{Action: audit.ActionAbort, User: "carol", Time: metav1.NewTime(now.Add(-time.Hour))})
+	roCtx = newRevisionRolloutContext(r)
+	roCtx.recordRevision(
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable"},
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable", Abort: true, AbortedAt: &abortedAt, Message: "RolloutAborted: Rollout aborted update to revision 1"},
+	)
+	revisionIf = roCtx.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(r.Namespace)
+	revision, err = revisionIf.Get(context.TODO(), fmt.Sprintf("foo-1-aborted-%d", now.Unix()), metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, abortedByUser, revision.Spec.AbortReason)
+	assert.Equal(t, "RolloutAborted: Rollout aborted update to revision 1", revision.Spec.Message)
+
+	// the revision is promoted by the last user who promoted it since the update started
+	setAuditLog(t, r,
+		audit.Entry{Action: audit.ActionPromoteFull, User: "carol", Time: metav1.NewTime(now.Add(-2 * time.Hour))},
+		audit.Entry{Action: audit.ActionPromote, User: "bob", Time: metav1.NewTime(now.Add(-time.Minute))},
+		audit.Entry{Action: audit.ActionPause, User: "dave", Time: metav1.NewTime(now)},
+	)
+	roCtx = newRevisionRolloutContext(r)
+	roCtx.newRS.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour))
+	roCtx.recordRevision(
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable"},
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: podHash},
+	)
+	revisionIf = roCtx.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(r.Namespace)
+	revision, err = revisionIf.Get(context.TODO(), "foo-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", revision.Spec.PromotedBy)
+}
+
+func TestRecordRevisionPrunesOldRecords(t *testing.T) {
+	r := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	r.Annotations["rollout.argoproj.io/revision"] = "4"
+	r.Spec.RolloutRevisionLimit = int32Ptr(2)
+	podHash := r.Status.CurrentPodHash
+	other := newCanaryRollout("bar", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	roCtx := newRevisionRolloutContext(r,
+		newRolloutRevision(r, "foo-1", 1),
+		newRolloutRevision(r, "foo-2", 2),
+		newRolloutRevision(r, "foo-3-aborted-1622541600", 3),
+		newRolloutRevision(r, "foo-3", 3),
+		newRolloutRevision(other, "bar-1", 1),
+	)
+	roCtx.recordRevision(
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable"},
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: podHash},
+	)
+	revisions, err := roCtx.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(r.Namespace).List(context.TODO(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	var names []string
+	for _, revision := range revisions.Items {
+		names = append(names, revision.Name)
+	}
+	assert.ElementsMatch(t, []string{"foo-3-aborted-1622541600", "foo-3", "foo-4", "bar-1"}, names)
+
+	// records are not written when the limit is 0
+	r.Spec.RolloutRevisionLimit = int32Ptr(0)
+	roCtx = newRevisionRolloutContext(r, newRolloutRevision(r, "foo-3", 3))
+	roCtx.recordRevision(
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: "stable"},
+		&v1alpha1.RolloutStatus{CurrentPodHash: podHash, StableRS: podHash},
+	)
+	revisions, err = roCtx.argoprojclientset.ArgoprojV1alpha1().RolloutRevisions(r.Namespace).List(context.TODO(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, revisions.Items)
+}
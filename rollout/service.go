@@ -1,22 +1,31 @@
 package rollout
 
 import (
-	"context"
 	"fmt"
+	"reflect"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	patchtypes "k8s.io/apimachinery/pkg/types"
+	v1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 
+	register "github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
 )
 
 const (
+	// serviceOwnerIndexName is the name of the index of the Services informer which finds the
+	// Services controlled by a rollout
+	serviceOwnerIndexName = "byRolloutOwner"
+
 	switchSelectorPatch = `{
 	"spec": {
 		"selector": {
@@ -47,7 +56,7 @@ func generatePatch(service *corev1.Service, newRolloutUniqueLabelValue string, r
 
 // switchSelector switch the selector on an existing service to a new value
 func (c rolloutContext) switchServiceSelector(service *corev1.Service, newRolloutUniqueLabelValue string, r *v1alpha1.Rollout) error {
-	ctx := context.TODO()
+	ctx := c.ctx
 	if service.Spec.Selector == nil {
 		service.Spec.Selector = make(map[string]string)
 	}
@@ -77,7 +86,7 @@ func (c *rolloutContext) reconcilePreviewService(previewSvc *corev1.Service) err
 		return err
 	}
 
-	return nil
+	return c.switchAdditionalServiceSelectors(c.rollout.Spec.Strategy.BlueGreen.AdditionalPreviewServices, newPodHash)
 }
 
 func (c *rolloutContext) reconcileActiveService(activeSvc *corev1.Service) error {
@@ -102,6 +111,25 @@ func (c *rolloutContext) reconcileActiveService(activeSvc *corev1.Service) error
 	if err != nil {
 		return err
 	}
+	return c.switchAdditionalServiceSelectors(c.rollout.Spec.Strategy.BlueGreen.AdditionalActiveServices, newPodHash)
+}
+
+// switchAdditionalServiceSelectors switches the selectors of the additional active or preview
+// services to the pod hash of their primary service, so that all of them target the same ReplicaSet
+func (c *rolloutContext) switchAdditionalServiceSelectors(serviceNames []string, newPodHash string) error {
+	if newPodHash == "" {
+		return nil
+	}
+	for _, serviceName := range serviceNames {
+		svc, err := c.servicesLister.Services(c.rollout.Namespace).Get(serviceName)
+		if err != nil {
+			return err
+		}
+		err = c.switchServiceSelector(svc.DeepCopy(), newPodHash, c.rollout)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -166,3 +194,185 @@ func (c *rolloutContext) newRSReady() bool {
 	readyReplicas := c.newRS.Status.ReadyReplicas
 	return replicas != nil && *replicas != 0 && readyReplicas != 0 && *replicas <= readyReplicas
 }
+
+// reconcileManagedServices creates the referenced Services of a rollout which uses managedServices,
+// keeps the Services owned by the rollout in sync with the spec, and deletes the owned Services which
+// are no longer referenced by the rollout. Returns true if a Service was created, since the Service
+// is not yet visible to the listers.
+func (c *rolloutContext) reconcileManagedServices() (bool, error) {
+	ctx := c.ctx
+	owned, err := c.servicesIndexer.ByIndex(serviceOwnerIndexName, fmt.Sprintf("%s/%s", c.rollout.Namespace, c.rollout.Name))
+	if err != nil {
+		return false, err
+	}
+	managed := serviceutil.GetManagedServices(c.rollout)
+	if managed == nil && len(owned) == 0 {
+		return false, nil
+	}
+
+	referenced := map[string]bool{}
+	var names []string
+	for _, key := range serviceutil.GetRolloutServiceKeys(c.rollout) {
+		_, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return false, err
+		}
+		referenced[name] = true
+		names = append(names, name)
+	}
+
+	created := false
+	if managed != nil && len(managed.Ports) > 0 {
+		for _, name := range names {
+			svc, err := c.servicesLister.Services(c.rollout.Namespace).Get(name)
+			if k8serrors.IsNotFound(err) {
+				newSvc := serviceutil.NewManagedService(c.rollout, name, c.managedServicePodHash(name))
+				_, err = c.kubeclientset.CoreV1().Services(c.rollout.Namespace).Create(ctx, newSvc, metav1.CreateOptions{})
+				if err != nil {
+					return false, err
+				}
+				c.log.Infof("Created managed service '%s'", name)
+				c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.ManagedServiceCreatedReason}, conditions.ManagedServiceCreatedMessage, name)
+				created = true
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			if !metav1.IsControlledBy(svc, c.rollout) {
+				// Services created by users are never modified
+				continue
+			}
+			err = c.syncManagedService(svc, managed)
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+
+	for _, obj := range owned {
+		svc, ok := obj.(*corev1.Service)
+		// the index is keyed by name, so a Service of a deleted rollout of the same name is skipped
+		if !ok || !metav1.IsControlledBy(svc, c.rollout) || referenced[svc.Name] {
+			continue
+		}
+		err = c.kubeclientset.CoreV1().Services(svc.Namespace).Delete(ctx, svc.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return false, err
+		}
+		c.log.Infof("Deleted managed service '%s'", svc.Name)
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.ManagedServiceDeletedReason}, conditions.ManagedServiceDeletedMessage, svc.Name)
+	}
+	return created, nil
+}
+
+// managedServicePodHash returns the pod template hash which a managed Service of the given name selects
+// when it is created. The stable and active services select the stable ReplicaSet, or the current
+// revision while the rollout has no stable ReplicaSet yet, and the canary and preview services select
+// the current revision.
+func (c *rolloutContext) managedServicePodHash(name string) string {
+	stableHash := c.rollout.Status.StableRS
+	if stableHash == "" {
+		stableHash = c.currentPodHash()
+	}
+	if bg := c.rollout.Spec.Strategy.BlueGreen; bg != nil {
+		if name == bg.ActiveService {
+			return stableHash
+		}
+		for _, svc := range bg.AdditionalActiveServices {
+			if name == svc {
+				return stableHash
+			}
+		}
+		return c.currentPodHash()
+	}
+	if canary := c.rollout.Spec.Strategy.Canary; canary != nil && name == canary.StableService {
+		return stableHash
+	}
+	return c.currentPodHash()
+}
+
+// serviceOwnerIndexFunc indexes the Services controlled by a rollout by the key of the rollout
+func serviceOwnerIndexFunc(obj interface{}) ([]string, error) {
+	svc, ok := obj.(*corev1.Service)
+	if !ok {
+		return nil, nil
+	}
+	ref := metav1.GetControllerOf(svc)
+	if ref == nil || ref.Kind != register.RolloutKind || ref.APIVersion != v1alpha1.SchemeGroupVersion.String() {
+		return nil, nil
+	}
+	return []string{fmt.Sprintf("%s/%s", svc.Namespace, ref.Name)}, nil
+}
+
+// syncManagedService updates the ports, type and metadata of a Service owned by the rollout to
+// match the managedServices spec. The selector is left untouched, since it is switched separately.
+func (c *rolloutContext) syncManagedService(svc *corev1.Service, managed *v1alpha1.ManagedServices) error {
+	ports := serviceutil.ManagedServicePorts(managed, svc.Spec.Ports)
+	svcType := serviceutil.ManagedServiceType(managed)
+	modified := svc.Spec.Type != svcType || !reflect.DeepEqual(svc.Spec.Ports, ports)
+	if managed.Metadata != nil {
+		for k, v := range managed.Metadata.Labels {
+			if svc.Labels[k] != v {
+				modified = true
+			}
+		}
+		for k, v := range managed.Metadata.Annotations {
+			if svc.Annotations[k] != v {
+				modified = true
+			}
+		}
+	}
+	if !modified {
+		return nil
+	}
+
+	updatedSvc := svc.DeepCopy()
+	updatedSvc.Spec.Type = svcType
+	updatedSvc.Spec.Ports = ports
+	if managed.Metadata != nil {
+		if updatedSvc.Labels == nil {
+			updatedSvc.Labels = map[string]string{}
+		}
+		for k, v := range managed.Metadata.Labels {
+			updatedSvc.Labels[k] = v
+		}
+		if updatedSvc.Annotations == nil {
+			updatedSvc.Annotations = map[string]string{}
+		}
+		for k, v := range managed.Metadata.Annotations {
+			updatedSvc.Annotations[k] = v
+		}
+	}
+	_, err := c.kubeclientset.CoreV1().Services(svc.Namespace).Update(c.ctx, updatedSvc, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	c.log.Infof("Updated managed service '%s'", svc.Name)
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.ManagedServiceUpdatedReason}, conditions.ManagedServiceUpdatedMessage, svc.Name)
+	return nil
+}
+
+// managedServiceLister returns the Service which the controller would create for a managed service
+// which does not exist yet, so that a rollout can be validated before its services are created
+type managedServiceLister struct {
+	v1.ServiceLister
+	rollout *v1alpha1.Rollout
+}
+
+func (l managedServiceLister) Services(namespace string) v1.ServiceNamespaceLister {
+	return managedServiceNamespaceLister{ServiceNamespaceLister: l.ServiceLister.Services(namespace), rollout: l.rollout}
+}
+
+type managedServiceNamespaceLister struct {
+	v1.ServiceNamespaceLister
+	rollout *v1alpha1.Rollout
+}
+
+func (l managedServiceNamespaceLister) Get(name string) (*corev1.Service, error) {
+	svc, err := l.ServiceNamespaceLister.Get(name)
+	if k8serrors.IsNotFound(err) {
+		return serviceutil.NewManagedService(l.rollout, name, ""), nil
+	}
+	return svc, err
+}
@@ -10,9 +10,12 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	core "k8s.io/client-go/testing"
+	"k8s.io/kubernetes/pkg/controller"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
+	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
 )
 
 func newService(name string, port int, selector map[string]string, ro *v1alpha1.Rollout) *corev1.Service {
@@ -162,3 +165,189 @@ func TestPreviewServiceNotFound(t *testing.T) {
 	assert.Equal(t, calculatePatch(r, fmt.Sprintf(expectedPatch, pausedCondition, conditions.InvalidSpecReason, strings.ReplaceAll(errmsg, "\"", "\\\""))), patch)
 
 }
+
+func newManagedServicesRollout() *v1alpha1.Rollout {
+	r := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.Strategy.Canary.CanaryService = "canary"
+	r.Spec.Strategy.Canary.StableService = "stable"
+	r.Spec.Strategy.Canary.ManagedServices = &v1alpha1.ManagedServices{
+		Ports: []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)}},
+		Metadata: &v1alpha1.PodTemplateMetadata{
+			Labels: map[string]string{"team": "payments"},
+		},
+	}
+	return r
+}
+
+func newManagedService(name string, r *v1alpha1.Rollout) *corev1.Service {
+	return serviceutil.NewManagedService(r, name, "abc123")
+}
+
+func TestManagedServicesCreated(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newManagedServicesRollout()
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	canaryIndex := f.expectCreateServiceAction(newService("canary", 80, nil, r))
+	stableIndex := f.expectCreateServiceAction(newService("stable", 80, nil, r))
+	f.run(getKey(r, t))
+
+	canarySvc := f.getCreatedService(canaryIndex)
+	assert.Equal(t, "canary", canarySvc.Name)
+	assert.Equal(t, "stable", f.getCreatedService(stableIndex).Name)
+	assert.Equal(t, corev1.ServiceTypeClusterIP, canarySvc.Spec.Type)
+	assert.Equal(t, []corev1.ServicePort{{
+		Name:       "http",
+		Protocol:   corev1.ProtocolTCP,
+		Port:       80,
+		TargetPort: intstr.FromInt(8080),
+	}}, canarySvc.Spec.Ports)
+	// the first revision becomes stable, so both services select it
+	podHash := controller.ComputeHash(&r.Spec.Template, r.Status.CollisionCount)
+	assert.Equal(t, map[string]string{"foo": "bar", v1alpha1.DefaultRolloutUniqueLabelKey: podHash}, canarySvc.Spec.Selector)
+	assert.Equal(t, podHash, f.getCreatedService(stableIndex).Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, "payments", canarySvc.Labels["team"])
+	assert.Equal(t, r.Name, canarySvc.Annotations[v1alpha1.ManagedByRolloutsKey])
+	assert.True(t, metav1.IsControlledBy(canarySvc, r))
+}
+
+func TestManagedServicesSelectTargetReplicaSets(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newManagedServicesRollout()
+	r.Status.StableRS = "stable-hash"
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	ctrl, _, _ := f.newController(noResyncPeriodFunc)
+	roCtx, err := ctrl.newRolloutContext(r)
+	assert.NoError(t, err)
+	created, err := roCtx.reconcileManagedServices()
+	assert.NoError(t, err)
+	assert.True(t, created)
+
+	selectors := map[string]string{}
+	for _, action := range filterInformerActions(f.kubeclient.Actions()) {
+		svc := action.(core.CreateAction).GetObject().(*corev1.Service)
+		selectors[svc.Name] = svc.Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey]
+	}
+	assert.Equal(t, map[string]string{
+		"stable": "stable-hash",
+		"canary": controller.ComputeHash(&r.Spec.Template, r.Status.CollisionCount),
+	}, selectors)
+}
+
+func TestManagedServicesNotCreatedForInvalidSpec(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newManagedServicesRollout()
+	r.Spec.Strategy.Canary.CanaryService = "stable"
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	cond := conditions.GetRolloutCondition(patchedRollout.Status, v1alpha1.InvalidSpec)
+	assert.NotNil(t, cond)
+}
+
+func TestManagedServicesSyncedAndDeleted(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newManagedServicesRollout()
+	stableSvc := newManagedService("stable", r)
+	stableSvc.Spec.Ports[0].Port = 8000
+	staleSvc := newManagedService("old-canary", r)
+	// the canary service was created by the user, so it is not modified even though its ports differ
+	canarySvc := newService("canary", 443, map[string]string{"foo": "bar"}, nil)
+	f.kubeobjects = append(f.kubeobjects, stableSvc, staleSvc, canarySvc)
+	f.serviceLister = append(f.serviceLister, stableSvc, staleSvc, canarySvc)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	ctrl, _, _ := f.newController(noResyncPeriodFunc)
+	roCtx, err := ctrl.newRolloutContext(r)
+	assert.NoError(t, err)
+	created, err := roCtx.reconcileManagedServices()
+	assert.NoError(t, err)
+	assert.False(t, created)
+
+	actions := filterInformerActions(f.kubeclient.Actions())
+	assert.Len(t, actions, 2)
+	updatedSvc := actions[0].(core.UpdateAction).GetObject().(*corev1.Service)
+	assert.Equal(t, "stable", updatedSvc.Name)
+	assert.Equal(t, int32(80), updatedSvc.Spec.Ports[0].Port)
+	// the selector is switched separately and is left untouched
+	assert.Equal(t, "abc123", updatedSvc.Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, "old-canary", actions[1].(core.DeleteAction).GetName())
+}
+
+func TestManagedServicesInSync(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newManagedServicesRollout()
+	stableSvc := newManagedService("stable", r)
+	canarySvc := newManagedService("canary", r)
+	f.kubeobjects = append(f.kubeobjects, stableSvc, canarySvc)
+	f.serviceLister = append(f.serviceLister, stableSvc, canarySvc)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	ctrl, _, _ := f.newController(noResyncPeriodFunc)
+	roCtx, err := ctrl.newRolloutContext(r)
+	assert.NoError(t, err)
+	created, err := roCtx.reconcileManagedServices()
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Empty(t, filterInformerActions(f.kubeclient.Actions()))
+}
+
+func TestManagedServicesRemovedFromSpec(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newManagedServicesRollout()
+	stableSvc := newManagedService("stable", r)
+	// a Service of a former rollout of the same name is not owned by this rollout
+	formerRollout := r.DeepCopy()
+	formerRollout.UID = "former"
+	formerSvc := newManagedService("former-canary", formerRollout)
+	r.Spec.Strategy.Canary.ManagedServices = nil
+	r.Spec.Strategy.Canary.StableService = ""
+	r.Spec.Strategy.Canary.CanaryService = ""
+	f.kubeobjects = append(f.kubeobjects, stableSvc, formerSvc)
+	f.serviceLister = append(f.serviceLister, stableSvc, formerSvc)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	ctrl, _, _ := f.newController(noResyncPeriodFunc)
+	roCtx, err := ctrl.newRolloutContext(r)
+	assert.NoError(t, err)
+	created, err := roCtx.reconcileManagedServices()
+	assert.NoError(t, err)
+	assert.False(t, created)
+
+	actions := filterInformerActions(f.kubeclient.Actions())
+	assert.Len(t, actions, 1)
+	assert.Equal(t, "stable", actions[0].(core.DeleteAction).GetName())
+}
+
+func TestServiceOwnerIndexFunc(t *testing.T) {
+	r := newManagedServicesRollout()
+	keys, err := serviceOwnerIndexFunc(newManagedService("stable", r))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"default/foo"}, keys)
+
+	keys, err = serviceOwnerIndexFunc(newService("stable", 80, nil, nil))
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
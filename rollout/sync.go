@@ -1,7 +1,6 @@
 package rollout
 
 import (
-	"context"
 	"fmt"
 	"sort"
 	"strconv"
@@ -62,7 +61,7 @@ func (c *rolloutContext) syncReplicaSetRevision() (*appsv1.ReplicaSet, error) {
 	if c.newRS == nil {
 		return nil, nil
 	}
-	ctx := context.TODO()
+	ctx := c.ctx
 
 	// Calculate the max revision number among all old RSes
 	maxOldRevision := replicasetutil.MaxRevision(c.olderRSs)
@@ -77,6 +76,10 @@ func (c *rolloutContext) syncReplicaSetRevision() (*appsv1.ReplicaSet, error) {
 
 	// Set existing new replica set's annotation
 	annotationsUpdated := annotations.SetNewReplicaSetAnnotations(c.rollout, rsCopy, newRevision, true)
+	// the rollback window only fast-tracks the ReplicaSets which were stable, not the aborted ones
+	if c.rollout.Spec.RollbackWindow != nil && replicasetutil.GetPodTemplateHash(rsCopy) == c.rollout.Status.StableRS {
+		annotationsUpdated = annotations.SetStableRevisionAnnotation(rsCopy) || annotationsUpdated
+	}
 	minReadySecondsNeedsUpdate := rsCopy.Spec.MinReadySeconds != c.rollout.Spec.MinReadySeconds
 	affinityNeedsUpdate := replicasetutil.IfInjectedAntiAffinityRuleNeedsUpdate(rsCopy.Spec.Template.Spec.Affinity, *c.rollout)
 
@@ -113,7 +116,7 @@ func (c *rolloutContext) syncReplicaSetRevision() (*appsv1.ReplicaSet, error) {
 
 func (c *rolloutContext) setRolloutRevision(revision string) error {
 	if annotations.SetRolloutRevision(c.rollout, revision) {
-		updatedRollout, err := c.argoprojclientset.ArgoprojV1alpha1().Rollouts(c.rollout.Namespace).Update(context.TODO(), c.rollout, metav1.UpdateOptions{})
+		updatedRollout, err := c.argoprojclientset.ArgoprojV1alpha1().Rollouts(c.rollout.Namespace).Update(c.ctx, c.rollout, metav1.UpdateOptions{})
 		if err != nil {
 			c.log.WithError(err).Error("Error: updating rollout revision")
 			return err
@@ -129,7 +132,7 @@ func (c *rolloutContext) setRolloutRevision(revision string) error {
 }
 
 func (c *rolloutContext) createDesiredReplicaSet() (*appsv1.ReplicaSet, error) {
-	ctx := context.TODO()
+	ctx := c.ctx
 	// Calculate the max revision number among all old RSes
 	maxOldRevision := replicasetutil.MaxRevision(c.olderRSs)
 	// Calculate revision number for this new replica set
@@ -377,7 +380,7 @@ func (c *rolloutContext) scaleReplicaSetAndRecordEvent(rs *appsv1.ReplicaSet, ne
 }
 
 func (c *rolloutContext) scaleReplicaSet(rs *appsv1.ReplicaSet, newScale int32, rollout *v1alpha1.Rollout, scalingOperation string) (bool, *appsv1.ReplicaSet, error) {
-	ctx := context.TODO()
+	ctx := c.ctx
 	sizeNeedsUpdate := *(rs.Spec.Replicas) != newScale
 	fullScaleDown := newScale == int32(0)
 	rolloutReplicas := defaults.GetReplicasOrDefault(rollout.Spec.Replicas)
@@ -433,6 +436,10 @@ func (c *rolloutContext) calculateBaseStatus() v1alpha1.RolloutStatus {
 	newStatus.Conditions = prevStatus.Conditions
 	newStatus.RestartedAt = c.newStatus.RestartedAt
 	newStatus.PromoteFull = (newStatus.CurrentPodHash != newStatus.StableRS) && prevStatus.PromoteFull
+	newStatus.Canary.SkippedSteps = append([]v1alpha1.SkippedCanaryStep(nil), prevStatus.Canary.SkippedSteps...)
+	if newStatus.Canary.Approval == nil {
+		newStatus.Canary.Approval = prevStatus.Canary.Approval
+	}
 	return newStatus
 }
 
@@ -440,7 +447,7 @@ func (c *rolloutContext) calculateBaseStatus() v1alpha1.RolloutStatus {
 // where N=r.Spec.RevisionHistoryLimit. Old replica sets are older versions of the podtemplate of a rollout kept
 // around by default 1) for historical reasons.
 func (c *rolloutContext) reconcileRevisionHistoryLimit(oldRSs []*appsv1.ReplicaSet) error {
-	ctx := context.TODO()
+	ctx := c.ctx
 	revHistoryLimit := defaults.GetRevisionHistoryLimitOrDefault(c.rollout)
 
 	// Avoid deleting replica set with deletion timestamp set
@@ -538,7 +545,7 @@ func (c *rolloutContext) checkPausedConditions() error {
 }
 
 func (c *rolloutContext) patchCondition(r *v1alpha1.Rollout, newStatus *v1alpha1.RolloutStatus, conditionList ...*v1alpha1.RolloutCondition) error {
-	ctx := context.TODO()
+	ctx := c.ctx
 	for _, condition := range conditionList {
 		conditions.SetRolloutCondition(newStatus, *condition)
 	}
@@ -571,11 +578,11 @@ func (c *rolloutContext) patchCondition(r *v1alpha1.Rollout, newStatus *v1alpha1
 	return nil
 }
 
-// isIndefiniteStep returns whether or not the rollout is at an Experiment or Analysis or Pause step which should
-// not affect the progressDeadlineSeconds
+// isIndefiniteStep returns whether or not the rollout is at an Experiment or Analysis or Pause or TimeWindow step
+// which should not affect the progressDeadlineSeconds
 func isIndefiniteStep(r *v1alpha1.Rollout) bool {
 	currentStep, _ := replicasetutil.GetCurrentCanaryStep(r)
-	if currentStep != nil && (currentStep.Experiment != nil || currentStep.Analysis != nil || currentStep.Pause != nil) {
+	if currentStep != nil && (currentStep.Experiment != nil || currentStep.Analysis != nil || currentStep.Pause != nil || currentStep.TimeWindow != nil) {
 		return true
 	}
 	return false
@@ -597,14 +604,10 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 	}
 
 	if isAborted {
-		revision, _ := replicasetutil.Revision(c.rollout)
-		message := fmt.Sprintf(conditions.RolloutAbortedMessage, revision)
-		if c.pauseContext.abortMessage != "" {
-			message = fmt.Sprintf("%s: %s", message, c.pauseContext.abortMessage)
-		}
-		condition := conditions.NewRolloutCondition(v1alpha1.RolloutProgressing, corev1.ConditionFalse, conditions.RolloutAbortedReason, message)
+		condition := c.newAbortedCondition(c.pauseContext.abortMessage)
 		if conditions.SetRolloutCondition(&newStatus, *condition) {
-			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutAbortedReason}, message)
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutAbortedReason}, condition.Message)
+			c.triggerHooks(&newStatus, v1alpha1.HookTypePostAbort)
 		}
 	}
 
@@ -656,6 +659,17 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 			if c.newRS != nil {
 				msg = fmt.Sprintf(conditions.ReplicaSetTimeOutMessage, c.newRS.Name)
 			}
+			if c.rollout.Spec.ProgressDeadlineAbort {
+				// Abort the update so traffic is shifted back to the stable version and the new
+				// ReplicaSet is scaled down, instead of leaving the rollout stuck mid-update
+				c.pauseContext.AddAbort(conditions.RolloutTimedOutAbortedReason, msg)
+				condition := c.newAbortedCondition(msg)
+				if conditions.SetRolloutCondition(&newStatus, *condition) {
+					c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutTimedOutAbortedReason}, condition.Message)
+					c.triggerHooks(&newStatus, v1alpha1.HookTypePostAbort)
+				}
+				break
+			}
 			condition := conditions.NewRolloutCondition(v1alpha1.RolloutProgressing, corev1.ConditionFalse, conditions.TimedOutReason, msg)
 			conditions.SetRolloutCondition(&newStatus, *condition)
 		}
@@ -684,9 +698,20 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 	return newStatus
 }
 
+// newAbortedCondition returns the Progressing condition of an aborted rollout, optionally with
+// the reason of the abort
+func (c *rolloutContext) newAbortedCondition(abortMessage string) *v1alpha1.RolloutCondition {
+	revision, _ := replicasetutil.Revision(c.rollout)
+	message := fmt.Sprintf(conditions.RolloutAbortedMessage, revision)
+	if abortMessage != "" {
+		message = fmt.Sprintf("%s: %s", message, abortMessage)
+	}
+	return conditions.NewRolloutCondition(v1alpha1.RolloutProgressing, corev1.ConditionFalse, conditions.RolloutAbortedReason, message)
+}
+
 // persistRolloutStatus persists updates to rollout status. If no changes were made, it is a no-op
 func (c *rolloutContext) persistRolloutStatus(newStatus *v1alpha1.RolloutStatus) error {
-	ctx := context.TODO()
+	ctx := c.ctx
 	logCtx := logutil.WithVersionFields(c.log, c.rollout)
 
 	prevStatus := c.rollout.Status
@@ -728,6 +753,8 @@ func (c *rolloutContext) persistRolloutStatus(newStatus *v1alpha1.RolloutStatus)
 	}
 
 	c.sendStateChangeEvents(&prevStatus, newStatus)
+	c.recordProgressMetrics(&prevStatus, newStatus)
+	c.recordRevision(&prevStatus, newStatus)
 	logCtx.Infof("Patched: %s", patch)
 	c.newRollout = newRollout
 	return nil
@@ -843,7 +870,35 @@ func (c *rolloutContext) resetRolloutStatus(newStatus *v1alpha1.RolloutStatus) {
 	newStatus.BlueGreen.ScaleUpPreviewCheckPoint = false
 	newStatus.Canary.CurrentStepAnalysisRunStatus = nil
 	newStatus.Canary.CurrentBackgroundAnalysisRunStatus = nil
+	newStatus.Canary.SkippedSteps = nil
+	newStatus.Canary.Approval = nil
 	newStatus.CurrentStepIndex = replicasetutil.ResetCurrentStepIndex(c.rollout)
+	if c.isRollbackWithinWindow() {
+		// Rolling back to a recently served revision is fast-tracked with a full promotion, which
+		// skips steps and analysis and scales up the ReplicaSet before it is marked stable
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RollbackWithinWindowReason}, conditions.RollbackWithinWindowMessage, c.newRS.Name)
+		newStatus.PromoteFull = true
+	}
+}
+
+// isRollbackWithinWindow returns whether the rollout is moving back to a ReplicaSet which was the
+// stable ReplicaSet of one of the revisions within the rollback window
+func (c *rolloutContext) isRollbackWithinWindow() bool {
+	rollbackWindow := c.rollout.Spec.RollbackWindow
+	if rollbackWindow == nil || c.newRS == nil || c.stableRS == nil {
+		return false
+	}
+	if replicasetutil.GetPodTemplateHash(c.newRS) == c.rollout.Status.StableRS {
+		// moving back to the stable ReplicaSet is already handled by each strategy
+		return false
+	}
+	revisionsSince, ok := annotations.GetRevisionsSinceStable(c.newRS)
+	if !ok {
+		return false
+	}
+	withinWindow := revisionsSince <= int64(rollbackWindow.Revisions)
+	c.log.Infof("ReplicaSet '%s' was last stable %d revisions ago (rollback window: %d, within window: %v)", c.newRS.Name, revisionsSince, rollbackWindow.Revisions, withinWindow)
+	return withinWindow
 }
 
 // shouldFullPromote returns a reason string explaining why a rollout should fully promote, marking
@@ -852,6 +907,8 @@ func (c *rolloutContext) shouldFullPromote(newStatus v1alpha1.RolloutStatus) str
 	// NOTE: the order of these checks are significant
 	if c.stableRS == nil {
 		return "Initial deploy"
+	} else if c.pauseContext.IsFrozen() {
+		return ""
 	} else if c.rollout.Spec.Strategy.Canary != nil {
 		if c.pauseContext.IsAborted() {
 			return ""
@@ -924,10 +981,11 @@ func (c *rolloutContext) promoteStable(newStatus *v1alpha1.RolloutStatus, reason
 		revision, _ := replicasetutil.Revision(c.rollout)
 		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutCompletedReason},
 			conditions.RolloutCompletedMessage, revision, newStatus.CurrentPodHash, reason)
+		c.triggerHooks(newStatus, v1alpha1.HookTypePostPromotion)
 		// Now that we've marked the desired RS as stable, start the scale-down countdown on the previous stable RS
 		previousStableRS, _ := replicasetutil.GetReplicaSetByTemplateHash(c.olderRSs, previousStableHash)
 		if replicasetutil.GetReplicaCountForReplicaSets([]*appsv1.ReplicaSet{previousStableRS}) > 0 {
-			err := c.addScaleDownDelay(previousStableRS)
+			err := c.addScaleDownDelay(previousStableRS, time.Duration(defaults.GetScaleDownDelaySecondsOrDefault(c.rollout)))
 			if err != nil {
 				return err
 			}
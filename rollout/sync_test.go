@@ -13,6 +13,7 @@ import (
 	testclient "k8s.io/client-go/testing"
 	"k8s.io/utils/pointer"
 
+	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
@@ -228,6 +229,10 @@ func TestPersistWorkloadRefGeneration(t *testing.T) {
 		log:     logutil.WithRollout(r),
 		reconcilerBase: reconcilerBase{
 			argoprojclientset: &fake,
+			metricsServer: metrics.NewMetricsServer(metrics.ServerConfig{
+				K8SRequestProvider: &metrics.K8sRequestsCountProvider{},
+			}),
+			progressTracker: newProgressTracker(),
 		},
 		pauseContext: &pauseContext{
 			rollout: r,
@@ -427,3 +432,48 @@ func TestSendStateChangeEvents(t *testing.T) {
 		assert.Equal(t, test.expectedEventReasons, recorder.Events)
 	}
 }
+
+func TestCalculateRolloutConditionsProgressDeadlineAbort(t *testing.T) {
+	newTimedOutContext := func(progressDeadlineAbort bool) (*rolloutContext, *record.FakeEventRecorder) {
+		r := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(0), intstr.FromInt(1))
+		r.Spec.ProgressDeadlineSeconds = pointer.Int32Ptr(10)
+		r.Spec.ProgressDeadlineAbort = progressDeadlineAbort
+		r.Status.Conditions = []v1alpha1.RolloutCondition{{
+			Type:           v1alpha1.RolloutProgressing,
+			Status:         corev1.ConditionTrue,
+			Reason:         conditions.ReplicaSetUpdatedReason,
+			LastUpdateTime: metav1.NewTime(metav1.Now().Add(-time.Minute)),
+		}}
+		recorder := record.NewFakeEventRecorder()
+		roCtx := &rolloutContext{
+			rollout: r,
+			log:     logutil.WithRollout(r),
+			reconcilerBase: reconcilerBase{
+				recorder: recorder,
+			},
+			pauseContext: &pauseContext{
+				rollout: r,
+			},
+		}
+		return roCtx, recorder
+	}
+
+	t.Run("TimedOut", func(t *testing.T) {
+		roCtx, recorder := newTimedOutContext(false)
+		newStatus := roCtx.calculateRolloutConditions(roCtx.rollout.Status)
+		progressing := conditions.GetRolloutCondition(newStatus, v1alpha1.RolloutProgressing)
+		assert.Equal(t, conditions.TimedOutReason, progressing.Reason)
+		assert.False(t, roCtx.pauseContext.IsAborted())
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("Aborted", func(t *testing.T) {
+		roCtx, recorder := newTimedOutContext(true)
+		newStatus := roCtx.calculateRolloutConditions(roCtx.rollout.Status)
+		progressing := conditions.GetRolloutCondition(newStatus, v1alpha1.RolloutProgressing)
+		assert.Equal(t, conditions.RolloutAbortedReason, progressing.Reason)
+		assert.Equal(t, "Rollout aborted update to revision 1: Rollout \"foo\" has timed out progressing.", progressing.Message)
+		assert.True(t, roCtx.pauseContext.IsAborted())
+		assert.Equal(t, []string{conditions.RolloutTimedOutAbortedReason}, recorder.Events)
+	})
+}
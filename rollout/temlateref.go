@@ -124,6 +124,26 @@ func remarshalMap(objMap map[string]interface{}, res interface{}) error {
 
 // Resolve verifies if given rollout has template reference and resolves pod template
 func (r *informerBasedTemplateResolver) Resolve(rollout *v1alpha1.Rollout) error {
+	return resolveWorkloadRef(rollout, func(gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+		informer, err := r.getInformer(gvk)
+		if err != nil {
+			return nil, err
+		}
+		obj, err := informer.Lister().Get(fmt.Sprintf("%s/%s", rollout.Namespace, rollout.Spec.WorkloadRef.Name))
+		if err != nil {
+			return nil, err
+		}
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("informer for %v must have unstructured object but had %v", gvk, obj)
+		}
+		return un, nil
+	})
+}
+
+// resolveWorkloadRef resolves the pod template, and the selector when it is not set, of a rollout
+// from the workload it references, which is returned by getWorkload
+func resolveWorkloadRef(rollout *v1alpha1.Rollout, getWorkload func(gvk schema.GroupVersionKind) (*unstructured.Unstructured, error)) error {
 	if rollout.Spec.WorkloadRef == nil {
 		annotations.RemoveRolloutWorkloadRefGeneration(rollout)
 		return nil
@@ -142,18 +162,10 @@ func (r *informerBasedTemplateResolver) Resolve(rollout *v1alpha1.Rollout) error
 		return fmt.Errorf("workload of type %s/%s is not supported", gvk.Group, gvk.Kind)
 	}
 
-	informer, err := r.getInformer(gvk)
-	if err != nil {
-		return err
-	}
-	obj, err := informer.Lister().Get(fmt.Sprintf("%s/%s", rollout.Namespace, rollout.Spec.WorkloadRef.Name))
+	un, err := getWorkload(gvk)
 	if err != nil {
 		return err
 	}
-	un, ok := obj.(*unstructured.Unstructured)
-	if !ok {
-		return fmt.Errorf("informer for %v must have unstructured object but had %v", gvk, obj)
-	}
 
 	if podTemplateSpecMap, ok, _ := unstructured.NestedMap(un.Object, info.TemplatePath...); ok {
 		var template corev1.PodTemplateSpec
@@ -175,11 +187,7 @@ func (r *informerBasedTemplateResolver) Resolve(rollout *v1alpha1.Rollout) error
 	}
 
 	// initialize rollout workload-generation annotation
-	workloadMeta, err := meta.Accessor(obj)
-	if err != nil {
-		return err
-	}
-	generation := strconv.FormatInt(workloadMeta.GetGeneration(), 10)
+	generation := strconv.FormatInt(un.GetGeneration(), 10)
 	annotations.SetRolloutWorkloadRefGeneration(rollout, generation)
 
 	return nil
@@ -12,6 +12,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 )
 
 // TrafficRoutingReconciler common function across all TrafficRouting implementation
@@ -108,7 +109,7 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 			// Use the previous weight since the new RS is not ready for a new weight
 			for i := *index - 1; i >= 0; i-- {
 				step := c.rollout.Spec.Strategy.Canary.Steps[i]
-				if step.SetWeight != nil {
+				if step.SetWeight != nil && !replicasetutil.IsCanaryStepSkipped(c.rollout, i) {
 					desiredWeight = *step.SetWeight
 					break
 				}
@@ -124,17 +125,27 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 		}
 	}
 
+	_, span := tracing.StartSpan(c.ctx, "trafficrouting.SetWeight", append(tracing.RolloutAttributes(c.rollout),
+		tracing.TrafficRouterKey.String(reconciler.Type()),
+		tracing.DesiredWeightKey.Int64(int64(desiredWeight)))...)
 	err = reconciler.SetWeight(desiredWeight)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: "TrafficRoutingError"}, err.Error())
 		return err
 	}
+	c.metricsServer.SetRolloutCanaryWeight(c.rollout, desiredWeight)
 
 	// If we are at a setWeight step, also perform weight verification. Note that we don't do this
 	// every reconciliation because weight verification typically involves API calls to the cloud
 	// provider which could incur rate limiting
 	if currentStep != nil && currentStep.SetWeight != nil {
+		_, span := tracing.StartSpan(c.ctx, "trafficrouting.VerifyWeight", append(tracing.RolloutAttributes(c.rollout),
+			tracing.TrafficRouterKey.String(reconciler.Type()),
+			tracing.DesiredWeightKey.Int64(int64(desiredWeight)))...)
 		weightVerified, err := reconciler.VerifyWeight(desiredWeight)
+		span.SetAttributes(tracing.VerifiedKey.Bool(weightVerified))
+		tracing.EndSpan(span, err)
 		if err != nil {
 			return err
 		}
@@ -143,6 +154,7 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 			c.enqueueRolloutAfter(c.rollout, 10*time.Second)
 		} else {
 			c.log.Infof("Desired weight (stepIdx: %d) %d verified", *index, desiredWeight)
+			c.metricsServer.SetRolloutVerifiedWeight(c.rollout, desiredWeight)
 		}
 		c.weightVerified = &weightVerified
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
@@ -46,11 +47,14 @@ type Reconciler struct {
 }
 
 var (
-	defaultVerifyWeight = false
+	defaultVerifyWeightMutex sync.RWMutex
+	defaultVerifyWeight      = false
 )
 
 // SetDefaultVerifyWeight sets the default setWeight verification when instantiating the reconciler
 func SetDefaultVerifyWeight(b bool) {
+	defaultVerifyWeightMutex.Lock()
+	defer defaultVerifyWeightMutex.Unlock()
 	defaultVerifyWeight = b
 }
 
@@ -119,6 +123,8 @@ func (r *Reconciler) shouldVerifyWeight() bool {
 	if r.cfg.VerifyWeight != nil {
 		return *r.cfg.VerifyWeight
 	}
+	defaultVerifyWeightMutex.RLock()
+	defer defaultVerifyWeightMutex.RUnlock()
 	return defaultVerifyWeight
 }
 
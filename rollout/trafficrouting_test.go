@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/dynamic/dynamiclister"
 	"k8s.io/utils/pointer"
 
+	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout/mocks"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/alb"
@@ -257,7 +258,10 @@ func TestRolloutSetWeightToZeroWhenFullyRolledOut(t *testing.T) {
 		return nil
 	})
 	f.fakeTrafficRouting.On("VerifyWeight", mock.Anything).Return(true, nil)
+	metrics.MetricRolloutCanaryWeightVerified.DeleteLabelValues(r1.Namespace, r1.Name)
 	f.run(getKey(r1, t))
+	// the weight is only verified at setWeight steps
+	assert.False(t, metrics.MetricRolloutCanaryWeightVerified.DeleteLabelValues(r1.Namespace, r1.Name))
 }
 
 func TestNewTrafficRoutingReconciler(t *testing.T) {
@@ -463,3 +467,29 @@ func TestCanaryWithTrafficRoutingScaleDownLimit(t *testing.T) {
 	_, ok := rs1Updated.Annotations[v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey]
 	assert.False(t, ok, "annotation not removed")
 }
+
+// Verifies with a canary using traffic routing, an aborted canary ReplicaSet is kept until its
+// abortScaleDownDelaySeconds has passed while receiving no traffic
+func TestCanaryWithTrafficRoutingAbortScaleDownDelay(t *testing.T) {
+	f, ro := newTrafficWeightFixture(t)
+	defer f.Close()
+	ro.Spec.Strategy.Canary.AbortScaleDownDelaySeconds = pointer.Int32Ptr(30)
+	ro.Status.Abort = true
+	now := metav1.Now()
+	ro.Status.AbortedAt = &now
+	rs2 := f.replicaSetLister[1]
+
+	f.fakeTrafficRouting = newUnmockedFakeTrafficRoutingReconciler()
+	f.fakeTrafficRouting.On("UpdateHash", mock.Anything, mock.Anything).Return(nil)
+	f.fakeTrafficRouting.On("SetWeight", mock.Anything).Return(func(desiredWeight int32) error {
+		assert.Equal(t, int32(0), desiredWeight)
+		return nil
+	})
+	f.fakeTrafficRouting.On("VerifyWeight", mock.Anything).Return(true, nil)
+
+	rsPatchIndex := f.expectPatchReplicaSetAction(rs2) // adds the annotation instead of scaling down
+	f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	f.verifyPatchedReplicaSet(rsPatchIndex, 30)
+}
@@ -31,6 +31,7 @@ import (
 	rolloutclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/abort"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/analysisrun"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/get"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/promote"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/restart"
@@ -39,6 +40,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/undo"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/viewcontroller"
+	"github.com/argoproj/argo-rollouts/utils/audit"
 	"github.com/argoproj/argo-rollouts/utils/json"
 	versionutils "github.com/argoproj/argo-rollouts/utils/version"
 )
@@ -58,6 +60,8 @@ type ServerOptions struct {
 	RolloutsClientset rolloutclientset.Interface
 	DynamicClientset  dynamic.Interface
 	Namespace         string
+	// User is recorded as the user who performed manual actions through the server
+	User string
 }
 
 const (
@@ -278,7 +282,12 @@ func (s *ArgoRolloutsServer) ListRolloutInfos(ctx context.Context, q *rollout.Ro
 func (s *ArgoRolloutsServer) RestartRollout(ctx context.Context, q *rollout.RestartRolloutRequest) (*v1alpha1.Rollout, error) {
 	rolloutIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().Rollouts(q.GetNamespace())
 	restartAt := time.Now().UTC()
-	return restart.RestartRollout(rolloutIf, q.GetName(), &restartAt)
+	ro, err := restart.RestartRollout(rolloutIf, q.GetName(), &restartAt)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAction(ctx, q.GetNamespace(), q.GetName(), audit.ActionRestart, q.GetReason())
+	return ro, nil
 }
 
 // WatchRolloutInfos returns a stream of all rollouts
@@ -383,14 +392,27 @@ func (s *ArgoRolloutsServer) GetNamespace(ctx context.Context, e *empty.Empty) (
 
 func (s *ArgoRolloutsServer) PromoteRollout(ctx context.Context, q *rollout.PromoteRolloutRequest) (*v1alpha1.Rollout, error) {
 	rolloutIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().Rollouts(q.GetNamespace())
+	action := audit.ActionPromote
+	if q.GetFull() {
+		action = audit.ActionPromoteFull
+	}
+	// the promotion is recorded first, so that the controller knows who promoted the rollout
+	s.recordAction(ctx, q.GetNamespace(), q.GetName(), action, q.GetReason())
 	return promote.PromoteRollout(rolloutIf, q.GetName(), false, false, q.GetFull())
 }
 
 func (s *ArgoRolloutsServer) AbortRollout(ctx context.Context, q *rollout.AbortRolloutRequest) (*v1alpha1.Rollout, error) {
 	rolloutIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().Rollouts(q.GetNamespace())
+	// the abort is recorded first, so that the controller knows who aborted the rollout
+	s.recordAction(ctx, q.GetNamespace(), q.GetName(), audit.ActionAbort, q.GetReason())
 	return abort.AbortRollout(rolloutIf, q.GetName())
 }
 
+func (s *ArgoRolloutsServer) JudgeAnalysisRun(ctx context.Context, q *rollout.JudgeAnalysisRunRequest) (*v1alpha1.AnalysisRun, error) {
+	analysisRunIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().AnalysisRuns(q.GetNamespace())
+	return analysisrun.JudgeAnalysisRun(analysisRunIf, q.GetName(), v1alpha1.AnalysisPhase(q.GetPhase()), q.GetReason(), s.Options.User)
+}
+
 func (s *ArgoRolloutsServer) getRollout(namespace string, name string) (*v1alpha1.Rollout, error) {
 	rolloutsInformerFactory := rolloutinformers.NewSharedInformerFactoryWithOptions(s.Options.RolloutsClientset, 0, rolloutinformers.WithNamespace(namespace))
 	rolloutsLister := rolloutsInformerFactory.Argoproj().V1alpha1().Rollouts().Lister().Rollouts(namespace)
@@ -399,7 +421,11 @@ func (s *ArgoRolloutsServer) getRollout(namespace string, name string) (*v1alpha
 
 func (s *ArgoRolloutsServer) SetRolloutImage(ctx context.Context, q *rollout.SetImageRequest) (*v1alpha1.Rollout, error) {
 	imageString := fmt.Sprintf("%s:%s", q.GetImage(), q.GetTag())
-	set.SetImage(s.Options.DynamicClientset, q.GetNamespace(), q.GetRollout(), q.GetContainer(), imageString)
+	err := set.SetImage(s.Options.DynamicClientset, q.GetNamespace(), q.GetRollout(), q.GetContainer(), imageString)
+	if err != nil {
+		return nil, err
+	}
+	s.recordAction(ctx, q.GetNamespace(), q.GetRollout(), audit.ActionSetImage, q.GetReason())
 	return s.getRollout(q.GetNamespace(), q.GetRollout())
 }
 
@@ -409,6 +435,7 @@ func (s *ArgoRolloutsServer) UndoRollout(ctx context.Context, q *rollout.UndoRol
 	if err != nil {
 		return nil, err
 	}
+	s.recordAction(ctx, q.GetNamespace(), q.GetRollout(), audit.ActionUndo, q.GetReason())
 	return s.getRollout(q.GetNamespace(), q.GetRollout())
 }
 
@@ -418,10 +445,21 @@ func (s *ArgoRolloutsServer) RetryRollout(ctx context.Context, q *rollout.RetryR
 	if err != nil {
 		return nil, err
 	}
+	s.recordAction(ctx, q.GetNamespace(), q.GetName(), audit.ActionRetry, q.GetReason())
 
 	return ro, nil
 }
 
+// recordAction records a manual action performed through the server in the audit log of the rollout.
+// Failures are only logged since the action was already performed.
+func (s *ArgoRolloutsServer) recordAction(ctx context.Context, namespace, name string, action audit.Action, reason string) {
+	rolloutIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().Rollouts(namespace)
+	err := audit.RecordAction(ctx, rolloutIf, s.Options.KubeClientset, name, action, s.Options.User, reason)
+	if err != nil {
+		log.Warnf("Failed to record %s of rollout %s/%s in the audit log: %v", action, namespace, name, err)
+	}
+}
+
 func (s *ArgoRolloutsServer) Version(ctx context.Context, _ *empty.Empty) (*rollout.VersionInfo, error) {
 	version := versionutils.GetVersion()
 	return &rollout.VersionInfo{
@@ -4655,6 +4655,12 @@ export interface RolloutAbortRolloutRequest {
      * @memberof RolloutAbortRolloutRequest
      */
     namespace?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RolloutAbortRolloutRequest
+     */
+    reason?: string;
 }
 /**
  * 
@@ -4890,6 +4896,12 @@ export interface RolloutPromoteRolloutRequest {
      * @memberof RolloutPromoteRolloutRequest
      */
     full?: boolean;
+    /**
+     * 
+     * @type {string}
+     * @memberof RolloutPromoteRolloutRequest
+     */
+    reason?: string;
 }
 /**
  * 
@@ -5000,6 +5012,12 @@ export interface RolloutRestartRolloutRequest {
      * @memberof RolloutRestartRolloutRequest
      */
     namespace?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RolloutRestartRolloutRequest
+     */
+    reason?: string;
 }
 /**
  * 
@@ -5019,6 +5037,12 @@ export interface RolloutRetryRolloutRequest {
      * @memberof RolloutRetryRolloutRequest
      */
     namespace?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RolloutRetryRolloutRequest
+     */
+    reason?: string;
 }
 /**
  * 
@@ -5215,6 +5239,12 @@ export interface RolloutSetImageRequest {
      * @memberof RolloutSetImageRequest
      */
     namespace?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RolloutSetImageRequest
+     */
+    reason?: string;
 }
 /**
  * 
@@ -5240,6 +5270,12 @@ export interface RolloutUndoRolloutRequest {
      * @memberof RolloutUndoRolloutRequest
      */
     namespace?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof RolloutUndoRolloutRequest
+     */
+    reason?: string;
 }
 /**
  * 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	templateutil "github.com/argoproj/argo-rollouts/utils/template"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -19,6 +20,16 @@ func BuildArgumentsForRolloutAnalysisRun(args []v1alpha1.AnalysisRunArgument, st
 		arg := args[i]
 		value := arg.Value
 		if arg.ValueFrom != nil {
+			if arg.ValueFrom.ConfigMapKeyRef != nil {
+				// ConfigMaps are resolved by the analysis controller when the AnalysisRun is run
+				arguments = append(arguments, v1alpha1.Argument{
+					Name: arg.Name,
+					ValueFrom: &v1alpha1.ValueFrom{
+						ConfigMapKeyRef: arg.ValueFrom.ConfigMapKeyRef.DeepCopy(),
+					},
+				})
+				continue
+			}
 			if arg.ValueFrom.PodTemplateHashValue != nil {
 				switch *arg.ValueFrom.PodTemplateHashValue {
 				case v1alpha1.Latest:
@@ -26,6 +37,8 @@ func BuildArgumentsForRolloutAnalysisRun(args []v1alpha1.AnalysisRunArgument, st
 				case v1alpha1.Stable:
 					value = stableRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
 				}
+			} else if arg.ValueFrom.RolloutStatusValue != nil {
+				value = rolloutStatusValue(*arg.ValueFrom.RolloutStatusValue, r)
 			} else {
 				if arg.ValueFrom.FieldRef != nil {
 					value, _ = fieldpath.ExtractFieldPathAsString(r, arg.ValueFrom.FieldRef.FieldPath)
@@ -43,6 +56,24 @@ func BuildArgumentsForRolloutAnalysisRun(args []v1alpha1.AnalysisRunArgument, st
 	return arguments
 }
 
+// rolloutStatusValue returns the value of the progress of the rollout referenced by an argument.
+// Returns an empty string if the value does not apply to the rollout (e.g. the canary weight of a
+// blue-green rollout).
+func rolloutStatusValue(valueFrom v1alpha1.ValueFromRolloutStatus, r *v1alpha1.Rollout) string {
+	if r.Spec.Strategy.Canary == nil {
+		return ""
+	}
+	switch valueFrom {
+	case v1alpha1.StepIndex:
+		if r.Status.CurrentStepIndex != nil {
+			return strconv.Itoa(int(*r.Status.CurrentStepIndex))
+		}
+	case v1alpha1.CanaryWeight:
+		return strconv.Itoa(int(replicasetutil.GetCurrentSetWeight(r)))
+	}
+	return ""
+}
+
 // PostPromotionLabels returns a map[string]string of common labels for the post promotion analysis
 func PostPromotionLabels(podHash, instanceID string) map[string]string {
 	labels := map[string]string{
@@ -212,5 +243,14 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 	if numProviders > 1 {
 		return fmt.Errorf("multiple providers specified")
 	}
+	switch metric.EvaluationMode {
+	case "", v1alpha1.EvaluationModeAggregate:
+	case v1alpha1.EvaluationModePerSeries:
+		if metric.Provider.Prometheus == nil && metric.Provider.Datadog == nil && metric.Provider.Wavefront == nil {
+			return fmt.Errorf("evaluationMode '%s' is only supported by the prometheus, datadog and wavefront providers", metric.EvaluationMode)
+		}
+	default:
+		return fmt.Errorf("invalid evaluationMode '%s'", metric.EvaluationMode)
+	}
 	return nil
 }
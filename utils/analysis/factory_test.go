@@ -114,6 +114,74 @@ func TestBuildArgumentsForRolloutAnalysisRun(t *testing.T) {
 
 }
 
+func TestBuildArgumentsForRolloutAnalysisRunFromRolloutStatus(t *testing.T) {
+	stepIndex := v1alpha1.StepIndex
+	canaryWeight := v1alpha1.CanaryWeight
+	args := []v1alpha1.AnalysisRunArgument{
+		{
+			Name: "step-index",
+			ValueFrom: &v1alpha1.ArgumentValueFrom{
+				RolloutStatusValue: &stepIndex,
+			},
+		},
+		{
+			Name: "canary-weight",
+			ValueFrom: &v1alpha1.ArgumentValueFrom{
+				RolloutStatusValue: &canaryWeight,
+			},
+		},
+		{
+			Name: "threshold",
+			ValueFrom: &v1alpha1.ArgumentValueFrom{
+				ConfigMapKeyRef: &v1alpha1.ConfigMapKeyRef{Name: "thresholds", Key: "success-rate"},
+			},
+		},
+		{
+			Name: "team",
+			ValueFrom: &v1alpha1.ArgumentValueFrom{
+				FieldRef: &v1alpha1.FieldRef{FieldPath: "metadata.annotations['team']"},
+			},
+		},
+	}
+	rs := &appsv1.ReplicaSet{}
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{"team": "payments"},
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{
+						{SetWeight: pointer.Int32Ptr(10)},
+						{Pause: &v1alpha1.RolloutPause{}},
+						{SetWeight: pointer.Int32Ptr(40)},
+						{Analysis: &v1alpha1.RolloutAnalysis{}},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			CurrentStepIndex: pointer.Int32Ptr(3),
+		},
+	}
+
+	built := BuildArgumentsForRolloutAnalysisRun(args, rs, rs, ro)
+	assert.Equal(t, []v1alpha1.Argument{
+		{Name: "step-index", Value: pointer.StringPtr("3")},
+		{Name: "canary-weight", Value: pointer.StringPtr("40")},
+		{Name: "threshold", ValueFrom: &v1alpha1.ValueFrom{ConfigMapKeyRef: &v1alpha1.ConfigMapKeyRef{Name: "thresholds", Key: "success-rate"}}},
+		{Name: "team", Value: pointer.StringPtr("payments")},
+	}, built)
+
+	// rollout status values do not apply to blue-green rollouts
+	ro.Spec.Strategy = v1alpha1.RolloutStrategy{BlueGreen: &v1alpha1.BlueGreenStrategy{}}
+	built = BuildArgumentsForRolloutAnalysisRun(args[:2], rs, rs, ro)
+	assert.Equal(t, []v1alpha1.Argument{
+		{Name: "step-index", Value: pointer.StringPtr("")},
+		{Name: "canary-weight", Value: pointer.StringPtr("")},
+	}, built)
+}
+
 func TestPrePromotionLabels(t *testing.T) {
 	podHash := "abcd123"
 	expected := map[string]string{
@@ -386,6 +454,26 @@ func TestValidateMetrics(t *testing.T) {
 		err := ValidateMetrics(spec.Metrics)
 		assert.EqualError(t, err, "metrics[0]: multiple providers specified")
 	})
+	t.Run("Ensure evaluationMode is valid", func(t *testing.T) {
+		metric := v1alpha1.Metric{
+			Name:           "success-rate",
+			EvaluationMode: "Sometimes",
+			Provider: v1alpha1.MetricProvider{
+				Prometheus: &v1alpha1.PrometheusMetric{},
+			},
+		}
+		err := ValidateMetrics([]v1alpha1.Metric{metric})
+		assert.EqualError(t, err, "metrics[0]: invalid evaluationMode 'Sometimes'")
+
+		metric.EvaluationMode = v1alpha1.EvaluationModePerSeries
+		assert.NoError(t, ValidateMetrics([]v1alpha1.Metric{metric}))
+
+		metric.Provider = v1alpha1.MetricProvider{
+			Web: &v1alpha1.WebMetric{},
+		}
+		err = ValidateMetrics([]v1alpha1.Metric{metric})
+		assert.EqualError(t, err, "metrics[0]: evaluationMode 'PerSeries' is only supported by the prometheus, datadog and wavefront providers")
+	})
 }
 
 // TestResolveMetricArgs verifies that metric arguments are resolved
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	log "github.com/sirupsen/logrus"
@@ -16,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	argoprojclient "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 )
 
 // CurrentAnalysisRuns holds all the current analysis runs for a Rollout
@@ -230,8 +232,8 @@ func CreateWithCollisionCounter(logCtx *log.Entry, analysisRunIf argoprojclient.
 	}
 }
 
-func NewAnalysisRunFromTemplates(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate, args []v1alpha1.Argument, name, generateName, namespace string) (*v1alpha1.AnalysisRun, error) {
-	template, err := FlattenTemplates(templates, clusterTemplates)
+func NewAnalysisRunFromTemplates(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate, getter TemplateGetter, args []v1alpha1.Argument, name, generateName, namespace string) (*v1alpha1.AnalysisRun, error) {
+	template, err := FlattenTemplates(templates, clusterTemplates, getter)
 	if err != nil {
 		return nil, err
 	}
@@ -253,24 +255,187 @@ func NewAnalysisRunFromTemplates(templates []*v1alpha1.AnalysisTemplate, cluster
 	return &ar, nil
 }
 
-func FlattenTemplates(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) (*v1alpha1.AnalysisTemplate, error) {
-	metrics, err := flattenMetrics(templates, clusterTemplates)
-	if err != nil {
-		return nil, err
+// TemplateGetter retrieves the templates referenced by the includes of a template
+type TemplateGetter interface {
+	GetAnalysisTemplate(namespace, name string) (*v1alpha1.AnalysisTemplate, error)
+	GetClusterAnalysisTemplate(name string) (*v1alpha1.ClusterAnalysisTemplate, error)
+}
+
+type listerTemplateGetter struct {
+	templateLister        listers.AnalysisTemplateLister
+	clusterTemplateLister listers.ClusterAnalysisTemplateLister
+}
+
+// NewTemplateGetter returns a TemplateGetter which retrieves templates from the informer caches
+func NewTemplateGetter(templateLister listers.AnalysisTemplateLister, clusterTemplateLister listers.ClusterAnalysisTemplateLister) TemplateGetter {
+	return &listerTemplateGetter{
+		templateLister:        templateLister,
+		clusterTemplateLister: clusterTemplateLister,
+	}
+}
+
+func (g *listerTemplateGetter) GetAnalysisTemplate(namespace, name string) (*v1alpha1.AnalysisTemplate, error) {
+	return g.templateLister.AnalysisTemplates(namespace).Get(name)
+}
+
+func (g *listerTemplateGetter) GetClusterAnalysisTemplate(name string) (*v1alpha1.ClusterAnalysisTemplate, error) {
+	return g.clusterTemplateLister.Get(name)
+}
+
+// FlattenTemplates merges the metrics and args of the templates, and of the templates they include,
+// into a single template. A template which is included several times contributes its metrics once.
+// Returns an error if the includes form a cycle, or if the templates define conflicting metrics or
+// args.
+func FlattenTemplates(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate, getter TemplateGetter) (*v1alpha1.AnalysisTemplate, error) {
+	var resolved []resolvedTemplate
+	for _, template := range templates {
+		r, err := resolveIncludes(template.Spec, template.Namespace, false, []string{templateKey(template.Name, false)}, getter)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, *r)
 	}
-	args, err := flattenArgs(templates, clusterTemplates)
+	for _, template := range clusterTemplates {
+		r, err := resolveIncludes(template.Spec, "", true, []string{templateKey(template.Name, true)}, getter)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, *r)
+	}
+	merged, err := mergeResolvedTemplates(resolved)
 	if err != nil {
 		return nil, err
 	}
 	return &v1alpha1.AnalysisTemplate{
 		Spec: v1alpha1.AnalysisTemplateSpec{
-			Metrics: metrics,
-			Args:    args,
+			Metrics: merged.metrics,
+			Args:    merged.args,
 		},
 	}, nil
 }
 
-func flattenArgs(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) ([]v1alpha1.Argument, error) {
+// resolvedTemplate holds the metrics and args of a template merged with those of its includes. Each
+// metric is paired with the key of the included template which defines it, so that the metrics of
+// a template which is reached through several includes are merged only once. The origin of the
+// metrics of the templates which are referenced directly is empty, since they are never merged.
+type resolvedTemplate struct {
+	metrics []v1alpha1.Metric
+	origins []string
+	args    []v1alpha1.Argument
+}
+
+func mergeResolvedTemplates(templates []resolvedTemplate) (*resolvedTemplate, error) {
+	var merged resolvedTemplate
+	origins := map[string]string{}
+	var specs []v1alpha1.AnalysisTemplateSpec
+	for _, template := range templates {
+		for i, metric := range template.metrics {
+			if origin, ok := origins[metric.Name]; ok {
+				if origin != "" && origin == template.origins[i] {
+					continue
+				}
+				return nil, fmt.Errorf("two metrics have the same name '%s'", metric.Name)
+			}
+			origins[metric.Name] = template.origins[i]
+			merged.metrics = append(merged.metrics, metric)
+			merged.origins = append(merged.origins, template.origins[i])
+		}
+		specs = append(specs, v1alpha1.AnalysisTemplateSpec{Args: template.args})
+	}
+	args, err := flattenArgs(specs)
+	if err != nil {
+		return nil, err
+	}
+	merged.args = args
+	return &merged, nil
+}
+
+func templateKey(name string, clusterScope bool) string {
+	if clusterScope {
+		return fmt.Sprintf("ClusterAnalysisTemplate '%s'", name)
+	}
+	return fmt.Sprintf("AnalysisTemplate '%s'", name)
+}
+
+// resolveIncludes returns the metrics and args of a template merged with those of its includes,
+// recursively. path is the chain of templates which led to this template and is used to detect
+// cycles.
+func resolveIncludes(spec v1alpha1.AnalysisTemplateSpec, namespace string, clusterScope bool, path []string, getter TemplateGetter) (*resolvedTemplate, error) {
+	own := resolvedTemplate{
+		metrics: spec.Metrics,
+		args:    spec.Args,
+	}
+	origin := ""
+	if len(path) > 1 {
+		origin = path[len(path)-1]
+	}
+	for range spec.Metrics {
+		own.origins = append(own.origins, origin)
+	}
+	if len(spec.Includes) == 0 {
+		return &own, nil
+	}
+	if getter == nil {
+		return nil, fmt.Errorf("unable to resolve the includes of %s", path[len(path)-1])
+	}
+	var templates []resolvedTemplate
+	for _, include := range spec.Includes {
+		key := templateKey(include.TemplateName, include.ClusterScope)
+		for _, visited := range path {
+			if visited == key {
+				return nil, fmt.Errorf("cycle detected in template includes: %s -> %s", strings.Join(path, " -> "), key)
+			}
+		}
+		var includedSpec v1alpha1.AnalysisTemplateSpec
+		if include.ClusterScope {
+			template, err := getter.GetClusterAnalysisTemplate(include.TemplateName)
+			if err != nil {
+				return nil, fmt.Errorf("%s included by %s: %v", key, path[len(path)-1], err)
+			}
+			includedSpec = template.Spec
+		} else {
+			if clusterScope {
+				return nil, fmt.Errorf("%s cannot include namespaced %s", path[len(path)-1], key)
+			}
+			template, err := getter.GetAnalysisTemplate(namespace, include.TemplateName)
+			if err != nil {
+				return nil, fmt.Errorf("%s included by %s: %v", key, path[len(path)-1], err)
+			}
+			includedSpec = template.Spec
+		}
+		resolved, err := resolveIncludes(includedSpec, namespace, include.ClusterScope, append(path[:len(path):len(path)], key), getter)
+		if err != nil {
+			return nil, err
+		}
+		resolved.args, err = overrideArgs(include.Args, resolved.args, key)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, *resolved)
+	}
+	merged, err := mergeResolvedTemplates(append(templates, own))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path[len(path)-1], err)
+	}
+	return merged, nil
+}
+
+// overrideArgs replaces the values of the args of an included template with the values specified
+// by the include
+func overrideArgs(overrides, templateArgs []v1alpha1.Argument, key string) ([]v1alpha1.Argument, error) {
+	newArgs := append(templateArgs[:0:0], templateArgs...)
+	for _, arg := range overrides {
+		i := findArg(arg.Name, newArgs)
+		if i < 0 {
+			return nil, fmt.Errorf("%s has no argument '%s'", key, arg.Name)
+		}
+		newArgs[i].Value = arg.Value
+		newArgs[i].ValueFrom = arg.ValueFrom
+	}
+	return newArgs, nil
+}
+
+func flattenArgs(specs []v1alpha1.AnalysisTemplateSpec) ([]v1alpha1.Argument, error) {
 	var combinedArgs []v1alpha1.Argument
 	appendOrUpdate := func(newArg v1alpha1.Argument) error {
 		for i, prevArg := range combinedArgs {
@@ -292,15 +457,8 @@ func flattenArgs(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1a
 		return nil
 	}
 
-	for _, template := range templates {
-		for _, arg := range template.Spec.Args {
-			if err := appendOrUpdate(arg); err != nil {
-				return nil, err
-			}
-		}
-	}
-	for _, template := range clusterTemplates {
-		for _, arg := range template.Spec.Args {
+	for _, spec := range specs {
+		for _, arg := range spec.Args {
 			if err := appendOrUpdate(arg); err != nil {
 				return nil, err
 			}
@@ -309,26 +467,6 @@ func flattenArgs(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1a
 	return combinedArgs, nil
 }
 
-func flattenMetrics(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) ([]v1alpha1.Metric, error) {
-	var combinedMetrics []v1alpha1.Metric
-	for _, template := range templates {
-		combinedMetrics = append(combinedMetrics, template.Spec.Metrics...)
-	}
-
-	for _, template := range clusterTemplates {
-		combinedMetrics = append(combinedMetrics, template.Spec.Metrics...)
-	}
-
-	metricMap := map[string]bool{}
-	for _, metric := range combinedMetrics {
-		if _, ok := metricMap[metric.Name]; ok {
-			return nil, fmt.Errorf("two metrics have the same name '%s'", metric.Name)
-		}
-		metricMap[metric.Name] = true
-	}
-	return combinedMetrics, nil
-}
-
 func NewAnalysisRunFromUnstructured(obj *unstructured.Unstructured, templateArgs []v1alpha1.Argument, name, generateName, namespace string) (*unstructured.Unstructured, error) {
 	var newArgs []v1alpha1.Argument
 
@@ -11,10 +11,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 )
 
 func TestIsWorst(t *testing.T) {
@@ -320,7 +322,7 @@ func TestFlattenTemplates(t *testing.T) {
 		}
 	}
 	t.Run("Handle empty list", func(t *testing.T) {
-		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{}, []*v1alpha1.ClusterAnalysisTemplate{})
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{}, []*v1alpha1.ClusterAnalysisTemplate{}, nil)
 		assert.Nil(t, err)
 		assert.Len(t, template.Spec.Metrics, 0)
 		assert.Len(t, template.Spec.Args, 0)
@@ -333,7 +335,7 @@ func TestFlattenTemplates(t *testing.T) {
 				Args:    []v1alpha1.Argument{arg("test", pointer.StringPtr("true"))},
 			},
 		}
-		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{orig}, []*v1alpha1.ClusterAnalysisTemplate{})
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{orig}, []*v1alpha1.ClusterAnalysisTemplate{}, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, orig.Spec, template.Spec)
 	})
@@ -352,7 +354,7 @@ func TestFlattenTemplates(t *testing.T) {
 					Args:    nil,
 				},
 			},
-		}, []*v1alpha1.ClusterAnalysisTemplate{})
+		}, []*v1alpha1.ClusterAnalysisTemplate{}, nil)
 		assert.Nil(t, err)
 		assert.Nil(t, template.Spec.Args)
 		assert.Len(t, template.Spec.Metrics, 2)
@@ -376,7 +378,7 @@ func TestFlattenTemplates(t *testing.T) {
 					Args:    nil,
 				},
 			},
-		})
+		}, nil)
 		assert.Nil(t, err)
 		assert.Nil(t, template.Spec.Args)
 		assert.Len(t, template.Spec.Metrics, 2)
@@ -397,7 +399,7 @@ func TestFlattenTemplates(t *testing.T) {
 					Args:    nil,
 				},
 			},
-		}, []*v1alpha1.ClusterAnalysisTemplate{})
+		}, []*v1alpha1.ClusterAnalysisTemplate{}, nil)
 		assert.Nil(t, template)
 		assert.Equal(t, err, fmt.Errorf("two metrics have the same name 'foo'"))
 	})
@@ -416,7 +418,7 @@ func TestFlattenTemplates(t *testing.T) {
 					Args:    []v1alpha1.Argument{barArgs},
 				},
 			},
-		}, []*v1alpha1.ClusterAnalysisTemplate{})
+		}, []*v1alpha1.ClusterAnalysisTemplate{}, nil)
 		assert.Nil(t, err)
 		assert.Len(t, template.Spec.Args, 2)
 		assert.Equal(t, fooArgs, template.Spec.Args[0])
@@ -437,7 +439,7 @@ func TestFlattenTemplates(t *testing.T) {
 					Args:    []v1alpha1.Argument{fooArgsNoValue},
 				},
 			},
-		}, []*v1alpha1.ClusterAnalysisTemplate{})
+		}, []*v1alpha1.ClusterAnalysisTemplate{}, nil)
 		assert.Nil(t, err)
 		assert.Len(t, template.Spec.Args, 1)
 		assert.Contains(t, template.Spec.Args, fooArgsValue)
@@ -457,12 +459,155 @@ func TestFlattenTemplates(t *testing.T) {
 					Args:    []v1alpha1.Argument{fooArgsWithDiffValue},
 				},
 			},
-		}, []*v1alpha1.ClusterAnalysisTemplate{})
+		}, []*v1alpha1.ClusterAnalysisTemplate{}, nil)
 		assert.Equal(t, fmt.Errorf("Argument `foo` specified multiple times with different values: 'true', 'false'"), err)
 		assert.Nil(t, template)
 	})
 }
 
+func TestFlattenTemplatesWithIncludes(t *testing.T) {
+	metric := func(name string) v1alpha1.Metric {
+		return v1alpha1.Metric{Name: name, SuccessCondition: "true"}
+	}
+	arg := func(name string, value *string) v1alpha1.Argument {
+		return v1alpha1.Argument{Name: name, Value: value}
+	}
+	newGetter := func(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) TemplateGetter {
+		templateIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		for _, template := range templates {
+			assert.NoError(t, templateIndexer.Add(template))
+		}
+		clusterTemplateIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		for _, template := range clusterTemplates {
+			assert.NoError(t, clusterTemplateIndexer.Add(template))
+		}
+		return NewTemplateGetter(listers.NewAnalysisTemplateLister(templateIndexer), listers.NewClusterAnalysisTemplateLister(clusterTemplateIndexer))
+	}
+	golden := &v1alpha1.ClusterAnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "golden-signals"},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{metric("error-rate"), metric("latency")},
+			Args:    []v1alpha1.Argument{arg("service", nil), arg("threshold", pointer.StringPtr("0.95"))},
+		},
+	}
+	base := &v1alpha1.AnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{metric("saturation")},
+			Includes: []v1alpha1.AnalysisTemplateInclude{{
+				TemplateName: "golden-signals",
+				ClusterScope: true,
+				Args:         []v1alpha1.Argument{arg("threshold", pointer.StringPtr("0.99"))},
+			}},
+		},
+	}
+
+	t.Run("Resolve includes recursively", func(t *testing.T) {
+		service := &v1alpha1.AnalysisTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha1.AnalysisTemplateSpec{
+				Metrics:  []v1alpha1.Metric{metric("queue-depth")},
+				Args:     []v1alpha1.Argument{arg("service", pointer.StringPtr("checkout"))},
+				Includes: []v1alpha1.AnalysisTemplateInclude{{TemplateName: "base"}},
+			},
+		}
+		getter := newGetter([]*v1alpha1.AnalysisTemplate{base}, []*v1alpha1.ClusterAnalysisTemplate{golden})
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{service}, nil, getter)
+		assert.NoError(t, err)
+		assert.Equal(t, []v1alpha1.Metric{metric("error-rate"), metric("latency"), metric("saturation"), metric("queue-depth")}, template.Spec.Metrics)
+		assert.Equal(t, []v1alpha1.Argument{arg("service", pointer.StringPtr("checkout")), arg("threshold", pointer.StringPtr("0.99"))}, template.Spec.Args)
+		assert.Nil(t, template.Spec.Includes)
+		// the included templates are not modified
+		assert.Nil(t, golden.Spec.Args[0].Value)
+		assert.Equal(t, "0.95", *golden.Spec.Args[1].Value)
+	})
+	t.Run("Include a template through several includes", func(t *testing.T) {
+		left := &v1alpha1.AnalysisTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "left", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha1.AnalysisTemplateSpec{
+				Metrics:  []v1alpha1.Metric{metric("left")},
+				Includes: []v1alpha1.AnalysisTemplateInclude{{TemplateName: "golden-signals", ClusterScope: true}},
+			},
+		}
+		right := &v1alpha1.AnalysisTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "right", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha1.AnalysisTemplateSpec{
+				Metrics:  []v1alpha1.Metric{metric("right")},
+				Includes: []v1alpha1.AnalysisTemplateInclude{{TemplateName: "golden-signals", ClusterScope: true}},
+			},
+		}
+		top := &v1alpha1.AnalysisTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "top", Namespace: metav1.NamespaceDefault},
+			Spec: v1alpha1.AnalysisTemplateSpec{
+				Includes: []v1alpha1.AnalysisTemplateInclude{{TemplateName: "left"}, {TemplateName: "right"}},
+			},
+		}
+		getter := newGetter([]*v1alpha1.AnalysisTemplate{left, right}, []*v1alpha1.ClusterAnalysisTemplate{golden})
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{top}, nil, getter)
+		assert.NoError(t, err)
+		assert.Equal(t, []v1alpha1.Metric{metric("error-rate"), metric("latency"), metric("left"), metric("right")}, template.Spec.Metrics)
+		assert.Equal(t, []v1alpha1.Argument{arg("service", nil), arg("threshold", pointer.StringPtr("0.95"))}, template.Spec.Args)
+
+		// the args of the template must not be overridden with different values
+		right.Spec.Includes[0].Args = []v1alpha1.Argument{arg("threshold", pointer.StringPtr("0.99"))}
+		getter = newGetter([]*v1alpha1.AnalysisTemplate{left, right}, []*v1alpha1.ClusterAnalysisTemplate{golden})
+		template, err = FlattenTemplates([]*v1alpha1.AnalysisTemplate{top}, nil, getter)
+		assert.Nil(t, template)
+		assert.EqualError(t, err, "AnalysisTemplate 'top': Argument `threshold` specified multiple times with different values: '0.95', '0.99'")
+	})
+	t.Run("Error: includes without a getter", func(t *testing.T) {
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{base}, nil, nil)
+		assert.Nil(t, template)
+		assert.EqualError(t, err, "unable to resolve the includes of AnalysisTemplate 'base'")
+	})
+	t.Run("Error: included template not found", func(t *testing.T) {
+		getter := newGetter(nil, nil)
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{base}, nil, getter)
+		assert.Nil(t, template)
+		assert.EqualError(t, err, "ClusterAnalysisTemplate 'golden-signals' included by AnalysisTemplate 'base': clusteranalysistemplate.argoproj.io \"golden-signals\" not found")
+	})
+	t.Run("Error: cycle", func(t *testing.T) {
+		a := &v1alpha1.AnalysisTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: metav1.NamespaceDefault},
+			Spec:       v1alpha1.AnalysisTemplateSpec{Includes: []v1alpha1.AnalysisTemplateInclude{{TemplateName: "b"}}},
+		}
+		b := &v1alpha1.AnalysisTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: metav1.NamespaceDefault},
+			Spec:       v1alpha1.AnalysisTemplateSpec{Includes: []v1alpha1.AnalysisTemplateInclude{{TemplateName: "a"}}},
+		}
+		getter := newGetter([]*v1alpha1.AnalysisTemplate{a, b}, nil)
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{a}, nil, getter)
+		assert.Nil(t, template)
+		assert.EqualError(t, err, "cycle detected in template includes: AnalysisTemplate 'a' -> AnalysisTemplate 'b' -> AnalysisTemplate 'a'")
+	})
+	t.Run("Error: metric conflicts with included metric", func(t *testing.T) {
+		conflicting := base.DeepCopy()
+		conflicting.Spec.Metrics = []v1alpha1.Metric{metric("latency")}
+		getter := newGetter(nil, []*v1alpha1.ClusterAnalysisTemplate{golden})
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{conflicting}, nil, getter)
+		assert.Nil(t, template)
+		assert.EqualError(t, err, "AnalysisTemplate 'base': two metrics have the same name 'latency'")
+	})
+	t.Run("Error: override of unknown argument", func(t *testing.T) {
+		unknownArg := base.DeepCopy()
+		unknownArg.Spec.Includes[0].Args = []v1alpha1.Argument{arg("unknown", pointer.StringPtr("true"))}
+		getter := newGetter(nil, []*v1alpha1.ClusterAnalysisTemplate{golden})
+		template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{unknownArg}, nil, getter)
+		assert.Nil(t, template)
+		assert.EqualError(t, err, "ClusterAnalysisTemplate 'golden-signals' has no argument 'unknown'")
+	})
+	t.Run("Error: cluster template includes namespaced template", func(t *testing.T) {
+		clusterTemplate := &v1alpha1.ClusterAnalysisTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec:       v1alpha1.AnalysisTemplateSpec{Includes: []v1alpha1.AnalysisTemplateInclude{{TemplateName: "base"}}},
+		}
+		getter := newGetter([]*v1alpha1.AnalysisTemplate{base}, nil)
+		template, err := FlattenTemplates(nil, []*v1alpha1.ClusterAnalysisTemplate{clusterTemplate}, getter)
+		assert.Nil(t, template)
+		assert.EqualError(t, err, "ClusterAnalysisTemplate 'cluster' cannot include namespaced AnalysisTemplate 'base'")
+	})
+}
+
 func TestNewAnalysisRunFromTemplates(t *testing.T) {
 	templates := []*v1alpha1.AnalysisTemplate{{
 		ObjectMeta: metav1.ObjectMeta{
@@ -503,7 +648,7 @@ func TestNewAnalysisRunFromTemplates(t *testing.T) {
 	}
 
 	args := []v1alpha1.Argument{arg, secretArg}
-	run, err := NewAnalysisRunFromTemplates(templates, clustertemplates, args, "foo-run", "foo-run-generate-", "my-ns")
+	run, err := NewAnalysisRunFromTemplates(templates, clustertemplates, nil, args, "foo-run", "foo-run-generate-", "my-ns")
 	assert.NoError(t, err)
 	assert.Equal(t, "foo-run", run.Name)
 	assert.Equal(t, "foo-run-generate-", run.GenerateName)
@@ -516,7 +661,7 @@ func TestNewAnalysisRunFromTemplates(t *testing.T) {
 	// Fail Merge Args
 	unresolvedArg := v1alpha1.Argument{Name: "unresolved"}
 	templates[0].Spec.Args = append(templates[0].Spec.Args, unresolvedArg)
-	run, err = NewAnalysisRunFromTemplates(templates, clustertemplates, args, "foo-run", "foo-run-generate-", "my-ns")
+	run, err = NewAnalysisRunFromTemplates(templates, clustertemplates, nil, args, "foo-run", "foo-run-generate-", "my-ns")
 	assert.Nil(t, run)
 	assert.Equal(t, fmt.Errorf("args.unresolved was not resolved"), err)
 	// Fail flatten metric
@@ -529,7 +674,7 @@ func TestNewAnalysisRunFromTemplates(t *testing.T) {
 	}
 	// Fail Flatten Templates
 	templates = append(templates, matchingMetric)
-	run, err = NewAnalysisRunFromTemplates(templates, clustertemplates, args, "foo-run", "foo-run-generate-", "my-ns")
+	run, err = NewAnalysisRunFromTemplates(templates, clustertemplates, nil, args, "foo-run", "foo-run-generate-", "my-ns")
 	assert.Nil(t, run)
 	assert.Equal(t, fmt.Errorf("two metrics have the same name 'success-rate'"), err)
 }
@@ -21,12 +21,18 @@ const (
 	RevisionAnnotation = RolloutLabel + "/revision"
 	// RevisionHistoryAnnotation maintains the history of all old revisions that a replica set has served for a rollout.
 	RevisionHistoryAnnotation = RolloutLabel + "/revision-history"
+	// StableRevisionAnnotation is the last revision of a rollout which a replica set served as the
+	// stable replica set. It is only recorded for rollouts with a rollback window.
+	StableRevisionAnnotation = RolloutLabel + "/stable-revision"
 	// DesiredReplicasAnnotation is the desired replicas for a rollout recorded as an annotation
 	// in its replica sets. Helps in separating scaling events from the rollout process and for
 	// determining if the new replica set for a rollout is really saturated.
 	DesiredReplicasAnnotation = RolloutLabel + "/desired-replicas"
 	// WorkloadGenerationAnnotation is the generation of the referenced workload
 	WorkloadGenerationAnnotation = RolloutLabel + "/workload-generation"
+	// AuditLogAnnotation is the log of the most recent manual actions on a rollout, i.e. who
+	// promoted, aborted, retried, restarted, paused, undid or set the image of the rollout and why
+	AuditLogAnnotation = RolloutLabel + "/audit-log"
 )
 
 // GetDesiredReplicasAnnotation returns the number of desired replicas
@@ -67,6 +73,43 @@ func getIntFromAnnotation(rs *appsv1.ReplicaSet, annotationKey string) (int32, b
 	return int32(intValue), true
 }
 
+// GetRevisionsSinceStable returns how many revisions were rolled out between the current revision
+// of the replica set and the last revision it served as the stable replica set. Returns false if the
+// replica set never was the stable replica set of an earlier revision.
+func GetRevisionsSinceStable(rs *appsv1.ReplicaSet) (int64, bool) {
+	if rs == nil {
+		return 0, false
+	}
+	revision, err := strconv.ParseInt(rs.Annotations[RevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	stableRevisionStr, ok := rs.Annotations[StableRevisionAnnotation]
+	if !ok {
+		return 0, false
+	}
+	stableRevision, err := strconv.ParseInt(stableRevisionStr, 10, 64)
+	if err != nil {
+		log.Warnf("Cannot convert the value %q with annotation key %q for the replica set %q", stableRevisionStr, StableRevisionAnnotation, rs.Name)
+		return 0, false
+	}
+	if stableRevision >= revision {
+		return 0, false
+	}
+	return revision - stableRevision - 1, true
+}
+
+// SetStableRevisionAnnotation records the current revision of the replica set as the last revision
+// it served as the stable replica set. Returns true if the annotation was changed.
+func SetStableRevisionAnnotation(rs *appsv1.ReplicaSet) bool {
+	revision, ok := rs.Annotations[RevisionAnnotation]
+	if !ok || rs.Annotations[StableRevisionAnnotation] == revision {
+		return false
+	}
+	rs.Annotations[StableRevisionAnnotation] = revision
+	return true
+}
+
 // SetRolloutRevision updates the revision for a rollout.
 func SetRolloutRevision(rollout *v1alpha1.Rollout, revision string) bool {
 	if rollout.Annotations == nil {
@@ -187,7 +230,9 @@ var annotationsToSkip = map[string]bool{
 	corev1.LastAppliedConfigAnnotation: true,
 	RevisionAnnotation:                 true,
 	RevisionHistoryAnnotation:          true,
+	StableRevisionAnnotation:           true,
 	DesiredReplicasAnnotation:          true,
+	AuditLogAnnotation:                 true,
 }
 
 // skipCopyAnnotation returns true if we should skip copying the annotation with the given annotation key
@@ -200,6 +200,14 @@ func TestAnnotationUtils(t *testing.T) {
 		assert.Equal(t, "value", newRS.Annotations["key"])
 	})
 
+	t.Run("SetNewReplicaSetAnnotationsSkipAuditLog", func(t *testing.T) {
+		newRS := tRS.DeepCopy()
+		newRollout := tRollout.DeepCopy()
+		newRollout.Annotations[AuditLogAnnotation] = `[{"action":"Abort"}]`
+		SetNewReplicaSetAnnotations(newRollout, newRS, "20", false)
+		assert.NotContains(t, newRS.Annotations, AuditLogAnnotation)
+	})
+
 	t.Run("SetNewReplicaSetAnnotationsHandleBadOldRevision", func(t *testing.T) {
 		badRS := tRS.DeepCopy()
 		badRS.Annotations[RevisionAnnotation] = "Not an int"
@@ -444,3 +452,43 @@ func TestReplicasAnnotationsNeedUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRevisionsSinceStable(t *testing.T) {
+	newRS := func(annotations map[string]string) *appsv1.ReplicaSet {
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "hello", Namespace: "test", Annotations: annotations},
+		}
+	}
+
+	_, ok := GetRevisionsSinceStable(nil)
+	assert.False(t, ok)
+
+	// a replica set which served an earlier revision without becoming stable, e.g. an aborted one
+	_, ok = GetRevisionsSinceStable(newRS(map[string]string{RevisionAnnotation: "5", RevisionHistoryAnnotation: "3"}))
+	assert.False(t, ok)
+
+	_, ok = GetRevisionsSinceStable(newRS(map[string]string{RevisionAnnotation: "3", StableRevisionAnnotation: "abc"}))
+	assert.False(t, ok)
+
+	// the current stable replica set
+	_, ok = GetRevisionsSinceStable(newRS(map[string]string{RevisionAnnotation: "3", StableRevisionAnnotation: "3"}))
+	assert.False(t, ok)
+
+	revisions, ok := GetRevisionsSinceStable(newRS(map[string]string{RevisionAnnotation: "3", StableRevisionAnnotation: "1"}))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), revisions)
+
+	revisions, ok = GetRevisionsSinceStable(newRS(map[string]string{RevisionAnnotation: "9", RevisionHistoryAnnotation: "1,4", StableRevisionAnnotation: "4"}))
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), revisions)
+}
+
+func TestSetStableRevisionAnnotation(t *testing.T) {
+	rs := &appsv1.ReplicaSet{}
+	assert.False(t, SetStableRevisionAnnotation(rs))
+
+	rs.Annotations = map[string]string{RevisionAnnotation: "3"}
+	assert.True(t, SetStableRevisionAnnotation(rs))
+	assert.Equal(t, "3", rs.Annotations[StableRevisionAnnotation])
+	assert.False(t, SetStableRevisionAnnotation(rs))
+}
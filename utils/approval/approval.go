@@ -0,0 +1,237 @@
+package approval
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const (
+	// requestTimeout bounds the time an approval request waits for its endpoint to answer
+	requestTimeout = 5 * time.Second
+	// answerTTL is how long an answer is kept for a rollout which does not collect it, e.g. because
+	// the rollout was deleted
+	answerTTL = 10 * time.Minute
+)
+
+var httpClient = &http.Client{
+	Timeout: requestTimeout,
+	// redirects are not followed, so that the allowed url prefixes cannot be bypassed
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+var (
+	allowedURLPrefixesMutex sync.RWMutex
+	allowedURLPrefixes      []string
+)
+
+// SetAllowedURLPrefixes restricts the approval endpoints to the urls which match one of the
+// prefixes. Any http(s) url is allowed if no prefix is set
+func SetAllowedURLPrefixes(prefixes []string) {
+	allowedURLPrefixesMutex.Lock()
+	defer allowedURLPrefixesMutex.Unlock()
+	allowedURLPrefixes = prefixes
+}
+
+// CheckURL returns an error if approval requests may not be sent to the url
+func CheckURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("approval url '%s' is not an absolute http or https url", rawURL)
+	}
+	allowedURLPrefixesMutex.RLock()
+	defer allowedURLPrefixesMutex.RUnlock()
+	if len(allowedURLPrefixes) == 0 {
+		return nil
+	}
+	for _, prefix := range allowedURLPrefixes {
+		if matchesPrefix(u, prefix) {
+			return nil
+		}
+	}
+	return fmt.Errorf("approval url '%s' does not match any of the allowed url prefixes", rawURL)
+}
+
+// CheckSecretHeaders returns an error if the headers of approval requests may not be read from
+// Secrets. The values of Secrets are only sent once the approval endpoints are restricted to the
+// allowed url prefixes, so that they cannot be sent to an arbitrary url.
+func CheckSecretHeaders() error {
+	allowedURLPrefixesMutex.RLock()
+	defer allowedURLPrefixesMutex.RUnlock()
+	if len(allowedURLPrefixes) == 0 {
+		return fmt.Errorf("headers can only be read from secrets if the approval url prefixes are configured")
+	}
+	return nil
+}
+
+// matchesPrefix returns whether the url has the scheme and host of the prefix, and a path which
+// starts with the path of the prefix at a segment boundary
+func matchesPrefix(u *url.URL, rawPrefix string) bool {
+	prefix, err := url.Parse(rawPrefix)
+	if err != nil || prefix.Host == "" {
+		return false
+	}
+	if !strings.EqualFold(u.Scheme, prefix.Scheme) || !strings.EqualFold(u.Host, prefix.Host) {
+		return false
+	}
+	// dot segments are resolved by the endpoint, so they must not escape the path of the prefix
+	urlPath := path.Clean("/" + u.Path)
+	prefixPath := path.Clean("/" + prefix.Path)
+	if prefixPath == "/" || urlPath == prefixPath {
+		return true
+	}
+	return strings.HasPrefix(urlPath, prefixPath+"/")
+}
+
+// Request is the body POSTed to approval endpoints
+type Request struct {
+	Rollout         string `json:"rollout"`
+	Namespace       string `json:"namespace"`
+	UID             string `json:"uid"`
+	Revision        string `json:"revision"`
+	PodTemplateHash string `json:"podTemplateHash"`
+	StepIndex       int32  `json:"stepIndex"`
+}
+
+// Response is the answer of an approval endpoint. An empty body or phase means the answer is pending
+type Response struct {
+	Phase   string `json:"phase,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Answer is the outcome of an approval request. Err is set if the request failed
+type Answer struct {
+	Phase   v1alpha1.ApprovalPhase
+	Message string
+	Err     error
+}
+
+// Sender sends approval requests in the background, so that reconciliations never wait for approval
+// endpoints, and keeps the answers until they are collected
+type Sender interface {
+	// Send starts sending the approval request with the key, unless a request with the key is still
+	// in flight, and calls done once the answer was received
+	Send(key string, url string, headers map[string]string, req Request, done func())
+	// Answer returns and forgets the answer to the request with the key, if it was received
+	Answer(key string) (Answer, bool)
+}
+
+type answer struct {
+	Answer
+	receivedAt time.Time
+}
+
+type sender struct {
+	mutex    sync.Mutex
+	inFlight map[string]bool
+	answers  map[string]answer
+}
+
+// NewSender returns a sender which POSTs the approval requests to their endpoints
+func NewSender() Sender {
+	return &sender{
+		inFlight: map[string]bool{},
+		answers:  map[string]answer{},
+	}
+}
+
+func (s *sender) Send(key string, url string, headers map[string]string, req Request, done func()) {
+	s.mutex.Lock()
+	if s.inFlight[key] {
+		s.mutex.Unlock()
+		return
+	}
+	s.inFlight[key] = true
+	s.mutex.Unlock()
+
+	go func() {
+		phase, message, err := Post(url, headers, req)
+		now := time.Now()
+		s.mutex.Lock()
+		delete(s.inFlight, key)
+		for k, a := range s.answers {
+			if now.Sub(a.receivedAt) > answerTTL {
+				delete(s.answers, k)
+			}
+		}
+		s.answers[key] = answer{
+			Answer:     Answer{Phase: phase, Message: message, Err: err},
+			receivedAt: now,
+		}
+		s.mutex.Unlock()
+		done()
+	}()
+}
+
+func (s *sender) Answer(key string) (Answer, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	a, ok := s.answers[key]
+	if !ok {
+		return Answer{}, false
+	}
+	delete(s.answers, key)
+	return a.Answer, true
+}
+
+// Post POSTs the approval request to the endpoint and returns the answer
+func Post(url string, headers map[string]string, req Request) (v1alpha1.ApprovalPhase, string, error) {
+	if err := CheckURL(url); err != nil {
+		return "", "", err
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return "", "", err
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", "", err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		httpReq.Header.Set(key, value)
+	}
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", "", fmt.Errorf("received non 2xx response code: %v", resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+	return ParseResponse(data)
+}
+
+// ParseResponse parses the answer of an approval endpoint
+func ParseResponse(data []byte) (v1alpha1.ApprovalPhase, string, error) {
+	var answer Response
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := json.Unmarshal(data, &answer); err != nil {
+			return "", "", fmt.Errorf("could not parse approval response: %v", err)
+		}
+	}
+	for _, phase := range []v1alpha1.ApprovalPhase{v1alpha1.ApprovalPhasePending, v1alpha1.ApprovalPhaseApproved, v1alpha1.ApprovalPhaseDenied} {
+		if strings.EqualFold(answer.Phase, string(phase)) {
+			return phase, answer.Message, nil
+		}
+	}
+	if answer.Phase == "" {
+		return v1alpha1.ApprovalPhasePending, answer.Message, nil
+	}
+	return "", "", fmt.Errorf("unknown approval phase '%s'", answer.Phase)
+}
@@ -0,0 +1,158 @@
+package approval
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestPost(t *testing.T) {
+	var received Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.Write([]byte(`{"phase":"approved","message":"CHG-1 approved"}`))
+	}))
+	defer server.Close()
+
+	headers := map[string]string{"Authorization": "Bearer token"}
+	phase, message, err := Post(server.URL, headers, Request{Rollout: "guestbook", Namespace: "default", StepIndex: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.ApprovalPhaseApproved, phase)
+	assert.Equal(t, "CHG-1 approved", message)
+	assert.Equal(t, Request{Rollout: "guestbook", Namespace: "default", StepIndex: 2}, received)
+}
+
+func TestPostErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, _, err := Post(server.URL, nil, Request{})
+	assert.EqualError(t, err, "received non 2xx response code: 503")
+}
+
+func TestPostDoesNotFollowRedirects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	_, _, err := Post(server.URL, nil, Request{})
+	assert.EqualError(t, err, "received non 2xx response code: 307")
+}
+
+func TestCheckURL(t *testing.T) {
+	assert.NoError(t, CheckURL("https://approvals.example.com/api"))
+	assert.EqualError(t, CheckURL("file:///etc/passwd"), "approval url 'file:///etc/passwd' is not an absolute http or https url")
+
+	SetAllowedURLPrefixes([]string{"https://approvals.example.com/"})
+	defer SetAllowedURLPrefixes(nil)
+	assert.NoError(t, CheckURL("https://approvals.example.com/api"))
+	assert.EqualError(t, CheckURL("https://approvals.example.com.evil.io/api"), "approval url 'https://approvals.example.com.evil.io/api' does not match any of the allowed url prefixes")
+	assert.EqualError(t, CheckURL("https://approvals.example.com@evil.io/api"), "approval url 'https://approvals.example.com@evil.io/api' does not match any of the allowed url prefixes")
+	assert.EqualError(t, CheckURL("http://approvals.example.com/api"), "approval url 'http://approvals.example.com/api' does not match any of the allowed url prefixes")
+	assert.NoError(t, CheckURL("https://APPROVALS.example.com/api"))
+
+	SetAllowedURLPrefixes([]string{"https://approvals.example.com/api"})
+	assert.NoError(t, CheckURL("https://approvals.example.com/api"))
+	assert.NoError(t, CheckURL("https://approvals.example.com/api/changes"))
+	assert.Error(t, CheckURL("https://approvals.example.com/api-internal"))
+	assert.Error(t, CheckURL("https://approvals.example.com/api/../admin"))
+	_, _, err := Post("http://10.0.0.1/api", nil, Request{})
+	assert.EqualError(t, err, "approval url 'http://10.0.0.1/api' does not match any of the allowed url prefixes")
+}
+
+func TestCheckSecretHeaders(t *testing.T) {
+	assert.EqualError(t, CheckSecretHeaders(), "headers can only be read from secrets if the approval url prefixes are configured")
+
+	SetAllowedURLPrefixes([]string{"https://approvals.example.com/"})
+	defer SetAllowedURLPrefixes(nil)
+	assert.NoError(t, CheckSecretHeaders())
+}
+
+func TestSender(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{"phase":"Denied","message":"CHG-1 rejected"}`))
+	}))
+	defer server.Close()
+
+	s := NewSender()
+	_, ok := s.Answer("default/guestbook")
+	assert.False(t, ok)
+
+	done := make(chan struct{})
+	s.Send("default/guestbook", server.URL, nil, Request{}, func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(requestTimeout):
+		t.Fatal("approval request was not answered")
+	}
+	answer, ok := s.Answer("default/guestbook")
+	assert.True(t, ok)
+	assert.Equal(t, Answer{Phase: v1alpha1.ApprovalPhaseDenied, Message: "CHG-1 rejected"}, answer)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// the answer is only returned once
+	_, ok = s.Answer("default/guestbook")
+	assert.False(t, ok)
+}
+
+func TestSenderSkipsRequestInFlight(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+	}))
+	defer server.Close()
+
+	s := NewSender()
+	done := make(chan struct{})
+	s.Send("default/guestbook", server.URL, nil, Request{}, func() { close(done) })
+	s.Send("default/guestbook", server.URL, nil, Request{}, func() { t.Error("request in flight was sent again") })
+	close(release)
+	<-done
+	answer, ok := s.Answer("default/guestbook")
+	assert.True(t, ok)
+	assert.NoError(t, answer.Err)
+	assert.Equal(t, v1alpha1.ApprovalPhasePending, answer.Phase)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		body            string
+		expectedPhase   v1alpha1.ApprovalPhase
+		expectedMessage string
+		expectedErr     string
+	}{
+		{body: ``, expectedPhase: v1alpha1.ApprovalPhasePending},
+		{body: `{"message":"queued"}`, expectedPhase: v1alpha1.ApprovalPhasePending, expectedMessage: "queued"},
+		{body: `{"phase":"Pending"}`, expectedPhase: v1alpha1.ApprovalPhasePending},
+		{body: `{"phase":"DENIED","message":"outside change window"}`, expectedPhase: v1alpha1.ApprovalPhaseDenied, expectedMessage: "outside change window"},
+		{body: `{"phase":"Maybe"}`, expectedErr: "unknown approval phase 'Maybe'"},
+		{body: `approved`, expectedErr: "could not parse approval response: invalid character 'a' looking for beginning of value"},
+	}
+	for _, test := range tests {
+		phase, message, err := ParseResponse([]byte(test.body))
+		if test.expectedErr != "" {
+			assert.EqualError(t, err, test.expectedErr)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectedPhase, phase)
+		assert.Equal(t, test.expectedMessage, message)
+	}
+}
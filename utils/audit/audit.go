@@ -0,0 +1,141 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+// Action is a manual action on a rollout
+type Action string
+
+const (
+	ActionPromote     Action = "Promote"
+	ActionPromoteFull Action = "PromoteFull"
+	ActionAbort       Action = "Abort"
+	ActionRetry       Action = "Retry"
+	ActionRestart     Action = "Restart"
+	ActionPause       Action = "Pause"
+	ActionUndo        Action = "Undo"
+	ActionSetImage    Action = "SetImage"
+)
+
+const (
+	// LogLimit is the number of the most recent actions which are kept in the audit log of a rollout
+	LogLimit = 10
+	// eventSource is the component of the events of the manual actions
+	eventSource = "kubectl-argo-rollouts"
+)
+
+// Entry is an entry of the audit log of a rollout
+type Entry struct {
+	Action Action      `json:"action"`
+	User   string      `json:"user,omitempty"`
+	Reason string      `json:"reason,omitempty"`
+	Time   metav1.Time `json:"time"`
+}
+
+// Message describes the action of the entry, e.g. "Abort requested by alice: error rate too high"
+func (e Entry) Message() string {
+	msg := fmt.Sprintf("%s requested", e.Action)
+	if e.User != "" {
+		msg += " by " + e.User
+	}
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
+}
+
+// GetLog returns the audit log of the rollout, oldest entry first. A malformed log is ignored.
+func GetLog(ro *v1alpha1.Rollout) []Entry {
+	value, ok := ro.Annotations[annotations.AuditLogAnnotation]
+	if !ok {
+		return nil
+	}
+	var entries []Entry
+	if err := json.Unmarshal([]byte(value), &entries); err != nil {
+		return nil
+	}
+	return entries
+}
+
+// RecordAction appends a manual action on a rollout to its audit log, which keeps the last
+// LogLimit actions, and sends an event for it. The user and the reason are optional.
+func RecordAction(ctx context.Context, rolloutIf clientset.RolloutInterface, kubeclientset kubernetes.Interface, name string, action Action, user, reason string) error {
+	entry := Entry{
+		Action: action,
+		User:   user,
+		Reason: reason,
+		Time:   metav1.Now(),
+	}
+	var ro *v1alpha1.Rollout
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		ro, err = rolloutIf.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		entries := append(GetLog(ro), entry)
+		if len(entries) > LogLimit {
+			entries = entries[len(entries)-LogLimit:]
+		}
+		value, err := json.Marshal(entries)
+		if err != nil {
+			return err
+		}
+		// the resource version fails the patch with a conflict when the log was changed concurrently
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{
+					annotations.AuditLogAnnotation: string(value),
+				},
+				"resourceVersion": ro.ResourceVersion,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = rolloutIf.Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = kubeclientset.CoreV1().Events(ro.Namespace).Create(ctx, newEvent(ro, entry), metav1.CreateOptions{})
+	return err
+}
+
+func newEvent(ro *v1alpha1.Rollout, entry Entry) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s.%x", ro.Name, entry.Time.UnixNano()),
+			Namespace: ro.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      v1alpha1.SchemeGroupVersion.String(),
+			Kind:            "Rollout",
+			Name:            ro.Name,
+			Namespace:       ro.Namespace,
+			UID:             ro.UID,
+			ResourceVersion: ro.ResourceVersion,
+		},
+		Reason:         string(entry.Action) + "Requested",
+		Message:        entry.Message(),
+		Source:         corev1.EventSource{Component: eventSource},
+		FirstTimestamp: entry.Time,
+		LastTimestamp:  entry.Time,
+		Count:          1,
+		Type:           corev1.EventTypeNormal,
+	}
+}
@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+func newRollout(annots map[string]string) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "guestbook",
+			Namespace:   metav1.NamespaceDefault,
+			Annotations: annots,
+		},
+	}
+}
+
+func TestEntryMessage(t *testing.T) {
+	assert.Equal(t, "Promote requested", Entry{Action: ActionPromote}.Message())
+	assert.Equal(t, "Abort requested by alice", Entry{Action: ActionAbort, User: "alice"}.Message())
+	assert.Equal(t, "Undo requested by alice: bad release", Entry{Action: ActionUndo, User: "alice", Reason: "bad release"}.Message())
+}
+
+func TestGetLog(t *testing.T) {
+	assert.Nil(t, GetLog(newRollout(nil)))
+	assert.Nil(t, GetLog(newRollout(map[string]string{annotations.AuditLogAnnotation: "not json"})))
+
+	ro := newRollout(map[string]string{annotations.AuditLogAnnotation: `[{"action":"Pause","user":"bob","time":"2021-06-01T10:00:00Z"}]`})
+	entries := GetLog(ro)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, ActionPause, entries[0].Action)
+		assert.Equal(t, "bob", entries[0].User)
+		assert.Empty(t, entries[0].Reason)
+	}
+}
+
+func TestRecordAction(t *testing.T) {
+	ctx := context.TODO()
+	rolloutIf := fake.NewSimpleClientset(newRollout(nil)).ArgoprojV1alpha1().Rollouts(metav1.NamespaceDefault)
+	kubeclientset := k8sfake.NewSimpleClientset()
+
+	err := RecordAction(ctx, rolloutIf, kubeclientset, "guestbook", ActionPromoteFull, "alice", "hotfix")
+	assert.NoError(t, err)
+
+	ro, err := rolloutIf.Get(ctx, "guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	entries := GetLog(ro)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, ActionPromoteFull, entries[0].Action)
+		assert.Equal(t, "alice", entries[0].User)
+		assert.Equal(t, "hotfix", entries[0].Reason)
+		assert.False(t, entries[0].Time.IsZero())
+	}
+
+	events, err := kubeclientset.CoreV1().Events(metav1.NamespaceDefault).List(ctx, metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, events.Items, 1) {
+		event := events.Items[0]
+		assert.Equal(t, "PromoteFullRequested", event.Reason)
+		assert.Equal(t, "PromoteFull requested by alice: hotfix", event.Message)
+		assert.Equal(t, corev1.EventTypeNormal, event.Type)
+		assert.Equal(t, "Rollout", event.InvolvedObject.Kind)
+		assert.Equal(t, "guestbook", event.InvolvedObject.Name)
+		assert.Equal(t, eventSource, event.Source.Component)
+	}
+}
+
+func TestRecordActionTrimsLog(t *testing.T) {
+	ctx := context.TODO()
+	rolloutIf := fake.NewSimpleClientset(newRollout(nil)).ArgoprojV1alpha1().Rollouts(metav1.NamespaceDefault)
+	kubeclientset := k8sfake.NewSimpleClientset()
+
+	for i := 0; i < LogLimit+2; i++ {
+		err := RecordAction(ctx, rolloutIf, kubeclientset, "guestbook", ActionRestart, "alice", fmt.Sprintf("restart %d", i))
+		assert.NoError(t, err)
+	}
+
+	ro, err := rolloutIf.Get(ctx, "guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	entries := GetLog(ro)
+	assert.Len(t, entries, LogLimit)
+	assert.Equal(t, "restart 2", entries[0].Reason)
+	assert.Equal(t, fmt.Sprintf("restart %d", LogLimit+1), entries[LogLimit-1].Reason)
+}
+
+func TestRecordActionReplacesMalformedLog(t *testing.T) {
+	ctx := context.TODO()
+	rolloutIf := fake.NewSimpleClientset(newRollout(map[string]string{annotations.AuditLogAnnotation: "not json"})).ArgoprojV1alpha1().Rollouts(metav1.NamespaceDefault)
+
+	err := RecordAction(ctx, rolloutIf, k8sfake.NewSimpleClientset(), "guestbook", ActionRetry, "", "")
+	assert.NoError(t, err)
+
+	ro, err := rolloutIf.Get(ctx, "guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	entries := GetLog(ro)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, ActionRetry, entries[0].Action)
+		assert.Empty(t, entries[0].User)
+	}
+}
+
+func TestRecordActionNotFound(t *testing.T) {
+	rolloutIf := fake.NewSimpleClientset().ArgoprojV1alpha1().Rollouts(metav1.NamespaceDefault)
+	err := RecordAction(context.TODO(), rolloutIf, k8sfake.NewSimpleClientset(), "guestbook", ActionAbort, "alice", "")
+	assert.Error(t, err)
+}
@@ -73,6 +73,24 @@ const (
 	RolloutAbortedReason = "RolloutAborted"
 	// RolloutAbortedMessage indicates that the rollout was aborted
 	RolloutAbortedMessage = "Rollout aborted update to revision %d"
+	// RolloutTimedOutAbortedReason is the event reason when a rollout is aborted because it
+	// exceeded its progress deadline (progressDeadlineAbort)
+	RolloutTimedOutAbortedReason = "RolloutTimedOutAborted"
+	// RolloutFrozenReason is the event reason when a rollout is held by a freeze window
+	RolloutFrozenReason = "RolloutFrozen"
+	// RolloutFrozenMessage is the event message when a rollout is held by a freeze window
+	RolloutFrozenMessage = "Rollout frozen by %s until %s"
+	// InvalidRolloutFreezeReason is the event reason of a RolloutFreeze or ClusterRolloutFreeze
+	// which cannot be evaluated
+	InvalidRolloutFreezeReason = "InvalidRolloutFreeze"
+	// InvalidRolloutFreezeMessage is the event message of a freeze which cannot be evaluated
+	InvalidRolloutFreezeMessage = "Freeze is invalid and partly ignored: %v"
+
+	// RollbackWithinWindowReason is the event reason when a rollback to a recently served revision
+	// is fast-tracked (rollbackWindow)
+	RollbackWithinWindowReason = "RollbackWithinWindow"
+	// RollbackWithinWindowMessage is the event message when a rollback is fast-tracked
+	RollbackWithinWindowMessage = "Fast-tracking rollback to '%s' within rollback window"
 
 	// RolloutRetryReason indicates that the rollout is retrying after being aborted
 	RolloutRetryReason = "RolloutRetry"
@@ -100,6 +118,55 @@ const (
 	// rollout that paused amidst a rollout and are bounded by a deadline.
 	RolloutStepCompletedMessage = "Rollout step %d/%d completed (%s)"
 
+	// RolloutStepSkippedReason is added in a rollout when a step is skipped because its when expression
+	// evaluated to false
+	RolloutStepSkippedReason = "RolloutStepSkipped"
+	// RolloutStepSkippedMessage is added in a rollout when a step is skipped because its when expression
+	// evaluated to false
+	RolloutStepSkippedMessage = "Rollout step %d/%d skipped (%s)"
+
+	// RolloutApprovalRequestedReason is added in a rollout when the approval of an approval step is
+	// requested from its endpoint
+	RolloutApprovalRequestedReason = "RolloutApprovalRequested"
+	// RolloutApprovalRequestedMessage is added in a rollout when the approval of an approval step is
+	// requested from its endpoint
+	RolloutApprovalRequestedMessage = "Requested approval of step %d/%d from %s"
+
+	// RolloutApprovalDeniedReason is added in a rollout when an approval step is denied
+	RolloutApprovalDeniedReason = "RolloutApprovalDenied"
+	// RolloutApprovalDeniedMessage is added in a rollout when an approval step is denied
+	RolloutApprovalDeniedMessage = "Approval of step %d/%d denied: %s"
+
+	// RolloutHookStartedReason is added in a rollout when the Job of a hook is created
+	RolloutHookStartedReason = "RolloutHookStarted"
+	// RolloutHookStartedMessage is added in a rollout when the Job of a hook is created
+	RolloutHookStartedMessage = "%s hook '%s' started Job %s"
+
+	// RolloutHookCompletedReason is added in a rollout when the Job of a hook completes successfully
+	RolloutHookCompletedReason = "RolloutHookCompleted"
+	// RolloutHookCompletedMessage is added in a rollout when the Job of a hook completes successfully
+	RolloutHookCompletedMessage = "%s hook '%s' completed"
+
+	// RolloutHookFailedReason is added in a rollout when the Job of a hook fails
+	RolloutHookFailedReason = "RolloutHookFailed"
+	// RolloutHookFailedMessage is added in a rollout when the Job of a hook fails
+	RolloutHookFailedMessage = "%s hook '%s' failed: %s"
+
+	// ManagedServiceCreatedReason is added in a rollout when the controller creates one of its managed Services
+	ManagedServiceCreatedReason = "ManagedServiceCreated"
+	// ManagedServiceCreatedMessage is added in a rollout when the controller creates one of its managed Services
+	ManagedServiceCreatedMessage = "Created Service '%s'"
+	// ManagedServiceUpdatedReason is added in a rollout when the controller updates one of its managed Services
+	ManagedServiceUpdatedReason = "ManagedServiceUpdated"
+	// ManagedServiceUpdatedMessage is added in a rollout when the controller updates one of its managed Services
+	ManagedServiceUpdatedMessage = "Updated Service '%s'"
+	// ManagedServiceDeletedReason is added in a rollout when the controller deletes a managed Service which is
+	// no longer referenced by the rollout
+	ManagedServiceDeletedReason = "ManagedServiceDeleted"
+	// ManagedServiceDeletedMessage is added in a rollout when the controller deletes a managed Service which is
+	// no longer referenced by the rollout
+	ManagedServiceDeletedMessage = "Deleted Service '%s'"
+
 	// NewRSAvailableReason is added in a rollout when its newest replica set is made available
 	// ie. the number of new pods that have passed readiness checks and run for at least minReadySeconds
 	// is at least the minimum available pods that need to run for the rollout.
@@ -0,0 +1,195 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap in the namespace of the controller which holds the
+	// controller configuration
+	ConfigMapName = "argo-rollouts-config"
+
+	// ProgressDeadlineSecondsKey is the key of the default progress deadline of Rollouts and Experiments
+	ProgressDeadlineSecondsKey = "progressDeadlineSeconds"
+	// MeasurementHistoryLimitKey is the key of the number of measurements retained per metric of an AnalysisRun
+	MeasurementHistoryLimitKey = "measurementHistoryLimit"
+	// ALBVerifyWeightKey is the key of the default ALB target group weight verification
+	ALBVerifyWeightKey = "albVerifyWeight"
+	// ALBIngressClassesKey is the key of the comma separated ingress classes of the ALB ingress controller
+	ALBIngressClassesKey = "albIngressClasses"
+	// NGINXIngressClassesKey is the key of the comma separated ingress classes of the nginx ingress controller
+	NGINXIngressClassesKey = "nginxIngressClasses"
+	// ApprovalURLPrefixesKey is the key of the comma separated url prefixes which approval endpoints must match
+	ApprovalURLPrefixesKey = "approvalURLPrefixes"
+)
+
+// Config is the configuration of the controller which can be changed without a restart
+type Config struct {
+	// ProgressDeadlineSeconds is the progress deadline of Rollouts and Experiments which do not set one
+	ProgressDeadlineSeconds int32
+	// MeasurementHistoryLimit is the number of measurements retained per metric of an AnalysisRun
+	MeasurementHistoryLimit int
+	// ALBVerifyWeight is whether ALB target group weights are verified for Rollouts which do not set it
+	ALBVerifyWeight bool
+	// ALBIngressClasses are the ingress classes of the ALB ingress controller
+	ALBIngressClasses []string
+	// NGINXIngressClasses are the ingress classes of the nginx ingress controller
+	NGINXIngressClasses []string
+	// ApprovalURLPrefixes are the url prefixes which the endpoints of approval steps must match. Any
+	// http(s) endpoint is allowed if empty
+	ApprovalURLPrefixes []string
+}
+
+// Parse returns the configuration of the ConfigMap. Keys which are missing from the ConfigMap keep
+// their value of the base configuration, which holds the values of the command line flags.
+func Parse(base Config, cm *corev1.ConfigMap) (*Config, error) {
+	cfg := base
+	for key, value := range cm.Data {
+		value = strings.TrimSpace(value)
+		switch key {
+		case ProgressDeadlineSecondsKey:
+			i, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || i <= 0 {
+				return nil, fmt.Errorf("%s must be a positive integer: '%s'", key, value)
+			}
+			cfg.ProgressDeadlineSeconds = int32(i)
+		case MeasurementHistoryLimitKey:
+			i, err := strconv.Atoi(value)
+			if err != nil || i <= 0 {
+				return nil, fmt.Errorf("%s must be a positive integer: '%s'", key, value)
+			}
+			cfg.MeasurementHistoryLimit = i
+		case ALBVerifyWeightKey:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be a boolean: '%s'", key, value)
+			}
+			cfg.ALBVerifyWeight = b
+		case ALBIngressClassesKey:
+			classes, err := parseList(key, value)
+			if err != nil {
+				return nil, err
+			}
+			cfg.ALBIngressClasses = classes
+		case NGINXIngressClassesKey:
+			classes, err := parseList(key, value)
+			if err != nil {
+				return nil, err
+			}
+			cfg.NGINXIngressClasses = classes
+		case ApprovalURLPrefixesKey:
+			prefixes, err := parseList(key, value)
+			if err != nil {
+				return nil, err
+			}
+			cfg.ApprovalURLPrefixes = prefixes
+		default:
+			return nil, fmt.Errorf("unknown key '%s'", key)
+		}
+	}
+	return &cfg, nil
+}
+
+func parseList(key, value string) ([]string, error) {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			return nil, fmt.Errorf("%s must be a comma separated list of non-empty values: '%s'", key, value)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Watcher watches the ConfigMap of the controller configuration and applies its changes. An invalid
+// ConfigMap is rejected, and the last valid configuration is kept until the ConfigMap is fixed.
+type Watcher struct {
+	base Config
+
+	mutex    sync.RWMutex
+	current  *Config
+	handlers []func(*Config)
+}
+
+// NewWatcher returns a watcher of the ConfigMap in the informer, which starts from the base configuration
+func NewWatcher(base Config, configMapInformer coreinformers.ConfigMapInformer) *Watcher {
+	w := &Watcher{
+		base:    base,
+		current: &base,
+	}
+	configMapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			cm, ok := obj.(*corev1.ConfigMap)
+			return ok && cm.Name == ConfigMapName
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				w.Load(obj.(*corev1.ConfigMap))
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				w.Load(newObj.(*corev1.ConfigMap))
+			},
+			DeleteFunc: func(obj interface{}) {
+				log.Infof("ConfigMap '%s' was deleted. Reverting to the default configuration", ConfigMapName)
+				w.apply(&w.base)
+			},
+		},
+	})
+	return w
+}
+
+// AddChangeHandler registers a function which is called with the configuration whenever it changes
+// and once right away with the current configuration
+func (w *Watcher) AddChangeHandler(handler func(*Config)) {
+	w.mutex.Lock()
+	w.handlers = append(w.handlers, handler)
+	current := w.current
+	w.mutex.Unlock()
+	handler(current)
+}
+
+// Current returns the configuration which is in effect
+func (w *Watcher) Current() *Config {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	return w.current
+}
+
+// Load parses the ConfigMap and applies the configuration if it is valid
+func (w *Watcher) Load(cm *corev1.ConfigMap) {
+	cfg, err := Parse(w.base, cm)
+	if err != nil {
+		log.Errorf("Rejected invalid ConfigMap '%s', keeping the last valid configuration: %v", ConfigMapName, err)
+		return
+	}
+	w.apply(cfg)
+}
+
+func (w *Watcher) apply(cfg *Config) {
+	w.mutex.Lock()
+	if reflect.DeepEqual(w.current, cfg) {
+		w.mutex.Unlock()
+		return
+	}
+	w.current = cfg
+	handlers := w.handlers
+	w.mutex.Unlock()
+
+	log.Infof("Applying controller configuration: %+v", *cfg)
+	for _, handler := range handlers {
+		handler(cfg)
+	}
+}
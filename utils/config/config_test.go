@@ -0,0 +1,90 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newBaseConfig() Config {
+	return Config{
+		ProgressDeadlineSeconds: 600,
+		MeasurementHistoryLimit: 10,
+		ALBIngressClasses:       []string{"alb"},
+		NGINXIngressClasses:     []string{"nginx"},
+	}
+}
+
+func newConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: "argo-rollouts"},
+		Data:       data,
+	}
+}
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse(newBaseConfig(), newConfigMap(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, newBaseConfig(), *cfg)
+
+	cfg, err = Parse(newBaseConfig(), newConfigMap(map[string]string{
+		ProgressDeadlineSecondsKey: "300",
+		MeasurementHistoryLimitKey: "5",
+		ALBVerifyWeightKey:         "true",
+		ALBIngressClassesKey:       "alb, internal-alb",
+		ApprovalURLPrefixesKey:     "https://approvals.example.com/",
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, Config{
+		ProgressDeadlineSeconds: 300,
+		MeasurementHistoryLimit: 5,
+		ALBVerifyWeight:         true,
+		ALBIngressClasses:       []string{"alb", "internal-alb"},
+		NGINXIngressClasses:     []string{"nginx"},
+		ApprovalURLPrefixes:     []string{"https://approvals.example.com/"},
+	}, *cfg)
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := map[string]string{
+		ProgressDeadlineSecondsKey: "0",
+		MeasurementHistoryLimitKey: "ten",
+		ALBVerifyWeightKey:         "maybe",
+		NGINXIngressClassesKey:     "nginx,",
+		"rolloutThreads":           "10",
+	}
+	for key, value := range tests {
+		_, err := Parse(newBaseConfig(), newConfigMap(map[string]string{key: value}))
+		assert.Error(t, err, key)
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	informer := kubeinformers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Core().V1().ConfigMaps()
+	w := NewWatcher(newBaseConfig(), informer)
+
+	var applied []*Config
+	w.AddChangeHandler(func(cfg *Config) {
+		applied = append(applied, cfg)
+	})
+	// the handler is called right away with the base configuration
+	assert.Len(t, applied, 1)
+	assert.Equal(t, int32(600), applied[0].ProgressDeadlineSeconds)
+
+	w.Load(newConfigMap(map[string]string{ProgressDeadlineSecondsKey: "300"}))
+	assert.Len(t, applied, 2)
+	assert.Equal(t, int32(300), w.Current().ProgressDeadlineSeconds)
+
+	// an invalid configuration is rejected and the last valid configuration is kept
+	w.Load(newConfigMap(map[string]string{ProgressDeadlineSecondsKey: "-1"}))
+	assert.Len(t, applied, 2)
+	assert.Equal(t, int32(300), w.Current().ProgressDeadlineSeconds)
+
+	// an unchanged configuration is not applied again
+	w.Load(newConfigMap(map[string]string{ProgressDeadlineSecondsKey: "300"}))
+	assert.Len(t, applied, 2)
+}
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -15,6 +16,8 @@ const (
 	DefaultReplicas = int32(1)
 	// DefaultRevisionHistoryLimit default number of revisions to keep if .Spec.RevisionHistoryLimit is nil
 	DefaultRevisionHistoryLimit = int32(10)
+	// DefaultRolloutRevisionLimit default number of RolloutRevision records to keep if .Spec.RolloutRevisionLimit is nil
+	DefaultRolloutRevisionLimit = int32(10)
 	// DefaultMaxSurge default number for the max number of additional pods that can be brought up during a rollout
 	DefaultMaxSurge = "25"
 	// DefaultMaxUnavailable default number for the max number of unavailable pods during a rollout
@@ -30,6 +33,11 @@ const (
 	DefaultConsecutiveErrorLimit int32 = 4
 )
 
+var (
+	defaultsMutex                  sync.RWMutex
+	defaultProgressDeadlineSeconds = DefaultProgressDeadlineSeconds
+)
+
 const (
 	DefaultAmbassadorAPIGroup     = "getambassador.io"
 	DefaultAmbassadorVersion      = "getambassador.io/v2"
@@ -53,6 +61,14 @@ func GetRevisionHistoryLimitOrDefault(rollout *v1alpha1.Rollout) int32 {
 	return *rollout.Spec.RevisionHistoryLimit
 }
 
+// GetRolloutRevisionLimitOrDefault returns the specified number of RolloutRevision records of a rollout or the default number
+func GetRolloutRevisionLimitOrDefault(rollout *v1alpha1.Rollout) int32 {
+	if rollout.Spec.RolloutRevisionLimit == nil {
+		return DefaultRolloutRevisionLimit
+	}
+	return *rollout.Spec.RolloutRevisionLimit
+}
+
 func GetMaxSurgeOrDefault(rollout *v1alpha1.Rollout) *intstr.IntOrString {
 	if rollout.Spec.Strategy.Canary != nil && rollout.Spec.Strategy.Canary.MaxSurge != nil {
 		return rollout.Spec.Strategy.Canary.MaxSurge
@@ -79,18 +95,31 @@ func GetCanaryIngressAnnotationPrefixOrDefault(rollout *v1alpha1.Rollout) string
 	return "nginx.ingress.kubernetes.io"
 }
 
+// SetDefaultProgressDeadlineSeconds sets the progress deadline of Rollouts and Experiments which do not specify one
+func SetDefaultProgressDeadlineSeconds(seconds int32) {
+	defaultsMutex.Lock()
+	defer defaultsMutex.Unlock()
+	defaultProgressDeadlineSeconds = seconds
+}
+
+func getDefaultProgressDeadlineSeconds() int32 {
+	defaultsMutex.RLock()
+	defer defaultsMutex.RUnlock()
+	return defaultProgressDeadlineSeconds
+}
+
 func GetProgressDeadlineSecondsOrDefault(rollout *v1alpha1.Rollout) int32 {
 	if rollout.Spec.ProgressDeadlineSeconds != nil {
 		return *rollout.Spec.ProgressDeadlineSeconds
 	}
-	return DefaultProgressDeadlineSeconds
+	return getDefaultProgressDeadlineSeconds()
 }
 
 func GetExperimentProgressDeadlineSecondsOrDefault(e *v1alpha1.Experiment) int32 {
 	if e.Spec.ProgressDeadlineSeconds != nil {
 		return *e.Spec.ProgressDeadlineSeconds
 	}
-	return DefaultProgressDeadlineSeconds
+	return getDefaultProgressDeadlineSeconds()
 }
 
 func GetScaleDownDelaySecondsOrDefault(rollout *v1alpha1.Rollout) int32 {
@@ -111,6 +140,19 @@ func GetScaleDownDelaySecondsOrDefault(rollout *v1alpha1.Rollout) int32 {
 	return 0
 }
 
+// GetAbortScaleDownDelaySeconds returns the delay before the new ReplicaSet of an aborted update is
+// scaled down, or nil if no delay is configured. The delay is ignored for canary rollouts without
+// traffic routing, since the new pods would otherwise keep receiving traffic.
+func GetAbortScaleDownDelaySeconds(rollout *v1alpha1.Rollout) *int32 {
+	if rollout.Spec.Strategy.BlueGreen != nil {
+		return rollout.Spec.Strategy.BlueGreen.AbortScaleDownDelaySeconds
+	}
+	if rollout.Spec.Strategy.Canary != nil && rollout.Spec.Strategy.Canary.TrafficRouting != nil {
+		return rollout.Spec.Strategy.Canary.AbortScaleDownDelaySeconds
+	}
+	return nil
+}
+
 func GetAutoPromotionEnabledOrDefault(rollout *v1alpha1.Rollout) bool {
 	if rollout.Spec.Strategy.BlueGreen == nil {
 		return DefaultAutoPromotionEnabled
@@ -28,6 +28,19 @@ func TestGetRevisionHistoryOrDefault(t *testing.T) {
 	assert.Equal(t, DefaultRevisionHistoryLimit, GetRevisionHistoryLimitOrDefault(rolloutDefaultValue))
 }
 
+func TestGetRolloutRevisionLimitOrDefault(t *testing.T) {
+	rolloutRevisionLimit := int32(0)
+	rolloutNonDefaultValue := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			RolloutRevisionLimit: &rolloutRevisionLimit,
+		},
+	}
+
+	assert.Equal(t, rolloutRevisionLimit, GetRolloutRevisionLimitOrDefault(rolloutNonDefaultValue))
+	rolloutDefaultValue := &v1alpha1.Rollout{}
+	assert.Equal(t, DefaultRolloutRevisionLimit, GetRolloutRevisionLimitOrDefault(rolloutDefaultValue))
+}
+
 func TestGetMaxSurgeOrDefault(t *testing.T) {
 	maxSurge := intstr.FromInt(2)
 	rolloutNonDefaultValue := &v1alpha1.Rollout{
@@ -106,6 +119,12 @@ func TestGetProgressDeadlineSecondsOrDefault(t *testing.T) {
 	assert.Equal(t, seconds, GetProgressDeadlineSecondsOrDefault(rolloutNonDefaultValue))
 	rolloutDefaultValue := &v1alpha1.Rollout{}
 	assert.Equal(t, DefaultProgressDeadlineSeconds, GetProgressDeadlineSecondsOrDefault(rolloutDefaultValue))
+
+	SetDefaultProgressDeadlineSeconds(300)
+	defer SetDefaultProgressDeadlineSeconds(DefaultProgressDeadlineSeconds)
+	assert.Equal(t, int32(300), GetProgressDeadlineSecondsOrDefault(rolloutDefaultValue))
+	assert.Equal(t, int32(300), GetExperimentProgressDeadlineSecondsOrDefault(&v1alpha1.Experiment{}))
+	assert.Equal(t, seconds, GetProgressDeadlineSecondsOrDefault(rolloutNonDefaultValue))
 }
 
 func TestGetScaleDownDelaySecondsOrDefault(t *testing.T) {
@@ -165,6 +184,39 @@ func TestGetScaleDownDelaySecondsOrDefault(t *testing.T) {
 	}
 }
 
+func TestGetAbortScaleDownDelaySeconds(t *testing.T) {
+	abortScaleDownDelaySeconds := int32(60)
+	{
+		assert.Nil(t, GetAbortScaleDownDelaySeconds(&v1alpha1.Rollout{}))
+	}
+	{
+		blueGreen := &v1alpha1.Rollout{
+			Spec: v1alpha1.RolloutSpec{
+				Strategy: v1alpha1.RolloutStrategy{
+					BlueGreen: &v1alpha1.BlueGreenStrategy{
+						AbortScaleDownDelaySeconds: &abortScaleDownDelaySeconds,
+					},
+				},
+			},
+		}
+		assert.Equal(t, &abortScaleDownDelaySeconds, GetAbortScaleDownDelaySeconds(blueGreen))
+	}
+	{
+		canaryNoTrafficRouting := &v1alpha1.Rollout{
+			Spec: v1alpha1.RolloutSpec{
+				Strategy: v1alpha1.RolloutStrategy{
+					Canary: &v1alpha1.CanaryStrategy{
+						AbortScaleDownDelaySeconds: &abortScaleDownDelaySeconds,
+					},
+				},
+			},
+		}
+		assert.Nil(t, GetAbortScaleDownDelaySeconds(canaryNoTrafficRouting))
+		canaryNoTrafficRouting.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{}
+		assert.Equal(t, &abortScaleDownDelaySeconds, GetAbortScaleDownDelaySeconds(canaryNoTrafficRouting))
+	}
+}
+
 func TestGetAutoPromotionEnabledOrDefault(t *testing.T) {
 	autoPromote := false
 	rolloutNonDefaultValue := &v1alpha1.Rollout{
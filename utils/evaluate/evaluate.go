@@ -1,11 +1,14 @@
 package evaluate
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/antonmedv/expr"
 	"github.com/antonmedv/expr/file"
@@ -14,19 +17,25 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 )
 
-func EvaluateResult(result interface{}, metric v1alpha1.Metric, logCtx logrus.Entry) (v1alpha1.AnalysisPhase, error) {
+// EvaluateResult evaluates the success and failure conditions of the metric against the result. The
+// values of the previous measurements of the metric in the run are made available to the conditions.
+func EvaluateResult(result interface{}, metric v1alpha1.Metric, run *v1alpha1.AnalysisRun, logCtx logrus.Entry) (v1alpha1.AnalysisPhase, error) {
+	return evaluateResult(result, metric, previousValues(run, metric.Name), logCtx)
+}
+
+func evaluateResult(result interface{}, metric v1alpha1.Metric, history []interface{}, logCtx logrus.Entry) (v1alpha1.AnalysisPhase, error) {
 	successCondition := false
 	failCondition := false
 	var err error
 
 	if metric.SuccessCondition != "" {
-		successCondition, err = EvalCondition(result, metric.SuccessCondition)
+		successCondition, err = evalCondition(result, history, metric.SuccessCondition)
 		if err != nil {
 			return v1alpha1.AnalysisPhaseError, err
 		}
 	}
 	if metric.FailureCondition != "" {
-		failCondition, err = EvalCondition(result, metric.FailureCondition)
+		failCondition, err = evalCondition(result, history, metric.FailureCondition)
 		if err != nil {
 			return v1alpha1.AnalysisPhaseError, err
 		}
@@ -56,24 +65,177 @@ func EvaluateResult(result interface{}, metric v1alpha1.Metric, logCtx logrus.En
 	return v1alpha1.AnalysisPhaseSuccessful, nil
 }
 
+// previousValues returns the values of the completed, non-errored measurements of a metric in the
+// run, ordered from oldest to newest
+func previousValues(run *v1alpha1.AnalysisRun, metricName string) []interface{} {
+	if run == nil {
+		return nil
+	}
+	var values []interface{}
+	for _, result := range run.Status.MetricResults {
+		if result.Name != metricName {
+			continue
+		}
+		for _, measurement := range result.Measurements {
+			switch measurement.Phase {
+			case v1alpha1.AnalysisPhaseSuccessful, v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseInconclusive:
+				if measurement.Value != "" {
+					values = append(values, parseMeasurementValue(measurement.Value))
+				}
+			}
+		}
+	}
+	return values
+}
+
+// parseMeasurementValue converts the string value of a measurement back into a number or array
+// where possible
+func parseMeasurementValue(value string) interface{} {
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err == nil {
+		return parsed
+	}
+	return value
+}
+
+const (
+	// FailedSeriesMetadataKey is the measurement metadata key holding the labels of the series
+	// which failed when a metric is evaluated per series
+	FailedSeriesMetadataKey = "failedSeries"
+	// InconclusiveSeriesMetadataKey is the measurement metadata key holding the labels of the series
+	// which were inconclusive when a metric is evaluated per series
+	InconclusiveSeriesMetadataKey = "inconclusiveSeries"
+)
+
+// Series is a single labelled value of a multi-series result (e.g. a sample of a Prometheus vector)
+type Series struct {
+	Labels map[string]string
+	Value  interface{}
+}
+
+// IsPerSeries returns whether the conditions of the metric should be evaluated against each series
+func IsPerSeries(metric v1alpha1.Metric) bool {
+	return metric.EvaluationMode == v1alpha1.EvaluationModePerSeries
+}
+
+// EvaluateSeriesResult evaluates the conditions of the metric against the value of each series.
+// The result is Failed if any series failed, Inconclusive if any series was inconclusive and
+// Successful if every series was successful. The labels of the failed and inconclusive series are
+// returned as measurement metadata.
+func EvaluateSeriesResult(series []Series, metric v1alpha1.Metric, run *v1alpha1.AnalysisRun, logCtx logrus.Entry) (v1alpha1.AnalysisPhase, map[string]string, error) {
+	if len(series) == 0 {
+		return v1alpha1.AnalysisPhaseError, nil, errors.New("no series returned to evaluate")
+	}
+	var failed, inconclusive []string
+	history := previousValues(run, metric.Name)
+	for _, s := range series {
+		status, err := evaluateResult(s.Value, metric, history, logCtx)
+		if err != nil {
+			return v1alpha1.AnalysisPhaseError, nil, fmt.Errorf("series %s: %v", FormatLabels(s.Labels), err)
+		}
+		switch status {
+		case v1alpha1.AnalysisPhaseFailed:
+			failed = append(failed, FormatLabels(s.Labels))
+		case v1alpha1.AnalysisPhaseInconclusive:
+			inconclusive = append(inconclusive, FormatLabels(s.Labels))
+		}
+	}
+
+	metadata := map[string]string{}
+	if len(failed) > 0 {
+		metadata[FailedSeriesMetadataKey] = "[" + strings.Join(failed, ",") + "]"
+	}
+	if len(inconclusive) > 0 {
+		metadata[InconclusiveSeriesMetadataKey] = "[" + strings.Join(inconclusive, ",") + "]"
+	}
+	switch {
+	case len(failed) > 0:
+		logCtx.Infof("%d of %d series failed: %s", len(failed), len(series), metadata[FailedSeriesMetadataKey])
+		return v1alpha1.AnalysisPhaseFailed, metadata, nil
+	case len(inconclusive) > 0:
+		return v1alpha1.AnalysisPhaseInconclusive, metadata, nil
+	}
+	return v1alpha1.AnalysisPhaseSuccessful, metadata, nil
+}
+
+// FormatLabels formats a set of series labels in the form of {key1="value1", key2="value2"},
+// ordered by key
+func FormatLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(labels))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, labels[k]))
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
 // EvalCondition evaluates the condition with the resultValue as an input
 func EvalCondition(resultValue interface{}, condition string) (bool, error) {
-	var err error
+	return evalCondition(resultValue, nil, condition)
+}
 
-	env := map[string]interface{}{
-		"result":  resultValue,
-		"asInt":   asInt,
-		"asFloat": asFloat,
-		"isNaN":   math.IsNaN,
-		"isInf":   isInf,
+// evalCondition evaluates the condition with the resultValue and the values of previous measurements
+// as inputs
+func evalCondition(resultValue interface{}, history []interface{}, condition string) (bool, error) {
+	var previous interface{}
+	if len(history) > 0 {
+		previous = history[len(history)-1]
 	}
+	env := functions()
+	env["result"] = resultValue
+	env["previous"] = previous
+	env["history"] = history
+	return evalBool(condition, env)
+}
 
-	unwrapFileErr := func(e error) error {
-		if fileErr, ok := err.(*file.Error); ok {
-			e = errors.New(fileErr.Message)
-		}
-		return e
+// EvalStepCondition evaluates the when expression of a canary step against the variables of the
+// rollout (e.g. its metadata and analysis results)
+func EvalStepCondition(condition string, vars map[string]interface{}) (bool, error) {
+	env := functions()
+	for k, v := range vars {
+		env[k] = v
 	}
+	return evalBool(condition, env)
+}
+
+// ValidateStepCondition returns an error if the when expression of a canary step cannot be compiled
+func ValidateStepCondition(condition string) error {
+	_, err := expr.Compile(condition)
+	return unwrapFileErr(err)
+}
+
+// functions returns the helper functions available to conditions
+func functions() map[string]interface{} {
+	return map[string]interface{}{
+		"asInt":      asInt,
+		"asFloat":    asFloat,
+		"isNaN":      math.IsNaN,
+		"isInf":      isInf,
+		"isNil":      isNil,
+		"default":    defaultValue,
+		"mean":       mean,
+		"max":        maxValue,
+		"min":        minValue,
+		"percentile": percentile,
+	}
+}
+
+// evalBool evaluates the condition against the environment. Errors raised by the helper functions
+// (e.g. asInt() on a non-numeric string) are returned as errors.
+func evalBool(condition string, env map[string]interface{}) (ok bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+			err = fmt.Errorf("%v", r)
+		}
+	}()
 
 	program, err := expr.Compile(condition, expr.Env(env))
 	if err != nil {
@@ -93,6 +255,13 @@ func EvalCondition(resultValue interface{}, condition string) (bool, error) {
 	}
 }
 
+func unwrapFileErr(e error) error {
+	if fileErr, ok := e.(*file.Error); ok {
+		e = errors.New(fileErr.Message)
+	}
+	return e
+}
+
 func isInf(f float64) bool {
 	return math.IsInf(f, 0)
 }
@@ -168,3 +337,100 @@ func asFloat(in interface{}) float64 {
 	}
 	panic(fmt.Sprintf("asFloat() not supported on %v %v", reflect.TypeOf(in), in))
 }
+
+// isNil returns whether the value is nil or a nil pointer, slice or map
+func isNil(in interface{}) bool {
+	if in == nil {
+		return true
+	}
+	v := reflect.ValueOf(in)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return v.IsNil()
+	}
+	return false
+}
+
+// defaultValue returns the fallback if the value is nil or an empty array, map or string (e.g. a
+// query which returned no results)
+func defaultValue(in interface{}, fallback interface{}) interface{} {
+	if isNil(in) {
+		return fallback
+	}
+	v := reflect.ValueOf(in)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String:
+		if v.Len() == 0 {
+			return fallback
+		}
+	}
+	return in
+}
+
+// asFloats converts an array of numeric values (or a single numeric value) into a slice of floats
+func asFloats(fn string, in interface{}) []float64 {
+	if in == nil {
+		panic(fmt.Sprintf("%s() not supported on nil", fn))
+	}
+	v := reflect.ValueOf(in)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		floats := make([]float64, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			floats[i] = asFloat(v.Index(i).Interface())
+		}
+		if len(floats) == 0 {
+			panic(fmt.Sprintf("%s() requires at least one value", fn))
+		}
+		return floats
+	}
+	return []float64{asFloat(in)}
+}
+
+// mean returns the arithmetic mean of the values
+func mean(in interface{}) float64 {
+	sum := float64(0)
+	floats := asFloats("mean", in)
+	for _, f := range floats {
+		sum += f
+	}
+	return sum / float64(len(floats))
+}
+
+// maxValue returns the largest of the values
+func maxValue(in interface{}) float64 {
+	floats := asFloats("max", in)
+	result := floats[0]
+	for _, f := range floats[1:] {
+		result = math.Max(result, f)
+	}
+	return result
+}
+
+// minValue returns the smallest of the values
+func minValue(in interface{}) float64 {
+	floats := asFloats("min", in)
+	result := floats[0]
+	for _, f := range floats[1:] {
+		result = math.Min(result, f)
+	}
+	return result
+}
+
+// percentile returns the p-th percentile (0-100) of the values, interpolating linearly between
+// the closest ranks
+func percentile(in interface{}, p interface{}) float64 {
+	pct := asFloat(p)
+	if pct < 0 || pct > 100 || math.IsNaN(pct) {
+		panic(fmt.Sprintf("percentile() requires a percentile between 0 and 100, got %v", p))
+	}
+	floats := asFloats("percentile", in)
+	sort.Float64s(floats)
+	rank := pct / 100 * float64(len(floats)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return floats[lower]
+	}
+	return floats[lower] + (rank-float64(lower))*(floats[upper]-floats[lower])
+}
@@ -17,7 +17,7 @@ func TestEvaluateResultWithSuccess(t *testing.T) {
 		FailureCondition: "false",
 	}
 	logCtx := logrus.WithField("test", "test")
-	status, err := EvaluateResult(true, metric, *logCtx)
+	status, err := EvaluateResult(true, metric, nil, *logCtx)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 	assert.NoError(t, err)
 }
@@ -28,7 +28,7 @@ func TestEvaluateResultWithFailure(t *testing.T) {
 		FailureCondition: "true",
 	}
 	logCtx := logrus.WithField("test", "test")
-	status, err := EvaluateResult(true, metric, *logCtx)
+	status, err := EvaluateResult(true, metric, nil, *logCtx)
 	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
 	assert.NoError(t, err)
 
@@ -40,7 +40,7 @@ func TestEvaluateResultInconclusive(t *testing.T) {
 		FailureCondition: "false",
 	}
 	logCtx := logrus.WithField("test", "test")
-	status, err := EvaluateResult(true, metric, *logCtx)
+	status, err := EvaluateResult(true, metric, nil, *logCtx)
 	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, status)
 	assert.NoError(t, err)
 }
@@ -51,7 +51,7 @@ func TestEvaluateResultNoSuccessConditionAndNotFailing(t *testing.T) {
 		FailureCondition: "false",
 	}
 	logCtx := logrus.WithField("test", "test")
-	status, err := EvaluateResult(true, metric, *logCtx)
+	status, err := EvaluateResult(true, metric, nil, *logCtx)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 	assert.NoError(t, err)
 }
@@ -62,7 +62,7 @@ func TestEvaluateResultNoFailureConditionAndNotSuccessful(t *testing.T) {
 		FailureCondition: "",
 	}
 	logCtx := logrus.WithField("test", "test")
-	status, err := EvaluateResult(true, metric, *logCtx)
+	status, err := EvaluateResult(true, metric, nil, *logCtx)
 	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
 	assert.NoError(t, err)
 }
@@ -73,7 +73,7 @@ func TestEvaluateResultNoFailureConditionAndNoSuccessCondition(t *testing.T) {
 		FailureCondition: "",
 	}
 	logCtx := logrus.WithField("test", "test")
-	status, err := EvaluateResult(true, metric, *logCtx)
+	status, err := EvaluateResult(true, metric, nil, *logCtx)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
 	assert.NoError(t, err)
 }
@@ -84,7 +84,7 @@ func TestEvaluateResultWithErrorOnSuccessCondition(t *testing.T) {
 		FailureCondition: "true",
 	}
 	logCtx := logrus.WithField("test", "test")
-	status, err := EvaluateResult(true, metric, *logCtx)
+	status, err := EvaluateResult(true, metric, nil, *logCtx)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, status)
 	assert.Error(t, err)
 }
@@ -95,7 +95,7 @@ func TestEvaluateResultWithErrorOnFailureCondition(t *testing.T) {
 		FailureCondition: "a == true",
 	}
 	logCtx := logrus.WithField("test", "test")
-	status, err := EvaluateResult(true, metric, *logCtx)
+	status, err := EvaluateResult(true, metric, nil, *logCtx)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, status)
 	assert.Error(t, err)
 }
@@ -256,3 +256,219 @@ func TestIsInf(t *testing.T) {
 	assert.True(t, isInf(inf))
 	assert.False(t, isInf(notInf))
 }
+
+func TestEvaluateSeriesResult(t *testing.T) {
+	logCtx := logrus.WithField("test", "test")
+	metric := v1alpha1.Metric{
+		SuccessCondition: "result < 0.05",
+		FailureCondition: "result > 0.1",
+	}
+	t.Run("Successful", func(t *testing.T) {
+		series := []Series{
+			{Labels: map[string]string{"endpoint": "/a"}, Value: 0.01},
+			{Labels: map[string]string{"endpoint": "/b"}, Value: 0.02},
+		}
+		status, metadata, err := EvaluateSeriesResult(series, metric, nil, *logCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+		assert.Empty(t, metadata)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		series := []Series{
+			{Labels: map[string]string{"endpoint": "/a"}, Value: 0.01},
+			{Labels: map[string]string{"endpoint": "/b", "code": "500"}, Value: 0.2},
+			{Labels: map[string]string{"endpoint": "/c"}, Value: 0.07},
+		}
+		status, metadata, err := EvaluateSeriesResult(series, metric, nil, *logCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
+		assert.Equal(t, `[{code="500", endpoint="/b"}]`, metadata[FailedSeriesMetadataKey])
+		assert.Equal(t, `[{endpoint="/c"}]`, metadata[InconclusiveSeriesMetadataKey])
+	})
+	t.Run("Inconclusive", func(t *testing.T) {
+		series := []Series{
+			{Labels: map[string]string{"endpoint": "/a"}, Value: 0.07},
+		}
+		status, _, err := EvaluateSeriesResult(series, metric, nil, *logCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, status)
+	})
+	t.Run("Error", func(t *testing.T) {
+		series := []Series{
+			{Labels: map[string]string{"endpoint": "/a"}, Value: "not-a-number"},
+		}
+		status, _, err := EvaluateSeriesResult(series, metric, nil, *logCtx)
+		assert.Error(t, err)
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, status)
+	})
+	t.Run("NoSeries", func(t *testing.T) {
+		status, _, err := EvaluateSeriesResult(nil, metric, nil, *logCtx)
+		assert.EqualError(t, err, "no series returned to evaluate")
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, status)
+	})
+}
+
+func TestFormatLabels(t *testing.T) {
+	assert.Equal(t, "{}", FormatLabels(nil))
+	assert.Equal(t, `{a="1", b="2"}`, FormatLabels(map[string]string{"b": "2", "a": "1"}))
+}
+
+func TestEvaluateDefault(t *testing.T) {
+	tests := []struct {
+		input       interface{}
+		expression  string
+		expectation bool
+	}{
+		{nil, "default(result, 0) == 0", true},
+		{[]float64{}, "default(result, [0])[0] == 0", true},
+		{"", "default(result, 'none') == 'none'", true},
+		{float64(5), "default(result, 0) == 5", true},
+		{[]float64{1, 2}, "len(default(result, [])) == 2", true},
+	}
+	for _, test := range tests {
+		b, err := EvalCondition(test.input, test.expression)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectation, b, test.expression)
+	}
+}
+
+func TestEvaluateIsNil(t *testing.T) {
+	var nilSlice []float64
+	tests := []struct {
+		input       interface{}
+		expectation bool
+	}{
+		{nil, true},
+		{nilSlice, true},
+		{[]float64{}, false},
+		{float64(0), false},
+		{"", false},
+	}
+	for _, test := range tests {
+		b, err := EvalCondition(test.input, "isNil(result)")
+		assert.NoError(t, err)
+		assert.Equal(t, test.expectation, b)
+	}
+}
+
+func TestEvaluateAggregations(t *testing.T) {
+	values := []interface{}{"4", 1, float64(3), 2}
+	tests := []struct {
+		expression  string
+		expectation bool
+	}{
+		{"mean(result) == 2.5", true},
+		{"max(result) == 4", true},
+		{"min(result) == 1", true},
+		{"percentile(result, 50) == 2.5", true},
+		{"percentile(result, 0) == 1", true},
+		{"percentile(result, 100) == 4", true},
+		{"percentile(result, 90) > 3.69 && percentile(result, 90) < 3.71", true},
+		{"max(2) == 2", true},
+	}
+	for _, test := range tests {
+		b, err := EvalCondition(values, test.expression)
+		assert.NoError(t, err, test.expression)
+		assert.Equal(t, test.expectation, b, test.expression)
+	}
+}
+
+func TestEvaluateAggregationsError(t *testing.T) {
+	tests := []struct {
+		input      interface{}
+		expression string
+		errRegexp  string
+	}{
+		{[]float64{}, "mean(result) > 1", `mean\(\) requires at least one value`},
+		{nil, "max(result) > 1", `max\(\) not supported on nil`},
+		{[]interface{}{"a"}, "min(result) > 1", `strconv.ParseFloat: parsing "a": invalid syntax`},
+		{[]float64{1}, "percentile(result, 101) > 1", `percentile\(\) requires a percentile between 0 and 100`},
+	}
+	for _, test := range tests {
+		b, err := EvalCondition(test.input, test.expression)
+		assert.Error(t, err)
+		assert.False(t, b)
+		assert.Regexp(t, test.errRegexp, err.Error())
+	}
+}
+
+func TestEvaluateResultWithHistory(t *testing.T) {
+	run := &v1alpha1.AnalysisRun{
+		Status: v1alpha1.AnalysisRunStatus{
+			MetricResults: []v1alpha1.MetricResult{{
+				Name: "foo",
+				Measurements: []v1alpha1.Measurement{
+					{Phase: v1alpha1.AnalysisPhaseSuccessful, Value: "1"},
+					{Phase: v1alpha1.AnalysisPhaseError, Value: "100"},
+					{Phase: v1alpha1.AnalysisPhaseFailed, Value: "[2,3]"},
+					{Phase: v1alpha1.AnalysisPhaseInconclusive, Value: "3"},
+					{Phase: v1alpha1.AnalysisPhaseRunning},
+				},
+			}, {
+				Name: "bar",
+				Measurements: []v1alpha1.Measurement{
+					{Phase: v1alpha1.AnalysisPhaseSuccessful, Value: "50"},
+				},
+			}},
+		},
+	}
+	logCtx := logrus.WithField("test", "test")
+	tests := []struct {
+		condition string
+		phase     v1alpha1.AnalysisPhase
+	}{
+		{"result > previous", v1alpha1.AnalysisPhaseSuccessful},
+		{"len(history) == 3 && history[0] == 1", v1alpha1.AnalysisPhaseSuccessful},
+		{"history[1][1] == 3", v1alpha1.AnalysisPhaseSuccessful},
+		{"result <= previous", v1alpha1.AnalysisPhaseFailed},
+	}
+	for _, test := range tests {
+		metric := v1alpha1.Metric{Name: "foo", SuccessCondition: test.condition}
+		status, err := EvaluateResult(float64(4), metric, run, *logCtx)
+		assert.NoError(t, err, test.condition)
+		assert.Equal(t, test.phase, status, test.condition)
+	}
+
+	metric := v1alpha1.Metric{Name: "foo", SuccessCondition: "isNil(previous) && len(history) == 0"}
+	status, err := EvaluateResult(float64(4), metric, nil, *logCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+}
+
+func TestEvalStepCondition(t *testing.T) {
+	vars := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "guestbook",
+			"labels": map[string]string{"env": "prod"},
+		},
+		"args": map[string]string{"replicas": "3"},
+		"analysis": map[string]interface{}{
+			"background": "Running",
+			"steps":      map[int]string{1: "Successful"},
+		},
+	}
+	tests := []struct {
+		condition string
+		expected  bool
+	}{
+		{`metadata.labels.env == "prod"`, true},
+		{`metadata.labels.team == ""`, true},
+		{`metadata.name != "guestbook"`, false},
+		{`asInt(args.replicas) > 1`, true},
+		{`analysis.steps[1] == "Successful" && analysis.background == "Running"`, true},
+		{`analysis.steps[3] == "Successful"`, false},
+	}
+	for _, test := range tests {
+		ok, err := EvalStepCondition(test.condition, vars)
+		assert.NoError(t, err, test.condition)
+		assert.Equal(t, test.expected, ok, test.condition)
+	}
+
+	_, err := EvalStepCondition(`metadata.name`, vars)
+	assert.EqualError(t, err, "expected bool, but got string")
+}
+
+func TestValidateStepCondition(t *testing.T) {
+	assert.NoError(t, ValidateStepCondition(`metadata.labels.env == "prod"`))
+	assert.Error(t, ValidateStepCondition(`metadata.labels.env ==`))
+}
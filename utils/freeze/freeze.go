@@ -0,0 +1,131 @@
+package freeze
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/timewindow"
+)
+
+// ActiveFreeze describes a freeze window which currently applies to a rollout
+type ActiveFreeze struct {
+	// Name identifies the freeze resource, e.g. ClusterRolloutFreeze/holidays
+	Name string
+	// End is the time at which the window ends
+	End time.Time
+}
+
+// ValidateWindow returns an error if the window cannot be evaluated
+func ValidateWindow(window v1alpha1.FreezeWindow) error {
+	_, _, _, err := parseWindow(window)
+	return err
+}
+
+// ValidateSpec returns an error if the freeze has no windows, or if its selector or one of its
+// windows cannot be evaluated
+func ValidateSpec(spec v1alpha1.RolloutFreezeSpec) error {
+	if len(spec.Windows) == 0 {
+		return fmt.Errorf("freeze must have at least one window")
+	}
+	if spec.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+			return fmt.Errorf("invalid selector: %v", err)
+		}
+	}
+	for i, window := range spec.Windows {
+		if err := ValidateWindow(window); err != nil {
+			return fmt.Errorf("invalid window %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// WindowEnd returns the end of the occurrence of the window which contains now, or nil if now is
+// outside of the window
+func WindowEnd(window v1alpha1.FreezeWindow, now time.Time) (*time.Time, error) {
+	sched, duration, loc, err := parseWindow(window)
+	if err != nil {
+		return nil, err
+	}
+	// only the most recent start of the window can still cover now
+	start, ok := sched.prev(now.In(loc), now.Add(-duration))
+	if !ok {
+		return nil, nil
+	}
+	end := start.Add(duration)
+	return &end, nil
+}
+
+// GetActiveFreeze returns the freeze window which applies to the rollout at the given time, or nil
+// if the rollout is not frozen. When several windows apply, the one which ends last is returned.
+// Windows which cannot be evaluated are logged and ignored.
+func GetActiveFreeze(ro *v1alpha1.Rollout, freezes []*v1alpha1.RolloutFreeze, clusterFreezes []*v1alpha1.ClusterRolloutFreeze, now time.Time) *ActiveFreeze {
+	var active *ActiveFreeze
+	check := func(kind, name string, spec v1alpha1.RolloutFreezeSpec) {
+		logCtx := logutil.WithRollout(ro).WithField(kind, name)
+		if !selectsRollout(spec.Selector, ro) {
+			return
+		}
+		for _, window := range spec.Windows {
+			end, err := WindowEnd(window, now)
+			if err != nil {
+				logCtx.Warnf("Ignoring invalid freeze window: %v", err)
+				continue
+			}
+			if end != nil && (active == nil || end.After(active.End)) {
+				active = &ActiveFreeze{
+					Name: fmt.Sprintf("%s/%s", kind, name),
+					End:  *end,
+				}
+			}
+		}
+	}
+	for _, f := range freezes {
+		if f.Namespace == ro.Namespace {
+			check("RolloutFreeze", f.Name, f.Spec)
+		}
+	}
+	for _, f := range clusterFreezes {
+		check("ClusterRolloutFreeze", f.Name, f.Spec)
+	}
+	return active
+}
+
+func parseWindow(window v1alpha1.FreezeWindow) (*schedule, time.Duration, *time.Location, error) {
+	sched, err := parseSchedule(window.Schedule)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	duration, err := window.Duration.Duration()
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("invalid duration '%s': %v", window.Duration, err)
+	}
+	if duration <= 0 {
+		return nil, 0, nil, fmt.Errorf("invalid duration '%s': must be positive", window.Duration)
+	}
+	loc, err := timewindow.LoadLocation(window.TimeZone)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return sched, duration, loc, nil
+}
+
+// selectsRollout returns whether the selector of a freeze matches the rollout. A missing selector
+// selects all rollouts
+func selectsRollout(selector *metav1.LabelSelector, ro *v1alpha1.Rollout) bool {
+	if selector == nil {
+		return true
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		log.Warnf("Ignoring freeze with invalid selector: %v", err)
+		return false
+	}
+	return s.Matches(labels.Set(ro.Labels))
+}
@@ -0,0 +1,162 @@
+package freeze
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestValidateWindow(t *testing.T) {
+	assert.NoError(t, ValidateWindow(v1alpha1.FreezeWindow{Schedule: "0 17 * * fri", Duration: "63h", TimeZone: "America/New_York"}))
+	assert.EqualError(t, ValidateWindow(v1alpha1.FreezeWindow{Schedule: "0 17 * * fri", Duration: "forever"}), `invalid duration 'forever': time: invalid duration "forever"`)
+	assert.EqualError(t, ValidateWindow(v1alpha1.FreezeWindow{Schedule: "0 17 * * fri", Duration: "0s"}), "invalid duration '0s': must be positive")
+	assert.EqualError(t, ValidateWindow(v1alpha1.FreezeWindow{Schedule: "0 17 * * fri", Duration: "1h", TimeZone: "Mars/Olympus_Mons"}), "invalid time zone 'Mars/Olympus_Mons': unknown time zone Mars/Olympus_Mons")
+	assert.EqualError(t, ValidateWindow(v1alpha1.FreezeWindow{Schedule: "0 17 * fri", Duration: "1h"}), "schedule '0 17 * fri' must have 5 fields (minute hour day-of-month month day-of-week)")
+}
+
+func TestWindowEnd(t *testing.T) {
+	// Friday 17:00 in New York until Monday 08:00
+	window := v1alpha1.FreezeWindow{Schedule: "0 17 * * fri", Duration: "63h", TimeZone: "America/New_York"}
+	ny, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	expectedEnd := time.Date(2021, 12, 27, 8, 0, 0, 0, ny)
+
+	end, err := WindowEnd(window, time.Date(2021, 12, 24, 16, 59, 0, 0, ny))
+	assert.NoError(t, err)
+	assert.Nil(t, end)
+
+	end, err = WindowEnd(window, time.Date(2021, 12, 24, 17, 0, 0, 0, ny))
+	assert.NoError(t, err)
+	if assert.NotNil(t, end) {
+		assert.True(t, expectedEnd.Equal(*end))
+	}
+
+	end, err = WindowEnd(window, time.Date(2021, 12, 26, 23, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	if assert.NotNil(t, end) {
+		assert.True(t, expectedEnd.Equal(*end))
+	}
+
+	end, err = WindowEnd(window, expectedEnd)
+	assert.NoError(t, err)
+	assert.Nil(t, end)
+}
+
+func TestWindowEndLongWindows(t *testing.T) {
+	// a window which lasts most of the year
+	window := v1alpha1.FreezeWindow{Schedule: "0 0 1 jan *", Duration: "8000h"}
+	end, err := WindowEnd(window, time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	if assert.NotNil(t, end) {
+		assert.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).Add(8000*time.Hour), *end)
+	}
+	end, err = WindowEnd(window, time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Nil(t, end)
+}
+
+func TestWindowEndDaylightSavingTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	// 02:30 does not exist on 2021-03-28 in Berlin, so the window last started the day before
+	window := v1alpha1.FreezeWindow{Schedule: "30 2 * * *", Duration: "26h", TimeZone: "Europe/Berlin"}
+	end, err := WindowEnd(window, time.Date(2021, 3, 28, 4, 0, 0, 0, berlin))
+	assert.NoError(t, err)
+	if assert.NotNil(t, end) {
+		assert.True(t, time.Date(2021, 3, 28, 5, 30, 0, 0, berlin).Equal(*end))
+	}
+}
+
+// windowEndByMinute is the reference implementation of WindowEnd, which tests every minute
+func windowEndByMinute(t *testing.T, window v1alpha1.FreezeWindow, now time.Time) *time.Time {
+	sched, duration, loc, err := parseWindow(window)
+	assert.NoError(t, err)
+	for start := now.In(loc).Truncate(time.Minute); now.Sub(start) < duration; start = start.Add(-time.Minute) {
+		if sched.matches(start) {
+			end := start.Add(duration)
+			return &end
+		}
+	}
+	return nil
+}
+
+func TestWindowEndMatchesEveryMinute(t *testing.T) {
+	schedules := []string{"0 17 * * fri", "*/20 9-17 * * mon-fri", "30 23 31 * *", "15 4 1,15 * sun", "0 0 29 feb *"}
+	durations := []v1alpha1.DurationString{"1m", "30m", "5h", "50h", "200h"}
+	start := time.Date(2021, 12, 24, 16, 59, 30, 0, time.UTC)
+	for _, schedule := range schedules {
+		for _, duration := range durations {
+			window := v1alpha1.FreezeWindow{Schedule: schedule, Duration: duration, TimeZone: "America/New_York"}
+			for now := start; now.Before(start.Add(10 * 24 * time.Hour)); now = now.Add(97 * time.Minute) {
+				end, err := WindowEnd(window, now)
+				assert.NoError(t, err)
+				assert.Equal(t, windowEndByMinute(t, window, now), end, "%s %s %s", schedule, duration, now)
+			}
+		}
+	}
+}
+
+func TestValidateSpec(t *testing.T) {
+	window := v1alpha1.FreezeWindow{Schedule: "0 17 * * fri", Duration: "63h"}
+	assert.NoError(t, ValidateSpec(v1alpha1.RolloutFreezeSpec{Windows: []v1alpha1.FreezeWindow{window}}))
+	assert.EqualError(t, ValidateSpec(v1alpha1.RolloutFreezeSpec{}), "freeze must have at least one window")
+	assert.EqualError(t, ValidateSpec(v1alpha1.RolloutFreezeSpec{
+		Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Near"}}},
+		Windows:  []v1alpha1.FreezeWindow{window},
+	}), `invalid selector: "Near" is not a valid pod selector operator`)
+	assert.EqualError(t, ValidateSpec(v1alpha1.RolloutFreezeSpec{
+		Windows: []v1alpha1.FreezeWindow{window, {Schedule: "0 17 * * fri", Duration: "0s"}},
+	}), "invalid window 1: invalid duration '0s': must be positive")
+}
+
+func TestGetActiveFreeze(t *testing.T) {
+	now := time.Date(2021, 12, 24, 18, 0, 0, 0, time.UTC)
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "default",
+			Labels:    map[string]string{"env": "production"},
+		},
+	}
+	evenings := &v1alpha1.RolloutFreeze{
+		ObjectMeta: metav1.ObjectMeta{Name: "evenings", Namespace: "default"},
+		Spec: v1alpha1.RolloutFreezeSpec{
+			Windows: []v1alpha1.FreezeWindow{{Schedule: "0 17 * * *", Duration: "2h"}},
+		},
+	}
+	holidays := &v1alpha1.ClusterRolloutFreeze{
+		ObjectMeta: metav1.ObjectMeta{Name: "holidays"},
+		Spec: v1alpha1.RolloutFreezeSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "production"}},
+			Windows: []v1alpha1.FreezeWindow{
+				{Schedule: "not a schedule", Duration: "1h"},
+				{Schedule: "0 0 24 dec *", Duration: "72h"},
+			},
+		},
+	}
+
+	assert.Nil(t, GetActiveFreeze(ro, nil, nil, now))
+
+	active := GetActiveFreeze(ro, []*v1alpha1.RolloutFreeze{evenings}, nil, now)
+	if assert.NotNil(t, active) {
+		assert.Equal(t, "RolloutFreeze/evenings", active.Name)
+		assert.Equal(t, time.Date(2021, 12, 24, 19, 0, 0, 0, time.UTC), active.End.UTC())
+	}
+
+	// the window ending last wins
+	active = GetActiveFreeze(ro, []*v1alpha1.RolloutFreeze{evenings}, []*v1alpha1.ClusterRolloutFreeze{holidays}, now)
+	if assert.NotNil(t, active) {
+		assert.Equal(t, "ClusterRolloutFreeze/holidays", active.Name)
+		assert.Equal(t, time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC), active.End.UTC())
+	}
+
+	// freezes of other namespaces and unselected rollouts are ignored
+	staging := ro.DeepCopy()
+	staging.Namespace = "staging"
+	staging.Labels["env"] = "staging"
+	assert.Nil(t, GetActiveFreeze(staging, []*v1alpha1.RolloutFreeze{evenings}, []*v1alpha1.ClusterRolloutFreeze{holidays}, now))
+}
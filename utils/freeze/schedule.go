@@ -0,0 +1,199 @@
+package freeze
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-rollouts/utils/timewindow"
+)
+
+type scheduleField struct {
+	name string
+	min  int
+	max  int
+	// lookup returns the value of a name of the field, e.g. 'jan' or 'fri'
+	lookup func(name string) (int, bool)
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var (
+	minuteField = scheduleField{name: "minute", min: 0, max: 59}
+	hourField   = scheduleField{name: "hour", min: 0, max: 23}
+	domField    = scheduleField{name: "day of month", min: 1, max: 31}
+	monthField  = scheduleField{name: "month", min: 1, max: 12, lookup: func(name string) (int, bool) {
+		v, ok := monthNames[strings.ToLower(name)]
+		return v, ok
+	}}
+	// day of week accepts 7 as an alias for Sunday
+	dowField = scheduleField{name: "day of week", min: 0, max: 7, lookup: func(name string) (int, bool) {
+		weekday, ok := timewindow.ParseWeekday(name)
+		return int(weekday), ok
+	}}
+)
+
+// schedule is a parsed cron expression. Each field is a bitset of the values it matches
+type schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// domStar and dowStar record whether the day fields were unrestricted, since a day matches
+	// either of the two fields when both are restricted
+	domStar bool
+	dowStar bool
+}
+
+// parseSchedule parses a standard cron expression with five fields: minute, hour, day of month,
+// month and day of week. Fields support '*', values, ranges ('1-5'), steps ('*/15', '9-17/2'),
+// lists ('1,15') and the names of months and days of week ('jan', 'fri').
+func parseSchedule(expr string) (*schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule '%s' must have 5 fields (minute hour day-of-month month day-of-week)", expr)
+	}
+	s := &schedule{
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}
+	var err error
+	if s.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if s.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if s.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if s.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if s.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1 << 0
+	}
+	return s, nil
+}
+
+// matches returns whether the schedule fires at the minute of t, in the location of t
+func (s *schedule) matches(t time.Time) bool {
+	return s.minute&(1<<uint(t.Minute())) != 0 && s.hour&(1<<uint(t.Hour())) != 0 && s.matchesDay(t)
+}
+
+// matchesDay returns whether the schedule fires on the day of t, in the location of t
+func (s *schedule) matchesDay(t time.Time) bool {
+	if s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// prev returns the latest time at or before t at which the schedule fires, in the location of t,
+// provided it is after the given time. Instead of testing every minute, the days are walked back
+// from t and only the hours and minutes of the schedule are tested on the days it fires.
+func (s *schedule) prev(t time.Time, after time.Time) (time.Time, bool) {
+	loc := t.Location()
+	year, month, day := t.Date()
+	for offset := 0; ; offset++ {
+		date := time.Date(year, month, day-offset, 0, 0, 0, 0, loc)
+		if !time.Date(year, month, day-offset+1, 0, 0, 0, 0, loc).After(after) {
+			return time.Time{}, false
+		}
+		if !s.matchesDay(date) {
+			continue
+		}
+		lastHour := 23
+		if offset == 0 {
+			lastHour = t.Hour()
+		}
+		for hour := lastHour; hour >= 0; hour-- {
+			if s.hour&(1<<uint(hour)) == 0 {
+				continue
+			}
+			lastMinute := 59
+			if offset == 0 && hour == t.Hour() {
+				lastMinute = t.Minute()
+			}
+			for minute := lastMinute; minute >= 0; minute-- {
+				if s.minute&(1<<uint(minute)) == 0 {
+					continue
+				}
+				fires := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, loc)
+				// skip the times which do not exist on the day, e.g. when daylight saving time starts
+				if fires.Hour() != hour || fires.Minute() != minute || fires.After(t) {
+					continue
+				}
+				if !fires.After(after) {
+					return time.Time{}, false
+				}
+				return fires, true
+			}
+		}
+	}
+}
+
+func (f scheduleField) parse(expr string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		rangeExpr, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangeExpr = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step '%s' in %s field", part[i+1:], f.name)
+			}
+		}
+		start, end := f.min, f.max
+		if rangeExpr != "*" {
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+			var err error
+			if start, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			end = start
+			if len(bounds) == 2 {
+				if end, err = f.value(bounds[1]); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				// '5/15' is short for '5-<max>/15'
+				end = f.max
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range '%s' in %s field", rangeExpr, f.name)
+			}
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func (f scheduleField) value(expr string) (int, error) {
+	if f.lookup != nil {
+		if v, ok := f.lookup(expr); ok {
+			return v, nil
+		}
+	}
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value '%s' in %s field", expr, f.name)
+	}
+	return v, nil
+}
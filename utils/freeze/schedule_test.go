@@ -0,0 +1,60 @@
+package freeze
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScheduleErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"* * * * fri-mon",
+		"*/0 * * * *",
+		"a * * * *",
+	} {
+		_, err := parseSchedule(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestScheduleMatches(t *testing.T) {
+	// 2021-12-24 is a Friday
+	friday := time.Date(2021, 12, 24, 18, 30, 0, 0, time.UTC)
+	tests := []struct {
+		expr    string
+		t       time.Time
+		matches bool
+	}{
+		{"* * * * *", friday, true},
+		{"30 18 * * fri", friday, true},
+		{"30 18 * * 5", friday, true},
+		{"30 18 * * MON-THU", friday, false},
+		{"0 18 * * fri", friday, false},
+		{"*/15 18 * * *", friday, true},
+		{"*/20 18 * * *", friday, false},
+		{"10/20 * * * *", friday, true},
+		{"0,30 9-17,18 * * *", friday, true},
+		{"30 18 24 dec *", friday, true},
+		{"30 18 25 dec *", friday, false},
+		// restricted day of month and day of week match either
+		{"30 18 1 * fri", friday, true},
+		{"30 18 24 * mon", friday, true},
+		{"30 18 1 * mon", friday, false},
+		// 7 is Sunday as well
+		{"30 18 * * 7", friday.AddDate(0, 0, 2), true},
+	}
+	for _, test := range tests {
+		s, err := parseSchedule(test.expr)
+		if assert.NoError(t, err, test.expr) {
+			assert.Equal(t, test.matches, s.matches(test.t), test.expr)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package hook
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const (
+	// HookNameLabelKey is the label key of hook Jobs containing the name of the hook
+	// Also used to filter the hook job informer
+	HookNameLabelKey = "rollouts.argoproj.io/hook"
+	// HookTypeLabelKey is the label key of hook Jobs containing the type of the hook
+	HookTypeLabelKey = "rollouts.argoproj.io/hook-type"
+
+	// maxJobNameLength is the maximum length of a hook Job name. Names must fit into a 63 character
+	// label, since the k8s job controller incorporates the job name into the pod labels.
+	maxJobNameLength = 63
+)
+
+var rolloutGVK = v1alpha1.SchemeGroupVersion.WithKind("Rollout")
+
+// GetHooks returns the hooks of the given type of the rollout
+func GetHooks(ro *v1alpha1.Rollout, hookType v1alpha1.HookType) []v1alpha1.RolloutHook {
+	hooks := ro.Spec.Hooks
+	if hooks == nil {
+		return nil
+	}
+	switch hookType {
+	case v1alpha1.HookTypePreRollout:
+		return hooks.PreRollout
+	case v1alpha1.HookTypePostPromotion:
+		return hooks.PostPromotion
+	case v1alpha1.HookTypePostAbort:
+		return hooks.PostAbort
+	}
+	return nil
+}
+
+// GetHook returns the hook of the given type and name of the rollout, or nil if there is none
+func GetHook(ro *v1alpha1.Rollout, hookType v1alpha1.HookType, name string) *v1alpha1.RolloutHook {
+	hooks := GetHooks(ro, hookType)
+	for i := range hooks {
+		if hooks[i].Name == name {
+			return &hooks[i]
+		}
+	}
+	return nil
+}
+
+// GetHookStatus returns the status of the hook of the given type and name for the revision, or nil
+// if the hook did not run for the revision
+func GetHookStatus(statuses []v1alpha1.RolloutHookStatus, hookType v1alpha1.HookType, name, podHash string) *v1alpha1.RolloutHookStatus {
+	for i := range statuses {
+		status := &statuses[i]
+		if status.Type == hookType && status.Name == name && status.PodTemplateHash == podHash {
+			return status
+		}
+	}
+	return nil
+}
+
+// FailurePolicy returns the failure policy of the hook, defaulting to Abort
+func FailurePolicy(hook v1alpha1.RolloutHook) v1alpha1.HookFailurePolicy {
+	if hook.FailurePolicy == "" {
+		return v1alpha1.HookFailurePolicyAbort
+	}
+	return hook.FailurePolicy
+}
+
+// JobName returns the name of the Job of an attempt of the hook for the revision. The first
+// attempt is named <rollout>-<pod-hash>-<hook>, retries get a .<attempt> suffix. The Jobs of
+// PostAbort hooks get an .abort-<occurrence> suffix, since they run for every abort of the
+// revision. Names which are too long are truncated and suffixed with a hash of the full name.
+func JobName(ro *v1alpha1.Rollout, hook v1alpha1.RolloutHook, podHash string, occurrence int32, attempt int) string {
+	suffix := ""
+	if occurrence > 0 {
+		suffix = fmt.Sprintf(".abort-%d", occurrence)
+	}
+	if attempt > 1 {
+		suffix += fmt.Sprintf(".%d", attempt)
+	}
+	name := fmt.Sprintf("%s-%s-%s", ro.Name, podHash, hook.Name)
+	if len(name)+len(suffix) > maxJobNameLength {
+		hasher := fnv.New32a()
+		hasher.Write([]byte(name))
+		hash := fmt.Sprintf("%08x", hasher.Sum32())
+		name = strings.TrimRight(name[:maxJobNameLength-len(suffix)-len(hash)-1], "-.") + "-" + hash
+	}
+	return name + suffix
+}
+
+// JobAttempt returns the attempt of a hook Job from its name
+func JobAttempt(jobName string) int {
+	i := strings.LastIndex(jobName, ".")
+	if i < 0 {
+		return 1
+	}
+	attempt, err := strconv.Atoi(jobName[i+1:])
+	if err != nil {
+		return 1
+	}
+	return attempt
+}
+
+// NewJob returns the Job of an attempt of the hook for the occurrence of the hook for the revision
+func NewJob(ro *v1alpha1.Rollout, hookType v1alpha1.HookType, hook v1alpha1.RolloutHook, podHash string, occurrence int32, attempt int) *batchv1.Job {
+	labels := map[string]string{}
+	for k, v := range hook.Job.Metadata.Labels {
+		labels[k] = v
+	}
+	labels[HookNameLabelKey] = hook.Name
+	labels[HookTypeLabelKey] = string(hookType)
+	labels[v1alpha1.DefaultRolloutUniqueLabelKey] = podHash
+	var annotations map[string]string
+	if len(hook.Job.Metadata.Annotations) > 0 {
+		annotations = map[string]string{}
+		for k, v := range hook.Job.Metadata.Annotations {
+			annotations[k] = v
+		}
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            JobName(ro, hook, podHash, occurrence, attempt),
+			Namespace:       ro.Namespace,
+			Labels:          labels,
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, rolloutGVK)},
+		},
+		Spec: *hook.Job.Spec.DeepCopy(),
+	}
+}
+
+// JobPhase returns the phase of a hook Job and the message of its completion condition
+func JobPhase(job *batchv1.Job) (v1alpha1.AnalysisPhase, string) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return v1alpha1.AnalysisPhaseSuccessful, condition.Message
+		case batchv1.JobFailed:
+			message := condition.Message
+			if message == "" {
+				message = condition.Reason
+			}
+			return v1alpha1.AnalysisPhaseFailed, message
+		}
+	}
+	return v1alpha1.AnalysisPhaseRunning, ""
+}
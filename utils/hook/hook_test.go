@@ -0,0 +1,117 @@
+package hook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newRollout(name string) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       "abc-123",
+		},
+	}
+}
+
+func TestJobName(t *testing.T) {
+	hook := v1alpha1.RolloutHook{Name: "migrate"}
+	assert.Equal(t, "guestbook-5d8f7c9b6-migrate", JobName(newRollout("guestbook"), hook, "5d8f7c9b6", 0, 1))
+	assert.Equal(t, "guestbook-5d8f7c9b6-migrate.2", JobName(newRollout("guestbook"), hook, "5d8f7c9b6", 0, 2))
+
+	ro := newRollout(strings.Repeat("a", 60))
+	name := JobName(ro, hook, "5d8f7c9b6", 0, 1)
+	assert.Len(t, name, 63)
+	assert.NotEqual(t, name, JobName(ro, v1alpha1.RolloutHook{Name: "warmup"}, "5d8f7c9b6", 0, 1))
+	retryName := JobName(ro, hook, "5d8f7c9b6", 0, 12)
+	assert.Len(t, retryName, 63)
+	assert.True(t, strings.HasSuffix(retryName, ".12"))
+	assert.Equal(t, 12, JobAttempt(retryName))
+
+	// the Jobs of PostAbort hooks are named after the abort they run for
+	assert.Equal(t, "guestbook-5d8f7c9b6-migrate.abort-2", JobName(newRollout("guestbook"), hook, "5d8f7c9b6", 2, 1))
+	abortName := JobName(ro, hook, "5d8f7c9b6", 2, 1)
+	assert.Len(t, abortName, 63)
+	assert.True(t, strings.HasSuffix(abortName, ".abort-2"))
+	assert.Equal(t, 1, JobAttempt(abortName))
+}
+
+func TestJobAttempt(t *testing.T) {
+	assert.Equal(t, 1, JobAttempt("guestbook-5d8f7c9b6-migrate"))
+	assert.Equal(t, 1, JobAttempt("guest.book-5d8f7c9b6-migrate"))
+	assert.Equal(t, 3, JobAttempt("guestbook-5d8f7c9b6-migrate.3"))
+}
+
+func TestNewJob(t *testing.T) {
+	hook := v1alpha1.RolloutHook{
+		Name: "migrate",
+		Job: v1alpha1.JobMetric{
+			Metadata: metav1.ObjectMeta{
+				Labels:      map[string]string{"team": "payments"},
+				Annotations: map[string]string{"owner": "db"},
+			},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers:    []corev1.Container{{Name: "migrate", Image: "migrate:1.0"}},
+						RestartPolicy: corev1.RestartPolicyNever,
+					},
+				},
+			},
+		},
+	}
+	ro := newRollout("guestbook")
+	job := NewJob(ro, v1alpha1.HookTypePreRollout, hook, "5d8f7c9b6", 0, 1)
+	assert.Equal(t, "guestbook-5d8f7c9b6-migrate", job.Name)
+	assert.Equal(t, "default", job.Namespace)
+	assert.Equal(t, map[string]string{
+		"team":                                "payments",
+		HookNameLabelKey:                      "migrate",
+		HookTypeLabelKey:                      "PreRollout",
+		v1alpha1.DefaultRolloutUniqueLabelKey: "5d8f7c9b6",
+	}, job.Labels)
+	assert.Equal(t, map[string]string{"owner": "db"}, job.Annotations)
+	assert.Equal(t, ro.UID, metav1.GetControllerOf(job).UID)
+	assert.Equal(t, "Rollout", metav1.GetControllerOf(job).Kind)
+	assert.Equal(t, hook.Job.Spec, job.Spec)
+	// the labels of the hook are not modified
+	assert.Len(t, hook.Job.Metadata.Labels, 1)
+}
+
+func TestJobPhase(t *testing.T) {
+	job := &batchv1.Job{}
+	phase, _ := JobPhase(job)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, phase)
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	phase, _ = JobPhase(job)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, phase)
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"}}
+	phase, message := JobPhase(job)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, phase)
+	assert.Equal(t, "BackoffLimitExceeded", message)
+}
+
+func TestGetHooks(t *testing.T) {
+	ro := newRollout("guestbook")
+	assert.Nil(t, GetHooks(ro, v1alpha1.HookTypePreRollout))
+	ro.Spec.Hooks = &v1alpha1.RolloutHooks{
+		PreRollout: []v1alpha1.RolloutHook{{Name: "migrate"}},
+		PostAbort:  []v1alpha1.RolloutHook{{Name: "notify", FailurePolicy: v1alpha1.HookFailurePolicyIgnore}},
+	}
+	assert.Len(t, GetHooks(ro, v1alpha1.HookTypePreRollout), 1)
+	assert.Nil(t, GetHooks(ro, v1alpha1.HookTypePostPromotion))
+	assert.Nil(t, GetHook(ro, v1alpha1.HookTypePreRollout, "notify"))
+	hook := GetHook(ro, v1alpha1.HookTypePostAbort, "notify")
+	assert.Equal(t, v1alpha1.HookFailurePolicyIgnore, FailurePolicy(*hook))
+	assert.Equal(t, v1alpha1.HookFailurePolicyAbort, FailurePolicy(ro.Spec.Hooks.PreRollout[0]))
+}
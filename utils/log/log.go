@@ -53,6 +53,10 @@ func WithObject(obj runtime.Object) *log.Entry {
 			kind = "clusteranalysistemplate"
 		case *v1alpha1.Experiment:
 			kind = "experiment"
+		case *v1alpha1.RolloutFreeze:
+			kind = "rolloutfreeze"
+		case *v1alpha1.ClusterRolloutFreeze:
+			kind = "clusterrolloutfreeze"
 		}
 	}
 	objectMeta, err := meta.Accessor(obj)
@@ -291,6 +291,16 @@ func GetCurrentCanaryStep(rollout *v1alpha1.Rollout) (*v1alpha1.CanaryStep, *int
 	return &rollout.Spec.Strategy.Canary.Steps[currentStepIndex], &currentStepIndex
 }
 
+// IsCanaryStepSkipped returns whether the step at the index was skipped because of its when expression
+func IsCanaryStepSkipped(rollout *v1alpha1.Rollout, index int32) bool {
+	for _, skipped := range rollout.Status.Canary.SkippedSteps {
+		if skipped.Index == index {
+			return true
+		}
+	}
+	return false
+}
+
 // GetCanaryReplicasOrWeight either returns a static set of replicas or a weight percentage
 func GetCanaryReplicasOrWeight(rollout *v1alpha1.Rollout) (*int32, int32) {
 	if rollout.Status.PromoteFull || rollout.Status.CurrentPodHash == rollout.Status.StableRS {
@@ -320,7 +330,7 @@ func GetCurrentSetWeight(rollout *v1alpha1.Rollout) int32 {
 
 	for i := *currentStepIndex; i >= 0; i-- {
 		step := rollout.Spec.Strategy.Canary.Steps[i]
-		if step.SetWeight != nil {
+		if step.SetWeight != nil && !IsCanaryStepSkipped(rollout, i) {
 			return *step.SetWeight
 		}
 	}
@@ -342,7 +352,7 @@ func UseSetCanaryScale(rollout *v1alpha1.Rollout) *v1alpha1.SetCanaryScale {
 
 	for i := *currentStepIndex; i >= 0; i-- {
 		step := rollout.Spec.Strategy.Canary.Steps[i]
-		if step.SetCanaryScale == nil {
+		if step.SetCanaryScale == nil || IsCanaryStepSkipped(rollout, i) {
 			continue
 		}
 		if step.SetCanaryScale.MatchTrafficWeight {
@@ -398,7 +408,7 @@ func GetCurrentExperimentStep(r *v1alpha1.Rollout) *v1alpha1.RolloutExperimentSt
 
 	for i := *currentStepIndex; i >= 0; i-- {
 		step := r.Spec.Strategy.Canary.Steps[i]
-		if step.Experiment != nil {
+		if step.Experiment != nil && !IsCanaryStepSkipped(r, i) {
 			return step.Experiment
 		}
 	}
@@ -730,6 +730,22 @@ func TestGetCurrentSetWeight(t *testing.T) {
 
 }
 
+func TestGetCurrentSetWeightSkippedStep(t *testing.T) {
+	rollout := newRollout(10, 10, intstr.FromInt(0), intstr.FromInt(1), "", "", nil, nil)
+	rollout.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32Ptr(10)},
+		{SetWeight: pointer.Int32Ptr(50), When: `metadata.labels.env == "prod"`},
+		{Pause: &v1alpha1.RolloutPause{}},
+	}
+	rollout.Status.CurrentStepIndex = pointer.Int32Ptr(2)
+	assert.Equal(t, int32(50), GetCurrentSetWeight(rollout))
+
+	rollout.Status.Canary.SkippedSteps = []v1alpha1.SkippedCanaryStep{{Index: 1}}
+	assert.True(t, IsCanaryStepSkipped(rollout, 1))
+	assert.False(t, IsCanaryStepSkipped(rollout, 2))
+	assert.Equal(t, int32(10), GetCurrentSetWeight(rollout))
+}
+
 func TestGetCurrentExperiment(t *testing.T) {
 	rollout := &v1alpha1.Rollout{
 		Spec: v1alpha1.RolloutSpec{
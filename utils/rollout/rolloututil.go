@@ -132,6 +132,12 @@ func CanaryStepString(c v1alpha1.CanaryStep) string {
 	if c.Analysis != nil {
 		return "analysis"
 	}
+	if c.TimeWindow != nil {
+		return "timeWindow"
+	}
+	if c.Approval != nil {
+		return "approval"
+	}
 	if c.SetCanaryScale != nil {
 		if c.SetCanaryScale.Weight != nil {
 			return fmt.Sprintf("setCanaryScale{weight: %d}", *c.SetCanaryScale.Weight)
@@ -342,6 +342,14 @@ func TestCanaryStepString(t *testing.T) {
 			step:           v1alpha1.CanaryStep{Analysis: &v1alpha1.RolloutAnalysis{}},
 			expectedString: "analysis",
 		},
+		{
+			step:           v1alpha1.CanaryStep{TimeWindow: &v1alpha1.RolloutTimeWindow{}},
+			expectedString: "timeWindow",
+		},
+		{
+			step:           v1alpha1.CanaryStep{Approval: &v1alpha1.RolloutApprovalStep{}},
+			expectedString: "approval",
+		},
 		{
 			step:           v1alpha1.CanaryStep{SetCanaryScale: &v1alpha1.SetCanaryScale{Weight: pointer.Int32Ptr(20)}},
 			expectedString: "setCanaryScale{weight: 20}",
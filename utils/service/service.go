@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 )
@@ -28,6 +30,12 @@ func GetRolloutServiceKeys(rollout *v1alpha1.Rollout) []string {
 		if rollout.Spec.Strategy.BlueGreen.PreviewService != "" {
 			services = append(services, fmt.Sprintf("%s/%s", rollout.Namespace, rollout.Spec.Strategy.BlueGreen.PreviewService))
 		}
+		for _, svc := range rollout.Spec.Strategy.BlueGreen.AdditionalActiveServices {
+			services = append(services, fmt.Sprintf("%s/%s", rollout.Namespace, svc))
+		}
+		for _, svc := range rollout.Spec.Strategy.BlueGreen.AdditionalPreviewServices {
+			services = append(services, fmt.Sprintf("%s/%s", rollout.Namespace, svc))
+		}
 	} else if rollout.Spec.Strategy.Canary != nil {
 		if rollout.Spec.Strategy.Canary.CanaryService != "" {
 			services = append(services, fmt.Sprintf("%s/%s", rollout.Namespace, rollout.Spec.Strategy.Canary.CanaryService))
@@ -56,3 +64,89 @@ func CheckRolloutForService(rollout *v1alpha1.Rollout, svc *corev1.Service) bool
 	}
 	return false
 }
+
+// GetManagedServices returns the managed services spec of the strategy of the rollout, or nil if the
+// controller does not manage the Services of the rollout
+func GetManagedServices(rollout *v1alpha1.Rollout) *v1alpha1.ManagedServices {
+	if rollout.Spec.Strategy.BlueGreen != nil {
+		return rollout.Spec.Strategy.BlueGreen.ManagedServices
+	}
+	if rollout.Spec.Strategy.Canary != nil {
+		return rollout.Spec.Strategy.Canary.ManagedServices
+	}
+	return nil
+}
+
+// NewManagedService returns a Service with the given name which is generated from the managed services
+// spec of the rollout. The Service selects the pods of the rollout with the given pod template hash, if
+// any, and is controlled by it.
+func NewManagedService(rollout *v1alpha1.Rollout, name string, podHash string) *corev1.Service {
+	managed := GetManagedServices(rollout)
+	labels := map[string]string{}
+	annotations := map[string]string{}
+	if managed.Metadata != nil {
+		for k, v := range managed.Metadata.Labels {
+			labels[k] = v
+		}
+		for k, v := range managed.Metadata.Annotations {
+			annotations[k] = v
+		}
+	}
+	annotations[v1alpha1.ManagedByRolloutsKey] = rollout.Name
+	selector := map[string]string{}
+	if rollout.Spec.Selector != nil {
+		for k, v := range rollout.Spec.Selector.MatchLabels {
+			selector[k] = v
+		}
+	}
+	if podHash != "" {
+		selector[v1alpha1.DefaultRolloutUniqueLabelKey] = podHash
+	}
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       rollout.Namespace,
+			Labels:          labels,
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rollout, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     ManagedServiceType(managed),
+			Ports:    ManagedServicePorts(managed, nil),
+			Selector: selector,
+		},
+	}
+}
+
+// ManagedServiceType returns the type of the managed Services, defaulting to ClusterIP
+func ManagedServiceType(managed *v1alpha1.ManagedServices) corev1.ServiceType {
+	if managed.Type == "" {
+		return corev1.ServiceTypeClusterIP
+	}
+	return managed.Type
+}
+
+// ManagedServicePorts returns the ports of a managed Service with the defaults of the API server
+// applied. Node ports which were allocated to the existing ports are preserved, so that updating a
+// Service does not reallocate them.
+func ManagedServicePorts(managed *v1alpha1.ManagedServices, existing []corev1.ServicePort) []corev1.ServicePort {
+	ports := make([]corev1.ServicePort, len(managed.Ports))
+	for i := range managed.Ports {
+		port := *managed.Ports[i].DeepCopy()
+		if port.Protocol == "" {
+			port.Protocol = corev1.ProtocolTCP
+		}
+		if port.TargetPort == (intstr.IntOrString{}) {
+			port.TargetPort = intstr.FromInt(int(port.Port))
+		}
+		if port.NodePort == 0 && ManagedServiceType(managed) != corev1.ServiceTypeClusterIP {
+			for _, existingPort := range existing {
+				if existingPort.Port == port.Port && existingPort.Protocol == port.Protocol {
+					port.NodePort = existingPort.NodePort
+				}
+			}
+		}
+		ports[i] = port
+	}
+	return ports
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 )
@@ -80,6 +81,25 @@ func TestGetRolloutServiceKeysForBlueGreen(t *testing.T) {
 	assert.Equal(t, keys, []string{"default/active-service", "default/preview-service"})
 }
 
+func TestGetRolloutServiceKeysForBlueGreenWithAdditionalServices(t *testing.T) {
+	keys := GetRolloutServiceKeys(&v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				BlueGreen: &v1alpha1.BlueGreenStrategy{
+					PreviewService:            "preview-service",
+					ActiveService:             "active-service",
+					AdditionalActiveServices:  []string{"active-grpc"},
+					AdditionalPreviewServices: []string{"preview-grpc"},
+				},
+			},
+		},
+	})
+	assert.Equal(t, keys, []string{"default/active-service", "default/preview-service", "default/active-grpc", "default/preview-grpc"})
+}
+
 func TestHasManagedByAnnotation(t *testing.T) {
 	service := &corev1.Service{}
 	managedBy, exists := HasManagedByAnnotation(service)
@@ -129,3 +149,55 @@ func TestCheckRolloutForService(t *testing.T) {
 		assert.True(t, CheckRolloutForService(ro, service))
 	})
 }
+
+func TestNewManagedService(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "default",
+			UID:       "abc-123",
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+			Strategy: v1alpha1.RolloutStrategy{
+				BlueGreen: &v1alpha1.BlueGreenStrategy{
+					ActiveService: "active",
+					ManagedServices: &v1alpha1.ManagedServices{
+						Ports: []corev1.ServicePort{{Port: 80}},
+						Type:  corev1.ServiceTypeNodePort,
+						Metadata: &v1alpha1.PodTemplateMetadata{
+							Annotations: map[string]string{"owner": "payments"},
+						},
+					},
+				},
+			},
+		},
+	}
+	svc := NewManagedService(ro, "active", "abc123")
+	assert.Equal(t, "active", svc.Name)
+	assert.Equal(t, "default", svc.Namespace)
+	assert.Equal(t, map[string]string{"owner": "payments", v1alpha1.ManagedByRolloutsKey: "guestbook"}, svc.Annotations)
+	assert.Equal(t, map[string]string{"app": "guestbook", v1alpha1.DefaultRolloutUniqueLabelKey: "abc123"}, svc.Spec.Selector)
+	assert.Equal(t, corev1.ServiceTypeNodePort, svc.Spec.Type)
+	assert.Equal(t, []corev1.ServicePort{{Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt(80)}}, svc.Spec.Ports)
+	assert.True(t, metav1.IsControlledBy(svc, ro))
+}
+
+func TestManagedServicePorts(t *testing.T) {
+	managed := &v1alpha1.ManagedServices{
+		Ports: []corev1.ServicePort{{Port: 80}, {Port: 53, Protocol: corev1.ProtocolUDP, TargetPort: intstr.FromString("dns")}},
+	}
+	existing := []corev1.ServicePort{{Port: 80, Protocol: corev1.ProtocolTCP, NodePort: 30080}}
+	ports := ManagedServicePorts(managed, existing)
+	assert.Equal(t, corev1.ProtocolTCP, ports[0].Protocol)
+	assert.Equal(t, intstr.FromInt(80), ports[0].TargetPort)
+	assert.Equal(t, int32(0), ports[0].NodePort)
+	assert.Equal(t, intstr.FromString("dns"), ports[1].TargetPort)
+
+	// allocated node ports are preserved for services which expose them
+	managed.Type = corev1.ServiceTypeLoadBalancer
+	ports = ManagedServicePorts(managed, existing)
+	assert.Equal(t, int32(30080), ports[0].NodePort)
+	assert.Equal(t, int32(0), ports[1].NodePort)
+	assert.Nil(t, GetManagedServices(&v1alpha1.Rollout{}))
+}
@@ -0,0 +1,383 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// ShardByNamespace assigns all the objects of a namespace to the same shard
+	ShardByNamespace = "namespace"
+	// ShardByRollout assigns the objects of a Rollout to the same shard
+	ShardByRollout = "rollout"
+
+	// ShardGroupLabelKey is the label key of the shard member Leases containing the name of the shard group
+	ShardGroupLabelKey = "rollouts.argoproj.io/shard-group"
+
+	// virtualNodes is the number of points of each member on the hash ring. More points spread the
+	// keys more evenly between the members.
+	virtualNodes = 100
+	// maxControllerDepth is the maximum number of controllers which are followed to find the key of
+	// an object, which guards against cycles of controller references
+	maxControllerDepth = 5
+)
+
+// Ring is a consistent hash ring which assigns keys to members. Adding or removing a member only
+// moves the keys of that member, so the other members keep the objects they already reconcile.
+type Ring struct {
+	hashes  []uint32
+	members map[uint32]string
+}
+
+// NewRing returns a hash ring of the given members
+func NewRing(members []string) *Ring {
+	r := &Ring{
+		members: map[uint32]string{},
+	}
+	for _, member := range members {
+		for i := 0; i < virtualNodes; i++ {
+			h := hash(member + "#" + strconv.Itoa(i))
+			r.hashes = append(r.hashes, h)
+			r.members[h] = member
+		}
+	}
+	sort.Slice(r.hashes, func(i, j int) bool { return r.hashes[i] < r.hashes[j] })
+	return r
+}
+
+// Owner returns the member which owns the key, or an empty string if the ring has no members
+func (r *Ring) Owner(key string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+	h := hash(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.members[r.hashes[i]]
+}
+
+// hash returns the FNV-1a hash of the string, mixed with the murmur3 finalizer so that similar
+// strings (e.g. rollout names with a numeric suffix) are spread evenly over the ring
+func hash(s string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(s))
+	x := h.Sum32()
+	x ^= x >> 16
+	x *= 0x85ebca6b
+	x ^= x >> 13
+	x *= 0xc2b2ae35
+	x ^= x >> 16
+	return x
+}
+
+// ControllerGetter returns the controller of an object in the namespace, or nil if it is unknown
+type ControllerGetter func(namespace string, ref metav1.OwnerReference) metav1.Object
+
+// Key returns the key which is used to assign the object to a shard. When sharding by rollout,
+// objects which are controlled by another object are assigned by the name of their top-most
+// controller, so that e.g. the Experiments of a Rollout and the AnalysisRuns of those Experiments
+// are reconciled by the same shard as the Rollout. The controllers of the controller are looked up
+// with getController, and without it objects are assigned by the name of their direct controller.
+func Key(obj metav1.Object, shardBy string, getController ControllerGetter) string {
+	if shardBy == ShardByNamespace {
+		return obj.GetNamespace()
+	}
+	name := obj.GetName()
+	ref := metav1.GetControllerOf(obj)
+	for depth := 0; ref != nil && depth < maxControllerDepth; depth++ {
+		name = ref.Name
+		if getController == nil {
+			break
+		}
+		controller := getController(obj.GetNamespace(), *ref)
+		if controller == nil {
+			break
+		}
+		ref = metav1.GetControllerOf(controller)
+	}
+	return obj.GetNamespace() + "/" + name
+}
+
+// Options configures the membership of a controller replica in a shard group
+type Options struct {
+	// ShardBy is either ShardByNamespace or ShardByRollout
+	ShardBy string
+	// Group is the name of the shard group. Replicas of the same group split the objects between them.
+	Group string
+	// Namespace is the namespace of the member Leases
+	Namespace string
+	// Identity is the unique name of the replica in the group
+	Identity string
+	// LeaseDuration is the duration after which a member which stopped renewing its Lease is removed
+	LeaseDuration time.Duration
+	// RenewPeriod is the interval at which the Lease of the replica is renewed and the members are refreshed
+	RenewPeriod time.Duration
+	// HandoffDelay is the duration for which a replica waits before it reconciles the objects which
+	// moved to it, so that their previous owner notices the change of the members first. It should
+	// be longer than RenewPeriod.
+	HandoffDelay time.Duration
+}
+
+// Sharder splits the objects of the controller between the replicas of a shard group. Each replica
+// holds a Lease which it renews periodically, and the replicas with a live Lease are the members of
+// a consistent hash ring. When a replica stops renewing its Lease, its objects are rebalanced to the
+// remaining members.
+//
+// The replicas refresh the members on their own schedules, so two replicas can briefly disagree on
+// the owner of an object. To keep them from reconciling the same object, a replica releases all of
+// its objects as soon as it fails to renew its Lease or its Lease expires, and waits for HandoffDelay
+// before it reconciles the objects which moved to it.
+type Sharder struct {
+	client kubernetes.Interface
+	opts   Options
+
+	mutex         sync.RWMutex
+	ring          *Ring
+	members       []string
+	handlers      []func()
+	getController ControllerGetter
+	// leaseExpiry is when the Lease of the replica expires unless it is renewed
+	leaseExpiry time.Time
+	// prevRings are the rings since the handoff started. Objects which were not owned in all of
+	// them are not owned until handoffEnd.
+	prevRings      []*Ring
+	handoffEnd     time.Time
+	handoffPending bool
+
+	// used for unit testing
+	now func() time.Time
+}
+
+// NewSharder returns a sharder for the given options. The replica owns no objects until Sync is called.
+func NewSharder(client kubernetes.Interface, opts Options) *Sharder {
+	return &Sharder{
+		client: client,
+		opts:   opts,
+		ring:   NewRing(nil),
+		now:    time.Now,
+	}
+}
+
+// AddChangeHandler registers a function which is called whenever objects moved to the replica, i.e.
+// once the handoff after a change of the members is over
+func (s *Sharder) AddChangeHandler(handler func()) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.handlers = append(s.handlers, handler)
+}
+
+// SetControllerGetter sets the function which looks up the controllers of objects, so that objects
+// are assigned by their top-most controller
+func (s *Sharder) SetControllerGetter(getController ControllerGetter) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.getController = getController
+}
+
+// Owns returns whether the object is reconciled by this replica. A nil sharder owns all objects.
+func (s *Sharder) Owns(obj metav1.Object) bool {
+	if s == nil {
+		return true
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	now := s.now()
+	if !now.Before(s.leaseExpiry) {
+		// the other members may have taken over the objects of the replica
+		return false
+	}
+	key := Key(obj, s.opts.ShardBy, s.getController)
+	if s.ring.Owner(key) != s.opts.Identity {
+		return false
+	}
+	if !now.Before(s.handoffEnd) {
+		return true
+	}
+	// an object which moved to the replica may still be reconciled by its previous owner
+	for _, ring := range s.prevRings {
+		if ring.Owner(key) != s.opts.Identity {
+			return false
+		}
+	}
+	return true
+}
+
+// Members returns the identities of the live members of the group
+func (s *Sharder) Members() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.members
+}
+
+// Run renews the Lease of the replica and refreshes the members of the group until stopCh is
+// closed, at which point the Lease is deleted so that the objects are rebalanced immediately.
+func (s *Sharder) Run(stopCh <-chan struct{}) {
+	wait.Until(func() {
+		if err := s.Sync(context.TODO()); err != nil {
+			log.Errorf("Failed to sync shard membership: %v", err)
+		}
+	}, s.opts.RenewPeriod, stopCh)
+
+	err := s.client.CoordinationV1().Leases(s.opts.Namespace).Delete(context.TODO(), s.leaseName(), metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		log.Errorf("Failed to delete shard lease '%s': %v", s.leaseName(), err)
+	}
+}
+
+// Sync renews the Lease of the replica and refreshes the members of the group. The replica releases
+// all of its objects when the Lease cannot be renewed.
+func (s *Sharder) Sync(ctx context.Context) error {
+	now := s.now()
+	if err := s.renew(ctx, now); err != nil {
+		s.release()
+		return err
+	}
+	s.mutex.Lock()
+	s.leaseExpiry = now.Add(s.opts.LeaseDuration)
+	s.mutex.Unlock()
+	return s.refresh(ctx)
+}
+
+// release drops the ownership of all objects until the members are refreshed again
+func (s *Sharder) release() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if len(s.members) == 0 {
+		return
+	}
+	log.Warnf("Releasing all objects of shard group '%s' since the shard lease could not be renewed", s.opts.Group)
+	s.members = nil
+	s.ring = NewRing(nil)
+	s.prevRings = nil
+	s.handoffPending = false
+}
+
+func (s *Sharder) leaseName() string {
+	return fmt.Sprintf("%s-%s", s.opts.Group, s.opts.Identity)
+}
+
+func (s *Sharder) renew(ctx context.Context, renewTime time.Time) error {
+	leases := s.client.CoordinationV1().Leases(s.opts.Namespace)
+	now := metav1.NewMicroTime(renewTime)
+	leaseDurationSeconds := int32(s.opts.LeaseDuration.Seconds())
+	lease, err := leases.Get(ctx, s.leaseName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.leaseName(),
+				Namespace: s.opts.Namespace,
+				Labels:    map[string]string{ShardGroupLabelKey: s.opts.Group},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &s.opts.Identity,
+				LeaseDurationSeconds: &leaseDurationSeconds,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		_, err = leases.Create(ctx, lease, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	lease = lease.DeepCopy()
+	lease.Spec.HolderIdentity = &s.opts.Identity
+	lease.Spec.LeaseDurationSeconds = &leaseDurationSeconds
+	lease.Spec.RenewTime = &now
+	_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+// refresh lists the Leases of the group and rebuilds the hash ring from the live members. Expired
+// Leases are deleted, since their replicas are gone or will recreate them on their next renewal.
+func (s *Sharder) refresh(ctx context.Context) error {
+	selector := labels.SelectorFromSet(labels.Set{ShardGroupLabelKey: s.opts.Group})
+	leaseList, err := s.client.CoordinationV1().Leases(s.opts.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+	members := []string{s.opts.Identity}
+	for _, lease := range leaseList.Items {
+		if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == s.opts.Identity {
+			continue
+		}
+		if s.expired(lease) {
+			log.Infof("Deleting expired shard lease '%s'", lease.Name)
+			err = s.client.CoordinationV1().Leases(s.opts.Namespace).Delete(ctx, lease.Name, metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		members = append(members, *lease.Spec.HolderIdentity)
+	}
+	sort.Strings(members)
+
+	now := s.now()
+	s.mutex.Lock()
+	changed := !equal(s.members, members)
+	if changed {
+		// during a handoff, only the objects which were owned all along stay owned
+		if !s.handoffPending {
+			s.prevRings = nil
+		}
+		s.prevRings = append(s.prevRings, s.ring)
+		s.members = members
+		s.ring = NewRing(members)
+		s.handoffEnd = now.Add(s.opts.HandoffDelay)
+		s.handoffPending = true
+	}
+	handoffDone := s.handoffPending && !now.Before(s.handoffEnd)
+	if handoffDone {
+		s.handoffPending = false
+	}
+	handlers := s.handlers
+	s.mutex.Unlock()
+
+	if changed {
+		log.Infof("Shard group '%s' changed to %d members: %v", s.opts.Group, len(members), members)
+	}
+	if handoffDone {
+		// the objects which moved to the replica are only reconciled once they are enqueued again
+		for _, handler := range handlers {
+			handler()
+		}
+	}
+	return nil
+}
+
+func (s *Sharder) expired(lease coordinationv1.Lease) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return s.now().After(expiry)
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
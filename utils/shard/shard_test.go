@@ -0,0 +1,234 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestRing(t *testing.T) {
+	assert.Equal(t, "", NewRing(nil).Owner("default/guestbook"))
+
+	ring := NewRing([]string{"a", "b", "c"})
+	owned := map[string]int{}
+	owners := map[string]string{}
+	for i := 0; i < 300; i++ {
+		key := fmt.Sprintf("default/rollout-%d", i)
+		owners[key] = ring.Owner(key)
+		owned[owners[key]]++
+	}
+	for _, member := range []string{"a", "b", "c"} {
+		assert.Greater(t, owned[member], 50)
+	}
+
+	// removing a member only moves the keys of that member
+	ring = NewRing([]string{"a", "c"})
+	for key, owner := range owners {
+		if owner != "b" {
+			assert.Equal(t, owner, ring.Owner(key))
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"}}
+	run := &v1alpha1.AnalysisRun{ObjectMeta: metav1.ObjectMeta{
+		Name:            "guestbook-abc-1",
+		Namespace:       "default",
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))},
+	}}
+	assert.Equal(t, "default/guestbook", Key(ro, ShardByRollout, nil))
+	assert.Equal(t, "default/guestbook", Key(run, ShardByRollout, nil))
+	assert.Equal(t, "default", Key(run, ShardByNamespace, nil))
+}
+
+func TestKeyControllerChain(t *testing.T) {
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"}}
+	ex := &v1alpha1.Experiment{ObjectMeta: metav1.ObjectMeta{
+		Name:            "guestbook-abc-1-0",
+		Namespace:       "default",
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))},
+	}}
+	run := &v1alpha1.AnalysisRun{ObjectMeta: metav1.ObjectMeta{
+		Name:            "guestbook-abc-1-0-web",
+		Namespace:       "default",
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ex, v1alpha1.SchemeGroupVersion.WithKind("Experiment"))},
+	}}
+	getController := func(namespace string, ref metav1.OwnerReference) metav1.Object {
+		if ref.Kind == "Experiment" && ref.Name == ex.Name {
+			return ex
+		}
+		return nil
+	}
+	// without the controller getter the run is assigned by its experiment
+	assert.Equal(t, "default/guestbook-abc-1-0", Key(run, ShardByRollout, nil))
+	assert.Equal(t, "default/guestbook", Key(run, ShardByRollout, getController))
+	assert.Equal(t, "default/guestbook", Key(ex, ShardByRollout, getController))
+
+	// a cycle of controller references ends after a few controllers
+	cyclic := &v1alpha1.Experiment{ObjectMeta: metav1.ObjectMeta{Name: "cyclic", Namespace: "default"}}
+	cyclic.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cyclic, v1alpha1.SchemeGroupVersion.WithKind("Experiment"))}
+	assert.Equal(t, "default/cyclic", Key(cyclic, ShardByRollout, func(string, metav1.OwnerReference) metav1.Object { return cyclic }))
+}
+
+func newLease(identity string, renewTime time.Time) *coordinationv1.Lease {
+	leaseDurationSeconds := int32(15)
+	renew := metav1.NewMicroTime(renewTime)
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shard-" + identity,
+			Namespace: "argo-rollouts",
+			Labels:    map[string]string{ShardGroupLabelKey: "shard"},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &identity,
+			LeaseDurationSeconds: &leaseDurationSeconds,
+			RenewTime:            &renew,
+		},
+	}
+}
+
+func newTestSharder(now time.Time, objs ...runtime.Object) (*Sharder, *fake.Clientset) {
+	client := fake.NewSimpleClientset(objs...)
+	s := NewSharder(client, Options{
+		ShardBy:       ShardByRollout,
+		Group:         "shard",
+		Namespace:     "argo-rollouts",
+		Identity:      "a",
+		LeaseDuration: 15 * time.Second,
+		RenewPeriod:   5 * time.Second,
+		HandoffDelay:  10 * time.Second,
+	})
+	s.now = func() time.Time { return now }
+	return s, client
+}
+
+func TestSharderSync(t *testing.T) {
+	now := time.Now()
+	s, client := newTestSharder(now, newLease("b", now), newLease("c", now.Add(-time.Minute)))
+	changes := 0
+	s.AddChangeHandler(func() { changes++ })
+
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"}}
+	assert.False(t, s.Owns(ro))
+
+	assert.NoError(t, s.Sync(context.TODO()))
+	assert.Equal(t, []string{"a", "b"}, s.Members())
+	// nothing is owned until the handoff is over
+	assert.Equal(t, 0, changes)
+	assert.False(t, s.Owns(ro))
+
+	// the lease of the replica was created and the expired lease was deleted
+	lease, err := client.CoordinationV1().Leases("argo-rollouts").Get(context.TODO(), "shard-a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", *lease.Spec.HolderIdentity)
+	_, err = client.CoordinationV1().Leases("argo-rollouts").Get(context.TODO(), "shard-c", metav1.GetOptions{})
+	assert.Error(t, err)
+
+	// syncing again renews the lease without changing the members
+	s.now = func() time.Time { return now.Add(5 * time.Second) }
+	assert.NoError(t, s.Sync(context.TODO()))
+	assert.Equal(t, 0, changes)
+	lease, err = client.CoordinationV1().Leases("argo-rollouts").Get(context.TODO(), "shard-a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, lease.Spec.RenewTime.Time.Equal(now.Add(5*time.Second)))
+
+	// the objects are handed off once the handoff delay passed
+	s.now = func() time.Time { return now.Add(10 * time.Second) }
+	assert.NoError(t, s.Sync(context.TODO()))
+	assert.Equal(t, 1, changes)
+	assert.Equal(t, NewRing([]string{"a", "b"}).Owner("default/guestbook") == "a", s.Owns(ro))
+}
+
+// ownedRollout returns a rollout which is owned by member in the ring of members
+func ownedRollout(t *testing.T, members []string, member string) *v1alpha1.Rollout {
+	ring := NewRing(members)
+	for i := 0; i < 100; i++ {
+		ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("rollout-%d", i), Namespace: "default"}}
+		if ring.Owner(Key(ro, ShardByRollout, nil)) == member {
+			return ro
+		}
+	}
+	t.Fatalf("no rollout is owned by %s", member)
+	return nil
+}
+
+func TestSharderHandoff(t *testing.T) {
+	now := time.Now()
+	s, client := newTestSharder(now)
+	assert.NoError(t, s.Sync(context.TODO()))
+	s.now = func() time.Time { return now.Add(10 * time.Second) }
+	assert.NoError(t, s.Sync(context.TODO()))
+
+	// a rollout which stays with "a" when "b" joins, and one which moves from "a" to "b"
+	staying := ownedRollout(t, []string{"a", "b"}, "a")
+	moving := ownedRollout(t, []string{"a", "b"}, "b")
+	assert.True(t, s.Owns(staying))
+	assert.True(t, s.Owns(moving))
+
+	// "b" joins and "a" gives up the moving rollout right away
+	_, err := client.CoordinationV1().Leases("argo-rollouts").Create(context.TODO(), newLease("b", now.Add(10*time.Second)), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	s.now = func() time.Time { return now.Add(15 * time.Second) }
+	assert.NoError(t, s.Sync(context.TODO()))
+	assert.True(t, s.Owns(staying))
+	assert.False(t, s.Owns(moving))
+
+	// "b" leaves and the moving rollout only comes back after the handoff delay
+	assert.NoError(t, client.CoordinationV1().Leases("argo-rollouts").Delete(context.TODO(), "shard-b", metav1.DeleteOptions{}))
+	s.now = func() time.Time { return now.Add(20 * time.Second) }
+	assert.NoError(t, s.Sync(context.TODO()))
+	assert.True(t, s.Owns(staying))
+	assert.False(t, s.Owns(moving))
+	s.now = func() time.Time { return now.Add(30 * time.Second) }
+	assert.NoError(t, s.Sync(context.TODO()))
+	assert.True(t, s.Owns(moving))
+}
+
+func TestSharderReleasesOnRenewFailure(t *testing.T) {
+	now := time.Now()
+	s, client := newTestSharder(now)
+	assert.NoError(t, s.Sync(context.TODO()))
+	s.now = func() time.Time { return now.Add(10 * time.Second) }
+	assert.NoError(t, s.Sync(context.TODO()))
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"}}
+	assert.True(t, s.Owns(ro))
+
+	client.PrependReactor("update", "leases", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("intentional error")
+	})
+	s.now = func() time.Time { return now.Add(15 * time.Second) }
+	assert.Error(t, s.Sync(context.TODO()))
+	assert.False(t, s.Owns(ro))
+	assert.Empty(t, s.Members())
+}
+
+func TestSharderReleasesOnLeaseExpiry(t *testing.T) {
+	now := time.Now()
+	s, _ := newTestSharder(now)
+	assert.NoError(t, s.Sync(context.TODO()))
+	s.now = func() time.Time { return now.Add(10 * time.Second) }
+	assert.NoError(t, s.Sync(context.TODO()))
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"}}
+	assert.True(t, s.Owns(ro))
+
+	// the lease was last renewed at +10s and expires at +25s without another sync
+	s.now = func() time.Time { return now.Add(25 * time.Second) }
+	assert.False(t, s.Owns(ro))
+}
+
+func TestNilSharderOwnsAll(t *testing.T) {
+	var s *Sharder
+	assert.True(t, s.Owns(&v1alpha1.Rollout{}))
+}
@@ -0,0 +1,138 @@
+package timewindow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	// embed the time zone database so windows can be evaluated in any time zone, independently of
+	// the image the controller runs in
+	_ "time/tzdata"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const minutesPerDay = 24 * 60
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// window is a parsed RolloutTimeWindow. start and end are minutes since midnight, where an end
+// before or equal to the start closes the window on the following day
+type window struct {
+	start int
+	end   int
+	days  map[time.Weekday]bool
+	loc   *time.Location
+}
+
+// Validate returns an error if the time window cannot be evaluated
+func Validate(tw v1alpha1.RolloutTimeWindow) error {
+	_, err := parse(tw)
+	return err
+}
+
+// NextOpen returns the earliest time at or after now at which the time window is open. If the
+// window is open at now, now is returned.
+func NextOpen(tw v1alpha1.RolloutTimeWindow, now time.Time) (time.Time, error) {
+	w, err := parse(tw)
+	if err != nil {
+		return time.Time{}, err
+	}
+	local := now.In(w.loc)
+	// the window which opened yesterday may span midnight and still be open
+	for offset := -1; offset <= 7; offset++ {
+		opens, closes, ok := w.occurrence(local, offset)
+		if !ok || !closes.After(now) {
+			continue
+		}
+		if opens.After(now) {
+			return opens, nil
+		}
+		return now, nil
+	}
+	// unreachable, since a valid window opens at least once a week
+	return time.Time{}, fmt.Errorf("time window never opens")
+}
+
+// occurrence returns the times at which the window opens and closes on the day offset from the
+// day of t, and whether the window opens on that day at all
+func (w *window) occurrence(t time.Time, offset int) (time.Time, time.Time, bool) {
+	year, month, day := t.Date()
+	midnight := time.Date(year, month, day+offset, 0, 0, 0, 0, w.loc)
+	if len(w.days) > 0 && !w.days[midnight.Weekday()] {
+		return time.Time{}, time.Time{}, false
+	}
+	end := w.end
+	if end <= w.start {
+		end += minutesPerDay
+	}
+	opens := time.Date(year, month, day+offset, 0, w.start, 0, 0, w.loc)
+	closes := time.Date(year, month, day+offset, 0, end, 0, 0, w.loc)
+	return opens, closes, true
+}
+
+func parse(tw v1alpha1.RolloutTimeWindow) (*window, error) {
+	w := &window{
+		end:  minutesPerDay,
+		days: map[time.Weekday]bool{},
+	}
+	var err error
+	if tw.StartTime != "" {
+		if w.start, err = parseTimeOfDay(tw.StartTime); err != nil {
+			return nil, err
+		}
+	}
+	if tw.EndTime != "" {
+		if w.end, err = parseTimeOfDay(tw.EndTime); err != nil {
+			return nil, err
+		}
+		if w.end == w.start {
+			return nil, fmt.Errorf("end time '%s' must differ from start time", tw.EndTime)
+		}
+	}
+	for _, d := range tw.DaysOfWeek {
+		weekday, ok := ParseWeekday(d)
+		if !ok {
+			return nil, fmt.Errorf("invalid day of week '%s'", d)
+		}
+		w.days[weekday] = true
+	}
+	if w.loc, err = LoadLocation(tw.TimeZone); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// ParseWeekday parses the three letter abbreviation of a day of week, e.g. 'mon' or 'Fri'
+func ParseWeekday(s string) (time.Weekday, bool) {
+	weekday, ok := weekdays[strings.ToLower(s)]
+	return weekday, ok
+}
+
+// LoadLocation returns the time zone with the given IANA name, or UTC if the name is empty
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone '%s': %v", name, err)
+	}
+	return loc, nil
+}
+
+// parseTimeOfDay parses a time of day in the HH:MM format into minutes since midnight
+func parseTimeOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day '%s': must be in the HH:MM format", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
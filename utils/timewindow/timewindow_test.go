@@ -0,0 +1,102 @@
+package timewindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	tm, err := time.Parse(time.RFC3339, value)
+	assert.NoError(t, err)
+	return tm
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(v1alpha1.RolloutTimeWindow{}))
+	assert.NoError(t, Validate(v1alpha1.RolloutTimeWindow{StartTime: "09:00", EndTime: "17:30", DaysOfWeek: []string{"Mon", "fri"}, TimeZone: "Europe/Berlin"}))
+	assert.EqualError(t, Validate(v1alpha1.RolloutTimeWindow{StartTime: "9am"}), "invalid time of day '9am': must be in the HH:MM format")
+	assert.EqualError(t, Validate(v1alpha1.RolloutTimeWindow{EndTime: "24:00"}), "invalid time of day '24:00': must be in the HH:MM format")
+	assert.EqualError(t, Validate(v1alpha1.RolloutTimeWindow{StartTime: "10:00", EndTime: "10:00"}), "end time '10:00' must differ from start time")
+	assert.EqualError(t, Validate(v1alpha1.RolloutTimeWindow{DaysOfWeek: []string{"Monday"}}), "invalid day of week 'Monday'")
+	assert.Contains(t, Validate(v1alpha1.RolloutTimeWindow{TimeZone: "Mars/Olympus"}).Error(), "invalid time zone 'Mars/Olympus'")
+}
+
+func TestNextOpen(t *testing.T) {
+	businessHours := v1alpha1.RolloutTimeWindow{
+		StartTime:  "09:00",
+		EndTime:    "17:00",
+		DaysOfWeek: []string{"Mon", "Tue", "Wed", "Thu", "Fri"},
+		TimeZone:   "Europe/Berlin",
+	}
+	overnight := v1alpha1.RolloutTimeWindow{
+		StartTime: "22:00",
+		EndTime:   "04:00",
+	}
+	tests := []struct {
+		name     string
+		window   v1alpha1.RolloutTimeWindow
+		now      string
+		expected string
+	}{
+		{"always open", v1alpha1.RolloutTimeWindow{}, "2021-03-10T12:00:00Z", "2021-03-10T12:00:00Z"},
+		{"after start time", v1alpha1.RolloutTimeWindow{StartTime: "02:00"}, "2021-03-10T01:00:00Z", "2021-03-10T02:00:00Z"},
+		{"after start time already open", v1alpha1.RolloutTimeWindow{StartTime: "02:00"}, "2021-03-10T23:59:00Z", "2021-03-10T23:59:00Z"},
+		{"business hours open", businessHours, "2021-03-10T10:00:00Z", "2021-03-10T10:00:00Z"},
+		{"business hours before opening", businessHours, "2021-03-10T07:00:00Z", "2021-03-10T08:00:00Z"},
+		{"business hours after closing", businessHours, "2021-03-10T16:00:00Z", "2021-03-11T08:00:00Z"},
+		{"business hours over the weekend", businessHours, "2021-03-12T17:00:00Z", "2021-03-15T08:00:00Z"},
+		{"business hours after daylight saving time change", businessHours, "2021-03-26T17:00:00Z", "2021-03-29T07:00:00Z"},
+		{"overnight before midnight", overnight, "2021-03-10T23:00:00Z", "2021-03-10T23:00:00Z"},
+		{"overnight after midnight", overnight, "2021-03-11T03:00:00Z", "2021-03-11T03:00:00Z"},
+		{"overnight closed", overnight, "2021-03-11T04:00:00Z", "2021-03-11T22:00:00Z"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			next, err := NextOpen(test.window, mustParseTime(t, test.now))
+			assert.NoError(t, err)
+			assert.True(t, mustParseTime(t, test.expected).Equal(next), "expected %s, got %s", test.expected, next.UTC())
+		})
+	}
+}
+
+func TestNextOpenOvernightRespectsDaysOfWeek(t *testing.T) {
+	// the window opening on Friday night is still open early on Saturday
+	window := v1alpha1.RolloutTimeWindow{StartTime: "22:00", EndTime: "04:00", DaysOfWeek: []string{"Fri"}}
+	now := mustParseTime(t, "2021-03-13T01:00:00Z")
+	next, err := NextOpen(window, now)
+	assert.NoError(t, err)
+	assert.Equal(t, now, next)
+
+	now = mustParseTime(t, "2021-03-13T23:00:00Z")
+	next, err = NextOpen(window, now)
+	assert.NoError(t, err)
+	assert.True(t, mustParseTime(t, "2021-03-19T22:00:00Z").Equal(next))
+}
+
+func TestNextOpenInvalid(t *testing.T) {
+	_, err := NextOpen(v1alpha1.RolloutTimeWindow{StartTime: "25:00"}, time.Now())
+	assert.Error(t, err)
+}
+
+func TestParseWeekday(t *testing.T) {
+	weekday, ok := ParseWeekday("Fri")
+	assert.True(t, ok)
+	assert.Equal(t, time.Friday, weekday)
+	_, ok = ParseWeekday("friday")
+	assert.False(t, ok)
+}
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := LoadLocation("")
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+	loc, err = LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", loc.String())
+	_, err = LoadLocation("Mars/Olympus")
+	assert.Contains(t, err.Error(), "invalid time zone 'Mars/Olympus'")
+}
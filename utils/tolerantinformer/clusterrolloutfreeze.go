@@ -0,0 +1,68 @@
+package tolerantinformer
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func NewTolerantClusterRolloutFreezeInformer(factory dynamicinformer.DynamicSharedInformerFactory) rolloutinformers.ClusterRolloutFreezeInformer {
+	return &tolerantClusterRolloutFreezeInformer{
+		delegate: factory.ForResource(v1alpha1.ClusterRolloutFreezeGVR),
+	}
+}
+
+type tolerantClusterRolloutFreezeInformer struct {
+	delegate informers.GenericInformer
+}
+
+func (i *tolerantClusterRolloutFreezeInformer) Informer() cache.SharedIndexInformer {
+	return i.delegate.Informer()
+}
+
+func (i *tolerantClusterRolloutFreezeInformer) Lister() rolloutlisters.ClusterRolloutFreezeLister {
+	return &tolerantClusterRolloutFreezeLister{
+		delegate: i.delegate.Lister(),
+	}
+}
+
+type tolerantClusterRolloutFreezeLister struct {
+	delegate cache.GenericLister
+}
+
+func (t *tolerantClusterRolloutFreezeLister) List(selector labels.Selector) ([]*v1alpha1.ClusterRolloutFreeze, error) {
+	objects, err := t.delegate.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return convertObjectsToClusterRolloutFreezes(objects)
+}
+
+func (t *tolerantClusterRolloutFreezeLister) Get(name string) (*v1alpha1.ClusterRolloutFreeze, error) {
+	object, err := t.delegate.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	v := &v1alpha1.ClusterRolloutFreeze{}
+	err = convertObject(object, v)
+	return v, err
+}
+
+func convertObjectsToClusterRolloutFreezes(objects []runtime.Object) ([]*v1alpha1.ClusterRolloutFreeze, error) {
+	var firstErr error
+	vs := make([]*v1alpha1.ClusterRolloutFreeze, len(objects))
+	for i, obj := range objects {
+		vs[i] = &v1alpha1.ClusterRolloutFreeze{}
+		err := convertObject(obj, vs[i])
+		if err != nil && firstErr != nil {
+			firstErr = err
+		}
+	}
+	return vs, firstErr
+}
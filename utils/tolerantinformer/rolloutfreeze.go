@@ -0,0 +1,86 @@
+package tolerantinformer
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func NewTolerantRolloutFreezeInformer(factory dynamicinformer.DynamicSharedInformerFactory) rolloutinformers.RolloutFreezeInformer {
+	return &tolerantRolloutFreezeInformer{
+		delegate: factory.ForResource(v1alpha1.RolloutFreezeGVR),
+	}
+}
+
+type tolerantRolloutFreezeInformer struct {
+	delegate informers.GenericInformer
+}
+
+func (i *tolerantRolloutFreezeInformer) Informer() cache.SharedIndexInformer {
+	return i.delegate.Informer()
+}
+
+func (i *tolerantRolloutFreezeInformer) Lister() rolloutlisters.RolloutFreezeLister {
+	return &tolerantRolloutFreezeLister{
+		delegate: i.delegate.Lister(),
+	}
+}
+
+type tolerantRolloutFreezeLister struct {
+	delegate cache.GenericLister
+}
+
+func (t *tolerantRolloutFreezeLister) List(selector labels.Selector) ([]*v1alpha1.RolloutFreeze, error) {
+	objects, err := t.delegate.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return convertObjectsToRolloutFreezes(objects)
+}
+
+func (t *tolerantRolloutFreezeLister) RolloutFreezes(namespace string) rolloutlisters.RolloutFreezeNamespaceLister {
+	return &tolerantRolloutFreezeNamespaceLister{
+		delegate: t.delegate.ByNamespace(namespace),
+	}
+}
+
+type tolerantRolloutFreezeNamespaceLister struct {
+	delegate cache.GenericNamespaceLister
+}
+
+func (t *tolerantRolloutFreezeNamespaceLister) Get(name string) (*v1alpha1.RolloutFreeze, error) {
+	object, err := t.delegate.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	v := &v1alpha1.RolloutFreeze{}
+	err = convertObject(object, v)
+	return v, err
+}
+
+func (t *tolerantRolloutFreezeNamespaceLister) List(selector labels.Selector) ([]*v1alpha1.RolloutFreeze, error) {
+	objects, err := t.delegate.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return convertObjectsToRolloutFreezes(objects)
+}
+
+func convertObjectsToRolloutFreezes(objects []runtime.Object) ([]*v1alpha1.RolloutFreeze, error) {
+	var firstErr error
+	vs := make([]*v1alpha1.RolloutFreeze, len(objects))
+	for i, obj := range objects {
+		vs[i] = &v1alpha1.RolloutFreeze{}
+		err := convertObject(obj, vs[i])
+		if err != nil && firstErr != nil {
+			firstErr = err
+		}
+	}
+	return vs, firstErr
+}
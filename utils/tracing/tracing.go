@@ -0,0 +1,169 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/version"
+)
+
+const (
+	// tracerName is the name of the tracer which creates the spans of the controller
+	tracerName = "github.com/argoproj/argo-rollouts"
+	// serviceName is the name of the controller in the traces
+	serviceName = "argo-rollouts"
+)
+
+// Attribute keys of the spans of the controller
+const (
+	NamespaceKey        = attribute.Key("k8s.namespace.name")
+	RolloutKey          = attribute.Key("rollout.name")
+	RolloutRevisionKey  = attribute.Key("rollout.revision")
+	RolloutStepKey      = attribute.Key("rollout.step")
+	ExperimentKey       = attribute.Key("experiment.name")
+	AnalysisRunKey      = attribute.Key("analysisrun.name")
+	MetricKey           = attribute.Key("metric.name")
+	MetricProviderKey   = attribute.Key("metric.provider")
+	MeasurementPhaseKey = attribute.Key("measurement.phase")
+	TrafficRouterKey    = attribute.Key("trafficrouting.type")
+	DesiredWeightKey    = attribute.Key("trafficrouting.desired_weight")
+	VerifiedKey         = attribute.Key("trafficrouting.verified")
+)
+
+// Options configures the export of the traces of the controller
+type Options struct {
+	// Address is the address of the OTLP gRPC collector. Tracing is turned off if the address is empty.
+	Address string
+	// Insecure disables the transport security of the connection to the collector
+	Insecure bool
+	// SampleRatio is the ratio of the reconciliations which are traced
+	SampleRatio float64
+}
+
+// Init registers a tracer provider which exports the spans of the controller to the OTLP collector,
+// and returns a function which flushes the remaining spans on shutdown. When tracing is turned off,
+// the default no-op tracer provider is kept, so that creating spans is free.
+func Init(opts Options) (func(context.Context) error, error) {
+	if opts.Address == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Address)}
+	if opts.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(context.Background(), exporterOpts...)
+	if err != nil {
+		return nil, err
+	}
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceNameKey.String(serviceName),
+		semconv.ServiceVersionKey.String(version.GetVersion().Version),
+	)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	log.Infof("Exporting traces to %s", opts.Address)
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span which is a child of the span in the context, if any
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span, and marks it as failed if the operation returned an error
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// RolloutAttributes returns the attributes which identify the rollout and its progress
+func RolloutAttributes(ro *v1alpha1.Rollout) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		NamespaceKey.String(ro.Namespace),
+		RolloutKey.String(ro.Name),
+	}
+	if revision, ok := ro.Annotations[annotations.RevisionAnnotation]; ok {
+		attrs = append(attrs, RolloutRevisionKey.String(revision))
+	}
+	if ro.Status.CurrentStepIndex != nil {
+		attrs = append(attrs, RolloutStepKey.Int64(int64(*ro.Status.CurrentStepIndex)))
+	}
+	return attrs
+}
+
+// ExperimentAttributes returns the attributes which identify the experiment
+func ExperimentAttributes(ex *v1alpha1.Experiment) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		NamespaceKey.String(ex.Namespace),
+		ExperimentKey.String(ex.Name),
+	}
+}
+
+// AnalysisRunAttributes returns the attributes which identify the analysis run
+func AnalysisRunAttributes(run *v1alpha1.AnalysisRun) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		NamespaceKey.String(run.Namespace),
+		AnalysisRunKey.String(run.Name),
+	}
+}
+
+// AddTracingTransportWrapper wraps the transport of the Kubernetes clients, so that the writes which
+// are made during a traced reconciliation are recorded as child spans of the reconciliation. Reads
+// are served from the informer caches and are not traced.
+func AddTracingTransportWrapper(config *rest.Config) *rest.Config {
+	wrap := config.WrapTransport
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrap != nil {
+			rt = wrap(rt)
+		}
+		return &tracingRoundTripper{roundTripper: rt}
+	}
+	return config
+}
+
+type tracingRoundTripper struct {
+	roundTripper http.RoundTripper
+}
+
+func (rt *tracingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if req.Method == http.MethodGet || !trace.SpanContextFromContext(ctx).IsValid() {
+		return rt.roundTripper.RoundTrip(req)
+	}
+	_, span := StartSpan(ctx, "k8s."+strings.ToLower(req.Method),
+		semconv.HTTPMethodKey.String(req.Method),
+		semconv.HTTPTargetKey.String(req.URL.Path),
+	)
+	res, err := rt.roundTripper.RoundTrip(req)
+	if err == nil {
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(res.StatusCode))
+		if res.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, res.Status)
+		}
+	}
+	EndSpan(span, err)
+	return res, err
+}
@@ -0,0 +1,109 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+func newSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestInitDisabled(t *testing.T) {
+	shutdown, err := Init(Options{})
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.TODO()))
+}
+
+func TestStartAndEndSpan(t *testing.T) {
+	recorder := newSpanRecorder(t)
+	ctx, parent := StartSpan(context.TODO(), "parent")
+	_, child := StartSpan(ctx, "child")
+	EndSpan(child, errors.New("intentional error"))
+	EndSpan(parent, nil)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "intentional error", spans[0].Status().Description)
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+}
+
+func TestRolloutAttributes(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "guestbook",
+			Namespace:   "default",
+			Annotations: map[string]string{annotations.RevisionAnnotation: "3"},
+		},
+		Status: v1alpha1.RolloutStatus{CurrentStepIndex: pointer.Int32Ptr(2)},
+	}
+	assert.Equal(t, []attribute.KeyValue{
+		NamespaceKey.String("default"),
+		RolloutKey.String("guestbook"),
+		RolloutRevisionKey.String("3"),
+		RolloutStepKey.Int64(2),
+	}, RolloutAttributes(ro))
+	assert.Len(t, RolloutAttributes(&v1alpha1.Rollout{}), 2)
+}
+
+func TestTracingRoundTripper(t *testing.T) {
+	recorder := newSpanRecorder(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := AddTracingTransportWrapper(&rest.Config{})
+	client := &http.Client{Transport: config.WrapTransport(http.DefaultTransport)}
+	do := func(ctx context.Context, method string) {
+		req, err := http.NewRequestWithContext(ctx, method, server.URL+"/apis/argoproj.io/v1alpha1/namespaces/default/rollouts/guestbook", nil)
+		assert.NoError(t, err)
+		res, err := client.Do(req)
+		assert.NoError(t, err)
+		res.Body.Close()
+	}
+
+	// requests outside of a reconciliation are not traced
+	do(context.TODO(), http.MethodPatch)
+	assert.Empty(t, recorder.Ended())
+
+	ctx, span := StartSpan(context.TODO(), "rollout.reconcile")
+	// reads are not traced
+	do(ctx, http.MethodGet)
+	do(ctx, http.MethodPatch)
+	do(ctx, http.MethodDelete)
+	span.End()
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 3)
+	assert.Equal(t, "k8s.patch", spans[0].Name())
+	assert.Equal(t, span.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, "k8s.delete", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
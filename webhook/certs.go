@@ -0,0 +1,282 @@
+package webhook
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// certValidity is the validity of the generated CA and serving certificates
+	certValidity = 365 * 24 * time.Hour
+	// certRotationThreshold is the remaining validity at which the certificates are regenerated
+	certRotationThreshold = 30 * 24 * time.Hour
+	// caCertKey is the key of the CA bundle in the certificate Secret
+	caCertKey = "ca.crt"
+)
+
+// certManager manages the self-signed certificates of the webhook server, which are stored in a
+// Secret so that all the replicas of the controller serve the same certificate, and the
+// ValidatingWebhookConfiguration which points the API server to the webhook Service.
+type certManager struct {
+	client kubernetes.Interface
+	cfg    ServerConfig
+
+	mutex sync.RWMutex
+	cert  *tls.Certificate
+
+	// used for unit testing
+	now func() time.Time
+}
+
+// getCertificate returns the current serving certificate
+func (m *certManager) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	if m.cert == nil {
+		return nil, fmt.Errorf("webhook certificate is not loaded")
+	}
+	return m.cert, nil
+}
+
+// sync ensures that the Secret holds a valid certificate, loads it, and registers its CA in the
+// ValidatingWebhookConfiguration
+func (m *certManager) sync(ctx context.Context) error {
+	secret, err := m.ensureSecret(ctx)
+	if err != nil {
+		return err
+	}
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return err
+	}
+	m.mutex.Lock()
+	m.cert = &cert
+	m.mutex.Unlock()
+	return m.ensureWebhookConfiguration(ctx, secret.Data[caCertKey])
+}
+
+func (m *certManager) ensureSecret(ctx context.Context) (*corev1.Secret, error) {
+	secrets := m.client.CoreV1().Secrets(m.cfg.Namespace)
+	secret, err := secrets.Get(ctx, m.cfg.SecretName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      m.cfg.SecretName,
+				Namespace: m.cfg.Namespace,
+			},
+			Type: corev1.SecretTypeTLS,
+		}
+		if err := m.generate(secret); err != nil {
+			return nil, err
+		}
+		created, err := secrets.Create(ctx, secret, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			// Another replica created the certificate first
+			return secrets.Get(ctx, m.cfg.SecretName, metav1.GetOptions{})
+		}
+		if err == nil {
+			log.Infof("Created webhook certificate in secret '%s'", m.cfg.SecretName)
+		}
+		return created, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	if m.valid(secret) {
+		return secret, nil
+	}
+	secret = secret.DeepCopy()
+	if err := m.generate(secret); err != nil {
+		return nil, err
+	}
+	updated, err := secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	if err == nil {
+		log.Infof("Rotated webhook certificate in secret '%s'", m.cfg.SecretName)
+	}
+	return updated, err
+}
+
+// valid returns whether the secret holds a certificate for the webhook Service which does not expire soon
+func (m *certManager) valid(secret *corev1.Secret) bool {
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+	if _, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]); err != nil {
+		return false
+	}
+	return cert.NotAfter.Sub(m.now()) > certRotationThreshold && reflect.DeepEqual(cert.DNSNames, m.dnsNames())
+}
+
+// generate stores a new CA and serving certificate in the secret. The previous CA is kept in the CA
+// bundle, so that the API server trusts the replicas which still serve the previous certificate.
+func (m *certManager) generate(secret *corev1.Secret) error {
+	caPEM, certPEM, keyPEM, err := generateCertificates(m.dnsNames(), m.now())
+	if err != nil {
+		return err
+	}
+	if previous, _ := pem.Decode(secret.Data[caCertKey]); previous != nil {
+		caPEM = append(caPEM, pem.EncodeToMemory(previous)...)
+	}
+	secret.Data = map[string][]byte{
+		caCertKey:               caPEM,
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+	}
+	return nil
+}
+
+func (m *certManager) dnsNames() []string {
+	svc := m.cfg.ServiceName
+	ns := m.cfg.Namespace
+	return []string{
+		svc,
+		fmt.Sprintf("%s.%s", svc, ns),
+		fmt.Sprintf("%s.%s.svc", svc, ns),
+		fmt.Sprintf("%s.%s.svc.cluster.local", svc, ns),
+	}
+}
+
+// generateCertificates returns a self-signed CA, and a serving certificate and key for the DNS names
+// which is signed by the CA, encoded as PEM
+func generateCertificates(dnsNames []string, now time.Time) ([]byte, []byte, []byte, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(now.UnixNano()),
+		Subject:               pkix.Name{CommonName: "argo-rollouts-webhook-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(now.UnixNano() + 1),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(certValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return caPEM, certPEM, keyPEM, nil
+}
+
+// newWebhookConfiguration returns the ValidatingWebhookConfiguration which sends the Rollouts,
+// Experiments and AnalysisTemplates of the controller instance to the webhook Service
+func (m *certManager) newWebhookConfiguration(caBundle []byte) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	path := ValidatePath
+	port := int32(443)
+	failurePolicy := m.cfg.FailurePolicy
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	scope := admissionregistrationv1.AllScopes
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: m.cfg.WebhookName,
+		},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name: "validation.rollouts.argoproj.io",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{
+					Namespace: m.cfg.Namespace,
+					Name:      m.cfg.ServiceName,
+					Path:      &path,
+					Port:      &port,
+				},
+				CABundle: caBundle,
+			},
+			Rules: []admissionregistrationv1.RuleWithOperations{{
+				Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{"argoproj.io"},
+					APIVersions: []string{"v1alpha1"},
+					Resources:   []string{"rollouts", "experiments", "analysistemplates", "clusteranalysistemplates", "rolloutfreezes", "clusterrolloutfreezes"},
+					Scope:       &scope,
+				},
+			}},
+			FailurePolicy:           &failurePolicy,
+			ObjectSelector:          m.cfg.ObjectSelector,
+			SideEffects:             &sideEffects,
+			AdmissionReviewVersions: []string{"v1"},
+		}},
+	}
+}
+
+func (m *certManager) ensureWebhookConfiguration(ctx context.Context, caBundle []byte) error {
+	configs := m.client.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+	desired := m.newWebhookConfiguration(caBundle)
+	existing, err := configs.Get(ctx, desired.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = configs.Create(ctx, desired, metav1.CreateOptions{})
+		if err == nil {
+			log.Infof("Created ValidatingWebhookConfiguration '%s'", desired.Name)
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	// Only the fields set by the controller are compared, since the API server defaults the others
+	if len(existing.Webhooks) == 1 && reflect.DeepEqual(existing.Webhooks[0].ClientConfig, desired.Webhooks[0].ClientConfig) &&
+		reflect.DeepEqual(existing.Webhooks[0].Rules, desired.Webhooks[0].Rules) &&
+		reflect.DeepEqual(existing.Webhooks[0].FailurePolicy, desired.Webhooks[0].FailurePolicy) &&
+		reflect.DeepEqual(existing.Webhooks[0].ObjectSelector, desired.Webhooks[0].ObjectSelector) {
+		return nil
+	}
+	updated := existing.DeepCopy()
+	updated.Webhooks = desired.Webhooks
+	_, err = configs.Update(ctx, updated, metav1.UpdateOptions{})
+	if err == nil {
+		log.Infof("Updated ValidatingWebhookConfiguration '%s'", desired.Name)
+	}
+	return err
+}
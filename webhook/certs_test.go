@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestCertManager(client *fake.Clientset, now time.Time) *certManager {
+	return &certManager{
+		client: client,
+		cfg: ServerConfig{
+			Namespace:     "argo-rollouts",
+			ServiceName:   "argo-rollouts-webhook",
+			SecretName:    "argo-rollouts-webhook-tls",
+			WebhookName:   "argo-rollouts-validating-webhook",
+			FailurePolicy: admissionregistrationv1.Fail,
+		},
+		now: func() time.Time { return now },
+	}
+}
+
+func TestCertManagerSync(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	m := newTestCertManager(client, now)
+	assert.NoError(t, m.sync(context.TODO()))
+
+	secret, err := client.CoreV1().Secrets("argo-rollouts").Get(context.TODO(), "argo-rollouts-webhook-tls", metav1.GetOptions{})
+	assert.NoError(t, err)
+	cert, err := m.getCertificate(nil)
+	assert.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.NoError(t, err)
+	assert.Contains(t, leaf.DNSNames, "argo-rollouts-webhook.argo-rollouts.svc")
+
+	// the serving certificate is signed by the CA which is registered with the API server
+	config, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(context.TODO(), "argo-rollouts-validating-webhook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	webhook := config.Webhooks[0]
+	assert.Equal(t, secret.Data[caCertKey], webhook.ClientConfig.CABundle)
+	assert.Equal(t, admissionregistrationv1.Fail, *webhook.FailurePolicy)
+	assert.Equal(t, "argo-rollouts-webhook", webhook.ClientConfig.Service.Name)
+	roots := x509.NewCertPool()
+	assert.True(t, roots.AppendCertsFromPEM(webhook.ClientConfig.CABundle))
+	_, err = leaf.Verify(x509.VerifyOptions{DNSName: "argo-rollouts-webhook.argo-rollouts.svc", Roots: roots})
+	assert.NoError(t, err)
+
+	// syncing again keeps the certificate and configuration
+	client.ClearActions()
+	assert.NoError(t, m.sync(context.TODO()))
+	for _, action := range client.Actions() {
+		assert.Equal(t, "get", action.GetVerb())
+	}
+}
+
+func TestCertManagerRotate(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	m := newTestCertManager(client, now)
+	assert.NoError(t, m.sync(context.TODO()))
+	secret, err := client.CoreV1().Secrets("argo-rollouts").Get(context.TODO(), "argo-rollouts-webhook-tls", metav1.GetOptions{})
+	assert.NoError(t, err)
+	previousCA := secret.Data[caCertKey]
+
+	// the certificate is rotated when it is about to expire
+	m.now = func() time.Time { return now.Add(certValidity - certRotationThreshold + time.Hour) }
+	assert.NoError(t, m.sync(context.TODO()))
+	secret, err = client.CoreV1().Secrets("argo-rollouts").Get(context.TODO(), "argo-rollouts-webhook-tls", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, previousCA, secret.Data[caCertKey])
+
+	// the CA bundle contains the new and the previous CA
+	block, rest := pem.Decode(secret.Data[caCertKey])
+	assert.NotNil(t, block)
+	assert.Equal(t, previousCA, rest)
+
+	config, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(context.TODO(), "argo-rollouts-validating-webhook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, secret.Data[caCertKey], config.Webhooks[0].ClientConfig.CABundle)
+}
+
+func TestCertManagerInvalidSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argo-rollouts-webhook-tls", Namespace: "argo-rollouts"},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("invalid")},
+	}
+	client := fake.NewSimpleClientset(secret)
+	m := newTestCertManager(client, time.Now())
+	assert.NoError(t, m.sync(context.TODO()))
+	_, err := m.getCertificate(nil)
+	assert.NoError(t, err)
+}
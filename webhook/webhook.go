@@ -0,0 +1,240 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/freeze"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+)
+
+const (
+	// ValidatePath is the endpoint of the webhook which validates objects
+	ValidatePath = "/validate"
+
+	// certSyncPeriod is the interval at which the certificate is reloaded and rotated before it expires
+	certSyncPeriod = time.Hour
+)
+
+// ServerConfig describes the data required to instantiate a new webhook server
+type ServerConfig struct {
+	Addr          string
+	KubeClientSet kubernetes.Interface
+	// Namespace is the namespace of the controller, which contains the webhook Service and certificate Secret
+	Namespace   string
+	ServiceName string
+	SecretName  string
+	// WebhookName is the name of the ValidatingWebhookConfiguration
+	WebhookName string
+	// ObjectSelector selects the objects which are operated on by the controller instance
+	ObjectSelector *metav1.LabelSelector
+	// FailurePolicy is either Ignore (fail-open) or Fail (fail-closed) when the webhook is unavailable
+	FailurePolicy admissionregistrationv1.FailurePolicyType
+	// RolloutValidator validates a rollout and the resources it references
+	RolloutValidator func(*v1alpha1.Rollout) error
+	// TemplateGetter resolves the templates included by analysis templates
+	TemplateGetter analysisutil.TemplateGetter
+}
+
+// Server is a validating admission webhook which rejects invalid Rollouts, Experiments,
+// AnalysisTemplates and freezes before they are persisted, instead of the controller reporting an
+// InvalidSpec condition or event later
+type Server struct {
+	*http.Server
+	certs            *certManager
+	rolloutValidator func(*v1alpha1.Rollout) error
+	templateGetter   analysisutil.TemplateGetter
+}
+
+// NewServer returns a new webhook server
+func NewServer(cfg ServerConfig) *Server {
+	certs := &certManager{
+		client: cfg.KubeClientSet,
+		cfg:    cfg,
+		now:    time.Now,
+	}
+	s := &Server{
+		certs:            certs,
+		rolloutValidator: cfg.RolloutValidator,
+		templateGetter:   cfg.TemplateGetter,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(ValidatePath, s.handleValidate)
+	s.Server = &http.Server{
+		Addr:    cfg.Addr,
+		Handler: mux,
+		TLSConfig: &tls.Config{
+			GetCertificate: certs.getCertificate,
+		},
+	}
+	return s
+}
+
+// Run loads the certificate, registers the webhook with the API server and serves requests. The
+// certificate is reloaded periodically, so that it is rotated before it expires.
+func (s *Server) Run(stopCh <-chan struct{}) error {
+	if err := s.certs.sync(context.TODO()); err != nil {
+		return errors.Wrap(err, "failed to set up webhook certificate")
+	}
+	go wait.Until(func() {
+		if err := s.certs.sync(context.TODO()); err != nil {
+			log.Errorf("Failed to sync webhook certificate: %v", err)
+		}
+	}, certSyncPeriod, stopCh)
+	go func() {
+		<-stopCh
+		_ = s.Shutdown(context.Background())
+	}()
+	log.Infof("Starting Webhook Server at %s", s.Addr)
+	err := s.ListenAndServeTLS("", "")
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review := admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, &review); err != nil || review.Request == nil {
+		http.Error(w, "invalid admission review", http.StatusBadRequest)
+		return
+	}
+	review.Response = s.validate(review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		log.Errorf("Failed to write admission review: %v", err)
+	}
+}
+
+// validate admits the object of the request if it is valid. Updates of objects which were already
+// invalid are admitted, so that the controller and users can still operate on existing objects.
+func (s *Server) validate(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	var validateFunc func(raw []byte) error
+	switch req.Kind.Kind {
+	case "Rollout":
+		validateFunc = func(raw []byte) error { return s.validateRollout(raw, req.Namespace) }
+	case "Experiment":
+		validateFunc = validateExperiment
+	case "AnalysisTemplate":
+		validateFunc = func(raw []byte) error { return s.validateAnalysisTemplate(raw, req.Namespace) }
+	case "ClusterAnalysisTemplate":
+		validateFunc = s.validateClusterAnalysisTemplate
+	case "RolloutFreeze":
+		validateFunc = validateRolloutFreeze
+	case "ClusterRolloutFreeze":
+		validateFunc = validateClusterRolloutFreeze
+	default:
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	err := validateFunc(req.Object.Raw)
+	if err != nil && req.Operation == admissionv1.Update && len(req.OldObject.Raw) > 0 && validateFunc(req.OldObject.Raw) != nil {
+		log.WithField(logutil.NamespaceKey, req.Namespace).Infof("Admitting update of invalid %s '%s'", req.Kind.Kind, req.Name)
+		err = nil
+	}
+	if err != nil {
+		return &admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Reason:  metav1.StatusReasonInvalid,
+				Code:    http.StatusUnprocessableEntity,
+				Message: fmt.Sprintf("The %s \"%s\" is invalid: %s", req.Kind.Kind, req.Name, err.Error()),
+			},
+		}
+	}
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func (s *Server) validateRollout(raw []byte, namespace string) error {
+	ro := v1alpha1.Rollout{}
+	if err := json.Unmarshal(raw, &ro); err != nil {
+		return err
+	}
+	if ro.Namespace == "" {
+		ro.Namespace = namespace
+	}
+	return s.rolloutValidator(&ro)
+}
+
+func validateExperiment(raw []byte) error {
+	ex := v1alpha1.Experiment{}
+	if err := json.Unmarshal(raw, &ex); err != nil {
+		return err
+	}
+	if cond := conditions.VerifyExperimentSpec(&ex, nil); cond != nil {
+		return fmt.Errorf("%s", cond.Message)
+	}
+	return nil
+}
+
+func (s *Server) validateAnalysisTemplate(raw []byte, namespace string) error {
+	template := v1alpha1.AnalysisTemplate{}
+	if err := json.Unmarshal(raw, &template); err != nil {
+		return err
+	}
+	if template.Namespace == "" {
+		template.Namespace = namespace
+	}
+	return s.validateTemplates([]*v1alpha1.AnalysisTemplate{&template}, nil)
+}
+
+func (s *Server) validateClusterAnalysisTemplate(raw []byte) error {
+	template := v1alpha1.ClusterAnalysisTemplate{}
+	if err := json.Unmarshal(raw, &template); err != nil {
+		return err
+	}
+	return s.validateTemplates(nil, []*v1alpha1.ClusterAnalysisTemplate{&template})
+}
+
+func validateRolloutFreeze(raw []byte) error {
+	f := v1alpha1.RolloutFreeze{}
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return err
+	}
+	return freeze.ValidateSpec(f.Spec)
+}
+
+func validateClusterRolloutFreeze(raw []byte) error {
+	f := v1alpha1.ClusterRolloutFreeze{}
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return err
+	}
+	return freeze.ValidateSpec(f.Spec)
+}
+
+// validateTemplates validates the metrics of the templates, including the metrics of the templates
+// they include. Templates without metrics are admitted, since they can be included to share args.
+func (s *Server) validateTemplates(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) error {
+	flattened, err := analysisutil.FlattenTemplates(templates, clusterTemplates, s.templateGetter)
+	if err != nil {
+		return err
+	}
+	if len(flattened.Spec.Metrics) == 0 {
+		return nil
+	}
+	return analysisutil.ValidateMetrics(flattened.Spec.Metrics)
+}
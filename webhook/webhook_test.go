@@ -0,0 +1,153 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newTestServer() *Server {
+	return NewServer(ServerConfig{
+		RolloutValidator: func(ro *v1alpha1.Rollout) error {
+			if ro.Spec.Strategy.Canary == nil && ro.Spec.Strategy.BlueGreen == nil {
+				return fmt.Errorf("missing strategy")
+			}
+			return nil
+		},
+	})
+}
+
+func newAdmissionReview(t *testing.T, kind string, operation admissionv1.Operation, obj, oldObj runtime.Object) []byte {
+	req := &admissionv1.AdmissionRequest{
+		UID:       types.UID("abc-123"),
+		Kind:      metav1.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: kind},
+		Name:      "foo",
+		Namespace: "default",
+		Operation: operation,
+	}
+	raw, err := json.Marshal(obj)
+	assert.NoError(t, err)
+	req.Object.Raw = raw
+	if oldObj != nil {
+		raw, err = json.Marshal(oldObj)
+		assert.NoError(t, err)
+		req.OldObject.Raw = raw
+	}
+	body, err := json.Marshal(admissionv1.AdmissionReview{Request: req})
+	assert.NoError(t, err)
+	return body
+}
+
+func review(t *testing.T, s *Server, body []byte) *admissionv1.AdmissionResponse {
+	req := httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	s.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	res := admissionv1.AdmissionReview{}
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	assert.Equal(t, types.UID("abc-123"), res.Response.UID)
+	return res.Response
+}
+
+func TestValidateRollout(t *testing.T) {
+	s := newTestServer()
+	valid := &v1alpha1.Rollout{Spec: v1alpha1.RolloutSpec{Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}}}}
+	invalid := &v1alpha1.Rollout{}
+
+	res := review(t, s, newAdmissionReview(t, "Rollout", admissionv1.Create, valid, nil))
+	assert.True(t, res.Allowed)
+
+	res = review(t, s, newAdmissionReview(t, "Rollout", admissionv1.Create, invalid, nil))
+	assert.False(t, res.Allowed)
+	assert.Equal(t, `The Rollout "foo" is invalid: missing strategy`, res.Result.Message)
+	assert.Equal(t, metav1.StatusReasonInvalid, res.Result.Reason)
+
+	res = review(t, s, newAdmissionReview(t, "Rollout", admissionv1.Update, invalid, valid))
+	assert.False(t, res.Allowed)
+
+	// updates of rollouts which were already invalid are admitted
+	res = review(t, s, newAdmissionReview(t, "Rollout", admissionv1.Update, invalid, invalid))
+	assert.True(t, res.Allowed)
+}
+
+func TestValidateExperiment(t *testing.T) {
+	s := newTestServer()
+	ex := &v1alpha1.Experiment{Spec: v1alpha1.ExperimentSpec{Templates: []v1alpha1.TemplateSpec{{Name: "baseline"}}}}
+	res := review(t, s, newAdmissionReview(t, "Experiment", admissionv1.Create, ex, nil))
+	assert.False(t, res.Allowed)
+	assert.Contains(t, res.Result.Message, ".Spec.Templates[0].Selector")
+
+	ex.Spec.Templates[0].Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "baseline"}}
+	res = review(t, s, newAdmissionReview(t, "Experiment", admissionv1.Create, ex, nil))
+	assert.True(t, res.Allowed)
+}
+
+func TestValidateAnalysisTemplate(t *testing.T) {
+	s := newTestServer()
+	count := intstr.FromInt(2)
+	template := &v1alpha1.AnalysisTemplate{Spec: v1alpha1.AnalysisTemplateSpec{
+		Metrics: []v1alpha1.Metric{{
+			Name:     "success-rate",
+			Count:    &count,
+			Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}},
+		}},
+	}}
+	res := review(t, s, newAdmissionReview(t, "AnalysisTemplate", admissionv1.Create, template, nil))
+	assert.False(t, res.Allowed)
+	assert.Equal(t, `The AnalysisTemplate "foo" is invalid: metrics[0]: interval must be specified when count > 1`, res.Result.Message)
+
+	template.Spec.Metrics[0].Interval = "1m"
+	res = review(t, s, newAdmissionReview(t, "AnalysisTemplate", admissionv1.Create, template, nil))
+	assert.True(t, res.Allowed)
+
+	clusterTemplate := &v1alpha1.ClusterAnalysisTemplate{Spec: v1alpha1.AnalysisTemplateSpec{
+		Includes: []v1alpha1.AnalysisTemplateInclude{{TemplateName: "base", ClusterScope: true}},
+	}}
+	res = review(t, s, newAdmissionReview(t, "ClusterAnalysisTemplate", admissionv1.Create, clusterTemplate, nil))
+	assert.False(t, res.Allowed)
+}
+
+func TestValidateRolloutFreeze(t *testing.T) {
+	s := newTestServer()
+	f := &v1alpha1.RolloutFreeze{Spec: v1alpha1.RolloutFreezeSpec{
+		Windows: []v1alpha1.FreezeWindow{{Schedule: "0 17 * fri", Duration: "1h"}},
+	}}
+	res := review(t, s, newAdmissionReview(t, "RolloutFreeze", admissionv1.Create, f, nil))
+	assert.False(t, res.Allowed)
+	assert.Contains(t, res.Result.Message, `The RolloutFreeze "foo" is invalid: invalid window 0`)
+
+	f.Spec.Windows[0].Schedule = "0 17 * * fri"
+	res = review(t, s, newAdmissionReview(t, "RolloutFreeze", admissionv1.Create, f, nil))
+	assert.True(t, res.Allowed)
+
+	clusterFreeze := &v1alpha1.ClusterRolloutFreeze{}
+	res = review(t, s, newAdmissionReview(t, "ClusterRolloutFreeze", admissionv1.Create, clusterFreeze, nil))
+	assert.False(t, res.Allowed)
+	assert.Equal(t, `The ClusterRolloutFreeze "foo" is invalid: freeze must have at least one window`, res.Result.Message)
+}
+
+func TestValidateUnknownKind(t *testing.T) {
+	s := newTestServer()
+	res := review(t, s, newAdmissionReview(t, "AnalysisRun", admissionv1.Create, &v1alpha1.AnalysisRun{}, nil))
+	assert.True(t, res.Allowed)
+}
+
+func TestInvalidAdmissionReview(t *testing.T) {
+	s := newTestServer()
+	req := httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewReader([]byte("{}")))
+	rr := httptest.NewRecorder()
+	s.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}